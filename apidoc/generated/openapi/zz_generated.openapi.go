@@ -29,404 +29,430 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.CommandArgsOverrider":           schema_pkg_apis_apps_v1alpha1_CommandArgsOverrider(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplication":                schema_pkg_apis_apps_v1alpha1_EdgeApplication(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationList":            schema_pkg_apis_apps_v1alpha1_EdgeApplicationList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationSpec":            schema_pkg_apis_apps_v1alpha1_EdgeApplicationSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationStatus":          schema_pkg_apis_apps_v1alpha1_EdgeApplicationStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EnvOverrider":                   schema_pkg_apis_apps_v1alpha1_EnvOverrider(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImageOverrider":                 schema_pkg_apis_apps_v1alpha1_ImageOverrider(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImagePredicate":                 schema_pkg_apis_apps_v1alpha1_ImagePredicate(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.Manifest":                       schema_pkg_apis_apps_v1alpha1_Manifest(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ManifestStatus":                 schema_pkg_apis_apps_v1alpha1_ManifestStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroup":                      schema_pkg_apis_apps_v1alpha1_NodeGroup(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupList":                  schema_pkg_apis_apps_v1alpha1_NodeGroupList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupSpec":                  schema_pkg_apis_apps_v1alpha1_NodeGroupSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupStatus":                schema_pkg_apis_apps_v1alpha1_NodeGroupStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeStatus":                     schema_pkg_apis_apps_v1alpha1_NodeStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.Overriders":                     schema_pkg_apis_apps_v1alpha1_Overriders(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourceIdentifier":             schema_pkg_apis_apps_v1alpha1_ResourceIdentifier(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourceTemplate":               schema_pkg_apis_apps_v1alpha1_ResourceTemplate(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourcesOverrider":             schema_pkg_apis_apps_v1alpha1_ResourcesOverrider(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.TargetNodeGroup":                schema_pkg_apis_apps_v1alpha1_TargetNodeGroup(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.WorkloadScope":                  schema_pkg_apis_apps_v1alpha1_WorkloadScope(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.BluetoothOperations":         schema_pkg_apis_devices_v1alpha2_BluetoothOperations(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.BluetoothReadConverter":      schema_pkg_apis_devices_v1alpha2_BluetoothReadConverter(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.CustomizedValue":             schema_pkg_apis_devices_v1alpha2_CustomizedValue(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DataProperty":                schema_pkg_apis_devices_v1alpha2_DataProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.Device":                      schema_pkg_apis_devices_v1alpha2_Device(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceData":                  schema_pkg_apis_devices_v1alpha2_DeviceData(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceList":                  schema_pkg_apis_devices_v1alpha2_DeviceList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModel":                 schema_pkg_apis_devices_v1alpha2_DeviceModel(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModelList":             schema_pkg_apis_devices_v1alpha2_DeviceModelList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModelSpec":             schema_pkg_apis_devices_v1alpha2_DeviceModelSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceProperty":              schema_pkg_apis_devices_v1alpha2_DeviceProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DevicePropertyVisitor":       schema_pkg_apis_devices_v1alpha2_DevicePropertyVisitor(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceSpec":                  schema_pkg_apis_devices_v1alpha2_DeviceSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceStatus":                schema_pkg_apis_devices_v1alpha2_DeviceStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyType":                schema_pkg_apis_devices_v1alpha2_PropertyType(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeBoolean":         schema_pkg_apis_devices_v1alpha2_PropertyTypeBoolean(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeBytes":           schema_pkg_apis_devices_v1alpha2_PropertyTypeBytes(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeDouble":          schema_pkg_apis_devices_v1alpha2_PropertyTypeDouble(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeFloat":           schema_pkg_apis_devices_v1alpha2_PropertyTypeFloat(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeInt64":           schema_pkg_apis_devices_v1alpha2_PropertyTypeInt64(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeString":          schema_pkg_apis_devices_v1alpha2_PropertyTypeString(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfig":              schema_pkg_apis_devices_v1alpha2_ProtocolConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigBluetooth":     schema_pkg_apis_devices_v1alpha2_ProtocolConfigBluetooth(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCOM":           schema_pkg_apis_devices_v1alpha2_ProtocolConfigCOM(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCommon":        schema_pkg_apis_devices_v1alpha2_ProtocolConfigCommon(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCustomized":    schema_pkg_apis_devices_v1alpha2_ProtocolConfigCustomized(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigModbus":        schema_pkg_apis_devices_v1alpha2_ProtocolConfigModbus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigOpcUA":         schema_pkg_apis_devices_v1alpha2_ProtocolConfigOpcUA(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigTCP":           schema_pkg_apis_devices_v1alpha2_ProtocolConfigTCP(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.Twin":                        schema_pkg_apis_devices_v1alpha2_Twin(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.TwinProperty":                schema_pkg_apis_devices_v1alpha2_TwinProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfig":               schema_pkg_apis_devices_v1alpha2_VisitorConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigBluetooth":      schema_pkg_apis_devices_v1alpha2_VisitorConfigBluetooth(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigCustomized":     schema_pkg_apis_devices_v1alpha2_VisitorConfigCustomized(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigModbus":         schema_pkg_apis_devices_v1alpha2_VisitorConfigModbus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigOPCUA":          schema_pkg_apis_devices_v1alpha2_VisitorConfigOPCUA(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue":              schema_pkg_apis_devices_v1beta1_CustomizedValue(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig":               schema_pkg_apis_devices_v1beta1_DBMethodConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodInfluxdb2":            schema_pkg_apis_devices_v1beta1_DBMethodInfluxdb2(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodMySQL":                schema_pkg_apis_devices_v1beta1_DBMethodMySQL(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodRedis":                schema_pkg_apis_devices_v1beta1_DBMethodRedis(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodTDEngine":             schema_pkg_apis_devices_v1beta1_DBMethodTDEngine(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device":                       schema_pkg_apis_devices_v1beta1_Device(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceList":                   schema_pkg_apis_devices_v1beta1_DeviceList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel":                  schema_pkg_apis_devices_v1beta1_DeviceModel(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelList":              schema_pkg_apis_devices_v1beta1_DeviceModelList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec":              schema_pkg_apis_devices_v1beta1_DeviceModelSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty":               schema_pkg_apis_devices_v1beta1_DeviceProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec":                   schema_pkg_apis_devices_v1beta1_DeviceSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus":                 schema_pkg_apis_devices_v1beta1_DeviceStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Influxdb2ClientConfig":        schema_pkg_apis_devices_v1beta1_Influxdb2ClientConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Influxdb2DataConfig":          schema_pkg_apis_devices_v1beta1_Influxdb2DataConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty":                schema_pkg_apis_devices_v1beta1_ModelProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.MySQLClientConfig":            schema_pkg_apis_devices_v1beta1_MySQLClientConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig":               schema_pkg_apis_devices_v1beta1_ProtocolConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod":                   schema_pkg_apis_devices_v1beta1_PushMethod(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP":               schema_pkg_apis_devices_v1beta1_PushMethodHTTP(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT":               schema_pkg_apis_devices_v1beta1_PushMethodMQTT(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.RedisClientConfig":            schema_pkg_apis_devices_v1beta1_RedisClientConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TDEngineClientConfig":         schema_pkg_apis_devices_v1beta1_TDEngineClientConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin":                         schema_pkg_apis_devices_v1beta1_Twin(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty":                 schema_pkg_apis_devices_v1beta1_TwinProperty(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig":                schema_pkg_apis_devices_v1beta1_VisitorConfig(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJob":          schema_pkg_apis_operations_v1alpha1_ImagePrePullJob(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobList":      schema_pkg_apis_operations_v1alpha1_ImagePrePullJobList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobSpec":      schema_pkg_apis_operations_v1alpha1_ImagePrePullJobSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobStatus":    schema_pkg_apis_operations_v1alpha1_ImagePrePullJobStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullStatus":       schema_pkg_apis_operations_v1alpha1_ImagePrePullStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullTemplate":     schema_pkg_apis_operations_v1alpha1_ImagePrePullTemplate(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImageStatus":              schema_pkg_apis_operations_v1alpha1_ImageStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJob":           schema_pkg_apis_operations_v1alpha1_NodeUpgradeJob(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobList":       schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobSpec":       schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobStatus":     schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.TaskStatus":               schema_pkg_apis_operations_v1alpha1_TaskStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessClusterRoleBinding":     schema_pkg_apis_policy_v1alpha1_AccessClusterRoleBinding(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessRoleBinding":            schema_pkg_apis_policy_v1alpha1_AccessRoleBinding(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessSpec":                   schema_pkg_apis_policy_v1alpha1_AccessSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessStatus":                 schema_pkg_apis_policy_v1alpha1_AccessStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.ServiceAccountAccess":         schema_pkg_apis_policy_v1alpha1_ServiceAccountAccess(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.ServiceAccountAccessList":     schema_pkg_apis_policy_v1alpha1_ServiceAccountAccessList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ClusterObjectSync":     schema_pkg_apis_reliablesyncs_v1alpha1_ClusterObjectSync(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ClusterObjectSyncList": schema_pkg_apis_reliablesyncs_v1alpha1_ClusterObjectSyncList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSync":            schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSync(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncList":        schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncSpec":        schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncStatus":      schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncStatus(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.Rule":                                schema_pkg_apis_rules_v1_Rule(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpoint":                        schema_pkg_apis_rules_v1_RuleEndpoint(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpointList":                    schema_pkg_apis_rules_v1_RuleEndpointList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpointSpec":                    schema_pkg_apis_rules_v1_RuleEndpointSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleList":                            schema_pkg_apis_rules_v1_RuleList(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleSpec":                            schema_pkg_apis_rules_v1_RuleSpec(ref),
-		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleStatus":                          schema_pkg_apis_rules_v1_RuleStatus(ref),
-		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
-		"k8s.io/api/core/v1.Affinity":                                                        schema_k8sio_api_core_v1_Affinity(ref),
-		"k8s.io/api/core/v1.AttachedVolume":                                                  schema_k8sio_api_core_v1_AttachedVolume(ref),
-		"k8s.io/api/core/v1.AvoidPods":                                                       schema_k8sio_api_core_v1_AvoidPods(ref),
-		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                           schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                 schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.AzureFileVolumeSource":                                           schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
-		"k8s.io/api/core/v1.Binding":                                                         schema_k8sio_api_core_v1_Binding(ref),
-		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                       schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CSIVolumeSource":                                                 schema_k8sio_api_core_v1_CSIVolumeSource(ref),
-		"k8s.io/api/core/v1.Capabilities":                                                    schema_k8sio_api_core_v1_Capabilities(ref),
-		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CephFSVolumeSource":                                              schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                    schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.CinderVolumeSource":                                              schema_k8sio_api_core_v1_CinderVolumeSource(ref),
-		"k8s.io/api/core/v1.ClaimSource":                                                     schema_k8sio_api_core_v1_ClaimSource(ref),
-		"k8s.io/api/core/v1.ClientIPConfig":                                                  schema_k8sio_api_core_v1_ClientIPConfig(ref),
-		"k8s.io/api/core/v1.ComponentCondition":                                              schema_k8sio_api_core_v1_ComponentCondition(ref),
-		"k8s.io/api/core/v1.ComponentStatus":                                                 schema_k8sio_api_core_v1_ComponentStatus(ref),
-		"k8s.io/api/core/v1.ComponentStatusList":                                             schema_k8sio_api_core_v1_ComponentStatusList(ref),
-		"k8s.io/api/core/v1.ConfigMap":                                                       schema_k8sio_api_core_v1_ConfigMap(ref),
-		"k8s.io/api/core/v1.ConfigMapEnvSource":                                              schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
-		"k8s.io/api/core/v1.ConfigMapKeySelector":                                            schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
-		"k8s.io/api/core/v1.ConfigMapList":                                                   schema_k8sio_api_core_v1_ConfigMapList(ref),
-		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                       schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
-		"k8s.io/api/core/v1.ConfigMapProjection":                                             schema_k8sio_api_core_v1_ConfigMapProjection(ref),
-		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                           schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
-		"k8s.io/api/core/v1.Container":                                                       schema_k8sio_api_core_v1_Container(ref),
-		"k8s.io/api/core/v1.ContainerImage":                                                  schema_k8sio_api_core_v1_ContainerImage(ref),
-		"k8s.io/api/core/v1.ContainerPort":                                                   schema_k8sio_api_core_v1_ContainerPort(ref),
-		"k8s.io/api/core/v1.ContainerResizePolicy":                                           schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
-		"k8s.io/api/core/v1.ContainerState":                                                  schema_k8sio_api_core_v1_ContainerState(ref),
-		"k8s.io/api/core/v1.ContainerStateRunning":                                           schema_k8sio_api_core_v1_ContainerStateRunning(ref),
-		"k8s.io/api/core/v1.ContainerStateTerminated":                                        schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
-		"k8s.io/api/core/v1.ContainerStateWaiting":                                           schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
-		"k8s.io/api/core/v1.ContainerStatus":                                                 schema_k8sio_api_core_v1_ContainerStatus(ref),
-		"k8s.io/api/core/v1.DaemonEndpoint":                                                  schema_k8sio_api_core_v1_DaemonEndpoint(ref),
-		"k8s.io/api/core/v1.DownwardAPIProjection":                                           schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                           schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
-		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                         schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
-		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                            schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
-		"k8s.io/api/core/v1.EndpointAddress":                                                 schema_k8sio_api_core_v1_EndpointAddress(ref),
-		"k8s.io/api/core/v1.EndpointPort":                                                    schema_k8sio_api_core_v1_EndpointPort(ref),
-		"k8s.io/api/core/v1.EndpointSubset":                                                  schema_k8sio_api_core_v1_EndpointSubset(ref),
-		"k8s.io/api/core/v1.Endpoints":                                                       schema_k8sio_api_core_v1_Endpoints(ref),
-		"k8s.io/api/core/v1.EndpointsList":                                                   schema_k8sio_api_core_v1_EndpointsList(ref),
-		"k8s.io/api/core/v1.EnvFromSource":                                                   schema_k8sio_api_core_v1_EnvFromSource(ref),
-		"k8s.io/api/core/v1.EnvVar":                                                          schema_k8sio_api_core_v1_EnvVar(ref),
-		"k8s.io/api/core/v1.EnvVarSource":                                                    schema_k8sio_api_core_v1_EnvVarSource(ref),
-		"k8s.io/api/core/v1.EphemeralContainer":                                              schema_k8sio_api_core_v1_EphemeralContainer(ref),
-		"k8s.io/api/core/v1.EphemeralContainerCommon":                                        schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
-		"k8s.io/api/core/v1.EphemeralVolumeSource":                                           schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
-		"k8s.io/api/core/v1.Event":                                                           schema_k8sio_api_core_v1_Event(ref),
-		"k8s.io/api/core/v1.EventList":                                                       schema_k8sio_api_core_v1_EventList(ref),
-		"k8s.io/api/core/v1.EventSeries":                                                     schema_k8sio_api_core_v1_EventSeries(ref),
-		"k8s.io/api/core/v1.EventSource":                                                     schema_k8sio_api_core_v1_EventSource(ref),
-		"k8s.io/api/core/v1.ExecAction":                                                      schema_k8sio_api_core_v1_ExecAction(ref),
-		"k8s.io/api/core/v1.FCVolumeSource":                                                  schema_k8sio_api_core_v1_FCVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                      schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.FlexVolumeSource":                                                schema_k8sio_api_core_v1_FlexVolumeSource(ref),
-		"k8s.io/api/core/v1.FlockerVolumeSource":                                             schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
-		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                   schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.GRPCAction":                                                      schema_k8sio_api_core_v1_GRPCAction(ref),
-		"k8s.io/api/core/v1.GitRepoVolumeSource":                                             schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                 schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                           schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
-		"k8s.io/api/core/v1.HTTPGetAction":                                                   schema_k8sio_api_core_v1_HTTPGetAction(ref),
-		"k8s.io/api/core/v1.HTTPHeader":                                                      schema_k8sio_api_core_v1_HTTPHeader(ref),
-		"k8s.io/api/core/v1.HostAlias":                                                       schema_k8sio_api_core_v1_HostAlias(ref),
-		"k8s.io/api/core/v1.HostIP":                                                          schema_k8sio_api_core_v1_HostIP(ref),
-		"k8s.io/api/core/v1.HostPathVolumeSource":                                            schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                     schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ISCSIVolumeSource":                                               schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
-		"k8s.io/api/core/v1.KeyToPath":                                                       schema_k8sio_api_core_v1_KeyToPath(ref),
-		"k8s.io/api/core/v1.Lifecycle":                                                       schema_k8sio_api_core_v1_Lifecycle(ref),
-		"k8s.io/api/core/v1.LifecycleHandler":                                                schema_k8sio_api_core_v1_LifecycleHandler(ref),
-		"k8s.io/api/core/v1.LimitRange":                                                      schema_k8sio_api_core_v1_LimitRange(ref),
-		"k8s.io/api/core/v1.LimitRangeItem":                                                  schema_k8sio_api_core_v1_LimitRangeItem(ref),
-		"k8s.io/api/core/v1.LimitRangeList":                                                  schema_k8sio_api_core_v1_LimitRangeList(ref),
-		"k8s.io/api/core/v1.LimitRangeSpec":                                                  schema_k8sio_api_core_v1_LimitRangeSpec(ref),
-		"k8s.io/api/core/v1.List":                                                            schema_k8sio_api_core_v1_List(ref),
-		"k8s.io/api/core/v1.LoadBalancerIngress":                                             schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
-		"k8s.io/api/core/v1.LoadBalancerStatus":                                              schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
-		"k8s.io/api/core/v1.LocalObjectReference":                                            schema_k8sio_api_core_v1_LocalObjectReference(ref),
-		"k8s.io/api/core/v1.LocalVolumeSource":                                               schema_k8sio_api_core_v1_LocalVolumeSource(ref),
-		"k8s.io/api/core/v1.NFSVolumeSource":                                                 schema_k8sio_api_core_v1_NFSVolumeSource(ref),
-		"k8s.io/api/core/v1.Namespace":                                                       schema_k8sio_api_core_v1_Namespace(ref),
-		"k8s.io/api/core/v1.NamespaceCondition":                                              schema_k8sio_api_core_v1_NamespaceCondition(ref),
-		"k8s.io/api/core/v1.NamespaceList":                                                   schema_k8sio_api_core_v1_NamespaceList(ref),
-		"k8s.io/api/core/v1.NamespaceSpec":                                                   schema_k8sio_api_core_v1_NamespaceSpec(ref),
-		"k8s.io/api/core/v1.NamespaceStatus":                                                 schema_k8sio_api_core_v1_NamespaceStatus(ref),
-		"k8s.io/api/core/v1.Node":                                                            schema_k8sio_api_core_v1_Node(ref),
-		"k8s.io/api/core/v1.NodeAddress":                                                     schema_k8sio_api_core_v1_NodeAddress(ref),
-		"k8s.io/api/core/v1.NodeAffinity":                                                    schema_k8sio_api_core_v1_NodeAffinity(ref),
-		"k8s.io/api/core/v1.NodeCondition":                                                   schema_k8sio_api_core_v1_NodeCondition(ref),
-		"k8s.io/api/core/v1.NodeConfigSource":                                                schema_k8sio_api_core_v1_NodeConfigSource(ref),
-		"k8s.io/api/core/v1.NodeConfigStatus":                                                schema_k8sio_api_core_v1_NodeConfigStatus(ref),
-		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                             schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
-		"k8s.io/api/core/v1.NodeList":                                                        schema_k8sio_api_core_v1_NodeList(ref),
-		"k8s.io/api/core/v1.NodeProxyOptions":                                                schema_k8sio_api_core_v1_NodeProxyOptions(ref),
-		"k8s.io/api/core/v1.NodeResources":                                                   schema_k8sio_api_core_v1_NodeResources(ref),
-		"k8s.io/api/core/v1.NodeSelector":                                                    schema_k8sio_api_core_v1_NodeSelector(ref),
-		"k8s.io/api/core/v1.NodeSelectorRequirement":                                         schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
-		"k8s.io/api/core/v1.NodeSelectorTerm":                                                schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
-		"k8s.io/api/core/v1.NodeSpec":                                                        schema_k8sio_api_core_v1_NodeSpec(ref),
-		"k8s.io/api/core/v1.NodeStatus":                                                      schema_k8sio_api_core_v1_NodeStatus(ref),
-		"k8s.io/api/core/v1.NodeSystemInfo":                                                  schema_k8sio_api_core_v1_NodeSystemInfo(ref),
-		"k8s.io/api/core/v1.ObjectFieldSelector":                                             schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
-		"k8s.io/api/core/v1.ObjectReference":                                                 schema_k8sio_api_core_v1_ObjectReference(ref),
-		"k8s.io/api/core/v1.PersistentVolume":                                                schema_k8sio_api_core_v1_PersistentVolume(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaim":                                           schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                  schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                       schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                   schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
-		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                               schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeList":                                            schema_k8sio_api_core_v1_PersistentVolumeList(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSource":                                          schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.PersistentVolumeSpec":                                            schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
-		"k8s.io/api/core/v1.PersistentVolumeStatus":                                          schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
-		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.Pod":                                                             schema_k8sio_api_core_v1_Pod(ref),
-		"k8s.io/api/core/v1.PodAffinity":                                                     schema_k8sio_api_core_v1_PodAffinity(ref),
-		"k8s.io/api/core/v1.PodAffinityTerm":                                                 schema_k8sio_api_core_v1_PodAffinityTerm(ref),
-		"k8s.io/api/core/v1.PodAntiAffinity":                                                 schema_k8sio_api_core_v1_PodAntiAffinity(ref),
-		"k8s.io/api/core/v1.PodAttachOptions":                                                schema_k8sio_api_core_v1_PodAttachOptions(ref),
-		"k8s.io/api/core/v1.PodCondition":                                                    schema_k8sio_api_core_v1_PodCondition(ref),
-		"k8s.io/api/core/v1.PodDNSConfig":                                                    schema_k8sio_api_core_v1_PodDNSConfig(ref),
-		"k8s.io/api/core/v1.PodDNSConfigOption":                                              schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
-		"k8s.io/api/core/v1.PodExecOptions":                                                  schema_k8sio_api_core_v1_PodExecOptions(ref),
-		"k8s.io/api/core/v1.PodIP":                                                           schema_k8sio_api_core_v1_PodIP(ref),
-		"k8s.io/api/core/v1.PodList":                                                         schema_k8sio_api_core_v1_PodList(ref),
-		"k8s.io/api/core/v1.PodLogOptions":                                                   schema_k8sio_api_core_v1_PodLogOptions(ref),
-		"k8s.io/api/core/v1.PodOS":                                                           schema_k8sio_api_core_v1_PodOS(ref),
-		"k8s.io/api/core/v1.PodPortForwardOptions":                                           schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
-		"k8s.io/api/core/v1.PodProxyOptions":                                                 schema_k8sio_api_core_v1_PodProxyOptions(ref),
-		"k8s.io/api/core/v1.PodReadinessGate":                                                schema_k8sio_api_core_v1_PodReadinessGate(ref),
-		"k8s.io/api/core/v1.PodResourceClaim":                                                schema_k8sio_api_core_v1_PodResourceClaim(ref),
-		"k8s.io/api/core/v1.PodResourceClaimStatus":                                          schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
-		"k8s.io/api/core/v1.PodSchedulingGate":                                               schema_k8sio_api_core_v1_PodSchedulingGate(ref),
-		"k8s.io/api/core/v1.PodSecurityContext":                                              schema_k8sio_api_core_v1_PodSecurityContext(ref),
-		"k8s.io/api/core/v1.PodSignature":                                                    schema_k8sio_api_core_v1_PodSignature(ref),
-		"k8s.io/api/core/v1.PodSpec":                                                         schema_k8sio_api_core_v1_PodSpec(ref),
-		"k8s.io/api/core/v1.PodStatus":                                                       schema_k8sio_api_core_v1_PodStatus(ref),
-		"k8s.io/api/core/v1.PodStatusResult":                                                 schema_k8sio_api_core_v1_PodStatusResult(ref),
-		"k8s.io/api/core/v1.PodTemplate":                                                     schema_k8sio_api_core_v1_PodTemplate(ref),
-		"k8s.io/api/core/v1.PodTemplateList":                                                 schema_k8sio_api_core_v1_PodTemplateList(ref),
-		"k8s.io/api/core/v1.PodTemplateSpec":                                                 schema_k8sio_api_core_v1_PodTemplateSpec(ref),
-		"k8s.io/api/core/v1.PortStatus":                                                      schema_k8sio_api_core_v1_PortStatus(ref),
-		"k8s.io/api/core/v1.PortworxVolumeSource":                                            schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
-		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                            schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
-		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                         schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
-		"k8s.io/api/core/v1.Probe":                                                           schema_k8sio_api_core_v1_Probe(ref),
-		"k8s.io/api/core/v1.ProbeHandler":                                                    schema_k8sio_api_core_v1_ProbeHandler(ref),
-		"k8s.io/api/core/v1.ProjectedVolumeSource":                                           schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
-		"k8s.io/api/core/v1.QuobyteVolumeSource":                                             schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                       schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.RBDVolumeSource":                                                 schema_k8sio_api_core_v1_RBDVolumeSource(ref),
-		"k8s.io/api/core/v1.RangeAllocation":                                                 schema_k8sio_api_core_v1_RangeAllocation(ref),
-		"k8s.io/api/core/v1.ReplicationController":                                           schema_k8sio_api_core_v1_ReplicationController(ref),
-		"k8s.io/api/core/v1.ReplicationControllerCondition":                                  schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
-		"k8s.io/api/core/v1.ReplicationControllerList":                                       schema_k8sio_api_core_v1_ReplicationControllerList(ref),
-		"k8s.io/api/core/v1.ReplicationControllerSpec":                                       schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
-		"k8s.io/api/core/v1.ReplicationControllerStatus":                                     schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
-		"k8s.io/api/core/v1.ResourceClaim":                                                   schema_k8sio_api_core_v1_ResourceClaim(ref),
-		"k8s.io/api/core/v1.ResourceFieldSelector":                                           schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
-		"k8s.io/api/core/v1.ResourceQuota":                                                   schema_k8sio_api_core_v1_ResourceQuota(ref),
-		"k8s.io/api/core/v1.ResourceQuotaList":                                               schema_k8sio_api_core_v1_ResourceQuotaList(ref),
-		"k8s.io/api/core/v1.ResourceQuotaSpec":                                               schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
-		"k8s.io/api/core/v1.ResourceQuotaStatus":                                             schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
-		"k8s.io/api/core/v1.ResourceRequirements":                                            schema_k8sio_api_core_v1_ResourceRequirements(ref),
-		"k8s.io/api/core/v1.SELinuxOptions":                                                  schema_k8sio_api_core_v1_SELinuxOptions(ref),
-		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                   schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                             schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
-		"k8s.io/api/core/v1.ScopeSelector":                                                   schema_k8sio_api_core_v1_ScopeSelector(ref),
-		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                               schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
-		"k8s.io/api/core/v1.SeccompProfile":                                                  schema_k8sio_api_core_v1_SeccompProfile(ref),
-		"k8s.io/api/core/v1.Secret":                                                          schema_k8sio_api_core_v1_Secret(ref),
-		"k8s.io/api/core/v1.SecretEnvSource":                                                 schema_k8sio_api_core_v1_SecretEnvSource(ref),
-		"k8s.io/api/core/v1.SecretKeySelector":                                               schema_k8sio_api_core_v1_SecretKeySelector(ref),
-		"k8s.io/api/core/v1.SecretList":                                                      schema_k8sio_api_core_v1_SecretList(ref),
-		"k8s.io/api/core/v1.SecretProjection":                                                schema_k8sio_api_core_v1_SecretProjection(ref),
-		"k8s.io/api/core/v1.SecretReference":                                                 schema_k8sio_api_core_v1_SecretReference(ref),
-		"k8s.io/api/core/v1.SecretVolumeSource":                                              schema_k8sio_api_core_v1_SecretVolumeSource(ref),
-		"k8s.io/api/core/v1.SecurityContext":                                                 schema_k8sio_api_core_v1_SecurityContext(ref),
-		"k8s.io/api/core/v1.SerializedReference":                                             schema_k8sio_api_core_v1_SerializedReference(ref),
-		"k8s.io/api/core/v1.Service":                                                         schema_k8sio_api_core_v1_Service(ref),
-		"k8s.io/api/core/v1.ServiceAccount":                                                  schema_k8sio_api_core_v1_ServiceAccount(ref),
-		"k8s.io/api/core/v1.ServiceAccountList":                                              schema_k8sio_api_core_v1_ServiceAccountList(ref),
-		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                   schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
-		"k8s.io/api/core/v1.ServiceList":                                                     schema_k8sio_api_core_v1_ServiceList(ref),
-		"k8s.io/api/core/v1.ServicePort":                                                     schema_k8sio_api_core_v1_ServicePort(ref),
-		"k8s.io/api/core/v1.ServiceProxyOptions":                                             schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
-		"k8s.io/api/core/v1.ServiceSpec":                                                     schema_k8sio_api_core_v1_ServiceSpec(ref),
-		"k8s.io/api/core/v1.ServiceStatus":                                                   schema_k8sio_api_core_v1_ServiceStatus(ref),
-		"k8s.io/api/core/v1.SessionAffinityConfig":                                           schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
-		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                 schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
-		"k8s.io/api/core/v1.StorageOSVolumeSource":                                           schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
-		"k8s.io/api/core/v1.Sysctl":                                                          schema_k8sio_api_core_v1_Sysctl(ref),
-		"k8s.io/api/core/v1.TCPSocketAction":                                                 schema_k8sio_api_core_v1_TCPSocketAction(ref),
-		"k8s.io/api/core/v1.Taint":                                                           schema_k8sio_api_core_v1_Taint(ref),
-		"k8s.io/api/core/v1.Toleration":                                                      schema_k8sio_api_core_v1_Toleration(ref),
-		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
-		"k8s.io/api/core/v1.TopologySelectorTerm":                                            schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
-		"k8s.io/api/core/v1.TopologySpreadConstraint":                                        schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
-		"k8s.io/api/core/v1.TypedLocalObjectReference":                                       schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
-		"k8s.io/api/core/v1.TypedObjectReference":                                            schema_k8sio_api_core_v1_TypedObjectReference(ref),
-		"k8s.io/api/core/v1.Volume":                                                          schema_k8sio_api_core_v1_Volume(ref),
-		"k8s.io/api/core/v1.VolumeDevice":                                                    schema_k8sio_api_core_v1_VolumeDevice(ref),
-		"k8s.io/api/core/v1.VolumeMount":                                                     schema_k8sio_api_core_v1_VolumeMount(ref),
-		"k8s.io/api/core/v1.VolumeNodeAffinity":                                              schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
-		"k8s.io/api/core/v1.VolumeProjection":                                                schema_k8sio_api_core_v1_VolumeProjection(ref),
-		"k8s.io/api/core/v1.VolumeSource":                                                    schema_k8sio_api_core_v1_VolumeSource(ref),
-		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                  schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
-		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                         schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
-		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                   schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
-		"k8s.io/api/rbac/v1.AggregationRule":                                                 schema_k8sio_api_rbac_v1_AggregationRule(ref),
-		"k8s.io/api/rbac/v1.ClusterRole":                                                     schema_k8sio_api_rbac_v1_ClusterRole(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                              schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                          schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
-		"k8s.io/api/rbac/v1.ClusterRoleList":                                                 schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
-		"k8s.io/api/rbac/v1.PolicyRule":                                                      schema_k8sio_api_rbac_v1_PolicyRule(ref),
-		"k8s.io/api/rbac/v1.Role":                                                            schema_k8sio_api_rbac_v1_Role(ref),
-		"k8s.io/api/rbac/v1.RoleBinding":                                                     schema_k8sio_api_rbac_v1_RoleBinding(ref),
-		"k8s.io/api/rbac/v1.RoleBindingList":                                                 schema_k8sio_api_rbac_v1_RoleBindingList(ref),
-		"k8s.io/api/rbac/v1.RoleList":                                                        schema_k8sio_api_rbac_v1_RoleList(ref),
-		"k8s.io/api/rbac/v1.RoleRef":                                                         schema_k8sio_api_rbac_v1_RoleRef(ref),
-		"k8s.io/api/rbac/v1.Subject":                                                         schema_k8sio_api_rbac_v1_Subject(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                      schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                   schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                      schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                  schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                   schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                               schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                   schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                  schema_pkg_apis_meta_v1_ApplyOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                     schema_pkg_apis_meta_v1_Condition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                 schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                 schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                      schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                      schema_pkg_apis_meta_v1_FieldsV1(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                    schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                     schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                 schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                  schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                      schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                              schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                          schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                 schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                 schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                      schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                          schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                      schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                   schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                            schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                     schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                    schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                         schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                     schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                         schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                  schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                 schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                     schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                     schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                        schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                   schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                 schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                         schema_pkg_apis_meta_v1_Table(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                         schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                  schema_pkg_apis_meta_v1_TableOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                      schema_pkg_apis_meta_v1_TableRow(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                             schema_pkg_apis_meta_v1_TableRowCondition(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                          schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                     schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                      schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                 schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                    schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                       schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                           schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                            schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                               schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.CommandArgsOverrider":                 schema_pkg_apis_apps_v1alpha1_CommandArgsOverrider(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplication":                      schema_pkg_apis_apps_v1alpha1_EdgeApplication(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationList":                  schema_pkg_apis_apps_v1alpha1_EdgeApplicationList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationSpec":                  schema_pkg_apis_apps_v1alpha1_EdgeApplicationSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EdgeApplicationStatus":                schema_pkg_apis_apps_v1alpha1_EdgeApplicationStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EnvOverrider":                         schema_pkg_apis_apps_v1alpha1_EnvOverrider(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImageOverrider":                       schema_pkg_apis_apps_v1alpha1_ImageOverrider(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImagePredicate":                       schema_pkg_apis_apps_v1alpha1_ImagePredicate(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.Manifest":                             schema_pkg_apis_apps_v1alpha1_Manifest(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ManifestStatus":                       schema_pkg_apis_apps_v1alpha1_ManifestStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroup":                            schema_pkg_apis_apps_v1alpha1_NodeGroup(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupList":                        schema_pkg_apis_apps_v1alpha1_NodeGroupList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupSpec":                        schema_pkg_apis_apps_v1alpha1_NodeGroupSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeGroupStatus":                      schema_pkg_apis_apps_v1alpha1_NodeGroupStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.NodeStatus":                           schema_pkg_apis_apps_v1alpha1_NodeStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.Overriders":                           schema_pkg_apis_apps_v1alpha1_Overriders(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourceIdentifier":                   schema_pkg_apis_apps_v1alpha1_ResourceIdentifier(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourceTemplate":                     schema_pkg_apis_apps_v1alpha1_ResourceTemplate(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourcesOverrider":                   schema_pkg_apis_apps_v1alpha1_ResourcesOverrider(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.TargetNodeGroup":                      schema_pkg_apis_apps_v1alpha1_TargetNodeGroup(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ValuesOverrider":                      schema_pkg_apis_apps_v1alpha1_ValuesOverrider(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.WorkloadScope":                        schema_pkg_apis_apps_v1alpha1_WorkloadScope(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.BluetoothOperations":               schema_pkg_apis_devices_v1alpha2_BluetoothOperations(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.BluetoothReadConverter":            schema_pkg_apis_devices_v1alpha2_BluetoothReadConverter(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.CustomizedValue":                   schema_pkg_apis_devices_v1alpha2_CustomizedValue(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DataProperty":                      schema_pkg_apis_devices_v1alpha2_DataProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.Device":                            schema_pkg_apis_devices_v1alpha2_Device(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceData":                        schema_pkg_apis_devices_v1alpha2_DeviceData(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceList":                        schema_pkg_apis_devices_v1alpha2_DeviceList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModel":                       schema_pkg_apis_devices_v1alpha2_DeviceModel(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModelList":                   schema_pkg_apis_devices_v1alpha2_DeviceModelList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceModelSpec":                   schema_pkg_apis_devices_v1alpha2_DeviceModelSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceProperty":                    schema_pkg_apis_devices_v1alpha2_DeviceProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DevicePropertyVisitor":             schema_pkg_apis_devices_v1alpha2_DevicePropertyVisitor(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceSpec":                        schema_pkg_apis_devices_v1alpha2_DeviceSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.DeviceStatus":                      schema_pkg_apis_devices_v1alpha2_DeviceStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyType":                      schema_pkg_apis_devices_v1alpha2_PropertyType(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeBoolean":               schema_pkg_apis_devices_v1alpha2_PropertyTypeBoolean(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeBytes":                 schema_pkg_apis_devices_v1alpha2_PropertyTypeBytes(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeDouble":                schema_pkg_apis_devices_v1alpha2_PropertyTypeDouble(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeFloat":                 schema_pkg_apis_devices_v1alpha2_PropertyTypeFloat(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeInt64":                 schema_pkg_apis_devices_v1alpha2_PropertyTypeInt64(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.PropertyTypeString":                schema_pkg_apis_devices_v1alpha2_PropertyTypeString(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfig":                    schema_pkg_apis_devices_v1alpha2_ProtocolConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigBluetooth":           schema_pkg_apis_devices_v1alpha2_ProtocolConfigBluetooth(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCOM":                 schema_pkg_apis_devices_v1alpha2_ProtocolConfigCOM(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCommon":              schema_pkg_apis_devices_v1alpha2_ProtocolConfigCommon(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigCustomized":          schema_pkg_apis_devices_v1alpha2_ProtocolConfigCustomized(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigModbus":              schema_pkg_apis_devices_v1alpha2_ProtocolConfigModbus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigOpcUA":               schema_pkg_apis_devices_v1alpha2_ProtocolConfigOpcUA(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.ProtocolConfigTCP":                 schema_pkg_apis_devices_v1alpha2_ProtocolConfigTCP(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.Twin":                              schema_pkg_apis_devices_v1alpha2_Twin(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.TwinProperty":                      schema_pkg_apis_devices_v1alpha2_TwinProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfig":                     schema_pkg_apis_devices_v1alpha2_VisitorConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigBluetooth":            schema_pkg_apis_devices_v1alpha2_VisitorConfigBluetooth(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigCustomized":           schema_pkg_apis_devices_v1alpha2_VisitorConfigCustomized(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigModbus":               schema_pkg_apis_devices_v1alpha2_VisitorConfigModbus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1alpha2.VisitorConfigOPCUA":                schema_pkg_apis_devices_v1alpha2_VisitorConfigOPCUA(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue":                    schema_pkg_apis_devices_v1beta1_CustomizedValue(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig":                     schema_pkg_apis_devices_v1beta1_DBMethodConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodInfluxdb2":                  schema_pkg_apis_devices_v1beta1_DBMethodInfluxdb2(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodMySQL":                      schema_pkg_apis_devices_v1beta1_DBMethodMySQL(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodRedis":                      schema_pkg_apis_devices_v1beta1_DBMethodRedis(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodTDEngine":                   schema_pkg_apis_devices_v1beta1_DBMethodTDEngine(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSink":                      schema_pkg_apis_devices_v1beta1_DataPlaneSink(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkCSV":                   schema_pkg_apis_devices_v1beta1_DataPlaneSinkCSV(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkInfluxDB":              schema_pkg_apis_devices_v1beta1_DataPlaneSinkInfluxDB(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkMQTT":                  schema_pkg_apis_devices_v1beta1_DataPlaneSinkMQTT(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkPrometheusRemoteWrite": schema_pkg_apis_devices_v1beta1_DataPlaneSinkPrometheusRemoteWrite(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device":                             schema_pkg_apis_devices_v1beta1_Device(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceDataPlane":                    schema_pkg_apis_devices_v1beta1_DeviceDataPlane(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmware":                     schema_pkg_apis_devices_v1beta1_DeviceFirmware(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmwareStatus":               schema_pkg_apis_devices_v1beta1_DeviceFirmwareStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceList":                         schema_pkg_apis_devices_v1beta1_DeviceList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel":                        schema_pkg_apis_devices_v1beta1_DeviceModel(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelList":                    schema_pkg_apis_devices_v1beta1_DeviceModelList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec":                    schema_pkg_apis_devices_v1beta1_DeviceModelSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty":                     schema_pkg_apis_devices_v1beta1_DeviceProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec":                         schema_pkg_apis_devices_v1beta1_DeviceSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus":                       schema_pkg_apis_devices_v1beta1_DeviceStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Influxdb2ClientConfig":              schema_pkg_apis_devices_v1beta1_Influxdb2ClientConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Influxdb2DataConfig":                schema_pkg_apis_devices_v1beta1_Influxdb2DataConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty":                      schema_pkg_apis_devices_v1beta1_ModelProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.MySQLClientConfig":                  schema_pkg_apis_devices_v1beta1_MySQLClientConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.OpcUAAuthentication":                schema_pkg_apis_devices_v1beta1_OpcUAAuthentication(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig":                     schema_pkg_apis_devices_v1beta1_ProtocolConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfigOpcUA":                schema_pkg_apis_devices_v1beta1_ProtocolConfigOpcUA(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod":                         schema_pkg_apis_devices_v1beta1_PushMethod(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP":                     schema_pkg_apis_devices_v1beta1_PushMethodHTTP(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT":                     schema_pkg_apis_devices_v1beta1_PushMethodMQTT(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.RedisClientConfig":                  schema_pkg_apis_devices_v1beta1_RedisClientConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TDEngineClientConfig":               schema_pkg_apis_devices_v1beta1_TDEngineClientConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin":                               schema_pkg_apis_devices_v1beta1_Twin(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty":                       schema_pkg_apis_devices_v1beta1_TwinProperty(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig":                      schema_pkg_apis_devices_v1beta1_VisitorConfig(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfigOPCUA":                 schema_pkg_apis_devices_v1beta1_VisitorConfigOPCUA(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStatus":                   schema_pkg_apis_operations_v1alpha1_CanaryStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStrategy":                 schema_pkg_apis_operations_v1alpha1_CanaryStrategy(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJob":               schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJob(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobList":           schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobSpec":           schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobStatus":         schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeStatus":            schema_pkg_apis_operations_v1alpha1_DeviceUpgradeStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJob":                schema_pkg_apis_operations_v1alpha1_ImagePrePullJob(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobList":            schema_pkg_apis_operations_v1alpha1_ImagePrePullJobList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobSpec":            schema_pkg_apis_operations_v1alpha1_ImagePrePullJobSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullJobStatus":          schema_pkg_apis_operations_v1alpha1_ImagePrePullJobStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullStatus":             schema_pkg_apis_operations_v1alpha1_ImagePrePullStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImagePrePullTemplate":           schema_pkg_apis_operations_v1alpha1_ImagePrePullTemplate(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.ImageStatus":                    schema_pkg_apis_operations_v1alpha1_ImageStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.LayerStatus":                    schema_pkg_apis_operations_v1alpha1_LayerStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeGroupImagePullSecret":       schema_pkg_apis_operations_v1alpha1_NodeGroupImagePullSecret(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJob":                 schema_pkg_apis_operations_v1alpha1_NodeUpgradeJob(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobList":             schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobSpec":             schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeUpgradeJobStatus":           schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.PullWindow":                     schema_pkg_apis_operations_v1alpha1_PullWindow(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.TaskStatus":                     schema_pkg_apis_operations_v1alpha1_TaskStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessClusterRoleBinding":           schema_pkg_apis_policy_v1alpha1_AccessClusterRoleBinding(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessRoleBinding":                  schema_pkg_apis_policy_v1alpha1_AccessRoleBinding(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessSpec":                         schema_pkg_apis_policy_v1alpha1_AccessSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.AccessStatus":                       schema_pkg_apis_policy_v1alpha1_AccessStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.ServiceAccountAccess":               schema_pkg_apis_policy_v1alpha1_ServiceAccountAccess(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/policy/v1alpha1.ServiceAccountAccessList":           schema_pkg_apis_policy_v1alpha1_ServiceAccountAccessList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ClusterObjectSync":           schema_pkg_apis_reliablesyncs_v1alpha1_ClusterObjectSync(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ClusterObjectSyncList":       schema_pkg_apis_reliablesyncs_v1alpha1_ClusterObjectSyncList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSync":                  schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSync(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncList":              schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncSpec":              schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1.ObjectSyncStatus":            schema_pkg_apis_reliablesyncs_v1alpha1_ObjectSyncStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.Rule":                                      schema_pkg_apis_rules_v1_Rule(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetter":                            schema_pkg_apis_rules_v1_RuleDeadLetter(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterList":                        schema_pkg_apis_rules_v1_RuleDeadLetterList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterSpec":                        schema_pkg_apis_rules_v1_RuleDeadLetterSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterStatus":                      schema_pkg_apis_rules_v1_RuleDeadLetterStatus(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpoint":                              schema_pkg_apis_rules_v1_RuleEndpoint(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpointList":                          schema_pkg_apis_rules_v1_RuleEndpointList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleEndpointSpec":                          schema_pkg_apis_rules_v1_RuleEndpointSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleList":                                  schema_pkg_apis_rules_v1_RuleList(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleSpec":                                  schema_pkg_apis_rules_v1_RuleSpec(ref),
+		"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleStatus":                                schema_pkg_apis_rules_v1_RuleStatus(ref),
+		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                      schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
+		"k8s.io/api/core/v1.Affinity":                                                              schema_k8sio_api_core_v1_Affinity(ref),
+		"k8s.io/api/core/v1.AttachedVolume":                                                        schema_k8sio_api_core_v1_AttachedVolume(ref),
+		"k8s.io/api/core/v1.AvoidPods":                                                             schema_k8sio_api_core_v1_AvoidPods(ref),
+		"k8s.io/api/core/v1.AzureDiskVolumeSource":                                                 schema_k8sio_api_core_v1_AzureDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFilePersistentVolumeSource":                                       schema_k8sio_api_core_v1_AzureFilePersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.AzureFileVolumeSource":                                                 schema_k8sio_api_core_v1_AzureFileVolumeSource(ref),
+		"k8s.io/api/core/v1.Binding":                                                               schema_k8sio_api_core_v1_Binding(ref),
+		"k8s.io/api/core/v1.CSIPersistentVolumeSource":                                             schema_k8sio_api_core_v1_CSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CSIVolumeSource":                                                       schema_k8sio_api_core_v1_CSIVolumeSource(ref),
+		"k8s.io/api/core/v1.Capabilities":                                                          schema_k8sio_api_core_v1_Capabilities(ref),
+		"k8s.io/api/core/v1.CephFSPersistentVolumeSource":                                          schema_k8sio_api_core_v1_CephFSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CephFSVolumeSource":                                                    schema_k8sio_api_core_v1_CephFSVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderPersistentVolumeSource":                                          schema_k8sio_api_core_v1_CinderPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.CinderVolumeSource":                                                    schema_k8sio_api_core_v1_CinderVolumeSource(ref),
+		"k8s.io/api/core/v1.ClaimSource":                                                           schema_k8sio_api_core_v1_ClaimSource(ref),
+		"k8s.io/api/core/v1.ClientIPConfig":                                                        schema_k8sio_api_core_v1_ClientIPConfig(ref),
+		"k8s.io/api/core/v1.ComponentCondition":                                                    schema_k8sio_api_core_v1_ComponentCondition(ref),
+		"k8s.io/api/core/v1.ComponentStatus":                                                       schema_k8sio_api_core_v1_ComponentStatus(ref),
+		"k8s.io/api/core/v1.ComponentStatusList":                                                   schema_k8sio_api_core_v1_ComponentStatusList(ref),
+		"k8s.io/api/core/v1.ConfigMap":                                                             schema_k8sio_api_core_v1_ConfigMap(ref),
+		"k8s.io/api/core/v1.ConfigMapEnvSource":                                                    schema_k8sio_api_core_v1_ConfigMapEnvSource(ref),
+		"k8s.io/api/core/v1.ConfigMapKeySelector":                                                  schema_k8sio_api_core_v1_ConfigMapKeySelector(ref),
+		"k8s.io/api/core/v1.ConfigMapList":                                                         schema_k8sio_api_core_v1_ConfigMapList(ref),
+		"k8s.io/api/core/v1.ConfigMapNodeConfigSource":                                             schema_k8sio_api_core_v1_ConfigMapNodeConfigSource(ref),
+		"k8s.io/api/core/v1.ConfigMapProjection":                                                   schema_k8sio_api_core_v1_ConfigMapProjection(ref),
+		"k8s.io/api/core/v1.ConfigMapVolumeSource":                                                 schema_k8sio_api_core_v1_ConfigMapVolumeSource(ref),
+		"k8s.io/api/core/v1.Container":                                                             schema_k8sio_api_core_v1_Container(ref),
+		"k8s.io/api/core/v1.ContainerImage":                                                        schema_k8sio_api_core_v1_ContainerImage(ref),
+		"k8s.io/api/core/v1.ContainerPort":                                                         schema_k8sio_api_core_v1_ContainerPort(ref),
+		"k8s.io/api/core/v1.ContainerResizePolicy":                                                 schema_k8sio_api_core_v1_ContainerResizePolicy(ref),
+		"k8s.io/api/core/v1.ContainerState":                                                        schema_k8sio_api_core_v1_ContainerState(ref),
+		"k8s.io/api/core/v1.ContainerStateRunning":                                                 schema_k8sio_api_core_v1_ContainerStateRunning(ref),
+		"k8s.io/api/core/v1.ContainerStateTerminated":                                              schema_k8sio_api_core_v1_ContainerStateTerminated(ref),
+		"k8s.io/api/core/v1.ContainerStateWaiting":                                                 schema_k8sio_api_core_v1_ContainerStateWaiting(ref),
+		"k8s.io/api/core/v1.ContainerStatus":                                                       schema_k8sio_api_core_v1_ContainerStatus(ref),
+		"k8s.io/api/core/v1.DaemonEndpoint":                                                        schema_k8sio_api_core_v1_DaemonEndpoint(ref),
+		"k8s.io/api/core/v1.DownwardAPIProjection":                                                 schema_k8sio_api_core_v1_DownwardAPIProjection(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeFile":                                                 schema_k8sio_api_core_v1_DownwardAPIVolumeFile(ref),
+		"k8s.io/api/core/v1.DownwardAPIVolumeSource":                                               schema_k8sio_api_core_v1_DownwardAPIVolumeSource(ref),
+		"k8s.io/api/core/v1.EmptyDirVolumeSource":                                                  schema_k8sio_api_core_v1_EmptyDirVolumeSource(ref),
+		"k8s.io/api/core/v1.EndpointAddress":                                                       schema_k8sio_api_core_v1_EndpointAddress(ref),
+		"k8s.io/api/core/v1.EndpointPort":                                                          schema_k8sio_api_core_v1_EndpointPort(ref),
+		"k8s.io/api/core/v1.EndpointSubset":                                                        schema_k8sio_api_core_v1_EndpointSubset(ref),
+		"k8s.io/api/core/v1.Endpoints":                                                             schema_k8sio_api_core_v1_Endpoints(ref),
+		"k8s.io/api/core/v1.EndpointsList":                                                         schema_k8sio_api_core_v1_EndpointsList(ref),
+		"k8s.io/api/core/v1.EnvFromSource":                                                         schema_k8sio_api_core_v1_EnvFromSource(ref),
+		"k8s.io/api/core/v1.EnvVar":                                                                schema_k8sio_api_core_v1_EnvVar(ref),
+		"k8s.io/api/core/v1.EnvVarSource":                                                          schema_k8sio_api_core_v1_EnvVarSource(ref),
+		"k8s.io/api/core/v1.EphemeralContainer":                                                    schema_k8sio_api_core_v1_EphemeralContainer(ref),
+		"k8s.io/api/core/v1.EphemeralContainerCommon":                                              schema_k8sio_api_core_v1_EphemeralContainerCommon(ref),
+		"k8s.io/api/core/v1.EphemeralVolumeSource":                                                 schema_k8sio_api_core_v1_EphemeralVolumeSource(ref),
+		"k8s.io/api/core/v1.Event":                                                                 schema_k8sio_api_core_v1_Event(ref),
+		"k8s.io/api/core/v1.EventList":                                                             schema_k8sio_api_core_v1_EventList(ref),
+		"k8s.io/api/core/v1.EventSeries":                                                           schema_k8sio_api_core_v1_EventSeries(ref),
+		"k8s.io/api/core/v1.EventSource":                                                           schema_k8sio_api_core_v1_EventSource(ref),
+		"k8s.io/api/core/v1.ExecAction":                                                            schema_k8sio_api_core_v1_ExecAction(ref),
+		"k8s.io/api/core/v1.FCVolumeSource":                                                        schema_k8sio_api_core_v1_FCVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexPersistentVolumeSource":                                            schema_k8sio_api_core_v1_FlexPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.FlexVolumeSource":                                                      schema_k8sio_api_core_v1_FlexVolumeSource(ref),
+		"k8s.io/api/core/v1.FlockerVolumeSource":                                                   schema_k8sio_api_core_v1_FlockerVolumeSource(ref),
+		"k8s.io/api/core/v1.GCEPersistentDiskVolumeSource":                                         schema_k8sio_api_core_v1_GCEPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.GRPCAction":                                                            schema_k8sio_api_core_v1_GRPCAction(ref),
+		"k8s.io/api/core/v1.GitRepoVolumeSource":                                                   schema_k8sio_api_core_v1_GitRepoVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsPersistentVolumeSource":                                       schema_k8sio_api_core_v1_GlusterfsPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.GlusterfsVolumeSource":                                                 schema_k8sio_api_core_v1_GlusterfsVolumeSource(ref),
+		"k8s.io/api/core/v1.HTTPGetAction":                                                         schema_k8sio_api_core_v1_HTTPGetAction(ref),
+		"k8s.io/api/core/v1.HTTPHeader":                                                            schema_k8sio_api_core_v1_HTTPHeader(ref),
+		"k8s.io/api/core/v1.HostAlias":                                                             schema_k8sio_api_core_v1_HostAlias(ref),
+		"k8s.io/api/core/v1.HostIP":                                                                schema_k8sio_api_core_v1_HostIP(ref),
+		"k8s.io/api/core/v1.HostPathVolumeSource":                                                  schema_k8sio_api_core_v1_HostPathVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIPersistentVolumeSource":                                           schema_k8sio_api_core_v1_ISCSIPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ISCSIVolumeSource":                                                     schema_k8sio_api_core_v1_ISCSIVolumeSource(ref),
+		"k8s.io/api/core/v1.KeyToPath":                                                             schema_k8sio_api_core_v1_KeyToPath(ref),
+		"k8s.io/api/core/v1.Lifecycle":                                                             schema_k8sio_api_core_v1_Lifecycle(ref),
+		"k8s.io/api/core/v1.LifecycleHandler":                                                      schema_k8sio_api_core_v1_LifecycleHandler(ref),
+		"k8s.io/api/core/v1.LimitRange":                                                            schema_k8sio_api_core_v1_LimitRange(ref),
+		"k8s.io/api/core/v1.LimitRangeItem":                                                        schema_k8sio_api_core_v1_LimitRangeItem(ref),
+		"k8s.io/api/core/v1.LimitRangeList":                                                        schema_k8sio_api_core_v1_LimitRangeList(ref),
+		"k8s.io/api/core/v1.LimitRangeSpec":                                                        schema_k8sio_api_core_v1_LimitRangeSpec(ref),
+		"k8s.io/api/core/v1.List":                                                                  schema_k8sio_api_core_v1_List(ref),
+		"k8s.io/api/core/v1.LoadBalancerIngress":                                                   schema_k8sio_api_core_v1_LoadBalancerIngress(ref),
+		"k8s.io/api/core/v1.LoadBalancerStatus":                                                    schema_k8sio_api_core_v1_LoadBalancerStatus(ref),
+		"k8s.io/api/core/v1.LocalObjectReference":                                                  schema_k8sio_api_core_v1_LocalObjectReference(ref),
+		"k8s.io/api/core/v1.LocalVolumeSource":                                                     schema_k8sio_api_core_v1_LocalVolumeSource(ref),
+		"k8s.io/api/core/v1.NFSVolumeSource":                                                       schema_k8sio_api_core_v1_NFSVolumeSource(ref),
+		"k8s.io/api/core/v1.Namespace":                                                             schema_k8sio_api_core_v1_Namespace(ref),
+		"k8s.io/api/core/v1.NamespaceCondition":                                                    schema_k8sio_api_core_v1_NamespaceCondition(ref),
+		"k8s.io/api/core/v1.NamespaceList":                                                         schema_k8sio_api_core_v1_NamespaceList(ref),
+		"k8s.io/api/core/v1.NamespaceSpec":                                                         schema_k8sio_api_core_v1_NamespaceSpec(ref),
+		"k8s.io/api/core/v1.NamespaceStatus":                                                       schema_k8sio_api_core_v1_NamespaceStatus(ref),
+		"k8s.io/api/core/v1.Node":                                                                  schema_k8sio_api_core_v1_Node(ref),
+		"k8s.io/api/core/v1.NodeAddress":                                                           schema_k8sio_api_core_v1_NodeAddress(ref),
+		"k8s.io/api/core/v1.NodeAffinity":                                                          schema_k8sio_api_core_v1_NodeAffinity(ref),
+		"k8s.io/api/core/v1.NodeCondition":                                                         schema_k8sio_api_core_v1_NodeCondition(ref),
+		"k8s.io/api/core/v1.NodeConfigSource":                                                      schema_k8sio_api_core_v1_NodeConfigSource(ref),
+		"k8s.io/api/core/v1.NodeConfigStatus":                                                      schema_k8sio_api_core_v1_NodeConfigStatus(ref),
+		"k8s.io/api/core/v1.NodeDaemonEndpoints":                                                   schema_k8sio_api_core_v1_NodeDaemonEndpoints(ref),
+		"k8s.io/api/core/v1.NodeList":                                                              schema_k8sio_api_core_v1_NodeList(ref),
+		"k8s.io/api/core/v1.NodeProxyOptions":                                                      schema_k8sio_api_core_v1_NodeProxyOptions(ref),
+		"k8s.io/api/core/v1.NodeResources":                                                         schema_k8sio_api_core_v1_NodeResources(ref),
+		"k8s.io/api/core/v1.NodeSelector":                                                          schema_k8sio_api_core_v1_NodeSelector(ref),
+		"k8s.io/api/core/v1.NodeSelectorRequirement":                                               schema_k8sio_api_core_v1_NodeSelectorRequirement(ref),
+		"k8s.io/api/core/v1.NodeSelectorTerm":                                                      schema_k8sio_api_core_v1_NodeSelectorTerm(ref),
+		"k8s.io/api/core/v1.NodeSpec":                                                              schema_k8sio_api_core_v1_NodeSpec(ref),
+		"k8s.io/api/core/v1.NodeStatus":                                                            schema_k8sio_api_core_v1_NodeStatus(ref),
+		"k8s.io/api/core/v1.NodeSystemInfo":                                                        schema_k8sio_api_core_v1_NodeSystemInfo(ref),
+		"k8s.io/api/core/v1.ObjectFieldSelector":                                                   schema_k8sio_api_core_v1_ObjectFieldSelector(ref),
+		"k8s.io/api/core/v1.ObjectReference":                                                       schema_k8sio_api_core_v1_ObjectReference(ref),
+		"k8s.io/api/core/v1.PersistentVolume":                                                      schema_k8sio_api_core_v1_PersistentVolume(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaim":                                                 schema_k8sio_api_core_v1_PersistentVolumeClaim(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimCondition":                                        schema_k8sio_api_core_v1_PersistentVolumeClaimCondition(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimList":                                             schema_k8sio_api_core_v1_PersistentVolumeClaimList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimSpec":                                             schema_k8sio_api_core_v1_PersistentVolumeClaimSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimStatus":                                           schema_k8sio_api_core_v1_PersistentVolumeClaimStatus(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimTemplate":                                         schema_k8sio_api_core_v1_PersistentVolumeClaimTemplate(ref),
+		"k8s.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                     schema_k8sio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeList":                                                  schema_k8sio_api_core_v1_PersistentVolumeList(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSource":                                                schema_k8sio_api_core_v1_PersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.PersistentVolumeSpec":                                                  schema_k8sio_api_core_v1_PersistentVolumeSpec(ref),
+		"k8s.io/api/core/v1.PersistentVolumeStatus":                                                schema_k8sio_api_core_v1_PersistentVolumeStatus(ref),
+		"k8s.io/api/core/v1.PhotonPersistentDiskVolumeSource":                                      schema_k8sio_api_core_v1_PhotonPersistentDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.Pod":                                                                   schema_k8sio_api_core_v1_Pod(ref),
+		"k8s.io/api/core/v1.PodAffinity":                                                           schema_k8sio_api_core_v1_PodAffinity(ref),
+		"k8s.io/api/core/v1.PodAffinityTerm":                                                       schema_k8sio_api_core_v1_PodAffinityTerm(ref),
+		"k8s.io/api/core/v1.PodAntiAffinity":                                                       schema_k8sio_api_core_v1_PodAntiAffinity(ref),
+		"k8s.io/api/core/v1.PodAttachOptions":                                                      schema_k8sio_api_core_v1_PodAttachOptions(ref),
+		"k8s.io/api/core/v1.PodCondition":                                                          schema_k8sio_api_core_v1_PodCondition(ref),
+		"k8s.io/api/core/v1.PodDNSConfig":                                                          schema_k8sio_api_core_v1_PodDNSConfig(ref),
+		"k8s.io/api/core/v1.PodDNSConfigOption":                                                    schema_k8sio_api_core_v1_PodDNSConfigOption(ref),
+		"k8s.io/api/core/v1.PodExecOptions":                                                        schema_k8sio_api_core_v1_PodExecOptions(ref),
+		"k8s.io/api/core/v1.PodIP":                                                                 schema_k8sio_api_core_v1_PodIP(ref),
+		"k8s.io/api/core/v1.PodList":                                                               schema_k8sio_api_core_v1_PodList(ref),
+		"k8s.io/api/core/v1.PodLogOptions":                                                         schema_k8sio_api_core_v1_PodLogOptions(ref),
+		"k8s.io/api/core/v1.PodOS":                                                                 schema_k8sio_api_core_v1_PodOS(ref),
+		"k8s.io/api/core/v1.PodPortForwardOptions":                                                 schema_k8sio_api_core_v1_PodPortForwardOptions(ref),
+		"k8s.io/api/core/v1.PodProxyOptions":                                                       schema_k8sio_api_core_v1_PodProxyOptions(ref),
+		"k8s.io/api/core/v1.PodReadinessGate":                                                      schema_k8sio_api_core_v1_PodReadinessGate(ref),
+		"k8s.io/api/core/v1.PodResourceClaim":                                                      schema_k8sio_api_core_v1_PodResourceClaim(ref),
+		"k8s.io/api/core/v1.PodResourceClaimStatus":                                                schema_k8sio_api_core_v1_PodResourceClaimStatus(ref),
+		"k8s.io/api/core/v1.PodSchedulingGate":                                                     schema_k8sio_api_core_v1_PodSchedulingGate(ref),
+		"k8s.io/api/core/v1.PodSecurityContext":                                                    schema_k8sio_api_core_v1_PodSecurityContext(ref),
+		"k8s.io/api/core/v1.PodSignature":                                                          schema_k8sio_api_core_v1_PodSignature(ref),
+		"k8s.io/api/core/v1.PodSpec":                                                               schema_k8sio_api_core_v1_PodSpec(ref),
+		"k8s.io/api/core/v1.PodStatus":                                                             schema_k8sio_api_core_v1_PodStatus(ref),
+		"k8s.io/api/core/v1.PodStatusResult":                                                       schema_k8sio_api_core_v1_PodStatusResult(ref),
+		"k8s.io/api/core/v1.PodTemplate":                                                           schema_k8sio_api_core_v1_PodTemplate(ref),
+		"k8s.io/api/core/v1.PodTemplateList":                                                       schema_k8sio_api_core_v1_PodTemplateList(ref),
+		"k8s.io/api/core/v1.PodTemplateSpec":                                                       schema_k8sio_api_core_v1_PodTemplateSpec(ref),
+		"k8s.io/api/core/v1.PortStatus":                                                            schema_k8sio_api_core_v1_PortStatus(ref),
+		"k8s.io/api/core/v1.PortworxVolumeSource":                                                  schema_k8sio_api_core_v1_PortworxVolumeSource(ref),
+		"k8s.io/api/core/v1.PreferAvoidPodsEntry":                                                  schema_k8sio_api_core_v1_PreferAvoidPodsEntry(ref),
+		"k8s.io/api/core/v1.PreferredSchedulingTerm":                                               schema_k8sio_api_core_v1_PreferredSchedulingTerm(ref),
+		"k8s.io/api/core/v1.Probe":                                                                 schema_k8sio_api_core_v1_Probe(ref),
+		"k8s.io/api/core/v1.ProbeHandler":                                                          schema_k8sio_api_core_v1_ProbeHandler(ref),
+		"k8s.io/api/core/v1.ProjectedVolumeSource":                                                 schema_k8sio_api_core_v1_ProjectedVolumeSource(ref),
+		"k8s.io/api/core/v1.QuobyteVolumeSource":                                                   schema_k8sio_api_core_v1_QuobyteVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDPersistentVolumeSource":                                             schema_k8sio_api_core_v1_RBDPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.RBDVolumeSource":                                                       schema_k8sio_api_core_v1_RBDVolumeSource(ref),
+		"k8s.io/api/core/v1.RangeAllocation":                                                       schema_k8sio_api_core_v1_RangeAllocation(ref),
+		"k8s.io/api/core/v1.ReplicationController":                                                 schema_k8sio_api_core_v1_ReplicationController(ref),
+		"k8s.io/api/core/v1.ReplicationControllerCondition":                                        schema_k8sio_api_core_v1_ReplicationControllerCondition(ref),
+		"k8s.io/api/core/v1.ReplicationControllerList":                                             schema_k8sio_api_core_v1_ReplicationControllerList(ref),
+		"k8s.io/api/core/v1.ReplicationControllerSpec":                                             schema_k8sio_api_core_v1_ReplicationControllerSpec(ref),
+		"k8s.io/api/core/v1.ReplicationControllerStatus":                                           schema_k8sio_api_core_v1_ReplicationControllerStatus(ref),
+		"k8s.io/api/core/v1.ResourceClaim":                                                         schema_k8sio_api_core_v1_ResourceClaim(ref),
+		"k8s.io/api/core/v1.ResourceFieldSelector":                                                 schema_k8sio_api_core_v1_ResourceFieldSelector(ref),
+		"k8s.io/api/core/v1.ResourceQuota":                                                         schema_k8sio_api_core_v1_ResourceQuota(ref),
+		"k8s.io/api/core/v1.ResourceQuotaList":                                                     schema_k8sio_api_core_v1_ResourceQuotaList(ref),
+		"k8s.io/api/core/v1.ResourceQuotaSpec":                                                     schema_k8sio_api_core_v1_ResourceQuotaSpec(ref),
+		"k8s.io/api/core/v1.ResourceQuotaStatus":                                                   schema_k8sio_api_core_v1_ResourceQuotaStatus(ref),
+		"k8s.io/api/core/v1.ResourceRequirements":                                                  schema_k8sio_api_core_v1_ResourceRequirements(ref),
+		"k8s.io/api/core/v1.SELinuxOptions":                                                        schema_k8sio_api_core_v1_SELinuxOptions(ref),
+		"k8s.io/api/core/v1.ScaleIOPersistentVolumeSource":                                         schema_k8sio_api_core_v1_ScaleIOPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.ScaleIOVolumeSource":                                                   schema_k8sio_api_core_v1_ScaleIOVolumeSource(ref),
+		"k8s.io/api/core/v1.ScopeSelector":                                                         schema_k8sio_api_core_v1_ScopeSelector(ref),
+		"k8s.io/api/core/v1.ScopedResourceSelectorRequirement":                                     schema_k8sio_api_core_v1_ScopedResourceSelectorRequirement(ref),
+		"k8s.io/api/core/v1.SeccompProfile":                                                        schema_k8sio_api_core_v1_SeccompProfile(ref),
+		"k8s.io/api/core/v1.Secret":                                                                schema_k8sio_api_core_v1_Secret(ref),
+		"k8s.io/api/core/v1.SecretEnvSource":                                                       schema_k8sio_api_core_v1_SecretEnvSource(ref),
+		"k8s.io/api/core/v1.SecretKeySelector":                                                     schema_k8sio_api_core_v1_SecretKeySelector(ref),
+		"k8s.io/api/core/v1.SecretList":                                                            schema_k8sio_api_core_v1_SecretList(ref),
+		"k8s.io/api/core/v1.SecretProjection":                                                      schema_k8sio_api_core_v1_SecretProjection(ref),
+		"k8s.io/api/core/v1.SecretReference":                                                       schema_k8sio_api_core_v1_SecretReference(ref),
+		"k8s.io/api/core/v1.SecretVolumeSource":                                                    schema_k8sio_api_core_v1_SecretVolumeSource(ref),
+		"k8s.io/api/core/v1.SecurityContext":                                                       schema_k8sio_api_core_v1_SecurityContext(ref),
+		"k8s.io/api/core/v1.SerializedReference":                                                   schema_k8sio_api_core_v1_SerializedReference(ref),
+		"k8s.io/api/core/v1.Service":                                                               schema_k8sio_api_core_v1_Service(ref),
+		"k8s.io/api/core/v1.ServiceAccount":                                                        schema_k8sio_api_core_v1_ServiceAccount(ref),
+		"k8s.io/api/core/v1.ServiceAccountList":                                                    schema_k8sio_api_core_v1_ServiceAccountList(ref),
+		"k8s.io/api/core/v1.ServiceAccountTokenProjection":                                         schema_k8sio_api_core_v1_ServiceAccountTokenProjection(ref),
+		"k8s.io/api/core/v1.ServiceList":                                                           schema_k8sio_api_core_v1_ServiceList(ref),
+		"k8s.io/api/core/v1.ServicePort":                                                           schema_k8sio_api_core_v1_ServicePort(ref),
+		"k8s.io/api/core/v1.ServiceProxyOptions":                                                   schema_k8sio_api_core_v1_ServiceProxyOptions(ref),
+		"k8s.io/api/core/v1.ServiceSpec":                                                           schema_k8sio_api_core_v1_ServiceSpec(ref),
+		"k8s.io/api/core/v1.ServiceStatus":                                                         schema_k8sio_api_core_v1_ServiceStatus(ref),
+		"k8s.io/api/core/v1.SessionAffinityConfig":                                                 schema_k8sio_api_core_v1_SessionAffinityConfig(ref),
+		"k8s.io/api/core/v1.StorageOSPersistentVolumeSource":                                       schema_k8sio_api_core_v1_StorageOSPersistentVolumeSource(ref),
+		"k8s.io/api/core/v1.StorageOSVolumeSource":                                                 schema_k8sio_api_core_v1_StorageOSVolumeSource(ref),
+		"k8s.io/api/core/v1.Sysctl":                                                                schema_k8sio_api_core_v1_Sysctl(ref),
+		"k8s.io/api/core/v1.TCPSocketAction":                                                       schema_k8sio_api_core_v1_TCPSocketAction(ref),
+		"k8s.io/api/core/v1.Taint":                                                                 schema_k8sio_api_core_v1_Taint(ref),
+		"k8s.io/api/core/v1.Toleration":                                                            schema_k8sio_api_core_v1_Toleration(ref),
+		"k8s.io/api/core/v1.TopologySelectorLabelRequirement":                                      schema_k8sio_api_core_v1_TopologySelectorLabelRequirement(ref),
+		"k8s.io/api/core/v1.TopologySelectorTerm":                                                  schema_k8sio_api_core_v1_TopologySelectorTerm(ref),
+		"k8s.io/api/core/v1.TopologySpreadConstraint":                                              schema_k8sio_api_core_v1_TopologySpreadConstraint(ref),
+		"k8s.io/api/core/v1.TypedLocalObjectReference":                                             schema_k8sio_api_core_v1_TypedLocalObjectReference(ref),
+		"k8s.io/api/core/v1.TypedObjectReference":                                                  schema_k8sio_api_core_v1_TypedObjectReference(ref),
+		"k8s.io/api/core/v1.Volume":                                                                schema_k8sio_api_core_v1_Volume(ref),
+		"k8s.io/api/core/v1.VolumeDevice":                                                          schema_k8sio_api_core_v1_VolumeDevice(ref),
+		"k8s.io/api/core/v1.VolumeMount":                                                           schema_k8sio_api_core_v1_VolumeMount(ref),
+		"k8s.io/api/core/v1.VolumeNodeAffinity":                                                    schema_k8sio_api_core_v1_VolumeNodeAffinity(ref),
+		"k8s.io/api/core/v1.VolumeProjection":                                                      schema_k8sio_api_core_v1_VolumeProjection(ref),
+		"k8s.io/api/core/v1.VolumeSource":                                                          schema_k8sio_api_core_v1_VolumeSource(ref),
+		"k8s.io/api/core/v1.VsphereVirtualDiskVolumeSource":                                        schema_k8sio_api_core_v1_VsphereVirtualDiskVolumeSource(ref),
+		"k8s.io/api/core/v1.WeightedPodAffinityTerm":                                               schema_k8sio_api_core_v1_WeightedPodAffinityTerm(ref),
+		"k8s.io/api/core/v1.WindowsSecurityContextOptions":                                         schema_k8sio_api_core_v1_WindowsSecurityContextOptions(ref),
+		"k8s.io/api/rbac/v1.AggregationRule":                                                       schema_k8sio_api_rbac_v1_AggregationRule(ref),
+		"k8s.io/api/rbac/v1.ClusterRole":                                                           schema_k8sio_api_rbac_v1_ClusterRole(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBinding":                                                    schema_k8sio_api_rbac_v1_ClusterRoleBinding(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleBindingList":                                                schema_k8sio_api_rbac_v1_ClusterRoleBindingList(ref),
+		"k8s.io/api/rbac/v1.ClusterRoleList":                                                       schema_k8sio_api_rbac_v1_ClusterRoleList(ref),
+		"k8s.io/api/rbac/v1.PolicyRule":                                                            schema_k8sio_api_rbac_v1_PolicyRule(ref),
+		"k8s.io/api/rbac/v1.Role":                                                                  schema_k8sio_api_rbac_v1_Role(ref),
+		"k8s.io/api/rbac/v1.RoleBinding":                                                           schema_k8sio_api_rbac_v1_RoleBinding(ref),
+		"k8s.io/api/rbac/v1.RoleBindingList":                                                       schema_k8sio_api_rbac_v1_RoleBindingList(ref),
+		"k8s.io/api/rbac/v1.RoleList":                                                              schema_k8sio_api_rbac_v1_RoleList(ref),
+		"k8s.io/api/rbac/v1.RoleRef":                                                               schema_k8sio_api_rbac_v1_RoleRef(ref),
+		"k8s.io/api/rbac/v1.Subject":                                                               schema_k8sio_api_rbac_v1_Subject(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                            schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                         schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                            schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                        schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                         schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                     schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                         schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ApplyOptions":                                        schema_pkg_apis_meta_v1_ApplyOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Condition":                                           schema_pkg_apis_meta_v1_Condition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                       schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                       schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                            schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":                                            schema_pkg_apis_meta_v1_FieldsV1(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                          schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                           schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                       schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                        schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                            schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                    schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                                schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                       schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                       schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                            schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                                schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                            schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                         schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                                  schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                           schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                          schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                      schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadata":                               schema_pkg_apis_meta_v1_PartialObjectMetadata(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PartialObjectMetadataList":                           schema_pkg_apis_meta_v1_PartialObjectMetadataList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                               schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                        schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                       schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                           schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                           schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                              schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                         schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                       schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Table":                                               schema_pkg_apis_meta_v1_Table(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableColumnDefinition":                               schema_pkg_apis_meta_v1_TableColumnDefinition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableOptions":                                        schema_pkg_apis_meta_v1_TableOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRow":                                            schema_pkg_apis_meta_v1_TableRow(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TableRowCondition":                                   schema_pkg_apis_meta_v1_TableRowCondition(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                                schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                           schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                            schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                       schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                          schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                             schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                                 schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                                  schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                     schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
@@ -1086,11 +1112,17 @@ func schema_pkg_apis_apps_v1alpha1_Overriders(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"valuesOverrider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesOverrider renders the templates in the manifests, such as \"{{ .Values.region }}\", with the values of the node group. It is applied before the other overriders.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ValuesOverrider"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.CommandArgsOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EnvOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImageOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourcesOverrider"},
+			"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.CommandArgsOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.EnvOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ImageOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ResourcesOverrider", "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1.ValuesOverrider"},
 	}
 }
 
@@ -1249,6 +1281,64 @@ func schema_pkg_apis_apps_v1alpha1_TargetNodeGroup(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_apps_v1alpha1_ValuesOverrider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ValuesOverrider represents the Helm-style values used to render the manifests for a node group.\n\nEvery string in a manifest containing \"{{\" is rendered as a Helm template, with the merged values as .Values, only metadata.name and metadata.namespace are never rendered. A string consisting of a single action, like \"{{ toYaml .Values.env }}\", is replaced by the YAML its output is parsed to, so that whole blocks such as env or the data of a ConfigMap can be templated, use the quote function to keep the output a string. ConfigMaps and Secrets using templates are created per node group, suffixed with the name of the node group like Deployments, the node group name is available as .Values.nodeGroup to refer to them. Use {{ \"{{\" }} to keep a literal \"{{\" in a string.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"valueFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFiles are the contents of YAML value files, merged in order like helm -f/--values, the values of a later file override those of an earlier one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are set on top of the ValueFiles like helm --set, such as \"image.tag=v1.2.0\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"stringValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StringValues are set after the Values like helm --set-string, always as strings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_apps_v1alpha1_WorkloadScope(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2822,418 +2912,1314 @@ func schema_pkg_apis_devices_v1beta1_DBMethodTDEngine(ref common.ReferenceCallba
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_Device(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_DataPlaneSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Device is the Schema for the devices API",
+				Description: "DataPlaneSink is a destination of the telemetry of a device, exactly one of the sink configurations must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Required: The name of the sink, unique within the device.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"influxdb": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Write the samples to an InfluxDB v2 bucket.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkInfluxDB"),
 						},
 					},
-					"metadata": {
+					"prometheusRemoteWrite": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Write the samples to a Prometheus remote-write endpoint.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkPrometheusRemoteWrite"),
 						},
 					},
-					"spec": {
+					"mqtt": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec"),
+							Description: "Publish the samples to a topic of a local MQTT broker.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkMQTT"),
 						},
 					},
-					"status": {
+					"csv": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus"),
+							Description: "Append the samples to CSV files on the edge node.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkCSV"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkCSV", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkInfluxDB", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkMQTT", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSinkPrometheusRemoteWrite"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_DataPlaneSinkCSV(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceList contains a list of Device",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Required: The directory on the edge node the CSV files are written to, the samples of a device are appended to <namespace>_<device name>.csv.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"maxFileSize": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "The size in bytes after which the file is rotated. Defaults to 104857600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"items": {
+					"maxFiles": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device"),
-									},
-								},
-							},
+							Description: "The number of rotated files kept. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceModel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_DataPlaneSinkInfluxDB(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceModel is the Schema for the device model API",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Required: The URL of the InfluxDB server, like http://127.0.0.1:8086",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"org": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Required: The organization of the bucket.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Required: The bucket the samples are written to.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"measurement": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec"),
+							Description: "The measurement of the samples, the property name is the field key. Defaults to the device name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional tags of the samples.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tokenFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the file holding the API token on the edge node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_DataPlaneSinkMQTT(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceModelList contains a list of DeviceModel",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"broker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Required: The broker address, like tcp://127.0.0.1:1883",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "The topic the batches are published to. Defaults to \"$ke/dataplane/<namespace>/<device name>\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"qos": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "The qos of the published messages.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel"),
-									},
-								},
-							},
+							Description: "The client ID of the connection, defaults to a generated one.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceModelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_DataPlaneSinkPrometheusRemoteWrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceModelSpec defines the model for a device.It is a blueprint which describes the device capabilities and access mechanism via property visitors.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"properties": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: List of device properties.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Required: The URL of the remote-write endpoint, like http://127.0.0.1:9090/api/v1/write",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metricName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the metric, the samples are labeled with the device, namespace and property. Defaults to \"kubeedge_device_property\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional labels of the samples.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"protocol": {
+					"bearerTokenFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: Protocol name used by the device.",
+							Description: "Path of the file holding the bearer token on the edge node.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout in milliseconds of a write request.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_Device(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceProperty describes the specifics all the properties of the device.",
+				Description: "Device is the Schema for the devices API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The device property name to be accessed. It must be unique. Note: If you need to use the built-in stream data processing function, you need to define Name as saveFrame or saveVideo",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"desired": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The desired property value.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"visitors": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Visitors are intended to be consumed by device mappers which connect to devices and collect data / perform actions on the device. Required: Protocol relevant config details about the how to access the device property.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"reportCycle": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Define how frequent mapper will report the value.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec"),
 						},
 					},
-					"collectCycle": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Define how frequent mapper will collect from device.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceSpec", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceDataPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceDataPlane describes the telemetry the mapper streams to edge-local sinks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the properties to stream, all properties of the device if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sampleRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of samples per second taken of each property, up to 100000. Defaults to the collectCycle of the property.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of samples written to a sink at once. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flushInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The interval in milliseconds after which the buffered samples are written even if the batch is not full. Defaults to 1000.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bufferSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of samples buffered for each sink, the oldest samples are dropped when a sink cannot keep up. Defaults to 10000.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"sinks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The sinks the samples are written to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSink"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DataPlaneSink"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceFirmware(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceFirmware describes a firmware image of the device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The version of the firmware.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The http or https URL the mapper downloads the firmware image from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The checksum of the firmware image in the form <algorithm>:<hex digest>, like sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae. Only sha256 is supported.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceFirmwareStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceFirmwareStatus is the firmware state reported by the mapper.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The firmware version running on the device.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desiredVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The desired firmware version the state refers to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The state of the upgrade to the desired firmware version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating why the upgrade failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time of the latest state transition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceList contains a list of Device",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Device", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceModel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceModel is the Schema for the device model API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModelSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceModelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceModelList contains a list of DeviceModel",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceModel", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceModelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceModelSpec defines the model for a device.It is a blueprint which describes the device capabilities and access mechanism via property visitors.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: List of device properties.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty"),
+									},
+								},
+							},
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Protocol name used by the device.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ModelProperty"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceProperty describes the specifics all the properties of the device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The device property name to be accessed. It must be unique. Note: If you need to use the built-in stream data processing function, you need to define Name as saveFrame or saveVideo",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The desired property value.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
+						},
+					},
+					"visitors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Visitors are intended to be consumed by device mappers which connect to devices and collect data / perform actions on the device. Required: Protocol relevant config details about the how to access the device property.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig"),
+						},
+					},
+					"reportCycle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Define how frequent mapper will report the value.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"collectCycle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Define how frequent mapper will collect from device.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 					"reportToCloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "whether be reported to the cloud",
-							Type:        []string{"boolean"},
+							Description: "whether be reported to the cloud",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pushMethod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PushMethod represents the protocol used to push data, please ensure that the mapper can access the destination address.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceSpec represents a single device instance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deviceModelRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: DeviceModelRef is reference to the device model used as a template to create the device instance.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is a request to schedule this device onto a specific node. If it is non-empty, the scheduler simply schedules this device onto that node, assuming that it fits resource requirements.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of properties which describe the device properties. properties list item must be unique by properties.Name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty"),
+									},
+								},
+							},
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The protocol configuration used to connect to the device.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig"),
+						},
+					},
+					"dataPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataPlane streams the telemetry of the device from the mapper directly to sinks reachable from the edge node, without going through edgecore and the cloud. Twin properties are still synced as usual.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceDataPlane"),
+						},
+					},
+					"firmware": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firmware is the desired firmware of the device. The mapper upgrades the device whenever the desired version differs from the version reported by the device.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmware"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceDataPlane", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmware", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_DeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeviceStatus reports the device state and the desired/reported values of twin attributes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"twins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A list of device twins containing desired/reported desired/reported values of twin properties. Optional: A passive device won't have twin properties and this list could be empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin"),
+									},
+								},
+							},
+						},
+					},
+					"firmware": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firmware reports the firmware version running on the device and the state of the latest firmware upgrade.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmwareStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmwareStatus", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_Influxdb2ClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Url of influx database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"org": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Org of the user in influx database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bucket of the user in influx database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_Influxdb2DataConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"measurement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Measurement of the user data",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "the tag of device data",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"fieldKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldKey of the user data",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_ModelProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ModelProperty describes an individual device property / attribute like temperature / humidity etc.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The device property name. Note: If you need to use the built-in stream data processing function, you need to define Name as saveFrame or saveVideo",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The device property description.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Type of device property, ENUM: INT,FLOAT,DOUBLE,STRING,BOOLEAN,BYTES,STREAM",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: Access mode of property, ReadWrite or ReadOnly.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minimum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"maximum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"unit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The unit of the property",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_MySQLClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "mysql address,like localhost:3306",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pushMethod": {
+					"database": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PushMethod represents the protocol used to push data, please ensure that the mapper can access the destination address.",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod"),
+							Description: "database name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "user name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_OpcUAAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpcUAAuthentication describes the user identity of an OPC UA session.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Required: The type of the user identity token.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username for access opc server, required by the \"UserName\" type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"passwordFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the file holding the password of UserName.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_ProtocolConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocolName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unique protocol name Required.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Any config data",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"),
+						},
+					},
+					"opcua": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol configuration for the built-in OPC UA protocol, used when ProtocolName is \"opcua\"",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfigOpcUA"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethod", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfig"},
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfigOpcUA"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_ProtocolConfigOpcUA(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceSpec represents a single device instance.",
+				Description: "ProtocolConfigOpcUA describes how the mapper connects to an OPC UA server. Certificates, keys and passwords are files on the edge node of the device, so that no secret is stored in the device.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"deviceModelRef": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: DeviceModelRef is reference to the device model used as a template to create the device instance.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Required: The URL for opc server endpoint, like opc.tcp://127.0.0.1:4840",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeName": {
+					"securityPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The security policy of the secure channel. Defaults to \"None\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"securityMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The security mode of the secure channel, it must be \"None\" if and only if the security policy is \"None\". Defaults to \"None\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the PEM encoded client certificate, used for the secure channel and for certificate authentication.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the PEM encoded private key of the client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serverCertificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the PEM encoded certificate the opc server must present, the server certificate is not pinned if it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The user identity of the session. Defaults to anonymous.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.OpcUAAuthentication"),
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout in milliseconds of the requests to the opc server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.OpcUAAuthentication"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_PushMethod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP Push method configuration for http",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP"),
+						},
+					},
+					"mqtt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MQTT Push method configuration for mqtt",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT"),
+						},
+					},
+					"dbMethod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DBMethod represents the method used to push data to database, please ensure that the mapper can access the destination address.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_PushMethodHTTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"requestPath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_PushMethodMQTT(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "broker address, like mqtt://127.0.0.1:1883",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "publish topic for mqtt",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"qos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "qos of mqtt publish param",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retained": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Is the message retained",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_RedisClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addr of Redis database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"db": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeName is a request to schedule this device onto a specific node. If it is non-empty, the scheduler simply schedules this device onto that node, assuming that it fits resource requirements.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Db of Redis database",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"properties": {
+					"poolsize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of properties which describe the device properties. properties list item must be unique by properties.Name.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty"),
-									},
-								},
-							},
+							Description: "Poolsize of Redis database",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"protocol": {
+					"minIdleConns": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The protocol configuration used to connect to the device.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig"),
+							Description: "MinIdleConns of Redis database",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceProperty", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.ProtocolConfig", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_DeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_TDEngineClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DeviceStatus reports the device state and the desired/reported values of twin attributes.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"twins": {
+					"addr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A list of device twins containing desired/reported desired/reported values of twin properties. Optional: A passive device won't have twin properties and this list could be empty.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin"),
-									},
-								},
-							},
+							Description: "addr of tdEngine database",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dbName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dbname of tdEngine database",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.Twin"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_Influxdb2ClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_Twin(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Twin provides a logical representation of control properties (writable properties in the device model). The properties can have a Desired state and a Reported state. The cloud configures the `Desired`state of a device property and this configuration update is pushed to the edge node. The mapper sends a command to the device to change this property value as per the desired state . It receives the `Reported` state of the property once the previous operation is complete and sends the reported state to the cloud. Offline device interaction in the edge is possible via twin properties for control/command operations.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
+					"propertyName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Url of influx database",
+							Description: "Required: The property name for which the desired/reported values are specified. This property should be present in the device model.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"org": {
+					"reported": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Org of the user in influx database",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Required: the reported property value.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
 						},
 					},
-					"bucket": {
+					"observedDesired": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bucket of the user in influx database",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The meaning of here is to indicate desired value of `deviceProperty.Desired` that the mapper has received in current cycle. Useful in cases that people want to check whether the mapper is working appropriately and its internal status is up-to-date. This value should be only updated by devicecontroller upstream.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_Influxdb2DataConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_TwinProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TwinProperty represents the device property for which an Expected/Actual state can be defined.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"measurement": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Measurement of the user data",
+							Description: "Required: The value for this property.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tag": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "the tag of device data",
+							Description: "Additional metadata like timestamp when the value was reported etc.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -3247,71 +4233,79 @@ func schema_pkg_apis_devices_v1beta1_Influxdb2DataConfig(ref common.ReferenceCal
 							},
 						},
 					},
-					"fieldKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FieldKey of the user data",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
+				Required: []string{"value"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_ModelProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_devices_v1beta1_VisitorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ModelProperty describes an individual device property / attribute like temperature / humidity etc.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"protocolName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The device property name. Note: If you need to use the built-in stream data processing function, you need to define Name as saveFrame or saveVideo",
+							Description: "Required: name of customized protocol",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"configData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The device property description.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Required: The configData of customized protocol",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"),
 						},
 					},
-					"type": {
+					"opcua": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: Type of device property, ENUM: INT,FLOAT,DOUBLE,STRING,BOOLEAN,BYTES,STREAM",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Visitor configuration for the built-in OPC UA protocol, replacing configData",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfigOPCUA"),
 						},
 					},
-					"accessMode": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.VisitorConfigOPCUA"},
+	}
+}
+
+func schema_pkg_apis_devices_v1beta1_VisitorConfigOPCUA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VisitorConfigOPCUA describes how the mapper accesses a device property on an OPC UA server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: Access mode of property, ReadWrite or ReadOnly.",
+							Description: "Required: The ID of opc-ua node, e.g. \"ns=2;s=Temperature\" or \"ns=1;i=1005\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"minimum": {
+					"browseName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The name of opc-ua node",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maximum": {
+					"subscriptionInterval": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The publishing interval in milliseconds of a subscription to the data changes of the node. If it is set, the mapper reports the property when the node changes instead of reading the node every collectCycle.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"unit": {
+					"samplingInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The unit of the property",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The interval in milliseconds the opc server samples the node for the subscription. Defaults to the subscription interval.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
@@ -3320,29 +4314,45 @@ func schema_pkg_apis_devices_v1beta1_ModelProperty(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_MySQLClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_CanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CanaryStatus stores the status of the canary nodes of NodeUpgradeJob.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"addr": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "mysql address,like localhost:3306",
+							Description: "Nodes are the names of the canary nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase represents for the phase of the canary nodes. There are several possible phase values: Upgrading, Verifying, Passed, RollingBack and Failed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"database": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "database name",
+							Description: "Reason represents for the reason of the health gate failure.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"userName": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "user name",
+							Description: "Time represents for the time of the latest phase transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3353,305 +4363,344 @@ func schema_pkg_apis_devices_v1beta1_MySQLClientConfig(ref common.ReferenceCallb
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_ProtocolConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_CanaryStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CanaryStrategy specifies the canary nodes of NodeUpgradeJob and the health gate they must pass.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"protocolName": {
+					"percentage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Unique protocol name Required.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Percentage is the percentage of the selected nodes which are upgraded as canary nodes, at least one node is selected. Users must set one of Percentage and LabelSelector and can only set one.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"configData": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Any config data",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"),
+							Description: "LabelSelector selects the canary nodes from the selected nodes by labels. Users must set one of Percentage and LabelSelector and can only set one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"},
-	}
-}
-
-func schema_pkg_apis_devices_v1beta1_PushMethod(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"http": {
+					"healthySeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HTTP Push method configuration for http",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP"),
+							Description: "HealthySeconds is how long the canary nodes must stay Ready with all their pods Ready after they are upgraded. Default to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"mqtt": {
+					"gateTimeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MQTT Push method configuration for mqtt",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT"),
+							Description: "GateTimeoutSeconds limits the duration of the health gate, the gate fails if the canary nodes are not healthy for HealthySeconds in GateTimeoutSeconds. Default to 900.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dbMethod": {
+					"failurePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DBMethod represents the method used to push data to database, please ensure that the mapper can access the destination address.",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig"),
+							Description: "FailurePolicy specifies what to do when the canary nodes fail the health gate. There are two possible values: Pause and Rollback. Default to Pause.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DBMethodConfig", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodHTTP", "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.PushMethodMQTT"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_PushMethodHTTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeviceUpgradeJob is used to upgrade the firmware of devices from cloud side.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hostName": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"port": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"requestPath": {
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec represents the specification of the desired behavior of DeviceUpgradeJob.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobSpec"),
 						},
 					},
-					"timeout": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Status represents the status of DeviceUpgradeJob.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobSpec", "github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_PushMethodMQTT(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeviceUpgradeJobList is a list of DeviceUpgradeJob.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"address": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "broker address, like mqtt://127.0.0.1:1883",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"topic": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "publish topic for mqtt",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"qos": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "qos of mqtt publish param",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"retained": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Is the message retained",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "List of DeviceUpgradeJob.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJob"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeJob", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_RedisClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeviceUpgradeJobSpec represents the specification of the desired behavior of DeviceUpgradeJob.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"addr": {
+					"firmware": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addr of Redis database",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Firmware is the firmware the devices are upgraded to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmware"),
 						},
 					},
-					"db": {
+					"deviceNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Db of Redis database",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "DeviceNames is a request to select some specific devices in the namespace of the job. Please note that sets of DeviceNames and LabelSelector are ORed. Users must set one and can only set one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"poolsize": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Poolsize of Redis database",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "LabelSelector is a filter to select devices in the namespace of the job by labels. Please note that sets of DeviceNames and LabelSelector are ORed. Users must set one and can only set one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"minIdleConns": {
+					"batchSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MinIdleConns of Redis database",
+							Description: "BatchSize specifies the number of devices upgraded in a batch, the next batch is started only after all the devices of the current batch finish the upgrade. The default BatchSize value is 1.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_devices_v1beta1_TDEngineClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"addr": {
+					"failureTolerate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "addr of tdEngine database",
+							Description: "FailureTolerate specifies the task tolerance failure ratio, no more batches are started once the ratio of the failed devices exceeds it. The default FailureTolerate value is 0, the job stops at the first failed device.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dbName": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "dbname of tdEngine database",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeoutSeconds limits the duration of the firmware upgrade of each device. Default to 600. If set to 0, we'll use the default value 600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"firmware"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.DeviceFirmware", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_Twin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_DeviceUpgradeJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Twin provides a logical representation of control properties (writable properties in the device model). The properties can have a Desired state and a Reported state. The cloud configures the `Desired`state of a device property and this configuration update is pushed to the edge node. The mapper sends a command to the device to change this property value as per the desired state . It receives the `Reported` state of the property once the previous operation is complete and sends the reported state to the cloud. Offline device interaction in the edge is possible via twin properties for control/command operations.",
+				Description: "DeviceUpgradeJobStatus stores the status of DeviceUpgradeJob.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"propertyName": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The property name for which the desired/reported values are specified. This property should be present in the device model.",
+							Description: "State represents for the state phase of the DeviceUpgradeJob. There are several possible state values: \"\", Upgrading, Completed and Failed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reported": {
+					"currentBatch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: the reported property value.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
+							Description: "CurrentBatch is the index of the latest batch started, starting from 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"observedDesired": {
+					"succeeded": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The meaning of here is to indicate desired value of `deviceProperty.Desired` that the mapper has received in current cycle. Useful in cases that people want to check whether the mapper is working appropriately and its internal status is up-to-date. This value should be only updated by devicecontroller upstream.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"),
+							Description: "Succeeded is the number of devices which are upgraded successfully.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.TwinProperty"},
-	}
-}
-
-func schema_pkg_apis_devices_v1beta1_TwinProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TwinProperty represents the device property for which an Expected/Actual state can be defined.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"value": {
+					"failed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The value for this property.",
-							Default:     "",
+							Description: "Failed is the number of devices which failed to upgrade.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason represents for the reason of the DeviceUpgradeJob failure.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"deviceStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Additional metadata like timestamp when the value was reported etc.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Devices contains the upgrade status for each device.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeStatus"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"value"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.DeviceUpgradeStatus"},
 	}
 }
 
-func schema_pkg_apis_devices_v1beta1_VisitorConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_DeviceUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DeviceUpgradeStatus stores the upgrade status of a device.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"protocolName": {
+					"deviceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: name of customized protocol",
+							Description: "DeviceName is the name of the device.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"configData": {
+					"batch": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Required: The configData of customized protocol",
-							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"),
+							Description: "Batch is the index of the batch the device is upgraded in, starting from 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State represents for the upgrade state of the device. There are several possible state values: Pending, Upgrading, Succeeded and Failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"firmwareState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FirmwareState is the latest firmware state reported by the mapper during the upgrade.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason represents for the reason of the device upgrade failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTime is the time the upgrade of the device is started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time the upgrade of the device finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1.CustomizedValue"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3951,62 +5000,186 @@ func schema_pkg_apis_operations_v1alpha1_ImagePrePullTemplate(ref common.Referen
 					},
 					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TimeoutSeconds limits the duration of the node prepull job on each edgenode. Default to 300. If set to 0, we'll use the default value 300.",
+							Description: "TimeoutSeconds limits the duration of the node prepull job on each edgenode. The edge nodes report the progress while pulling the images or waiting for the pull windows, which resets the timeout. Default to 300. If set to 0, we'll use the default value 300.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"imageSecrets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageSecret specifies the secret for image pull if private registry used. Use {namespace}/{secretName} in format.",
+							Description: "ImageSecret specifies the secret for image pull if private registry used. Use {namespace}/{secretName} in format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets specifies the secrets for image pull of the nodes in node groups. The secrets of all the entries matching a node are used together with ImageSecret, each image is pulled with the credentials of the secrets matching its registry.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeGroupImagePullSecret"),
+									},
+								},
+							},
+						},
+					},
+					"bandwidthLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BandwidthLimit specifies the maximum download rate of the images on each edge node, in bytes per second, e.g. 512Ki. The download rate is not limited if it is not set. It is only supported by the edge nodes using containerd.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"pullWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullWindows specifies the daily time windows in the local time of the edge nodes in which the images are pulled. A pull in progress when the window closes is paused and resumed in the next window. The images are pulled at any time if it is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.PullWindow"),
+									},
+								},
+							},
+						},
+					},
+					"retryTimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryTimes specifies the retry times if image pull failed on each edgenode. Default to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.NodeGroupImagePullSecret", "github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.PullWindow", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ImageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageStatus stores the prepull status for each image.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the name of the image",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State represents for the state phase of this image pull on the edge node There are three possible state values: pulling, successful, failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason represents the fail reason if image pull failed",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryTimes": {
+					"layers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryTimes specifies the retry times if image pull failed on each edgenode. Default to 0",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Layers represents the download progress of the layers of the image, it is only reported by the edge nodes using containerd.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.LayerStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.LayerStatus"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_ImageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_LayerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageStatus stores the prepull status for each image.",
+				Description: "LayerStatus stores the download progress of an image layer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image is the name of the image",
+							Description: "Digest is the digest of the layer",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
+					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "State represents for the state phase of this image pull on the edge node There are two possible state values: successful, failed.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Size is the size of the layer in bytes",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"reason": {
+					"downloaded": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason represents the fail reason if image pull failed",
+							Description: "Downloaded is the downloaded size of the layer in bytes",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"digest", "size", "downloaded"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_NodeGroupImagePullSecret(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeGroupImagePullSecret specifies the image pull secrets of the nodes in a node group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeGroup is the name of the NodeGroup whose nodes use the secrets. The secrets are used by all the nodes if it is empty.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secrets are the image pull secrets in {namespace}/{secretName} format.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"secrets"},
 			},
 		},
 	}
@@ -4189,11 +5362,17 @@ func schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobSpec(ref common.Reference
 							Format:      "",
 						},
 					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary specifies the canary nodes which are upgraded before the other nodes. If it is set, the other nodes are upgraded only after the canary nodes pass the health gate.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStrategy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4267,11 +5446,47 @@ func schema_pkg_apis_operations_v1alpha1_NodeUpgradeJobStatus(ref common.Referen
 							},
 						},
 					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary contains the status of the canary nodes.",
+							Ref:         ref("github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.TaskStatus"},
+			"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.CanaryStatus", "github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1.TaskStatus"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_PullWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PullWindow is a daily time window in the local time of the edge nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start time of the window in HH:MM format.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end time of the window in HH:MM format, the window spans midnight if End is not later than Start.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
 	}
 }
 
@@ -4884,6 +6099,200 @@ func schema_pkg_apis_rules_v1_Rule(ref common.ReferenceCallback) common.OpenAPID
 	}
 }
 
+func schema_pkg_apis_rules_v1_RuleDeadLetter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleDeadLetter is the Schema for the ruledeadletters API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterSpec", "github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetterStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_rules_v1_RuleDeadLetterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleDeadLetterList contains a list of RuleDeadLetter",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetter"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kubeedge/kubeedge/pkg/apis/rules/v1.RuleDeadLetter", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_rules_v1_RuleDeadLetterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleDeadLetterSpec defines a message which could not be delivered to the target of a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target represents the ruleendpoint name the message was delivered to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetResource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetResource is a map representing the resource info of target the message was delivered with.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName represents the edge node the message came from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data is the content of the message.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"replay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replay asks the router to deliver the message to the target again, the dead letter is deleted once the message is delivered.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "targetResource", "data"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_rules_v1_RuleDeadLetterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleDeadLetterStatus defines the delivery attempts of a dead letter.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts represents how many times the message was delivered.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError represents the failed reason of the last delivery.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime represents the time of the last delivery.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"attempts"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_rules_v1_RuleEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4984,7 +6393,7 @@ func schema_pkg_apis_rules_v1_RuleEndpointSpec(ref common.ReferenceCallback) com
 				Properties: map[string]spec.Schema{
 					"ruleEndpointType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuleEndpointType defines type: servicebus, rest",
+							Description: "RuleEndpointType defines type: servicebus, rest, webhook, kubernetesevent, cloudevents",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -4992,7 +6401,7 @@ func schema_pkg_apis_rules_v1_RuleEndpointSpec(ref common.ReferenceCallback) com
 					},
 					"properties": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Properties: properties of endpoint. for example: servicebus: {\"service_port\":\"8080\"}",
+							Description: "Properties: properties of endpoint. for example: servicebus: {\"service_port\":\"8080\"} webhook, the secret holds the HMAC key of the requests in its \"hmacKey\": {\"secret\":\"my-webhook-secret\"} cloudevents, the events are sent over http by default, or to the kafka brokers: {\"protocol\":\"kafka\",\"brokers\":\"kafka-0:9092,kafka-1:9092\"}",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5103,7 +6512,7 @@ func schema_pkg_apis_rules_v1_RuleSpec(ref common.ReferenceCallback) common.Open
 					},
 					"targetResource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "targetResource is a map representing the resource info of target. For api ruleendpoint type its value is {\"resource\":\"http://a.com\"}. For eventbus ruleendpoint type its value is {\"topic\":\"/xxxx\"}. For servicebus ruleendpoint type its value is {\"path\":\"/request_path\"}.",
+							Description: "targetResource is a map representing the resource info of target. For api ruleendpoint type its value is {\"resource\":\"http://a.com\"}. For eventbus ruleendpoint type its value is {\"topic\":\"/xxxx\"}. For servicebus ruleendpoint type its value is {\"path\":\"/request_path\"}. For kubernetesevent ruleendpoint type its value is {\"reason\":\"TemperatureAlert\",\"eventType\":\"Warning\"}. For cloudevents ruleendpoint type its value is {\"resource\":\"http://broker.example.com\",\"type\":\"com.example.alert\"}, the resource is the kafka topic if the ruleendpoint protocol is kafka. The \"extensions\" of both types maps the message headers to the event, e.g. \"edgenode=nodeName,edgetopic=topic\".",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
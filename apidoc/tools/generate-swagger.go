@@ -88,6 +88,10 @@ func main() {
 		operationsv1alpha1.SchemeGroupVersion.WithResource("nodeupgradejobs"),
 		operationsv1alpha1.SchemeGroupVersion.WithResource("nodeupgradejob"), meta.RESTScopeNamespace)
 
+	mapper.AddSpecific(operationsv1alpha1.SchemeGroupVersion.WithKind("DeviceUpgradeJob"),
+		operationsv1alpha1.SchemeGroupVersion.WithResource("deviceupgradejobs"),
+		operationsv1alpha1.SchemeGroupVersion.WithResource("deviceupgradejob"), meta.RESTScopeNamespace)
+
 	mapper.AddSpecific(policyv1alpha1.SchemeGroupVersion.WithKind("ServiceAccountAccess"),
 		policyv1alpha1.SchemeGroupVersion.WithResource("serviceaccountaccesses"),
 		policyv1alpha1.SchemeGroupVersion.WithResource("serviceaccountaccess"), meta.RESTScopeNamespace)
@@ -130,6 +134,7 @@ func main() {
 			{GVR: devicesv1alpha2.SchemeGroupVersion.WithResource("devicemodels"), NamespaceScoped: true},
 			{GVR: operationsv1alpha1.SchemeGroupVersion.WithResource("imageprepulljobs"), NamespaceScoped: false},
 			{GVR: operationsv1alpha1.SchemeGroupVersion.WithResource("nodeupgradejobs"), NamespaceScoped: false},
+			{GVR: operationsv1alpha1.SchemeGroupVersion.WithResource("deviceupgradejobs"), NamespaceScoped: true},
 			{GVR: policyv1alpha1.SchemeGroupVersion.WithResource("serviceaccountaccesses"), NamespaceScoped: true},
 			{GVR: reliablesyncsv1alpha1.SchemeGroupVersion.WithResource("clusterobjectsyncs"), NamespaceScoped: false},
 			{GVR: reliablesyncsv1alpha1.SchemeGroupVersion.WithResource("objectsyncs"), NamespaceScoped: true},
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/util"
+)
+
+// runWithLeaderElection runs start once the cloudcore becomes the leader of the cloudcore replicas,
+// or runs it directly if the leader election is disabled. The cloudhub of the standby replicas is
+// not started, so they are not ready and the service of cloudcore only routes the edge nodes to the
+// leader. The cloudcore exits once it loses the leadership, and is restarted to stand by again.
+func runWithLeaderElection(config *v1alpha1.LeaderElection, start func()) {
+	if config == nil || !config.Enable {
+		start()
+		return
+	}
+
+	id := util.GetHostname() + "_" + string(uuid.NewUUID())
+	kubeClient := client.GetKubeClient()
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock, config.ResourceNamespace, config.ResourceName,
+		kubeClient.CoreV1(), kubeClient.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: id})
+	if err != nil {
+		klog.Exitf("failed to create the leader election lock, err: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   time.Duration(config.LeaseDuration) * time.Second,
+		RenewDeadline:   time.Duration(config.RenewDeadline) * time.Second,
+		RetryPeriod:     time.Duration(config.RetryPeriod) * time.Second,
+		ReleaseOnCancel: true,
+		Name:            config.ResourceName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				klog.Infof("cloudcore %s becomes the leader and starts the modules", id)
+				start()
+				// start returns when the cloudcore shuts down, release the leadership
+				// so that a standby replica takes over immediately
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				if ctx.Err() == nil {
+					klog.Exitf("cloudcore %s lost the leadership", id)
+				}
+				klog.Infof("cloudcore %s released the leadership", id)
+			},
+			OnNewLeader: func(identity string) {
+				if identity != id {
+					klog.Infof("cloudcore %s stands by, the leader is %s", id, identity)
+				}
+			},
+		},
+	})
+}
@@ -113,27 +113,29 @@ kubernetes controller which manages devices so that the device metadata/status d
 				updateCloudCoreConfigMap(config)
 			}
 
-			ctx := beehiveContext.GetContext()
-			if features.DefaultFeatureGate.Enabled(features.RequireAuthorization) {
-				go csrapprovercontroller.NewCSRApprover(client.GetKubeClient(), informers.GetInformersManager().GetKubeInformerFactory().Certificates().V1().CertificateSigningRequests()).
-					Run(5, ctx.Done())
-			}
-
-			gis := informers.GetInformersManager()
-
-			registerModules(config)
-
-			if config.Modules.IptablesManager == nil || config.Modules.IptablesManager.Enable && config.Modules.IptablesManager.Mode == v1alpha1.InternalMode {
-				// By default, IptablesManager manages tunnel port related iptables rules
-				// The internal mode will share the host network, forward to the stream port.
-				streamPort := int(config.Modules.CloudStream.StreamPort)
-				go iptables.NewIptablesManager(config.KubeAPIConfig, streamPort).Run(ctx)
-			}
-
-			// Start all modules
-			core.StartModules()
-			gis.Start(ctx.Done())
-			core.GracefulShutdown()
+			runWithLeaderElection(config.CommonConfig.LeaderElection, func() {
+				ctx := beehiveContext.GetContext()
+				if features.DefaultFeatureGate.Enabled(features.RequireAuthorization) {
+					go csrapprovercontroller.NewCSRApprover(client.GetKubeClient(), informers.GetInformersManager().GetKubeInformerFactory().Certificates().V1().CertificateSigningRequests()).
+						Run(5, ctx.Done())
+				}
+
+				gis := informers.GetInformersManager()
+
+				registerModules(config)
+
+				if config.Modules.IptablesManager == nil || config.Modules.IptablesManager.Enable && config.Modules.IptablesManager.Mode == v1alpha1.InternalMode {
+					// By default, IptablesManager manages tunnel port related iptables rules
+					// The internal mode will share the host network, forward to the stream port.
+					streamPort := int(config.Modules.CloudStream.StreamPort)
+					go iptables.NewIptablesManager(config.KubeAPIConfig, streamPort).Run(ctx)
+				}
+
+				// Start all modules
+				core.StartModules()
+				gis.Start(ctx.Done())
+				core.GracefulShutdown()
+			})
 		},
 	}
 	fs := cmd.Flags()
@@ -1,7 +1,10 @@
 package admissioncontroller
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -54,9 +57,189 @@ func validateDevice(device *devicesv1beta1.Device, response *admissionv1.Admissi
 		}
 	}
 
+	if err := validateDeviceOpcUA(device); err != nil {
+		response.Allowed = false
+		return err.Error()
+	}
+	if err := validateDeviceDataPlane(device); err != nil {
+		response.Allowed = false
+		return err.Error()
+	}
+	if device.Spec.Firmware != nil {
+		if err := validateDeviceFirmware(device.Spec.Firmware); err != nil {
+			response.Allowed = false
+			return err.Error()
+		}
+	}
+
 	return msg
 }
 
+// opcUANodeIDPattern matches the string form of an OPC UA node ID, like ns=2;s=Temperature.
+// The namespace index may be omitted for namespace 0.
+var opcUANodeIDPattern = regexp.MustCompile(`^(ns=[0-9]+;)?[isgb]=.+$`)
+
+// validateDeviceOpcUA validates the OPC UA protocol configuration and visitors of the device.
+func validateDeviceOpcUA(device *devicesv1beta1.Device) error {
+	protocol := device.Spec.Protocol
+	if protocol.OpcUA != nil {
+		if protocol.ProtocolName != devicesv1beta1.ProtocolNameOpcUA {
+			return fmt.Errorf("opcua protocol config requires protocolName %q, got %q", devicesv1beta1.ProtocolNameOpcUA, protocol.ProtocolName)
+		}
+		if err := validateProtocolConfigOpcUA(protocol.OpcUA); err != nil {
+			return err
+		}
+	}
+	for _, property := range device.Spec.Properties {
+		visitor := property.Visitors.OpcUA
+		if visitor == nil {
+			continue
+		}
+		if protocol.OpcUA == nil {
+			return fmt.Errorf("property %s has an opcua visitor, but the device has no opcua protocol config", property.Name)
+		}
+		if !opcUANodeIDPattern.MatchString(visitor.NodeID) {
+			return fmt.Errorf("property %s has an invalid opcua node ID %q", property.Name, visitor.NodeID)
+		}
+		if visitor.SubscriptionInterval < 0 || visitor.SamplingInterval < 0 {
+			return fmt.Errorf("property %s has a negative opcua subscription or sampling interval", property.Name)
+		}
+	}
+	return nil
+}
+
+func validateProtocolConfigOpcUA(config *devicesv1beta1.ProtocolConfigOpcUA) error {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Scheme != "opc.tcp" || u.Host == "" {
+		return fmt.Errorf("opcua url must be an opc.tcp URL like opc.tcp://127.0.0.1:4840, got %q", config.URL)
+	}
+
+	policyNone := config.SecurityPolicy == "" || config.SecurityPolicy == devicesv1beta1.OpcUASecurityPolicyNone
+	modeNone := config.SecurityMode == "" || config.SecurityMode == devicesv1beta1.OpcUASecurityModeNone
+	if policyNone != modeNone {
+		return fmt.Errorf("opcua securityPolicy %q and securityMode %q must both be None or both not be None", config.SecurityPolicy, config.SecurityMode)
+	}
+	hasCert := config.Certificate != "" && config.PrivateKey != ""
+	if !policyNone && !hasCert {
+		return fmt.Errorf("opcua securityPolicy %s requires certificate and privateKey", config.SecurityPolicy)
+	}
+
+	if auth := config.Authentication; auth != nil {
+		switch auth.Type {
+		case devicesv1beta1.OpcUAAuthenticationAnonymous:
+		case devicesv1beta1.OpcUAAuthenticationUserName:
+			if auth.UserName == "" {
+				return fmt.Errorf("opcua authentication type UserName requires userName")
+			}
+		case devicesv1beta1.OpcUAAuthenticationCertificate:
+			if !hasCert {
+				return fmt.Errorf("opcua authentication type Certificate requires certificate and privateKey")
+			}
+		default:
+			return fmt.Errorf("unsupported opcua authentication type %q", auth.Type)
+		}
+	}
+	return nil
+}
+
+// validateDeviceDataPlane validates the edge-local sinks the telemetry of the device is streamed to.
+func validateDeviceDataPlane(device *devicesv1beta1.Device) error {
+	dataPlane := device.Spec.DataPlane
+	if dataPlane == nil {
+		return nil
+	}
+	properties := make(map[string]bool, len(device.Spec.Properties))
+	for _, property := range device.Spec.Properties {
+		properties[property.Name] = true
+	}
+	for _, name := range dataPlane.Properties {
+		if !properties[name] {
+			return fmt.Errorf("dataPlane streams property %s which is not a property of the device", name)
+		}
+	}
+	if len(dataPlane.Sinks) == 0 {
+		return fmt.Errorf("dataPlane requires at least one sink")
+	}
+
+	names := make(map[string]bool, len(dataPlane.Sinks))
+	for _, sink := range dataPlane.Sinks {
+		if sink.Name == "" {
+			return fmt.Errorf("dataPlane sink names must not be empty")
+		}
+		if names[sink.Name] {
+			return fmt.Errorf("dataPlane sink names must be unique, %s is duplicated", sink.Name)
+		}
+		names[sink.Name] = true
+		if err := validateDataPlaneSink(&sink); err != nil {
+			return fmt.Errorf("dataPlane sink %s is invalid: %v", sink.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateDataPlaneSink(sink *devicesv1beta1.DataPlaneSink) error {
+	count := 0
+	if influx := sink.InfluxDB; influx != nil {
+		count++
+		if err := validateHTTPURL(influx.URL); err != nil {
+			return err
+		}
+		if influx.Org == "" || influx.Bucket == "" {
+			return fmt.Errorf("influxdb requires org and bucket")
+		}
+	}
+	if prometheus := sink.PrometheusRemoteWrite; prometheus != nil {
+		count++
+		if err := validateHTTPURL(prometheus.URL); err != nil {
+			return err
+		}
+	}
+	if mqtt := sink.MQTT; mqtt != nil {
+		count++
+		if mqtt.Broker == "" {
+			return fmt.Errorf("mqtt requires broker")
+		}
+		if mqtt.QoS < 0 || mqtt.QoS > 2 {
+			return fmt.Errorf("mqtt qos must be 0, 1 or 2, got %d", mqtt.QoS)
+		}
+	}
+	if csv := sink.CSV; csv != nil {
+		count++
+		if !strings.HasPrefix(csv.Path, "/") {
+			return fmt.Errorf("csv path must be an absolute path, got %q", csv.Path)
+		}
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of influxdb, prometheusRemoteWrite, mqtt and csv must be set, got %d", count)
+	}
+	return nil
+}
+
+// sha256ChecksumPattern matches the sha256 checksum of a firmware image, like sha256:<64 hex digits>.
+var sha256ChecksumPattern = regexp.MustCompile(`^sha256:[0-9a-fA-F]{64}$`)
+
+// validateDeviceFirmware validates the firmware image the device is upgraded to.
+func validateDeviceFirmware(firmware *devicesv1beta1.DeviceFirmware) error {
+	if firmware.Version == "" {
+		return fmt.Errorf("firmware version must not be empty")
+	}
+	if err := validateHTTPURL(firmware.URL); err != nil {
+		return fmt.Errorf("firmware %v", err)
+	}
+	if !sha256ChecksumPattern.MatchString(firmware.Checksum) {
+		return fmt.Errorf("firmware checksum must be in the form sha256:<hex digest>, got %q", firmware.Checksum)
+	}
+	return nil
+}
+
+func validateHTTPURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an http or https URL, got %q", raw)
+	}
+	return nil
+}
+
 func serveDevice(w http.ResponseWriter, r *http.Request) {
 	serve(w, r, admitDevice)
 }
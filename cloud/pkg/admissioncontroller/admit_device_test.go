@@ -0,0 +1,226 @@
+package admissioncontroller
+
+import (
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	devicesv1beta1 "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+)
+
+func newOpcUADevice(config *devicesv1beta1.ProtocolConfigOpcUA, visitor *devicesv1beta1.VisitorConfigOPCUA) devicesv1beta1.Device {
+	return devicesv1beta1.Device{
+		TypeMeta: v1.TypeMeta{
+			Kind:       "Device",
+			APIVersion: "devices.kubeedge.io/v1beta1",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "opcua-test",
+			Namespace: "default",
+		},
+		Spec: devicesv1beta1.DeviceSpec{
+			Protocol: devicesv1beta1.ProtocolConfig{
+				ProtocolName: devicesv1beta1.ProtocolNameOpcUA,
+				OpcUA:        config,
+			},
+			Properties: []devicesv1beta1.DeviceProperty{{
+				Name:     "temperature",
+				Visitors: devicesv1beta1.VisitorConfig{ProtocolName: devicesv1beta1.ProtocolNameOpcUA, OpcUA: visitor},
+			}},
+		},
+	}
+}
+
+func TestAdmitDeviceOpcUA(t *testing.T) {
+	device := newOpcUADevice(&devicesv1beta1.ProtocolConfigOpcUA{URL: "opc.tcp://127.0.0.1:4840"},
+		&devicesv1beta1.VisitorConfigOPCUA{NodeID: "ns=2;s=Temperature"})
+	jsonData, _ := json.Marshal(device)
+	review := admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: jsonData},
+		},
+	}
+	if resp := admitDevice(review); !resp.Allowed {
+		t.Fatalf("create opcua device error: %v", resp.Result.Message)
+	}
+
+	device.Spec.Properties[0].Visitors.OpcUA.NodeID = "Temperature"
+	jsonData, _ = json.Marshal(device)
+	review.Request.Object.Raw = jsonData
+	if resp := admitDevice(review); resp.Allowed {
+		t.Fatalf("create opcua device with invalid node ID should not succeed")
+	}
+}
+
+func TestValidateDeviceOpcUA(t *testing.T) {
+	certConfig := func(policy devicesv1beta1.OpcUASecurityPolicy, mode devicesv1beta1.OpcUASecurityMode) *devicesv1beta1.ProtocolConfigOpcUA {
+		return &devicesv1beta1.ProtocolConfigOpcUA{
+			URL:            "opc.tcp://127.0.0.1:4840",
+			SecurityPolicy: policy,
+			SecurityMode:   mode,
+			Certificate:    "/etc/kubeedge/opcua/client.crt",
+			PrivateKey:     "/etc/kubeedge/opcua/client.key",
+		}
+	}
+	visitor := &devicesv1beta1.VisitorConfigOPCUA{NodeID: "i=2258", SubscriptionInterval: 1000}
+
+	cases := []struct {
+		name    string
+		device  devicesv1beta1.Device
+		wantErr bool
+	}{
+		{
+			name:   "no opcua config",
+			device: newOpcUADevice(nil, nil),
+		},
+		{
+			name:   "secure channel with certificate authentication",
+			device: newOpcUADevice(withAuth(certConfig(devicesv1beta1.OpcUASecurityPolicyBasic256Sha256, devicesv1beta1.OpcUASecurityModeSignAndEncrypt), devicesv1beta1.OpcUAAuthenticationCertificate, ""), visitor),
+		},
+		{
+			name:    "invalid url scheme",
+			device:  newOpcUADevice(&devicesv1beta1.ProtocolConfigOpcUA{URL: "http://127.0.0.1:4840"}, visitor),
+			wantErr: true,
+		},
+		{
+			name:    "security mode without policy",
+			device:  newOpcUADevice(certConfig(devicesv1beta1.OpcUASecurityPolicyNone, devicesv1beta1.OpcUASecurityModeSign), visitor),
+			wantErr: true,
+		},
+		{
+			name: "security policy without certificate",
+			device: newOpcUADevice(&devicesv1beta1.ProtocolConfigOpcUA{
+				URL:            "opc.tcp://127.0.0.1:4840",
+				SecurityPolicy: devicesv1beta1.OpcUASecurityPolicyBasic256,
+				SecurityMode:   devicesv1beta1.OpcUASecurityModeSign,
+			}, visitor),
+			wantErr: true,
+		},
+		{
+			name:    "user name authentication without user name",
+			device:  newOpcUADevice(withAuth(&devicesv1beta1.ProtocolConfigOpcUA{URL: "opc.tcp://127.0.0.1:4840"}, devicesv1beta1.OpcUAAuthenticationUserName, ""), visitor),
+			wantErr: true,
+		},
+		{
+			name:    "certificate authentication without certificate",
+			device:  newOpcUADevice(withAuth(&devicesv1beta1.ProtocolConfigOpcUA{URL: "opc.tcp://127.0.0.1:4840"}, devicesv1beta1.OpcUAAuthenticationCertificate, ""), visitor),
+			wantErr: true,
+		},
+		{
+			name:    "opcua visitor without opcua protocol",
+			device:  newOpcUADevice(nil, visitor),
+			wantErr: true,
+		},
+		{
+			name:    "negative sampling interval",
+			device:  newOpcUADevice(&devicesv1beta1.ProtocolConfigOpcUA{URL: "opc.tcp://127.0.0.1:4840"}, &devicesv1beta1.VisitorConfigOPCUA{NodeID: "ns=1;i=1005", SamplingInterval: -1}),
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDeviceOpcUA(&tc.device)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateDeviceOpcUA() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+
+	device := newOpcUADevice(&devicesv1beta1.ProtocolConfigOpcUA{URL: "opc.tcp://127.0.0.1:4840"}, visitor)
+	device.Spec.Protocol.ProtocolName = "modbus"
+	if err := validateDeviceOpcUA(&device); err == nil {
+		t.Errorf("opcua config of a modbus device should be rejected")
+	}
+}
+
+func withAuth(config *devicesv1beta1.ProtocolConfigOpcUA, authType devicesv1beta1.OpcUAAuthenticationType, userName string) *devicesv1beta1.ProtocolConfigOpcUA {
+	config.Authentication = &devicesv1beta1.OpcUAAuthentication{Type: authType, UserName: userName}
+	return config
+}
+
+func TestValidateDeviceDataPlane(t *testing.T) {
+	newDevice := func(dataPlane *devicesv1beta1.DeviceDataPlane) devicesv1beta1.Device {
+		device := newOpcUADevice(nil, nil)
+		device.Spec.DataPlane = dataPlane
+		return device
+	}
+	influx := &devicesv1beta1.DataPlaneSinkInfluxDB{URL: "http://127.0.0.1:8086", Org: "kubeedge", Bucket: "telemetry"}
+	csv := &devicesv1beta1.DataPlaneSinkCSV{Path: "/var/lib/kubeedge/telemetry"}
+
+	cases := []struct {
+		name      string
+		dataPlane *devicesv1beta1.DeviceDataPlane
+		wantErr   bool
+	}{
+		{
+			name: "no data plane",
+		},
+		{
+			name: "all sink types",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Properties: []string{"temperature"},
+				SampleRate: 1000,
+				Sinks: []devicesv1beta1.DataPlaneSink{
+					{Name: "influx", InfluxDB: influx},
+					{Name: "prometheus", PrometheusRemoteWrite: &devicesv1beta1.DataPlaneSinkPrometheusRemoteWrite{URL: "http://127.0.0.1:9090/api/v1/write"}},
+					{Name: "mqtt", MQTT: &devicesv1beta1.DataPlaneSinkMQTT{Broker: "tcp://127.0.0.1:1883", QoS: 1}},
+					{Name: "csv", CSV: csv},
+				},
+			},
+		},
+		{
+			name:      "no sinks",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{},
+			wantErr:   true,
+		},
+		{
+			name: "unknown property",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Properties: []string{"humidity"},
+				Sinks:      []devicesv1beta1.DataPlaneSink{{Name: "csv", CSV: csv}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated sink name",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Sinks: []devicesv1beta1.DataPlaneSink{{Name: "local", CSV: csv}, {Name: "local", InfluxDB: influx}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "sink with two configs",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Sinks: []devicesv1beta1.DataPlaneSink{{Name: "local", CSV: csv, InfluxDB: influx}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "influxdb without bucket",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Sinks: []devicesv1beta1.DataPlaneSink{{Name: "influx", InfluxDB: &devicesv1beta1.DataPlaneSinkInfluxDB{URL: "http://127.0.0.1:8086", Org: "kubeedge"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "relative csv path",
+			dataPlane: &devicesv1beta1.DeviceDataPlane{
+				Sinks: []devicesv1beta1.DataPlaneSink{{Name: "csv", CSV: &devicesv1beta1.DataPlaneSinkCSV{Path: "telemetry"}}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			device := newDevice(tc.dataPlane)
+			err := validateDeviceDataPlane(&device)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateDeviceDataPlane() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissioncontroller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+func serveDeviceUpgradeJob(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, admitDeviceUpgradeJob)
+}
+
+func admitDeviceUpgradeJob(review admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	switch review.Request.Operation {
+	case admissionv1.Create:
+		upgrade := v1alpha1.DeviceUpgradeJob{}
+		if err := json.Unmarshal(review.Request.Object.Raw, &upgrade); err != nil {
+			return admissionResponse(fmt.Errorf("validation failed with error: %v", err))
+		}
+
+		return admissionResponse(validateDeviceUpgradeJob(&upgrade))
+
+	case admissionv1.Update:
+		newUpgrade := v1alpha1.DeviceUpgradeJob{}
+		if err := json.Unmarshal(review.Request.Object.Raw, &newUpgrade); err != nil {
+			return admissionResponse(fmt.Errorf("validation failed with error: %v", err))
+		}
+
+		oldUpgrade := v1alpha1.DeviceUpgradeJob{}
+		if err := json.Unmarshal(review.Request.OldObject.Raw, &oldUpgrade); err != nil {
+			return admissionResponse(fmt.Errorf("validation failed with error: %v", err))
+		}
+
+		// For update, we don't allow update spec fields once an Upgrade is created.
+		if !reflect.DeepEqual(oldUpgrade.Spec, newUpgrade.Spec) {
+			err := errors.New("spec fields are not allowed to update once it's created")
+			return admissionResponse(err)
+		}
+
+		return admissionResponse(nil)
+
+	case admissionv1.Delete:
+		//no rule defined for above operations, greenlight for all of above.
+		return admissionResponse(nil)
+	default:
+		err := fmt.Errorf("unsupported webhook operation %v", review.Request.Operation)
+		return admissionResponse(err)
+	}
+}
+
+func validateDeviceUpgradeJob(upgrade *v1alpha1.DeviceUpgradeJob) error {
+	if err := validateDeviceFirmware(&upgrade.Spec.Firmware); err != nil {
+		return err
+	}
+
+	// we must specify DeviceNames or LabelSelector, and we can only specify only one
+	if len(upgrade.Spec.DeviceNames) == 0 && upgrade.Spec.LabelSelector == nil {
+		return fmt.Errorf("both DeviceNames and LabelSelector are NOT specified")
+	}
+	if len(upgrade.Spec.DeviceNames) != 0 && upgrade.Spec.LabelSelector != nil {
+		return fmt.Errorf("both DeviceNames and LabelSelector are specified")
+	}
+	if upgrade.Spec.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(upgrade.Spec.LabelSelector); err != nil {
+			return fmt.Errorf("labelSelector is not valid: %v", err)
+		}
+	}
+
+	if upgrade.Spec.BatchSize < 0 {
+		return fmt.Errorf("batchSize %d must not be negative", upgrade.Spec.BatchSize)
+	}
+	if upgrade.Spec.FailureTolerate != "" {
+		tolerate, err := strconv.ParseFloat(upgrade.Spec.FailureTolerate, 64)
+		if err != nil || tolerate < 0 || tolerate > 1 {
+			return fmt.Errorf("failureTolerate %q must be a number in range [0, 1]", upgrade.Spec.FailureTolerate)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package admissioncontroller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	devicesv1beta1 "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+func TestValidateDeviceUpgradeJob(t *testing.T) {
+	firmware := devicesv1beta1.DeviceFirmware{
+		Version:  "v2.0.0",
+		URL:      "https://firmware.example.com/meter-v2.0.0.bin",
+		Checksum: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+	}
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "meter"}}
+	withFirmware := func(modify func(*devicesv1beta1.DeviceFirmware)) devicesv1beta1.DeviceFirmware {
+		f := firmware
+		modify(&f)
+		return f
+	}
+
+	cases := []struct {
+		name    string
+		spec    v1alpha1.DeviceUpgradeJobSpec
+		wantErr bool
+	}{
+		{
+			name: "device names",
+			spec: v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware, DeviceNames: []string{"meter"}, BatchSize: 10, FailureTolerate: "0.1"},
+		},
+		{
+			name: "label selector",
+			spec: v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware, LabelSelector: selector},
+		},
+		{
+			name:    "no devices",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware},
+			wantErr: true,
+		},
+		{
+			name:    "both device names and label selector",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware, DeviceNames: []string{"meter"}, LabelSelector: selector},
+			wantErr: true,
+		},
+		{
+			name:    "no firmware version",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: withFirmware(func(f *devicesv1beta1.DeviceFirmware) { f.Version = "" }), LabelSelector: selector},
+			wantErr: true,
+		},
+		{
+			name:    "firmware url not http",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: withFirmware(func(f *devicesv1beta1.DeviceFirmware) { f.URL = "ftp://firmware.example.com/a.bin" }), LabelSelector: selector},
+			wantErr: true,
+		},
+		{
+			name:    "md5 checksum",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: withFirmware(func(f *devicesv1beta1.DeviceFirmware) { f.Checksum = "md5:098f6bcd4621d373cade4e832627b4f6" }), LabelSelector: selector},
+			wantErr: true,
+		},
+		{
+			name:    "failure tolerate out of range",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware, LabelSelector: selector, FailureTolerate: "1.5"},
+			wantErr: true,
+		},
+		{
+			name:    "negative batch size",
+			spec:    v1alpha1.DeviceUpgradeJobSpec{Firmware: firmware, LabelSelector: selector, BatchSize: -1},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDeviceUpgradeJob(&v1alpha1.DeviceUpgradeJob{Spec: tc.spec})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateDeviceUpgradeJob() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
 	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
 )
 
@@ -100,6 +101,49 @@ func validateNodeUpgradeJob(upgrade *v1alpha1.NodeUpgradeJob) error {
 		return fmt.Errorf("both NodeNames and LabelSelctor are specified")
 	}
 
+	if upgrade.Spec.Canary != nil {
+		return validateCanaryStrategy(upgrade.Spec.Canary)
+	}
+	return nil
+}
+
+func validateCanaryStrategy(canary *v1alpha1.CanaryStrategy) error {
+	// we must specify Percentage or LabelSelector of canary, and we can only specify only one
+	if canary.Percentage == 0 && canary.LabelSelector == nil {
+		return fmt.Errorf("both canary Percentage and LabelSelector are NOT specified")
+	}
+	if canary.Percentage != 0 && canary.LabelSelector != nil {
+		return fmt.Errorf("both canary Percentage and LabelSelector are specified")
+	}
+	if canary.Percentage < 0 || canary.Percentage > 100 {
+		return fmt.Errorf("canary percentage %d must be in range [1, 100]", canary.Percentage)
+	}
+	if canary.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(canary.LabelSelector); err != nil {
+			return fmt.Errorf("canary labelSelector is not valid: %v", err)
+		}
+	}
+	if canary.HealthySeconds < 0 || canary.GateTimeoutSeconds < 0 {
+		return fmt.Errorf("canary healthySeconds and gateTimeoutSeconds must not be negative")
+	}
+
+	healthySeconds, gateTimeoutSeconds := canary.HealthySeconds, canary.GateTimeoutSeconds
+	if healthySeconds == 0 {
+		healthySeconds = util.DefaultCanaryHealthySeconds
+	}
+	if gateTimeoutSeconds == 0 {
+		gateTimeoutSeconds = util.DefaultCanaryGateTimeoutSeconds
+	}
+	if gateTimeoutSeconds <= healthySeconds {
+		return fmt.Errorf("canary gateTimeoutSeconds %d must be greater than healthySeconds %d", gateTimeoutSeconds, healthySeconds)
+	}
+
+	switch canary.FailurePolicy {
+	case "", v1alpha1.CanaryFailurePause, v1alpha1.CanaryFailureRollback:
+	default:
+		return fmt.Errorf("canary failurePolicy %s is not supported, must be %s or %s",
+			canary.FailurePolicy, v1alpha1.CanaryFailurePause, v1alpha1.CanaryFailureRollback)
+	}
 	return nil
 }
 
@@ -0,0 +1,63 @@
+package admissioncontroller
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+func TestValidateCanaryStrategy(t *testing.T) {
+	cases := []struct {
+		name    string
+		canary  v1alpha1.CanaryStrategy
+		wantErr bool
+	}{
+		{
+			name:   "percentage",
+			canary: v1alpha1.CanaryStrategy{Percentage: 10},
+		},
+		{
+			name: "label selector with rollback",
+			canary: v1alpha1.CanaryStrategy{
+				LabelSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+				HealthySeconds: 600, GateTimeoutSeconds: 1200, FailurePolicy: v1alpha1.CanaryFailureRollback,
+			},
+		},
+		{
+			name:    "no canary nodes",
+			canary:  v1alpha1.CanaryStrategy{},
+			wantErr: true,
+		},
+		{
+			name: "both percentage and label selector",
+			canary: v1alpha1.CanaryStrategy{Percentage: 10,
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+			wantErr: true,
+		},
+		{
+			name:    "percentage out of range",
+			canary:  v1alpha1.CanaryStrategy{Percentage: 101},
+			wantErr: true,
+		},
+		{
+			name:    "gate timeout shorter than the default healthy seconds",
+			canary:  v1alpha1.CanaryStrategy{Percentage: 10, GateTimeoutSeconds: 300},
+			wantErr: true,
+		},
+		{
+			name:    "unknown failure policy",
+			canary:  v1alpha1.CanaryStrategy{Percentage: 10, FailurePolicy: "Ignore"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCanaryStrategy(&tc.canary)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateCanaryStrategy() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -3,10 +3,17 @@ package admissioncontroller
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider/cloudevents"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider/kubernetesevent"
 	rulesv1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
 )
 
@@ -15,6 +22,11 @@ var (
 		{rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeEventBus},
 		{rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeServiceBus},
 		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeRest},
+		{rulesv1.RuleEndpointTypeWebhook, rulesv1.RuleEndpointTypeEventBus},
+		{rulesv1.RuleEndpointTypeWebhook, rulesv1.RuleEndpointTypeServiceBus},
+		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeWebhook},
+		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeKubernetesEvent},
+		{rulesv1.RuleEndpointTypeEventBus, rulesv1.RuleEndpointTypeCloudEvents},
 	}
 )
 
@@ -83,10 +95,11 @@ func validateRule(rule *rulesv1.Rule) error {
 }
 func validateSourceRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint, sourceResource map[string]string) error {
 	switch ruleEndpoint.Spec.RuleEndpointType {
-	case rulesv1.RuleEndpointTypeRest:
+	case rulesv1.RuleEndpointTypeRest, rulesv1.RuleEndpointTypeWebhook:
+		// webhook sources share the router rest listener, so their paths must not conflict with the rest sources
 		_, exist := sourceResource["path"]
 		if !exist {
-			return fmt.Errorf("\"path\" property missed in sourceResource when ruleEndpoint is \"rest\"")
+			return fmt.Errorf("\"path\" property missed in sourceResource when ruleEndpoint is %q", ruleEndpoint.Spec.RuleEndpointType)
 		}
 		rules, err := controller.listRule(ruleEndpoint.Namespace)
 		if err != nil {
@@ -136,6 +149,91 @@ func validateTargetRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint, targetResour
 		if !exist {
 			return fmt.Errorf("\"path\" property missed in targetResource when ruleEndpoint is \"servicebus\"")
 		}
+		if v, exist := targetResource["timeout"]; exist {
+			if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+				return fmt.Errorf("\"timeout\" %q must be a positive duration", v)
+			}
+		}
+	case rulesv1.RuleEndpointTypeWebhook:
+		return validateWebhookTargetResource(targetResource)
+	case rulesv1.RuleEndpointTypeKubernetesEvent:
+		return validateKubernetesEventTargetResource(targetResource)
+	case rulesv1.RuleEndpointTypeCloudEvents:
+		return validateCloudEventsTargetResource(ruleEndpoint, targetResource)
+	}
+	return nil
+}
+
+func validateKubernetesEventTargetResource(targetResource map[string]string) error {
+	if v, exist := targetResource["reason"]; exist && v == "" {
+		return fmt.Errorf("\"reason\" must not be empty when ruleEndpoint is \"kubernetesevent\"")
+	}
+	if v, exist := targetResource["eventType"]; exist && v != corev1.EventTypeNormal && v != corev1.EventTypeWarning {
+		return fmt.Errorf("\"eventType\" %q must be %s or %s", v, corev1.EventTypeNormal, corev1.EventTypeWarning)
+	}
+	extensions, err := provider.ParseExtensions(targetResource["extensions"])
+	if err != nil {
+		return err
+	}
+	return kubernetesevent.ValidateExtensions(extensions)
+}
+
+func validateCloudEventsTargetResource(ruleEndpoint *rulesv1.RuleEndpoint, targetResource map[string]string) error {
+	resource, exist := targetResource["resource"]
+	if !exist || resource == "" {
+		return fmt.Errorf("\"resource\" property missed in targetResource when ruleEndpoint is \"cloudevents\"")
+	}
+	if protocol := ruleEndpoint.Spec.Properties["protocol"]; protocol == "" || protocol == "http" {
+		u, err := url.Parse(resource)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("\"resource\" %q must be a http or https url when ruleEndpoint protocol is \"http\"", resource)
+		}
+	}
+	if v, exist := targetResource["mode"]; exist && v != "binary" && v != "structured" {
+		return fmt.Errorf("\"mode\" %q must be binary or structured", v)
+	}
+	if v, exist := targetResource["retries"]; exist {
+		if retries, err := strconv.Atoi(v); err != nil || retries < 0 {
+			return fmt.Errorf("\"retries\" %q must be a non-negative integer", v)
+		}
+	}
+	if v, exist := targetResource["timeout"]; exist {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			return fmt.Errorf("\"timeout\" %q must be a positive duration", v)
+		}
+	}
+	extensions, err := provider.ParseExtensions(targetResource["extensions"])
+	if err != nil {
+		return err
+	}
+	return cloudevents.ValidateExtensions(extensions)
+}
+
+func validateWebhookTargetResource(targetResource map[string]string) error {
+	resource, exist := targetResource["resource"]
+	if !exist {
+		return fmt.Errorf("\"resource\" property missed in targetResource when ruleEndpoint is \"webhook\"")
+	}
+	u, err := url.Parse(resource)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("\"resource\" %q must be a http or https url when ruleEndpoint is \"webhook\"", resource)
+	}
+	if v, exist := targetResource["retries"]; exist {
+		if retries, err := strconv.Atoi(v); err != nil || retries < 0 {
+			return fmt.Errorf("\"retries\" %q must be a non-negative integer", v)
+		}
+	}
+	for _, name := range []string{"retryBackoff", "maxRetryBackoff", "timeout"} {
+		if v, exist := targetResource[name]; exist {
+			if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+				return fmt.Errorf("%q %q must be a positive duration", name, v)
+			}
+		}
+	}
+	if v, exist := targetResource["deadLetter"]; exist {
+		if _, err := strconv.ParseBool(v); err != nil {
+			return fmt.Errorf("\"deadLetter\" %q must be true or false", v)
+		}
 	}
 	return nil
 }
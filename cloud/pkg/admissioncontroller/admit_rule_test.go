@@ -0,0 +1,167 @@
+package admissioncontroller
+
+import (
+	"testing"
+
+	rulesv1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+func TestValidateWebhookTargetResource(t *testing.T) {
+	cases := []struct {
+		name           string
+		targetResource map[string]string
+		wantErr        bool
+	}{
+		{
+			name:           "only resource",
+			targetResource: map[string]string{"resource": "https://example.com/hook"},
+		},
+		{
+			name: "all options",
+			targetResource: map[string]string{"resource": "http://127.0.0.1:8080/hook", "retries": "5",
+				"retryBackoff": "500ms", "maxRetryBackoff": "1m", "timeout": "5s", "deadLetter": "false"},
+		},
+		{
+			name:           "no resource",
+			targetResource: map[string]string{},
+			wantErr:        true,
+		},
+		{
+			name:           "not http url",
+			targetResource: map[string]string{"resource": "tcp://127.0.0.1:8080"},
+			wantErr:        true,
+		},
+		{
+			name:           "negative retries",
+			targetResource: map[string]string{"resource": "https://example.com/hook", "retries": "-1"},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid timeout",
+			targetResource: map[string]string{"resource": "https://example.com/hook", "timeout": "10"},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid dead letter",
+			targetResource: map[string]string{"resource": "https://example.com/hook", "deadLetter": "no"},
+			wantErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateWebhookTargetResource(tc.targetResource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateWebhookTargetResource() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateKubernetesEventTargetResource(t *testing.T) {
+	cases := []struct {
+		name           string
+		targetResource map[string]string
+		wantErr        bool
+	}{
+		{
+			name:           "defaults",
+			targetResource: map[string]string{},
+		},
+		{
+			name:           "all options",
+			targetResource: map[string]string{"reason": "TemperatureAlert", "eventType": "Warning", "extensions": "kubeedge.io/topic=topic, msgid=messageID"},
+		},
+		{
+			name:           "empty reason",
+			targetResource: map[string]string{"reason": ""},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid event type",
+			targetResource: map[string]string{"eventType": "Error"},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid annotation key",
+			targetResource: map[string]string{"extensions": "edge topic=topic"},
+			wantErr:        true,
+		},
+		{
+			name:           "extension without header",
+			targetResource: map[string]string{"extensions": "edgetopic"},
+			wantErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateKubernetesEventTargetResource(tc.targetResource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateKubernetesEventTargetResource() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCloudEventsTargetResource(t *testing.T) {
+	cases := []struct {
+		name           string
+		properties     map[string]string
+		targetResource map[string]string
+		wantErr        bool
+	}{
+		{
+			name:           "http",
+			targetResource: map[string]string{"resource": "http://broker-ingress.knative-eventing/default/default"},
+		},
+		{
+			name:       "kafka with all options",
+			properties: map[string]string{"protocol": "kafka", "brokers": "kafka:9092"},
+			targetResource: map[string]string{"resource": "edge-alerts", "type": "com.example.alert", "source": "/factory/1",
+				"mode": "structured", "retries": "0", "timeout": "5s", "extensions": "edgenode=nodeName,edgetopic=topic"},
+		},
+		{
+			name:           "no resource",
+			targetResource: map[string]string{},
+			wantErr:        true,
+		},
+		{
+			name:           "not http url",
+			targetResource: map[string]string{"resource": "edge-alerts"},
+			wantErr:        true,
+		},
+		{
+			name:           "invalid mode",
+			targetResource: map[string]string{"resource": "http://127.0.0.1/events", "mode": "batch"},
+			wantErr:        true,
+		},
+		{
+			name:           "upper-case extension",
+			targetResource: map[string]string{"resource": "http://127.0.0.1/events", "extensions": "edgeNode=nodeName"},
+			wantErr:        true,
+		},
+		{
+			name:           "context attribute extension",
+			targetResource: map[string]string{"resource": "http://127.0.0.1/events", "extensions": "subject=topic"},
+			wantErr:        true,
+		},
+		{
+			name:           "duplicated extension",
+			targetResource: map[string]string{"resource": "http://127.0.0.1/events", "extensions": "edgenode=nodeName,edgenode=topic"},
+			wantErr:        true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleEndpoint := &rulesv1.RuleEndpoint{
+				Spec: rulesv1.RuleEndpointSpec{
+					RuleEndpointType: rulesv1.RuleEndpointTypeCloudEvents,
+					Properties:       tc.properties,
+				},
+			}
+			err := validateCloudEventsTargetResource(ruleEndpoint, tc.targetResource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateCloudEventsTargetResource() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
 	rulesv1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
@@ -53,6 +55,32 @@ func validateRuleEndpoint(ruleEndpoint *rulesv1.RuleEndpoint) error {
 		if port < 1 || port > 65535 {
 			return fmt.Errorf("port must be in range 1-65535")
 		}
+	case rulesv1.RuleEndpointTypeWebhook:
+		if secret, exist := ruleEndpoint.Spec.Properties["secret"]; exist {
+			if errs := validation.IsDNS1123Subdomain(secret); len(errs) != 0 {
+				return fmt.Errorf("\"secret\" property %q is not a valid secret name: %s", secret, strings.Join(errs, ", "))
+			}
+		}
+	case rulesv1.RuleEndpointTypeCloudEvents:
+		switch protocol := ruleEndpoint.Spec.Properties["protocol"]; protocol {
+		case "", "http":
+		case "kafka":
+			if strings.TrimSpace(strings.ReplaceAll(ruleEndpoint.Spec.Properties["brokers"], ",", "")) == "" {
+				return fmt.Errorf("\"brokers\" property missed in property when ruleEndpoint protocol is \"kafka\"")
+			}
+		default:
+			return fmt.Errorf("\"protocol\" property %q must be http or kafka", protocol)
+		}
+		if v, exist := ruleEndpoint.Spec.Properties["tls"]; exist {
+			if _, err := strconv.ParseBool(v); err != nil {
+				return fmt.Errorf("\"tls\" property %q must be true or false", v)
+			}
+		}
+		if secret, exist := ruleEndpoint.Spec.Properties["secret"]; exist {
+			if errs := validation.IsDNS1123Subdomain(secret); len(errs) != 0 {
+				return fmt.Errorf("\"secret\" property %q is not a valid secret name: %s", secret, strings.Join(errs, ", "))
+			}
+		}
 	}
 	return nil
 }
@@ -191,3 +191,88 @@ func Test_admitRuleEndpoint(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateWebhookRuleEndpoint(t *testing.T) {
+	cases := []struct {
+		name       string
+		properties map[string]string
+		wantErr    bool
+	}{
+		{
+			name: "no secret",
+		},
+		{
+			name:       "valid secret",
+			properties: map[string]string{"secret": "my-webhook-secret"},
+		},
+		{
+			name:       "empty secret",
+			properties: map[string]string{"secret": ""},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid secret name",
+			properties: map[string]string{"secret": "My_Secret"},
+			wantErr:    true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleEndpoint := rulesv1.RuleEndpoint{
+				Spec: rulesv1.RuleEndpointSpec{
+					RuleEndpointType: rulesv1.RuleEndpointTypeWebhook,
+					Properties:       tc.properties,
+				},
+			}
+			err := validateRuleEndpoint(&ruleEndpoint)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateRuleEndpoint() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCloudEventsRuleEndpoint(t *testing.T) {
+	cases := []struct {
+		name       string
+		properties map[string]string
+		wantErr    bool
+	}{
+		{
+			name: "http by default",
+		},
+		{
+			name:       "kafka",
+			properties: map[string]string{"protocol": "kafka", "brokers": "kafka-0:9092,kafka-1:9092", "tls": "true", "secret": "kafka-credentials"},
+		},
+		{
+			name:       "kafka without brokers",
+			properties: map[string]string{"protocol": "kafka", "brokers": " , "},
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported protocol",
+			properties: map[string]string{"protocol": "amqp"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid tls",
+			properties: map[string]string{"protocol": "kafka", "brokers": "kafka:9092", "tls": "yes"},
+			wantErr:    true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleEndpoint := rulesv1.RuleEndpoint{
+				Spec: rulesv1.RuleEndpointSpec{
+					RuleEndpointType: rulesv1.RuleEndpointTypeCloudEvents,
+					Properties:       tc.properties,
+				},
+			}
+			err := validateRuleEndpoint(&ruleEndpoint)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("validateRuleEndpoint() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
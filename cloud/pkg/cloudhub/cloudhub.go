@@ -2,12 +2,15 @@ package cloudhub
 
 import (
 	"os"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/dispatcher"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/handler"
@@ -23,12 +26,16 @@ import (
 
 var DoneTLSTunnelCerts = make(chan bool, 1)
 
+// messageStoreGCInterval is the interval of removing the expired persisted messages
+const messageStoreGCInterval = 10 * time.Minute
+
 type cloudHub struct {
 	enable               bool
 	informersSyncedFuncs []cache.InformerSynced
 
 	messageHandler handler.Handler
 	dispatcher     dispatcher.MessageDispatcher
+	messageStore   common.MessageStore
 }
 
 var _ core.Module = (*cloudHub)(nil)
@@ -41,9 +48,21 @@ func newCloudHub(enable bool) *cloudHub {
 
 	sessionManager := session.NewSessionManager(hubconfig.Config.NodeLimit)
 
+	var messageStore common.MessageStore
+	if enable && hubconfig.Config.MessageStore != nil && hubconfig.Config.MessageStore.Enable {
+		storeConfig := hubconfig.Config.MessageStore
+		store, err := common.NewBoltMessageStore(storeConfig.Path,
+			time.Duration(storeConfig.Retention)*time.Hour, int(storeConfig.MaxMessagesPerNode))
+		if err != nil {
+			klog.Exitf("failed to init cloudhub message store: %v", err)
+		}
+		messageStore = store
+	}
+
 	messageDispatcher := dispatcher.NewMessageDispatcher(
 		sessionManager, objectSyncInformer.Lister(),
-		clusterObjectSyncInformer.Lister(), client.GetCRDClient())
+		clusterObjectSyncInformer.Lister(), client.GetCRDClient(), messageStore,
+		hubconfig.Config.TrafficShaping)
 
 	messageHandler := handler.NewMessageHandler(
 		int(hubconfig.Config.KeepaliveInterval),
@@ -53,6 +72,7 @@ func newCloudHub(enable bool) *cloudHub {
 		enable:         enable,
 		dispatcher:     messageDispatcher,
 		messageHandler: messageHandler,
+		messageStore:   messageStore,
 	}
 
 	ch.informersSyncedFuncs = append(ch.informersSyncedFuncs, clusterObjectSyncInformer.Informer().HasSynced)
@@ -88,6 +108,16 @@ func (ch *cloudHub) Start() {
 	// start dispatch message from the cloud to edge node
 	go ch.dispatcher.DispatchDownstream()
 
+	if ch.messageStore != nil {
+		go wait.Until(ch.messageStore.GC, messageStoreGCInterval, beehiveContext.Done())
+		go func() {
+			<-beehiveContext.Done()
+			if err := ch.messageStore.Close(); err != nil {
+				klog.Errorf("failed to close cloudhub message store: %v", err)
+			}
+		}()
+	}
+
 	// check whether the certificates exist in the local directory,
 	// and then check whether certificates exist in the secret, generate if they don't exist
 	if err := httpserver.PrepareAllCerts(); err != nil {
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/x509"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/events"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+)
+
+// Reasons of the events cloudhub records on the edge nodes for their certificates
+const (
+	EventReasonEdgeCertificateSigned   = "EdgeCertificateSigned"
+	EventReasonEdgeCertificateExpiring = "EdgeCertificateExpiring"
+)
+
+// edgeCertificateExpiringRatio is the ratio of the lifetime of the edge certificate after which
+// the certificate is considered expiring, edgecore rotates it at 70-90% of the lifetime, so
+// a certificate beyond the ratio is not rotated in time
+const edgeCertificateExpiringRatio = 0.9
+
+// eventComponent is the source component of the events recorded by cloudhub
+const eventComponent = "cloudhub"
+
+// ObserveEdgeCertificate records the expiration of the certificate the node connects with,
+// and warns on the node when the certificate is expiring without being rotated
+func ObserveEdgeCertificate(nodeID string, cert *x509.Certificate) {
+	monitor.EdgeCertificateExpiration.WithLabelValues(nodeID).Set(float64(cert.NotAfter.Unix()))
+	if edgeCertificateExpiring(cert, time.Now()) {
+		events.Recorder(eventComponent).Eventf(events.NodeReference(nodeID), v1.EventTypeWarning, EventReasonEdgeCertificateExpiring,
+			"Certificate of edge node %s expires at %s and is not rotated", nodeID, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+}
+
+// RecordEdgeCertificateSigned records the expiration of the certificate signed for the node
+func RecordEdgeCertificateSigned(nodeID string, cert *x509.Certificate) {
+	monitor.EdgeCertificateExpiration.WithLabelValues(nodeID).Set(float64(cert.NotAfter.Unix()))
+	events.Recorder(eventComponent).Eventf(events.NodeReference(nodeID), v1.EventTypeNormal, EventReasonEdgeCertificateSigned,
+		"Signed certificate of edge node %s, it expires at %s", nodeID, cert.NotAfter.UTC().Format(time.RFC3339))
+}
+
+func edgeCertificateExpiring(cert *x509.Certificate, now time.Time) bool {
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return now.After(cert.NotBefore.Add(time.Duration(float64(lifetime) * edgeCertificateExpiringRatio)))
+}
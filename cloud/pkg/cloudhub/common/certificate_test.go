@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+)
+
+func TestEdgeCertificateExpiring(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(100 * 24 * time.Hour)}
+
+	cases := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "within the rotation window", now: notBefore.Add(80 * 24 * time.Hour), want: false},
+		{name: "beyond the rotation window", now: notBefore.Add(95 * 24 * time.Hour), want: true},
+		{name: "expired", now: notBefore.Add(101 * 24 * time.Hour), want: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := edgeCertificateExpiring(cert, tc.now); got != tc.want {
+				t.Errorf("edgeCertificateExpiring() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
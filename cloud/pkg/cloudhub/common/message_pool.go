@@ -21,6 +21,7 @@ import (
 
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
 
 	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
 )
@@ -42,6 +43,9 @@ type NodeMessagePool struct {
 	// NoAckMessageQueue store message key that will send to edge node
 	// and do not require acknowledgement from edge node.
 	NoAckMessageQueue workqueue.RateLimitingInterface
+	// MessageStore persists the messages of the pool until they are delivered,
+	// it is nil if the persistence of the downstream messages is disabled.
+	MessageStore MessageStore
 }
 
 // InitNodeMessagePool init node message pool for node
@@ -100,6 +104,26 @@ func (nsp *NodeMessagePool) GetNoAckMessage(key string) (*beehivemodel.Message,
 	return msg, nil
 }
 
+// PersistMessage persists the message with its key in the pool
+func (nsp *NodeMessagePool) PersistMessage(nodeID, key string, ack bool, msg *beehivemodel.Message) {
+	if nsp.MessageStore == nil {
+		return
+	}
+	if err := nsp.MessageStore.Put(nodeID, key, ack, msg); err != nil {
+		klog.Warningf("failed to persist message %s for node %s, it is kept in memory only: %v", msg.GetID(), nodeID, err)
+	}
+}
+
+// UnpersistMessage removes the persisted message once it is delivered or discarded
+func (nsp *NodeMessagePool) UnpersistMessage(nodeID, key string, ack bool, msg *beehivemodel.Message) {
+	if nsp.MessageStore == nil {
+		return
+	}
+	if err := nsp.MessageStore.Delete(nodeID, key, ack, msg); err != nil {
+		klog.Errorf("failed to delete persisted message %s of node %s, err: %v", msg.GetID(), nodeID, err)
+	}
+}
+
 // ShutDown will close all the message queue in the message pool
 func (nsp *NodeMessagePool) ShutDown() {
 	nsp.AckMessageQueue.ShutDown()
@@ -0,0 +1,372 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/klog/v2"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// ErrMessageStoreFull is returned by Put when the node has reached the maximum number of persisted messages
+var ErrMessageStoreFull = errors.New("message store of the node is full")
+
+const (
+	ackKeyPrefix   = "ack/"
+	noAckKeyPrefix = "noack/"
+)
+
+// MessageStore persists the downstream messages of the edge nodes, so that the messages of
+// an offline node survive the restarts of CloudCore and are replayed when the node connects again
+type MessageStore interface {
+	// Put persists the message with its key in the node message pool, it replaces the message
+	// persisted with the same key
+	Put(nodeID, key string, ack bool, msg *beehivemodel.Message) error
+	// Delete removes the persisted message once it is delivered, a newer message persisted
+	// with the same key in the meantime is kept
+	Delete(nodeID, key string, ack bool, msg *beehivemodel.Message) error
+	// DeleteNode removes all the persisted messages of the node
+	DeleteNode(nodeID string) error
+	// List returns the unexpired persisted messages of the node in the order they were persisted
+	List(nodeID string) ([]*PersistedMessage, error)
+	// GC removes the expired messages of all the nodes
+	GC()
+	// Close closes the store
+	Close() error
+}
+
+// PersistedMessage is a message read from the MessageStore
+type PersistedMessage struct {
+	// Key is the key of the message in the node message pool
+	Key string
+	// Ack indicates whether the message requires acknowledgement from the edge node
+	Ack     bool
+	Message *beehivemodel.Message
+}
+
+// messageRecord is the persisted form of a message
+type messageRecord struct {
+	Header beehivemodel.MessageHeader `json:"header"`
+	Router beehivemodel.MessageRoute  `json:"route,omitempty"`
+	// Content is the content of the message in the form it is sent to the edge node
+	Content []byte `json:"content,omitempty"`
+	// Object indicates the content is an encoded object rather than raw bytes
+	Object   bool      `json:"object,omitempty"`
+	Key      string    `json:"key"`
+	Ack      bool      `json:"ack,omitempty"`
+	Seq      uint64    `json:"seq"`
+	StoredAt time.Time `json:"storedAt"`
+}
+
+type boltMessageStore struct {
+	db          *bolt.DB
+	retention   time.Duration
+	maxMessages int
+
+	// counts records the number of the persisted messages of each node
+	counts     map[string]int
+	countsLock sync.Mutex
+}
+
+// NewBoltMessageStore opens the bbolt database file at path as the MessageStore, the messages
+// older than retention are dropped and at most maxMessages messages are persisted for a node
+func NewBoltMessageStore(path string, retention time.Duration, maxMessages int) (MessageStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of %s, err: %v", path, err)
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: constants.BoltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open message store %s, err: %v", path, err)
+	}
+	s := &boltMessageStore{
+		db:          db,
+		retention:   retention,
+		maxMessages: maxMessages,
+		counts:      make(map[string]int),
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			s.setCount(string(name), b.Stats().KeyN)
+			return nil
+		})
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to load message store %s, err: %v", path, err)
+	}
+	return s, nil
+}
+
+func (s *boltMessageStore) Put(nodeID, key string, ack bool, msg *beehivemodel.Message) error {
+	record, err := newMessageRecord(key, ack, msg)
+	if err != nil {
+		return err
+	}
+	var created bool
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(nodeID))
+		if err != nil {
+			return err
+		}
+		k := recordKey(key, ack)
+		if data := b.Get(k); data != nil {
+			var old messageRecord
+			if err := json.Unmarshal(data, &old); err == nil && old.Header.ID == msg.GetID() {
+				// the message is already persisted, e.g. it is replayed from the store
+				return nil
+			}
+		} else if s.getCount(nodeID) >= s.maxMessages {
+			return ErrMessageStoreFull
+		} else {
+			created = true
+		}
+		if record.Seq, err = b.NextSequence(); err != nil {
+			return err
+		}
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		return b.Put(k, data)
+	})
+	if err == nil && created {
+		s.addCount(nodeID, 1)
+	}
+	return err
+}
+
+func (s *boltMessageStore) Delete(nodeID, key string, ack bool, msg *beehivemodel.Message) error {
+	var deleted bool
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(nodeID))
+		if b == nil {
+			return nil
+		}
+		k := recordKey(key, ack)
+		data := b.Get(k)
+		if data == nil {
+			return nil
+		}
+		var record messageRecord
+		if err := json.Unmarshal(data, &record); err == nil && !sameMessage(&record, msg) {
+			// a newer message is persisted with the key
+			return nil
+		}
+		deleted = true
+		return b.Delete(k)
+	})
+	if err == nil && deleted {
+		s.addCount(nodeID, -1)
+	}
+	return err
+}
+
+// sameMessage reports whether the record is the persisted form of the message, the messages of
+// the same object are compared by resource version since the retried message gets a new ID,
+// the messages without resource version are compared by ID
+func sameMessage(record *messageRecord, msg *beehivemodel.Message) bool {
+	if record.Header.ResourceVersion == "" || msg.GetResourceVersion() == "" {
+		return record.Header.ID == msg.GetID()
+	}
+	return record.Header.ResourceVersion == msg.GetResourceVersion()
+}
+
+func (s *boltMessageStore) DeleteNode(nodeID string) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		err := tx.DeleteBucket([]byte(nodeID))
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.countsLock.Lock()
+	delete(s.counts, nodeID)
+	s.countsLock.Unlock()
+	monitor.PersistedMessages.DeleteLabelValues(nodeID)
+	return nil
+}
+
+func (s *boltMessageStore) List(nodeID string) ([]*PersistedMessage, error) {
+	var records []messageRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(nodeID))
+		if b == nil {
+			return nil
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var record messageRecord
+			if err := json.Unmarshal(v, &record); err != nil {
+				klog.Errorf("failed to decode persisted message %s of node %s, err: %v", k, nodeID, err)
+				return nil
+			}
+			if !s.expired(&record) {
+				records = append(records, record)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Seq < records[j].Seq
+	})
+	messages := make([]*PersistedMessage, 0, len(records))
+	for i := range records {
+		messages = append(messages, &PersistedMessage{
+			Key:     records[i].Key,
+			Ack:     records[i].Ack,
+			Message: records[i].message(),
+		})
+	}
+	return messages, nil
+}
+
+func (s *boltMessageStore) GC() {
+	removed := make(map[string]int)
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			var keys [][]byte
+			err := b.ForEach(func(k, v []byte) error {
+				var record messageRecord
+				// the undecodable records can never be replayed, drop them as well
+				if err := json.Unmarshal(v, &record); err != nil || s.expired(&record) {
+					keys = append(keys, k)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, k := range keys {
+				if err := b.Delete(k); err != nil {
+					return err
+				}
+			}
+			removed[string(name)] = len(keys)
+			return nil
+		})
+	})
+	if err != nil {
+		klog.Errorf("failed to remove expired messages from message store, err: %v", err)
+		return
+	}
+	for nodeID, n := range removed {
+		if n != 0 {
+			klog.Warningf("%d expired messages of node %s are dropped", n, nodeID)
+			s.addCount(nodeID, -n)
+		}
+	}
+}
+
+func (s *boltMessageStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *boltMessageStore) expired(record *messageRecord) bool {
+	return time.Since(record.StoredAt) > s.retention
+}
+
+func (s *boltMessageStore) getCount(nodeID string) int {
+	s.countsLock.Lock()
+	defer s.countsLock.Unlock()
+	return s.counts[nodeID]
+}
+
+func (s *boltMessageStore) addCount(nodeID string, delta int) {
+	s.countsLock.Lock()
+	defer s.countsLock.Unlock()
+	s.counts[nodeID] += delta
+	monitor.PersistedMessages.WithLabelValues(nodeID).Set(float64(s.counts[nodeID]))
+}
+
+func (s *boltMessageStore) setCount(nodeID string, n int) {
+	s.countsLock.Lock()
+	defer s.countsLock.Unlock()
+	s.counts[nodeID] = n
+	monitor.PersistedMessages.WithLabelValues(nodeID).Set(float64(n))
+}
+
+func recordKey(key string, ack bool) []byte {
+	if ack {
+		return []byte(ackKeyPrefix + key)
+	}
+	return []byte(noAckKeyPrefix + key)
+}
+
+func newMessageRecord(key string, ack bool, msg *beehivemodel.Message) (*messageRecord, error) {
+	record := &messageRecord{
+		Header:   msg.Header,
+		Router:   msg.Router,
+		Key:      key,
+		Ack:      ack,
+		StoredAt: time.Now(),
+	}
+	// the content is persisted in the same form as it is sent to the edge node
+	switch content := msg.GetContent().(type) {
+	case nil:
+	case []byte:
+		record.Content = content
+	case string:
+		record.Content = []byte(content)
+	default:
+		data, err := json.Marshal(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal content of message %s, err: %v", msg.GetID(), err)
+		}
+		record.Content = data
+		record.Object = true
+	}
+	return record, nil
+}
+
+func (r *messageRecord) message() *beehivemodel.Message {
+	msg := &beehivemodel.Message{
+		Header: r.Header,
+		Router: r.Router,
+	}
+	if r.Object {
+		obj := make(map[string]interface{})
+		if err := utiljson.Unmarshal(r.Content, &obj); err == nil {
+			// the objects are restored as unstructured, so that the metadata used
+			// to deduplicate and acknowledge the messages is still accessible
+			msg.Content = &unstructured.Unstructured{Object: obj}
+			return msg
+		}
+	}
+	if r.Content != nil {
+		msg.Content = r.Content
+	}
+	return msg
+}
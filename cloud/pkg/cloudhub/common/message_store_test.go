@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+)
+
+const testNodeID = "edge-node"
+
+func newTestPodMessage(uid, resourceVersion string) *beehivemodel.Message {
+	pod := &v1.Pod{
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "pod",
+			Namespace:       "default",
+			UID:             types.UID(uid),
+			ResourceVersion: resourceVersion,
+		},
+	}
+	return beehivemodel.NewMessage("").SetResourceVersion(resourceVersion).
+		BuildRouter("edgecontroller", "resource", "node/"+testNodeID+"/default/pod/pod", beehivemodel.UpdateOperation).
+		FillBody(pod)
+}
+
+func newTestMessageStore(t *testing.T, path string, retention time.Duration, maxMessages int) MessageStore {
+	store, err := NewBoltMessageStore(path, retention, maxMessages)
+	if err != nil {
+		t.Fatalf("failed to open message store: %v", err)
+	}
+	return store
+}
+
+func TestMessageStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "messages.db")
+	store := newTestMessageStore(t, path, time.Hour, 10)
+
+	podMsg := newTestPodMessage("uid-1", "10")
+	twinMsg := beehivemodel.NewMessage("").BuildRouter("devicecontroller", "twin",
+		"node/"+testNodeID+"/twin/cloud_updated", beehivemodel.UpdateOperation).FillBody([]byte(`{"twin":{}}`))
+	if err := store.Put(testNodeID, "uid-1", true, podMsg); err != nil {
+		t.Fatalf("failed to put ack message: %v", err)
+	}
+	if err := store.Put(testNodeID, twinMsg.GetID(), false, twinMsg); err != nil {
+		t.Fatalf("failed to put no ack message: %v", err)
+	}
+	// a newer version of the pod replaces the persisted one
+	newPodMsg := newTestPodMessage("uid-1", "11")
+	if err := store.Put(testNodeID, "uid-1", true, newPodMsg); err != nil {
+		t.Fatalf("failed to put ack message: %v", err)
+	}
+	// the delivery of the old version does not remove the new one
+	if err := store.Delete(testNodeID, "uid-1", true, podMsg); err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("failed to close message store: %v", err)
+	}
+
+	// the messages survive reopening the store
+	store = newTestMessageStore(t, path, time.Hour, 10)
+	defer store.Close()
+	messages, err := store.List(testNodeID)
+	if err != nil || len(messages) != 2 {
+		t.Fatalf("expected 2 persisted messages, got %v: %v", messages, err)
+	}
+	if messages[0].Key != twinMsg.GetID() || messages[0].Ack {
+		t.Errorf("expected the twin message first, got %+v", messages[0])
+	}
+	if content, ok := messages[0].Message.GetContent().([]byte); !ok || !bytes.Equal(content, twinMsg.GetContent().([]byte)) {
+		t.Errorf("unexpected content of twin message: %v", messages[0].Message.GetContent())
+	}
+	restored := messages[1].Message
+	if uid, err := GetMessageUID(*restored); err != nil || uid != "uid-1" ||
+		restored.GetID() != newPodMsg.GetID() || restored.GetResourceVersion() != "11" || restored.GetResource() != newPodMsg.GetResource() {
+		t.Errorf("unexpected restored pod message %+v, uid %q: %v", restored, uid, err)
+	}
+
+	if err := store.Delete(testNodeID, "uid-1", true, restored); err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+	if err := store.DeleteNode(testNodeID); err != nil {
+		t.Fatalf("failed to delete node: %v", err)
+	}
+	if messages, err := store.List(testNodeID); err != nil || len(messages) != 0 {
+		t.Errorf("expected no persisted messages, got %v: %v", messages, err)
+	}
+}
+
+func TestMessageStoreDeleteWithoutResourceVersion(t *testing.T) {
+	store := newTestMessageStore(t, filepath.Join(t.TempDir(), "messages.db"), time.Hour, 10)
+	defer store.Close()
+
+	newTwinMsg := func() *beehivemodel.Message {
+		return beehivemodel.NewMessage("").BuildRouter("devicecontroller", "twin",
+			"node/"+testNodeID+"/twin/cloud_updated", beehivemodel.UpdateOperation).FillBody([]byte(`{"twin":{}}`))
+	}
+	oldMsg, newMsg := newTwinMsg(), newTwinMsg()
+	if err := store.Put(testNodeID, "twin", true, oldMsg); err != nil {
+		t.Fatalf("failed to put message: %v", err)
+	}
+	if err := store.Put(testNodeID, "twin", true, newMsg); err != nil {
+		t.Fatalf("failed to put message: %v", err)
+	}
+	// the messages without resource version are told apart by ID
+	if err := store.Delete(testNodeID, "twin", true, oldMsg); err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+	messages, err := store.List(testNodeID)
+	if err != nil || len(messages) != 1 || messages[0].Message.GetID() != newMsg.GetID() {
+		t.Fatalf("expected the newer message to be kept, got %v: %v", messages, err)
+	}
+
+	if err := store.Delete(testNodeID, "twin", true, newMsg); err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+	if messages, err := store.List(testNodeID); err != nil || len(messages) != 0 {
+		t.Errorf("expected no persisted messages, got %v: %v", messages, err)
+	}
+}
+
+func TestMessageStoreLimits(t *testing.T) {
+	store := newTestMessageStore(t, filepath.Join(t.TempDir(), "messages.db"), time.Hour, 1)
+	defer store.Close()
+
+	if err := store.Put(testNodeID, "uid-1", true, newTestPodMessage("uid-1", "1")); err != nil {
+		t.Fatalf("failed to put message: %v", err)
+	}
+	if err := store.Put(testNodeID, "uid-1", true, newTestPodMessage("uid-1", "2")); err != nil {
+		t.Errorf("replacing a message should not be limited: %v", err)
+	}
+	if err := store.Put(testNodeID, "uid-2", true, newTestPodMessage("uid-2", "1")); !errors.Is(err, ErrMessageStoreFull) {
+		t.Errorf("expected ErrMessageStoreFull, got %v", err)
+	}
+	if err := store.Put("other-node", "uid-2", true, newTestPodMessage("uid-2", "1")); err != nil {
+		t.Errorf("the limit should apply to each node: %v", err)
+	}
+
+	expiring := newTestMessageStore(t, filepath.Join(t.TempDir(), "messages.db"), time.Nanosecond, 10)
+	defer expiring.Close()
+	if err := expiring.Put(testNodeID, "uid-1", true, newTestPodMessage("uid-1", "1")); err != nil {
+		t.Fatalf("failed to put message: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+	if messages, err := expiring.List(testNodeID); err != nil || len(messages) != 0 {
+		t.Errorf("expected expired message not listed, got %v: %v", messages, err)
+	}
+	expiring.GC()
+	if count := expiring.(*boltMessageStore).getCount(testNodeID); count != 0 {
+		t.Errorf("expected expired message removed, %d messages left", count)
+	}
+}
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"bytes"
 	"encoding/pem"
 	"os"
 	"sync"
 
+	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
@@ -20,6 +22,8 @@ type Configure struct {
 	CaKey         []byte
 	Cert          []byte
 	Key           []byte
+	// CaBundle is the PEM of the CA certificates trusted besides Ca
+	CaBundle []byte
 }
 
 func InitConfigure(hub *v1alpha1.CloudHub) {
@@ -53,6 +57,18 @@ func InitConfigure(hub *v1alpha1.CloudHub) {
 			klog.Exit("Both of ca and caKey should be specified!")
 		}
 
+		if hub.TLSCABundleFile != "" {
+			caBundle, err := os.ReadFile(hub.TLSCABundleFile)
+			if err != nil {
+				klog.Exitf("Failed to load CA bundle from %s, err: %v", hub.TLSCABundleFile, err)
+			}
+			if _, err := certutil.ParseCertsPEM(caBundle); err != nil {
+				klog.Exitf("Failed to parse CA bundle %s, err: %v", hub.TLSCABundleFile, err)
+			}
+			Config.CaBundle = caBundle
+			klog.Info("Succeed in loading CA bundle from local directory")
+		}
+
 		cert, err := os.ReadFile(hub.TLSCertFile)
 		if err == nil {
 			block, _ := pem.Decode(cert)
@@ -74,3 +90,12 @@ func InitConfigure(hub *v1alpha1.CloudHub) {
 		}
 	})
 }
+
+// TrustedCAs returns the PEM of the CA certificates the edge certificates are verified with,
+// the first one is Ca, which signs the certificates, followed by the certificates of CaBundle
+func (c *Configure) TrustedCAs() []byte {
+	var buf bytes.Buffer
+	buf.Write(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: c.Ca}))
+	buf.Write(c.CaBundle)
+	return buf.Bytes()
+}
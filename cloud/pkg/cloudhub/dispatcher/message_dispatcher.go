@@ -31,6 +31,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/session"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/trafficshaping"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/messagelayer"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
@@ -38,6 +39,7 @@ import (
 	taskutil "github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
 	commonconst "github.com/kubeedge/kubeedge/common/constants"
 	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
+	configv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1"
 	reliableclient "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
 	synclisters "github.com/kubeedge/kubeedge/pkg/client/listers/reliablesyncs/v1alpha1"
@@ -106,6 +108,12 @@ type messageDispatcher struct {
 
 	// clusterObjectSyncLister can list/get clusterObjectSync from the shared informer's store
 	clusterObjectSyncLister synclisters.ClusterObjectSyncLister
+
+	// messageStore persists the downstream messages, it is nil if the persistence is disabled
+	messageStore common.MessageStore
+
+	// shaper queues and rate limits the upstream messages, it is nil if the traffic shaping is disabled
+	shaper *trafficshaping.Shaper
 }
 
 // NewMessageDispatcher initializes a new MessageDispatcher
@@ -113,13 +121,18 @@ func NewMessageDispatcher(
 	sessionManager *session.Manager,
 	objectSyncLister synclisters.ObjectSyncLister,
 	clusterObjectSyncLister synclisters.ClusterObjectSyncLister,
-	reliableClient reliableclient.Interface) MessageDispatcher {
-	return &messageDispatcher{
+	reliableClient reliableclient.Interface,
+	messageStore common.MessageStore,
+	trafficShaping *configv1alpha1.CloudHubTrafficShaping) MessageDispatcher {
+	md := &messageDispatcher{
 		objectSyncLister:        objectSyncLister,
 		clusterObjectSyncLister: clusterObjectSyncLister,
 		reliableClient:          reliableClient,
 		SessionManager:          sessionManager,
+		messageStore:            messageStore,
 	}
+	md.shaper = trafficshaping.NewShaper(trafficShaping, md.dispatchUpstream)
+	return md
 }
 
 func (md *messageDispatcher) DispatchDownstream() {
@@ -160,6 +173,16 @@ func (md *messageDispatcher) DispatchDownstream() {
 }
 
 func (md *messageDispatcher) DispatchUpstream(message *beehivemodel.Message, info *model.HubInfo) {
+	// keepalives and acks are handled at once, they only update the node session
+	if md.shaper != nil && message.GetOperation() != model.OpKeepalive &&
+		message.GetOperation() != beehivemodel.ResponseOperation {
+		md.shaper.Enqueue(message, info)
+		return
+	}
+	md.dispatchUpstream(message, info)
+}
+
+func (md *messageDispatcher) dispatchUpstream(message *beehivemodel.Message, info *model.HubInfo) {
 	switch {
 	case message.GetOperation() == model.OpKeepalive:
 		klog.V(4).Infof("Keepalive message received from node: %s", info.NodeID)
@@ -220,9 +243,24 @@ func (md *messageDispatcher) enqueueNoAckMessage(nodeID string, msg *beehivemode
 		return
 	}
 	nodeMessagePool.NoAckMessageQueue.Add(messageKey)
+
+	switch {
+	case model.IsNodeStopped(msg):
+		// the node is deleted, its persisted messages will never be delivered
+		if md.messageStore != nil {
+			if err := md.messageStore.DeleteNode(nodeID); err != nil {
+				klog.Errorf("failed to delete persisted messages of node %s, err: %v", nodeID, err)
+			}
+		}
+	case msg.GetOperation() != beehivemodel.ResponseOperation:
+		// responses are not persisted since the edge node requests them again if it does not receive them
+		nodeMessagePool.PersistMessage(nodeID, messageKey, false, msg)
+	}
 }
 
-func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.Message) {
+// enqueueAckMessage enqueues the message that requires ack and returns whether it is enqueued,
+// the message is discarded if the edge node or the node message pool has a newer version of it
+func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.Message) (shouldEnqueue bool) {
 	// Message that require ack MUST have resource version.
 	if msg.GetResourceVersion() == "" && !isDeleteMessage(msg) {
 		return
@@ -238,14 +276,15 @@ func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.
 		return
 	}
 
-	shouldEnqueue := false
 	defer func() {
 		if shouldEnqueue {
 			if err := nodeStore.Add(msg); err != nil {
 				klog.Errorf("fail to add message %v nodeStore, err: %v", msg, err)
+				shouldEnqueue = false
 				return
 			}
 			nodeQueue.Add(messageKey)
+			nodeMessagePool.PersistMessage(nodeID, messageKey, true, msg)
 		}
 	}()
 
@@ -278,6 +317,7 @@ func (md *messageDispatcher) enqueueAckMessage(nodeID string, msg *beehivemodel.
 	} else {
 		shouldEnqueue = md.enqueueNamespacedResource(nodeID, msg)
 	}
+	return
 }
 
 func (md *messageDispatcher) enqueueNonNamespacedResource(nodeID string, msg *beehivemodel.Message) bool {
@@ -505,6 +545,7 @@ func (md *messageDispatcher) GetNodeMessagePool(nodeID string) *common.NodeMessa
 	if !exist {
 		klog.Warningf("message pool for edge node %s not found and created now", nodeID)
 		nodeMessagePool := common.InitNodeMessagePool(nodeID)
+		nodeMessagePool.MessageStore = md.messageStore
 		md.NodeMessagePools.Store(nodeID, nodeMessagePool)
 		return nodeMessagePool
 	}
@@ -513,7 +554,40 @@ func (md *messageDispatcher) GetNodeMessagePool(nodeID string) *common.NodeMessa
 }
 
 func (md *messageDispatcher) AddNodeMessagePool(nodeID string, pool *common.NodeMessagePool) {
+	pool.MessageStore = md.messageStore
 	md.NodeMessagePools.Store(nodeID, pool)
+
+	md.restoreNodeMessages(nodeID, pool)
+}
+
+// restoreNodeMessages replays the persisted messages of the node into its message pool,
+// including the messages persisted before the restart of CloudCore
+func (md *messageDispatcher) restoreNodeMessages(nodeID string, pool *common.NodeMessagePool) {
+	if md.messageStore == nil {
+		return
+	}
+
+	messages, err := md.messageStore.List(nodeID)
+	if err != nil {
+		klog.Errorf("failed to list persisted messages of node %s, err: %v", nodeID, err)
+		return
+	}
+
+	for _, m := range messages {
+		if !m.Ack {
+			md.enqueueNoAckMessage(nodeID, m.Message)
+			continue
+		}
+		if !md.enqueueAckMessage(nodeID, m.Message) {
+			// the edge node or the message pool already has the resource of
+			// the message or a newer version of it
+			pool.UnpersistMessage(nodeID, m.Key, true, m.Message)
+		}
+	}
+
+	if len(messages) != 0 {
+		klog.Infof("%d persisted messages are restored for node %s", len(messages), nodeID)
+	}
 }
 
 func (md *messageDispatcher) DeleteNodeMessagePool(nodeID string, pool *common.NodeMessagePool) {
@@ -530,6 +604,9 @@ func (md *messageDispatcher) DeleteNodeMessagePool(nodeID string, pool *common.N
 	}
 
 	md.NodeMessagePools.Delete(nodeID)
+	if md.shaper != nil {
+		md.shaper.RemoveNode(nodeID)
+	}
 }
 
 func (md *messageDispatcher) Publish(msg *beehivemodel.Message) error {
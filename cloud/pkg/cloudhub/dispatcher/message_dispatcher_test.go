@@ -18,8 +18,10 @@ package dispatcher
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"k8s.io/client-go/tools/cache"
@@ -29,6 +31,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	tf "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/testing"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/session"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/client/clientset/versioned/fake"
 	syncinformer "github.com/kubeedge/kubeedge/pkg/client/informers/externalversions"
@@ -369,7 +372,7 @@ func TestGetAddNodeMessagePool(t *testing.T) {
 	objectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ObjectSyncs()
 	clusterObjectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ClusterObjectSyncs()
 
-	dispatcher := NewMessageDispatcher(manager, objectSyncInformer.Lister(), clusterObjectSyncInformer.Lister(), client)
+	dispatcher := NewMessageDispatcher(manager, objectSyncInformer.Lister(), clusterObjectSyncInformer.Lister(), client, nil, nil)
 
 	nmp := common.InitNodeMessagePool(tf.TestNodeID)
 	dispatcher.AddNodeMessagePool(tf.TestNodeID, nmp)
@@ -405,3 +408,53 @@ func TestDeleteNodeMessagePool(t *testing.T) {
 		t.Errorf("expected pool not exist but got it")
 	}
 }
+
+func TestRestoreNodeMessages(t *testing.T) {
+	client := &fake.Clientset{}
+	manager := session.NewSessionManager(10)
+
+	objectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ObjectSyncs()
+	clusterObjectSyncInformer := syncinformer.NewSharedInformerFactory(client, 0).Reliablesyncs().V1alpha1().ClusterObjectSyncs()
+
+	store, err := common.NewBoltMessageStore(filepath.Join(t.TempDir(), "messages.db"), time.Hour, 100)
+	if err != nil {
+		t.Fatalf("failed to open message store: %v", err)
+	}
+	defer store.Close()
+
+	md := &messageDispatcher{
+		reliableClient:          client,
+		SessionManager:          manager,
+		objectSyncLister:        objectSyncInformer.Lister(),
+		clusterObjectSyncLister: clusterObjectSyncInformer.Lister(),
+		messageStore:            store,
+	}
+
+	// the message arrives when the node is offline
+	msg := beehivemodel.NewMessage("").BuildRouter(modules.RouterModuleName, modules.UserGroup,
+		fmt.Sprintf("node/%s/rule/test", tf.TestNodeID), beehivemodel.UploadOperation).FillBody("hello")
+	md.enqueueNoAckMessage(tf.TestNodeID, msg)
+	response := beehivemodel.NewMessage(msg.GetID()).BuildRouter(modules.RouterModuleName, modules.UserGroup,
+		fmt.Sprintf("node/%s/rule/test", tf.TestNodeID), beehivemodel.ResponseOperation).FillBody("ok")
+	md.enqueueNoAckMessage(tf.TestNodeID, response)
+
+	// the node connects with a new message pool
+	nmp := common.InitNodeMessagePool(tf.TestNodeID)
+	md.AddNodeMessagePool(tf.TestNodeID, nmp)
+
+	if nmp.NoAckMessageQueue.Len() != 1 {
+		t.Fatalf("expected 1 restored message, got %d", nmp.NoAckMessageQueue.Len())
+	}
+	restored, err := nmp.GetNoAckMessage(msg.GetID())
+	if err != nil {
+		t.Fatalf("failed to get restored message: %v", err)
+	}
+	if string(restored.GetContent().([]byte)) != "hello" {
+		t.Errorf("unexpected content of restored message: %v", restored.GetContent())
+	}
+
+	nmp.UnpersistMessage(tf.TestNodeID, msg.GetID(), false, restored)
+	if messages, err := store.List(tf.TestNodeID); err != nil || len(messages) != 0 {
+		t.Errorf("expected no persisted messages after delivery, got %v: %v", messages, err)
+	}
+}
@@ -117,6 +117,10 @@ func (mh *messageHandler) HandleConnection(connection conn.Connection) {
 		return
 	}
 
+	if certs := connection.ConnectionState().PeerCertificates; len(certs) > 0 {
+		common.ObserveEdgeCertificate(nodeID, certs[0])
+	}
+
 	// start a goroutine for serving the node connection
 	go func() {
 		klog.Infof("edge node %s for project %s connected", nodeInfo.NodeID, nodeInfo.ProjectID)
@@ -0,0 +1,226 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcserver
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
+	"github.com/kubeedge/viaduct/pkg/api"
+	"github.com/kubeedge/viaduct/pkg/comm"
+	"github.com/kubeedge/viaduct/pkg/conn"
+	"github.com/kubeedge/viaduct/pkg/keeper"
+	"github.com/kubeedge/viaduct/pkg/mux"
+)
+
+var errRawDataUnsupported = errors.New("grpc connection does not support raw data")
+
+// Connection is the conn.Connection of the stream of an edge node, so that the
+// sessions of cloudhub serve it the same way as websocket and quic connections.
+type Connection struct {
+	WriteDeadline      time.Time
+	ReadDeadline       time.Time
+	stream             grpc.ServerStream
+	writer             *grpchub.FrameWriter
+	handler            mux.Handler
+	state              *conn.ConnectionState
+	syncKeeper         *keeper.SyncKeeper
+	remoteAddr         net.Addr
+	localAddr          net.Addr
+	OnReadTransportErr func(nodeID, projectID string)
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func newConnection(stream grpc.ServerStream, state *conn.ConnectionState, handler mux.Handler,
+	remoteAddr, localAddr net.Addr, onReadTransportErr func(nodeID, projectID string)) *Connection {
+	c := &Connection{
+		stream:             stream,
+		handler:            handler,
+		state:              state,
+		syncKeeper:         keeper.NewSyncKeeper(),
+		remoteAddr:         remoteAddr,
+		localAddr:          localAddr,
+		OnReadTransportErr: onReadTransportErr,
+		done:               make(chan struct{}),
+	}
+	c.writer = grpchub.NewFrameWriter(stream, func() { _ = c.Close() })
+	return c
+}
+
+// ServeConn start to receive message from connection
+func (c *Connection) ServeConn() {
+	go c.handleMessage()
+}
+
+func (c *Connection) handleMessage() {
+	for {
+		frame := &grpchub.Frame{}
+		if err := c.stream.RecvMsg(frame); err != nil {
+			if !errors.Is(err, io.EOF) {
+				klog.Errorf("failed to read message, error: %+v", err)
+			}
+			c.state.State = api.StatDisconnected
+			_ = c.Close()
+
+			if c.OnReadTransportErr != nil {
+				c.OnReadTransportErr(c.state.Headers.Get(grpchub.MetadataNodeID),
+					c.state.Headers.Get(grpchub.MetadataProjectID))
+			}
+			return
+		}
+
+		msg := &model.Message{}
+		if err := grpchub.DecodeMessage(frame, msg); err != nil {
+			klog.Errorf("failed to decode message, error: %+v", err)
+			continue
+		}
+
+		// filter control message
+		if filtered := c.filterControlMessage(msg); filtered {
+			continue
+		}
+
+		// to check whether the message is a response or not
+		if matched := c.syncKeeper.MatchAndNotify(*msg); matched {
+			continue
+		}
+
+		if c.handler == nil {
+			// use default mux
+			c.handler = mux.MuxDefault
+		}
+		c.handler.ServeConn(&mux.MessageRequest{
+			Header:  c.state.Headers,
+			Message: msg,
+		}, &responseWriter{conn: c})
+	}
+}
+
+func (c *Connection) filterControlMessage(msg *model.Message) bool {
+	operation := msg.GetOperation()
+	if operation != comm.ControlTypeConfig &&
+		operation != comm.ControlTypePing &&
+		operation != comm.ControlTypePong {
+		return false
+	}
+
+	// feedback the response
+	resp := msg.NewRespByMessage(msg, comm.RespTypeAck)
+	if err := c.writeMessage(resp); err != nil {
+		klog.Errorf("failed to send response back, error:%+v", err)
+	}
+	return true
+}
+
+func (c *Connection) writeMessage(msg *model.Message) error {
+	frame, err := grpchub.EncodeMessage(msg)
+	if err != nil {
+		return err
+	}
+	return c.writer.Write(frame, c.WriteDeadline)
+}
+
+func (c *Connection) SetReadDeadline(t time.Time) error {
+	c.ReadDeadline = t
+	return nil
+}
+
+func (c *Connection) SetWriteDeadline(t time.Time) error {
+	c.WriteDeadline = t
+	return nil
+}
+
+func (c *Connection) Read(raw []byte) (int, error) {
+	return 0, errRawDataUnsupported
+}
+
+func (c *Connection) Write(raw []byte) (int, error) {
+	return 0, errRawDataUnsupported
+}
+
+func (c *Connection) WriteMessageAsync(msg *model.Message) error {
+	msg.Header.Sync = false
+	return c.writeMessage(msg)
+}
+
+func (c *Connection) WriteMessageSync(msg *model.Message) (*model.Message, error) {
+	msg.Header.Sync = true
+	if err := c.writeMessage(msg); err != nil {
+		klog.Errorf("write message error(%+v)", err)
+		return nil, err
+	}
+	//receive response
+	response, err := c.syncKeeper.WaitResponse(msg, c.WriteDeadline)
+	return &response, err
+}
+
+// ReadMessage is not supported, as the messages of the connection are always
+// routed to the handler.
+func (c *Connection) ReadMessage(msg *model.Message) error {
+	return errors.New("grpc connection routes the messages automatically")
+}
+
+func (c *Connection) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+func (c *Connection) LocalAddr() net.Addr {
+	return c.localAddr
+}
+
+// Close ends the stream, the pending reads and writes return once the stream
+// handler returned.
+func (c *Connection) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	return nil
+}
+
+func (c *Connection) ConnectionState() conn.ConnectionState {
+	return *c.state
+}
+
+type responseWriter struct {
+	conn *Connection
+}
+
+// write response
+func (r *responseWriter) WriteResponse(msg *model.Message, content interface{}) {
+	response := msg.NewRespByMessage(msg, content)
+	if err := r.conn.writeMessage(response); err != nil {
+		klog.Errorf("failed to write response, error: %+v", err)
+	}
+}
+
+// write error
+func (r *responseWriter) WriteError(msg *model.Message, errMsg string) {
+	response := model.NewErrorMessage(msg, errMsg)
+	if err := r.conn.writeMessage(response); err != nil {
+		klog.Errorf("failed to write error, error: %+v", err)
+	}
+}
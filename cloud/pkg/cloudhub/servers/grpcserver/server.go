@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcserver
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
+	"github.com/kubeedge/viaduct/pkg/api"
+	"github.com/kubeedge/viaduct/pkg/conn"
+	"github.com/kubeedge/viaduct/pkg/mux"
+)
+
+// Server serves the grpc streams of the edge nodes.
+type Server struct {
+	// Addr is the address to listen on
+	Addr string
+	// TLSConfig is the tls config, it has to require and verify the client certificates
+	TLSConfig *tls.Config
+	// KeepaliveTime is the interval of the pings sent on an idle connection
+	KeepaliveTime time.Duration
+	// KeepaliveTimeout is how long to wait for a ping ack before closing the connection
+	KeepaliveTimeout time.Duration
+	// ConnNotify is called when a new connection coming
+	ConnNotify func(conn.Connection)
+	// OnReadTransportErr is invoked when the connection read message err
+	OnReadTransportErr func(nodeID, projectID string)
+	// Handler routes the messages, mux.MuxDefault if nil
+	Handler mux.Handler
+
+	grpcServer *grpc.Server
+}
+
+// ListenAndServeTLS listens on Addr and serves the streams until the server is closed.
+func (s *Server) ListenAndServeTLS() error {
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(listener)
+}
+
+// Serve serves the streams accepted by listener.
+func (s *Server) Serve(listener net.Listener) error {
+	s.grpcServer = grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(s.TLSConfig)),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    s.KeepaliveTime,
+			Timeout: s.KeepaliveTimeout,
+		}),
+		// let the edge nodes ping as often as the server does
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             s.KeepaliveTime / 2,
+			PermitWithoutStream: true,
+		}),
+		grpc.ForceServerCodec(grpchub.Codec{}),
+		grpc.MaxRecvMsgSize(grpchub.MaxMessageSize),
+		grpc.MaxSendMsgSize(grpchub.MaxMessageSize),
+	)
+	s.grpcServer.RegisterService(&grpchub.ServiceDesc, s)
+	return s.grpcServer.Serve(listener)
+}
+
+// Close stops the server and closes all streams.
+func (s *Server) Close() error {
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+	}
+	return nil
+}
+
+// Connect serves the stream of an edge node, it returns when the connection is closed.
+func (s *Server) Connect(stream grpc.ServerStream) error {
+	ctx := stream.Context()
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := make(http.Header)
+	for _, key := range []string{grpchub.MetadataNodeID, grpchub.MetadataProjectID} {
+		if values := md.Get(key); len(values) > 0 {
+			headers.Set(key, values[0])
+		}
+	}
+	if headers.Get(grpchub.MetadataNodeID) == "" {
+		return fmt.Errorf("missing %s in the stream metadata", grpchub.MetadataNodeID)
+	}
+
+	state := &conn.ConnectionState{
+		State:   api.StatConnected,
+		Headers: headers,
+	}
+	var remoteAddr net.Addr
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state.PeerCertificates = info.State.PeerCertificates
+		}
+	}
+	var localAddr net.Addr
+	if l, err := net.ResolveTCPAddr("tcp", s.Addr); err == nil {
+		localAddr = l
+	}
+
+	connection := newConnection(stream, state, s.Handler, remoteAddr, localAddr, s.OnReadTransportErr)
+	klog.V(4).Infof("grpc stream of node %s from %v connected", headers.Get(grpchub.MetadataNodeID), remoteAddr)
+	if s.ConnNotify != nil {
+		s.ConnNotify(connection)
+	}
+	connection.ServeConn()
+
+	select {
+	case <-connection.done:
+	case <-ctx.Done():
+		_ = connection.Close()
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// bootstrapTokenID returns the id of a per-node bootstrap token, which is
+// empty for the shared token of the tokensecret.
+func bootstrapTokenID(claims jwt.MapClaims) string {
+	id, _ := claims["jti"].(string)
+	return id
+}
+
+// verifyBootstrapToken checks that the bootstrap token has not been revoked
+// and was issued for the node nodeName.
+func verifyBootstrapToken(claims jwt.MapClaims, nodeName string) error {
+	id := bootstrapTokenID(claims)
+	secret, err := GetSecret(constants.BootstrapTokenSecretPrefix+id, constants.SystemNamespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("bootstrap token %s has been revoked", id)
+		}
+		return fmt.Errorf("failed to get bootstrap token %s, err: %v", id, err)
+	}
+	subject, _ := claims["sub"].(string)
+	return checkBootstrapTokenSecret(secret, id, subject, nodeName)
+}
+
+// checkBootstrapTokenSecret checks that secret records the bootstrap token id
+// issued for subject, and that subject is the node nodeName.
+func checkBootstrapTokenSecret(secret *corev1.Secret, id, subject, nodeName string) error {
+	if secret.Type != constants.BootstrapTokenSecretType || string(secret.Data[constants.BootstrapTokenIDKey]) != id {
+		return fmt.Errorf("secret %s does not record the bootstrap token %s", secret.Name, id)
+	}
+	owner := string(secret.Data[constants.BootstrapTokenNodeNameKey])
+	if owner != subject {
+		return fmt.Errorf("bootstrap token %s was issued for node %s, but claims node %s", id, owner, subject)
+	}
+	if owner != nodeName {
+		return fmt.Errorf("bootstrap token %s was issued for node %s, not for node %s", id, owner, nodeName)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+func TestCheckBootstrapTokenSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.BootstrapTokenSecretPrefix + "abc"},
+		Type:       constants.BootstrapTokenSecretType,
+		Data: map[string][]byte{
+			constants.BootstrapTokenIDKey:       []byte("abc"),
+			constants.BootstrapTokenNodeNameKey: []byte("edge-01"),
+		},
+	}
+	cases := []struct {
+		name     string
+		id       string
+		subject  string
+		nodeName string
+		wantErr  bool
+	}{
+		{name: "valid", id: "abc", subject: "edge-01", nodeName: "edge-01"},
+		{name: "other node", id: "abc", subject: "edge-01", nodeName: "edge-02", wantErr: true},
+		{name: "forged subject", id: "abc", subject: "edge-02", nodeName: "edge-02", wantErr: true},
+		{name: "other token", id: "def", subject: "edge-01", nodeName: "edge-01", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkBootstrapTokenSecret(secret, c.id, c.subject, c.nodeName)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("expected error %t, got %v", c.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/common/types"
+	nodeutil "github.com/kubeedge/kubeedge/pkg/util/node"
+)
+
+// deregisterNode cordons the edge node which is being reset by keadm and taints it to evict
+// its pods, only the node itself can deregister with its own node certificate
+func deregisterNode(request *restful.Request, response *restful.Response) {
+	nodeName := request.Request.Header.Get(types.NodeNameKey)
+	var certs []*x509.Certificate
+	if request.Request.TLS != nil {
+		certs = request.Request.TLS.PeerCertificates
+	}
+	code, err := deregister(client.GetKubeClient(), nodeName, certs)
+	if err != nil {
+		klog.Errorf("failed to deregister edgenode %s, err: %v", nodeName, err)
+		if err := response.WriteError(code, err); err != nil {
+			klog.Warning(err.Error())
+		}
+		return
+	}
+	klog.Infof("edgenode %s is deregistered", nodeName)
+	if _, err := response.Write([]byte("ok")); err != nil {
+		klog.Errorf("failed to write response, err: %v", err)
+	}
+}
+
+func deregister(kubeClient kubernetes.Interface, nodeName string, certs []*x509.Certificate) (int, error) {
+	if nodeName == "" {
+		return http.StatusBadRequest, fmt.Errorf("header %s is required", types.NodeNameKey)
+	}
+	if len(certs) == 0 {
+		return http.StatusUnauthorized, fmt.Errorf("the certificate of the edge node is required")
+	}
+	// deregistering evicts the pods of the node, so the legacy certificates shared by all
+	// the nodes are not enough
+	if err := verifyCertChain(certs[0]); err != nil {
+		return http.StatusUnauthorized, err
+	}
+	if err := verifyNodeCertSubject(certs[0], nodeName); err != nil {
+		return http.StatusUnauthorized, err
+	}
+	if err := nodeutil.Deregister(context.Background(), kubeClient, nodeName); err != nil {
+		if apierrors.IsNotFound(err) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
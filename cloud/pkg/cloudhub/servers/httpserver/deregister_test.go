@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
+	nodeutil "github.com/kubeedge/kubeedge/pkg/util/node"
+)
+
+// newNodeCerts returns the CA certificate and the client certificates with the subjects signed by it
+func newNodeCerts(t *testing.T, subjects ...pkix.Name) ([]byte, []*x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "KubeEdge"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, key.Public(), key)
+	if err != nil {
+		t.Fatalf("failed to create CA certificate: %v", err)
+	}
+	ca, _ := x509.ParseCertificate(caDER)
+	certs := make([]*x509.Certificate, 0, len(subjects))
+	for i, subject := range subjects {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(int64(i + 2)),
+			Subject:      subject,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, key.Public(), key)
+		if err != nil {
+			t.Fatalf("failed to create node certificate: %v", err)
+		}
+		cert, _ := x509.ParseCertificate(der)
+		certs = append(certs, cert)
+	}
+	return caDER, certs
+}
+
+func TestDeregister(t *testing.T) {
+	caDER, certs := newNodeCerts(t,
+		pkix.Name{Organization: []string{"system:nodes"}, CommonName: "system:node:edge-01"},
+		pkix.Name{Organization: []string{"KubeEdge"}, CommonName: "kubeedge.io"},
+		pkix.Name{Organization: []string{"system:masters"}, CommonName: "system:node:edge-01"},
+	)
+	cert, legacyCert, otherOrgCert := certs[0], certs[1], certs[2]
+	hubconfig.Config.Ca = caDER
+	defer func() { hubconfig.Config.Ca = nil }()
+
+	cases := []struct {
+		name     string
+		nodeName string
+		certs    []*x509.Certificate
+		wantCode int
+	}{
+		{name: "valid", nodeName: "edge-01", certs: []*x509.Certificate{cert}, wantCode: http.StatusOK},
+		{name: "no node name", certs: []*x509.Certificate{cert}, wantCode: http.StatusBadRequest},
+		{name: "no certificate", nodeName: "edge-01", wantCode: http.StatusUnauthorized},
+		{name: "other node", nodeName: "edge-02", certs: []*x509.Certificate{cert}, wantCode: http.StatusUnauthorized},
+		{name: "legacy certificate", nodeName: "edge-02", certs: []*x509.Certificate{legacyCert}, wantCode: http.StatusUnauthorized},
+		{name: "other organization", nodeName: "edge-01", certs: []*x509.Certificate{otherOrgCert}, wantCode: http.StatusUnauthorized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			kubeClient := fake.NewSimpleClientset(
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-01"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-02"}},
+			)
+			code, err := deregister(kubeClient, c.nodeName, c.certs)
+			if code != c.wantCode {
+				t.Fatalf("expected code %d, got %d, err: %v", c.wantCode, code, err)
+			}
+			for _, name := range []string{"edge-01", "edge-02"} {
+				n, _ := kubeClient.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+				if want := c.wantCode == http.StatusOK && name == c.nodeName; nodeutil.IsDeregistered(n) != want {
+					t.Errorf("expected node %s deregistered %t", name, want)
+				}
+			}
+		})
+	}
+}
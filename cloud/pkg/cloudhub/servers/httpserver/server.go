@@ -34,6 +34,7 @@ import (
 	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/klog/v2"
 
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common"
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/common/types"
@@ -46,7 +47,10 @@ func StartHTTPServer() {
 	ws.Path("/")
 	ws.Route(ws.GET(constants.DefaultCertURL).To(edgeCoreClientCert))
 	ws.Route(ws.GET(constants.DefaultCAURL).To(getCA))
+	ws.Route(ws.GET(constants.DefaultCABundleURL).To(getCABundle))
+	ws.Route(ws.GET(constants.DefaultReadyzURL).To(readyz))
 	ws.Route(ws.POST(constants.DefaultNodeUpgradeURL).To(upgradeEdge))
+	ws.Route(ws.POST(constants.DefaultNodeDeregisterURL).To(deregisterNode))
 	ws.Route(ws.POST(constants.DefaultTaskStateReportURL).To(reportTaskStatus))
 	serverContainer.Add(ws)
 
@@ -77,6 +81,22 @@ func getCA(request *restful.Request, response *restful.Response) {
 	}
 }
 
+// readyz reports the cloudhub is ready to serve the edge nodes, it is the readiness probe of cloudcore.
+// The cloudhub of a standby cloudcore replica is not started, so the probe fails until it becomes the leader.
+func readyz(request *restful.Request, response *restful.Response) {
+	if _, err := response.Write([]byte("ok")); err != nil {
+		klog.Errorf("failed to write readyz response, err: %v", err)
+	}
+}
+
+// getCABundle returns the PEM of the trusted CA certificates, the first one is the CA of cloudcore.
+// The edge nodes sync it to trust the new CA before cloudcore is switched to it.
+func getCABundle(request *restful.Request, response *restful.Response) {
+	if _, err := response.Write(hubconfig.Config.TrustedCAs()); err != nil {
+		klog.Errorf("failed to write CA bundle, err: %v", err)
+	}
+}
+
 // EncodeCertPEM returns PEM-encoded certificate data
 func EncodeCertPEM(cert *x509.Certificate) []byte {
 	block := pem.Block{
@@ -97,12 +117,12 @@ func edgeCoreClientCert(request *restful.Request, response *restful.Response) {
 				klog.Errorf("failed to write response, err: %v", err)
 			}
 		} else {
-			signEdgeCert(response, request.Request)
+			signEdgeCert(response, request.Request, "")
 		}
 		return
 	}
-	if verifyAuthorization(response, request.Request) {
-		signEdgeCert(response, request.Request)
+	if nodeScope, ok := verifyAuthorization(response, request.Request); ok {
+		signEdgeCert(response, request.Request, nodeScope)
 	} else {
 		klog.Errorf("failed to sign the certificate for edgenode: %s, invalid token", nodeName)
 	}
@@ -110,8 +130,16 @@ func edgeCoreClientCert(request *restful.Request, response *restful.Response) {
 
 // verifyCert verifies the edge certificate by CA certificate when edge certificates rotate.
 func verifyCert(cert *x509.Certificate, nodeName string) error {
+	if err := verifyCertChain(cert); err != nil {
+		return err
+	}
+	return verifyCertSubject(cert, nodeName)
+}
+
+// verifyCertChain verifies the edge certificate is signed by the trusted CA for client auth
+func verifyCertChain(cert *x509.Certificate) error {
 	roots := x509.NewCertPool()
-	ok := roots.AppendCertsFromPEM(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: hubconfig.Config.Ca}))
+	ok := roots.AppendCertsFromPEM(hubconfig.Config.TrustedCAs())
 	if !ok {
 		return fmt.Errorf("failed to parse root certificate")
 	}
@@ -122,7 +150,7 @@ func verifyCert(cert *x509.Certificate, nodeName string) error {
 	if _, err := cert.Verify(opts); err != nil {
 		return fmt.Errorf("failed to verify edge certificate: %v", err)
 	}
-	return verifyCertSubject(cert, nodeName)
+	return nil
 }
 
 func verifyCertSubject(cert *x509.Certificate, nodeName string) error {
@@ -131,22 +159,30 @@ func verifyCertSubject(cert *x509.Certificate, nodeName string) error {
 		// this condition will be removed in KubeEdge v1.18.
 		return nil
 	}
+	return verifyNodeCertSubject(cert, nodeName)
+}
+
+// verifyNodeCertSubject verifies the certificate is issued to the node itself, the legacy
+// certificates are shared by all the nodes so they are not accepted
+func verifyNodeCertSubject(cert *x509.Certificate, nodeName string) error {
 	commonName := fmt.Sprintf("system:node:%s", nodeName)
-	if cert.Subject.Organization[0] == "system:nodes" && cert.Subject.CommonName == commonName {
+	if len(cert.Subject.Organization) > 0 && cert.Subject.Organization[0] == "system:nodes" &&
+		cert.Subject.CommonName == commonName {
 		return nil
 	}
 	return fmt.Errorf("request node name is not match with the certificate")
 }
 
-// verifyAuthorization verifies the token from EdgeCore CSR
-func verifyAuthorization(w http.ResponseWriter, r *http.Request) bool {
+// verifyAuthorization verifies the token from EdgeCore CSR. For a per-node bootstrap
+// token it returns the node the token was issued for, which the CSR must be for.
+func verifyAuthorization(w http.ResponseWriter, r *http.Request) (string, bool) {
 	authorizationHeader := r.Header.Get("authorization")
 	if authorizationHeader == "" {
 		w.WriteHeader(http.StatusUnauthorized)
 		if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
 			klog.Errorf("failed to write http response, err: %v", err)
 		}
-		return false
+		return "", false
 	}
 	bearerToken := strings.Split(authorizationHeader, " ")
 	if len(bearerToken) != 2 {
@@ -154,7 +190,7 @@ func verifyAuthorization(w http.ResponseWriter, r *http.Request) bool {
 		if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
 			klog.Errorf("failed to write http response, err: %v", err)
 		}
-		return false
+		return "", false
 	}
 	token, err := jwt.Parse(bearerToken[1], func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -169,27 +205,40 @@ func verifyAuthorization(w http.ResponseWriter, r *http.Request) bool {
 			if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
 				klog.Errorf("Write body error %v", err)
 			}
-			return false
+			return "", false
 		}
 		w.WriteHeader(http.StatusBadRequest)
 		if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
 			klog.Errorf("Write body error %v", err)
 		}
 
-		return false
+		return "", false
 	}
 	if !token.Valid {
 		w.WriteHeader(http.StatusUnauthorized)
 		if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
 			klog.Errorf("Write body error %v", err)
 		}
-		return false
+		return "", false
 	}
-	return true
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || bootstrapTokenID(claims) == "" {
+		return "", true
+	}
+	nodeName := r.Header.Get(types.NodeNameKey)
+	if err := verifyBootstrapToken(claims, nodeName); err != nil {
+		klog.Errorf("failed to verify the bootstrap token of edgenode %s, err: %v", nodeName, err)
+		w.WriteHeader(http.StatusUnauthorized)
+		if _, err := w.Write([]byte("Invalid authorization token")); err != nil {
+			klog.Errorf("Write body error %v", err)
+		}
+		return "", false
+	}
+	return nodeName, true
 }
 
-// signEdgeCert signs the CSR from EdgeCore
-func signEdgeCert(w http.ResponseWriter, r *http.Request) {
+// signEdgeCert signs the CSR from EdgeCore, if nodeScope is not empty the CSR must be for that node
+func signEdgeCert(w http.ResponseWriter, r *http.Request, nodeScope string) {
 	r.Body = http.MaxBytesReader(w, r.Body, constants.MaxRespBodyLength)
 	csrContent, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -201,6 +250,14 @@ func signEdgeCert(w http.ResponseWriter, r *http.Request) {
 		klog.Errorf("fail to ParseCertificateRequest of edgenode: %s! error:%v", r.Header.Get(types.NodeNameKey), err)
 		return
 	}
+	if nodeScope != "" && csr.Subject.CommonName != fmt.Sprintf("system:node:%s", nodeScope) {
+		klog.Errorf("refuse to sign the CSR for %s with the bootstrap token of edgenode %s", csr.Subject.CommonName, nodeScope)
+		w.WriteHeader(http.StatusUnauthorized)
+		if _, err := w.Write([]byte("the bootstrap token was not issued for this node")); err != nil {
+			klog.Errorf("Write body error %v", err)
+		}
+		return
+	}
 	usagesStr := r.Header.Get("ExtKeyUsages")
 	var usages []x509.ExtKeyUsage
 	if usagesStr == "" {
@@ -222,6 +279,12 @@ func signEdgeCert(w http.ResponseWriter, r *http.Request) {
 	if _, err := w.Write(clientCertDER); err != nil {
 		klog.Errorf("write error %v", err)
 	}
+	if usagesStr == "" {
+		// the client certificate edgehub connects to cloudhub with
+		if cert, err := x509.ParseCertificate(clientCertDER); err == nil {
+			common.RecordEdgeCertificateSigned(r.Header.Get(types.NodeNameKey), cert)
+		}
+	}
 }
 
 // signCerts will create a certificate for EdgeCore
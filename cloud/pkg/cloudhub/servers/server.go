@@ -5,32 +5,55 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"time"
 
 	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/klog/v2"
 
 	hubconfig "github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/handler"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/grpcserver"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
 	"github.com/kubeedge/viaduct/pkg/api"
 	"github.com/kubeedge/viaduct/pkg/server"
 )
 
+// Transport is a protocol cloudhub serves the edge nodes on. The connections it
+// accepts are handed to the message handler, which dispatches the messages the
+// same way regardless of the protocol.
+type Transport interface {
+	// Name returns the protocol name
+	Name() string
+	// Enabled reports whether the protocol is enabled in the cloudhub config
+	Enabled() bool
+	// ListenAndServe serves the protocol until it fails
+	ListenAndServe(messageHandler handler.Handler) error
+}
+
+// transports are the protocols cloudhub supports
+var transports = []Transport{
+	websocketTransport{},
+	quicTransport{},
+	grpcTransport{},
+}
+
 // StartCloudHub starts the cloud hub service
 func StartCloudHub(messageHandler handler.Handler) {
-	// start websocket server
-	if hubconfig.Config.WebSocket.Enable {
-		go startWebsocketServer(messageHandler)
-	}
-	// start quic server
-	if hubconfig.Config.Quic.Enable {
-		go startQuicServer(messageHandler)
+	for _, t := range transports {
+		if !t.Enabled() {
+			continue
+		}
+		go func(t Transport) {
+			klog.Infof("Starting cloudhub %s server", t.Name())
+			klog.Exit(t.ListenAndServe(messageHandler))
+		}(t)
 	}
 }
 
-func createTLSConfig(ca, cert, key []byte) tls.Config {
+func createTLSConfig(cas, cert, key []byte) tls.Config {
 	// init certificate
 	pool := x509.NewCertPool()
-	ok := pool.AppendCertsFromPEM(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: ca}))
+	ok := pool.AppendCertsFromPEM(cas)
 	if !ok {
 		panic(fmt.Errorf("fail to load ca content"))
 	}
@@ -52,8 +75,18 @@ func createTLSConfig(ca, cert, key []byte) tls.Config {
 	}
 }
 
-func startWebsocketServer(messageHandler handler.Handler) {
-	tlsConfig := createTLSConfig(hubconfig.Config.Ca, hubconfig.Config.Cert, hubconfig.Config.Key)
+type websocketTransport struct{}
+
+func (websocketTransport) Name() string {
+	return api.ProtocolTypeWS
+}
+
+func (websocketTransport) Enabled() bool {
+	return hubconfig.Config.WebSocket.Enable
+}
+
+func (websocketTransport) ListenAndServe(messageHandler handler.Handler) error {
+	tlsConfig := createTLSConfig(hubconfig.Config.TrustedCAs(), hubconfig.Config.Cert, hubconfig.Config.Key)
 	svc := server.Server{
 		Type:               api.ProtocolTypeWS,
 		TLSConfig:          &tlsConfig,
@@ -63,12 +96,21 @@ func startWebsocketServer(messageHandler handler.Handler) {
 		Addr:               fmt.Sprintf("%s:%d", hubconfig.Config.WebSocket.Address, hubconfig.Config.WebSocket.Port),
 		ExOpts:             api.WSServerOption{Path: "/"},
 	}
-	klog.Infof("Starting cloudhub %s server", api.ProtocolTypeWS)
-	klog.Exit(svc.ListenAndServeTLS("", ""))
+	return svc.ListenAndServeTLS("", "")
+}
+
+type quicTransport struct{}
+
+func (quicTransport) Name() string {
+	return api.ProtocolTypeQuic
 }
 
-func startQuicServer(messageHandler handler.Handler) {
-	tlsConfig := createTLSConfig(hubconfig.Config.Ca, hubconfig.Config.Cert, hubconfig.Config.Key)
+func (quicTransport) Enabled() bool {
+	return hubconfig.Config.Quic.Enable
+}
+
+func (quicTransport) ListenAndServe(messageHandler handler.Handler) error {
+	tlsConfig := createTLSConfig(hubconfig.Config.TrustedCAs(), hubconfig.Config.Cert, hubconfig.Config.Key)
 	svc := server.Server{
 		Type:               api.ProtocolTypeQuic,
 		TLSConfig:          &tlsConfig,
@@ -78,7 +120,29 @@ func startQuicServer(messageHandler handler.Handler) {
 		Addr:               fmt.Sprintf("%s:%d", hubconfig.Config.Quic.Address, hubconfig.Config.Quic.Port),
 		ExOpts:             api.QuicServerOption{MaxIncomingStreams: int(hubconfig.Config.Quic.MaxIncomingStreams)},
 	}
+	return svc.ListenAndServeTLS("", "")
+}
+
+type grpcTransport struct{}
+
+func (grpcTransport) Name() string {
+	return grpchub.ProtocolTypeGRPC
+}
 
-	klog.Infof("Starting cloudhub %s server", api.ProtocolTypeQuic)
-	klog.Exit(svc.ListenAndServeTLS("", ""))
+func (grpcTransport) Enabled() bool {
+	return hubconfig.Config.GRPC != nil && hubconfig.Config.GRPC.Enable
+}
+
+func (grpcTransport) ListenAndServe(messageHandler handler.Handler) error {
+	tlsConfig := createTLSConfig(hubconfig.Config.TrustedCAs(), hubconfig.Config.Cert, hubconfig.Config.Key)
+	config := hubconfig.Config.GRPC
+	svc := grpcserver.Server{
+		Addr:               fmt.Sprintf("%s:%d", config.Address, config.Port),
+		TLSConfig:          &tlsConfig,
+		KeepaliveTime:      time.Duration(config.KeepaliveTime) * time.Second,
+		KeepaliveTimeout:   time.Duration(config.KeepaliveTimeout) * time.Second,
+		ConnNotify:         messageHandler.HandleConnection,
+		OnReadTransportErr: messageHandler.OnReadTransportErr,
+	}
+	return svc.ListenAndServeTLS()
 }
@@ -295,6 +295,8 @@ func (ns *NodeSession) syncNoAckMessage() (bool, error) {
 		return true, fmt.Errorf("send message to edge node %s err: %v", ns.nodeID, err)
 	}
 
+	ns.nodeMessagePool.UnpersistMessage(ns.nodeID, key.(string), false, msg)
+
 	return false, nil
 }
 
@@ -321,6 +323,7 @@ func (ns *NodeSession) syncAckMessage() (bool, error) {
 	case err == nil:
 		// no err, forget this key and return
 		ns.nodeMessagePool.AckMessageQueue.Forget(key)
+		ns.nodeMessagePool.UnpersistMessage(ns.nodeID, key.(string), true, msg)
 		return false, nil
 
 	case err == ErrWaitTimeout:
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficshaping
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/klog/v2"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+)
+
+// nodeLimit is the label of the throttled messages metric for the rate limit of the node
+const nodeLimit = "node"
+
+type item struct {
+	msg      *beehivemodel.Message
+	info     *model.HubInfo
+	class    v1alpha1.MessageQoSClass
+	enqueued time.Time
+	// throttled records whether the message is delayed by the rate limit of its group
+	throttled bool
+}
+
+// nodeQueue is the queue of the upstream messages of an edge node, it has a FIFO list for
+// each QoS class and a worker dispatching the messages in the order of the classes
+type nodeQueue struct {
+	nodeID        string
+	size          int
+	policy        v1alpha1.DropPolicy
+	limiter       *rate.Limiter
+	groupLimiters map[string]*rate.Limiter
+
+	mu     sync.Mutex
+	lists  map[v1alpha1.MessageQoSClass]*list.List
+	length int
+	closed bool
+	// space wakes up the blocked add when a message is dequeued or the queue is closed
+	space *sync.Cond
+	// notify wakes up the worker when a message is queued or the queue is closed
+	notify chan struct{}
+}
+
+func newNodeQueue(nodeID string, config v1alpha1.CloudHubTrafficShaping, groupLimiters map[string]*rate.Limiter) *nodeQueue {
+	q := &nodeQueue{
+		nodeID:        nodeID,
+		size:          int(config.QueueSize),
+		policy:        config.DropPolicy,
+		limiter:       rate.NewLimiter(rate.Limit(config.NodeQPS), int(config.NodeBurst)),
+		groupLimiters: groupLimiters,
+		lists:         make(map[v1alpha1.MessageQoSClass]*list.List, len(classes)),
+		notify:        make(chan struct{}, 1),
+	}
+	q.space = sync.NewCond(&q.mu)
+	for _, class := range classes {
+		q.lists[class] = list.New()
+	}
+	return q
+}
+
+// add queues the message and returns whether the message is handled, which is false if the queue is closed.
+// When the queue is full, the message is dropped or a message of a lower class is dropped for it,
+// or the add is blocked until the queue has room, according to the drop policy.
+func (q *nodeQueue) add(msg *beehivemodel.Message, info *model.HubInfo, class v1alpha1.MessageQoSClass) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for !q.closed && q.length >= q.size && q.policy == v1alpha1.DropPolicyBlock {
+		q.space.Wait()
+	}
+	if q.closed {
+		return false
+	}
+	if q.length >= q.size && !q.evict(class) {
+		q.drop(msg, class)
+		return true
+	}
+
+	q.lists[class].PushBack(&item{msg: msg, info: info, class: class, enqueued: time.Now()})
+	q.length++
+	monitor.UpstreamQueuedMessages.WithLabelValues(q.nodeID, string(class)).Inc()
+	q.wakeWorker()
+	return true
+}
+
+// evict drops the oldest message of the lowest class lower than class,
+// it returns false if there is no such message or the drop policy is not DropLowestPriority
+func (q *nodeQueue) evict(class v1alpha1.MessageQoSClass) bool {
+	if q.policy != v1alpha1.DropPolicyLowestPriority {
+		return false
+	}
+	for i := len(classes) - 1; i >= 0 && classes[i] != class; i-- {
+		l := q.lists[classes[i]]
+		if front := l.Front(); front != nil {
+			l.Remove(front)
+			q.length--
+			monitor.UpstreamQueuedMessages.WithLabelValues(q.nodeID, string(classes[i])).Dec()
+			q.drop(front.Value.(*item).msg, classes[i])
+			return true
+		}
+	}
+	return false
+}
+
+func (q *nodeQueue) drop(msg *beehivemodel.Message, class v1alpha1.MessageQoSClass) {
+	monitor.UpstreamDroppedMessages.WithLabelValues(q.nodeID, string(class)).Inc()
+	klog.V(2).Infof("drop upstream message %s of node %s in QoS class %s, the queue is full",
+		msg.GetID(), q.nodeID, class)
+}
+
+func (q *nodeQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.space.Broadcast()
+	q.wakeWorker()
+}
+
+func (q *nodeQueue) wakeWorker() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run dispatches the messages at the rate limit of the node, until the queue is closed and empty
+func (q *nodeQueue) run(dispatch DispatchFunc, onExit func()) {
+	defer onExit()
+
+	for {
+		it, ok := q.next()
+		if !ok {
+			return
+		}
+		if delay := q.limiter.Reserve().Delay(); delay > 0 {
+			monitor.UpstreamThrottledMessages.WithLabelValues(q.nodeID, nodeLimit).Inc()
+			time.Sleep(delay)
+		}
+		monitor.UpstreamQueueDuration.WithLabelValues(string(it.class)).Observe(time.Since(it.enqueued).Seconds())
+		dispatch(it.msg, it.info)
+	}
+}
+
+// next waits for the message to dispatch, it returns false if the queue is closed and empty
+func (q *nodeQueue) next() (*item, bool) {
+	for {
+		q.mu.Lock()
+		it, delay, empty := q.pop()
+		closed := q.closed
+		q.mu.Unlock()
+
+		switch {
+		case it != nil:
+			return it, true
+		case empty && closed:
+			return nil, false
+		case empty:
+			<-q.notify
+		default:
+			// all the messages at the heads of the lists are throttled by the rate limits of their groups
+			timer := time.NewTimer(delay)
+			select {
+			case <-q.notify:
+			case <-timer.C:
+			}
+			timer.Stop()
+		}
+	}
+}
+
+// pop removes the first message of the highest class whose group is not throttled. If all of them are
+// throttled, it returns the shortest delay until one of them can be dispatched. It returns whether the
+// queue is empty too. It must be called with the lock held.
+func (q *nodeQueue) pop() (*item, time.Duration, bool) {
+	var delay time.Duration
+	empty := true
+	for _, class := range classes {
+		l := q.lists[class]
+		front := l.Front()
+		if front == nil {
+			continue
+		}
+		empty = false
+
+		it := front.Value.(*item)
+		group := it.msg.GetGroup()
+		if limiter, ok := q.groupLimiters[group]; ok {
+			r := limiter.Reserve()
+			if d := r.Delay(); d > 0 {
+				r.Cancel()
+				if !it.throttled {
+					it.throttled = true
+					monitor.UpstreamThrottledMessages.WithLabelValues(q.nodeID, group).Inc()
+				}
+				if delay == 0 || d < delay {
+					delay = d
+				}
+				continue
+			}
+		}
+
+		l.Remove(front)
+		q.length--
+		monitor.UpstreamQueuedMessages.WithLabelValues(q.nodeID, string(class)).Dec()
+		q.space.Signal()
+		return it, 0, false
+	}
+	return nil, delay, empty
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficshaping
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/monitor"
+	commonconst "github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+)
+
+// defaultQoSRules are checked after the rules of the config, the device twin messages are
+// dispatched before the others, and the events are dispatched last and dropped first
+var defaultQoSRules = []v1alpha1.MessageQoSRule{
+	{Source: model.ResTwin, Class: v1alpha1.QoSClassHigh},
+	{ResourceType: "event", Class: v1alpha1.QoSClassLow},
+	{ResourceType: "events", Class: v1alpha1.QoSClassLow},
+}
+
+// classes are the QoS classes in the order they are dispatched
+var classes = []v1alpha1.MessageQoSClass{
+	v1alpha1.QoSClassHigh,
+	v1alpha1.QoSClassNormal,
+	v1alpha1.QoSClassLow,
+}
+
+// DispatchFunc dispatches an upstream message of the edge node
+type DispatchFunc func(msg *beehivemodel.Message, info *model.HubInfo)
+
+// Shaper queues the upstream messages of each edge node by their QoS class, and dispatches them
+// in the order of the classes at the rate limits of the node. Every node has its own queue and
+// worker, so the messages of a chatty node only wait behind the messages of the node itself.
+type Shaper struct {
+	config   v1alpha1.CloudHubTrafficShaping
+	rules    []v1alpha1.MessageQoSRule
+	dispatch DispatchFunc
+
+	mu     sync.Mutex
+	queues map[string]*nodeQueue
+}
+
+// NewShaper returns the Shaper dispatching the messages with dispatch, it returns nil if the
+// traffic shaping is disabled
+func NewShaper(config *v1alpha1.CloudHubTrafficShaping, dispatch DispatchFunc) *Shaper {
+	if config == nil || !config.Enable {
+		return nil
+	}
+	rules := make([]v1alpha1.MessageQoSRule, 0, len(config.QoSRules)+len(defaultQoSRules))
+	rules = append(rules, config.QoSRules...)
+	rules = append(rules, defaultQoSRules...)
+	return &Shaper{
+		config:   *config,
+		rules:    rules,
+		dispatch: dispatch,
+		queues:   make(map[string]*nodeQueue),
+	}
+}
+
+// Enqueue queues the message of the edge node, it blocks until the queue has room
+// if the queue is full and the drop policy is Block
+func (s *Shaper) Enqueue(msg *beehivemodel.Message, info *model.HubInfo) {
+	class := s.classify(msg)
+	for {
+		// the queue is closed if the node is removed in the meantime, then
+		// the message goes to the queue created for the node again
+		if s.getQueue(info.NodeID).add(msg, info, class) {
+			return
+		}
+	}
+}
+
+// RemoveNode closes the queue of the edge node, the messages queued are still
+// dispatched before the worker of the queue exits
+func (s *Shaper) RemoveNode(nodeID string) {
+	s.mu.Lock()
+	q, ok := s.queues[nodeID]
+	delete(s.queues, nodeID)
+	s.mu.Unlock()
+
+	if ok {
+		q.close()
+	}
+}
+
+func (s *Shaper) getQueue(nodeID string) *nodeQueue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[nodeID]
+	if !ok {
+		q = newNodeQueue(nodeID, s.config, s.groupLimiters())
+		s.queues[nodeID] = q
+		go q.run(s.dispatch, func() { s.cleanup(nodeID) })
+	}
+	return q
+}
+
+// cleanup deletes the metrics of the edge node after the worker of its queue exits,
+// unless the node has got a new queue since it connects again
+func (s *Shaper) cleanup(nodeID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.queues[nodeID]; ok {
+		return
+	}
+	labels := prometheus.Labels{"node": nodeID}
+	monitor.UpstreamQueuedMessages.DeletePartialMatch(labels)
+	monitor.UpstreamDroppedMessages.DeletePartialMatch(labels)
+	monitor.UpstreamThrottledMessages.DeletePartialMatch(labels)
+}
+
+func (s *Shaper) groupLimiters() map[string]*rate.Limiter {
+	limiters := make(map[string]*rate.Limiter, len(s.config.GroupLimits))
+	for _, limit := range s.config.GroupLimits {
+		limiters[limit.Group] = rate.NewLimiter(rate.Limit(limit.QPS), int(limit.Burst))
+	}
+	return limiters
+}
+
+// classify returns the QoS class of the first rule the message matches
+func (s *Shaper) classify(msg *beehivemodel.Message) v1alpha1.MessageQoSClass {
+	for _, rule := range s.rules {
+		if matchRule(rule, msg) {
+			return rule.Class
+		}
+	}
+	return v1alpha1.QoSClassNormal
+}
+
+func matchRule(rule v1alpha1.MessageQoSRule, msg *beehivemodel.Message) bool {
+	if rule.Source != "" && rule.Source != msg.GetSource() {
+		return false
+	}
+	if rule.Group != "" && rule.Group != msg.GetGroup() {
+		return false
+	}
+	if rule.ResourceType == "" {
+		return true
+	}
+	for _, segment := range strings.Split(msg.GetResource(), commonconst.ResourceSep) {
+		if segment == rule.ResourceType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trafficshaping
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	beehivemodel "github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/common/model"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+)
+
+const testNodeID = "edge-node"
+
+var testInfo = &model.HubInfo{NodeID: testNodeID}
+
+func newTestConfig(policy v1alpha1.DropPolicy) *v1alpha1.CloudHubTrafficShaping {
+	return &v1alpha1.CloudHubTrafficShaping{
+		Enable:     true,
+		NodeQPS:    1000,
+		NodeBurst:  1000,
+		QueueSize:  2,
+		DropPolicy: policy,
+		QoSRules: []v1alpha1.MessageQoSRule{
+			{Group: "user", ResourceType: "rulestatus", Class: v1alpha1.QoSClassLow},
+		},
+	}
+}
+
+func newTestMessage(source, group, resource string) *beehivemodel.Message {
+	return beehivemodel.NewMessage("").BuildRouter(source, group, resource, beehivemodel.UpdateOperation)
+}
+
+func TestClassify(t *testing.T) {
+	s := NewShaper(newTestConfig(v1alpha1.DropPolicyLowestPriority), nil)
+	tests := []struct {
+		name string
+		msg  *beehivemodel.Message
+		want v1alpha1.MessageQoSClass
+	}{
+		{name: "device twin", msg: newTestMessage("twin", "resource", "$hw/events/device/dev/twin/edge_updated"), want: v1alpha1.QoSClassHigh},
+		{name: "pod status", msg: newTestMessage("edged", "resource", "default/podstatus/pod"), want: v1alpha1.QoSClassNormal},
+		{name: "event", msg: newTestMessage("metaserver", "resource", "default/event/pod.17a"), want: v1alpha1.QoSClassLow},
+		{name: "rule of config", msg: newTestMessage("router", "user", "default/rulestatus/rule"), want: v1alpha1.QoSClassLow},
+		{name: "rule of config not matched", msg: newTestMessage("router", "resource", "default/rulestatus/rule"), want: v1alpha1.QoSClassNormal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.classify(tt.msg); got != tt.want {
+				t.Errorf("classify() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if NewShaper(&v1alpha1.CloudHubTrafficShaping{Enable: false}, nil) != nil {
+		t.Errorf("expected no shaper if the traffic shaping is disabled")
+	}
+}
+
+func popAll(q *nodeQueue) []*beehivemodel.Message {
+	var msgs []*beehivemodel.Message
+	for {
+		it, _, _ := q.pop()
+		if it == nil {
+			return msgs
+		}
+		msgs = append(msgs, it.msg)
+	}
+}
+
+func TestQueueDropPolicy(t *testing.T) {
+	low := newTestMessage("metaserver", "resource", "default/event/a")
+	normal := newTestMessage("edged", "resource", "default/podstatus/pod")
+	high := newTestMessage("twin", "resource", "$hw/events/device/dev/twin/edge_updated")
+
+	q := newNodeQueue(testNodeID, *newTestConfig(v1alpha1.DropPolicyLowestPriority), nil)
+	q.add(low, testInfo, v1alpha1.QoSClassLow)
+	q.add(normal, testInfo, v1alpha1.QoSClassNormal)
+	// the event is dropped for the device twin message
+	q.add(high, testInfo, v1alpha1.QoSClassHigh)
+	// no message of a lower class to drop for the event
+	q.add(newTestMessage("metaserver", "resource", "default/event/b"), testInfo, v1alpha1.QoSClassLow)
+	got := popAll(q)
+	if len(got) != 2 || got[0] != high || got[1] != normal {
+		t.Errorf("expected the device twin and the pod status messages in order, but got %v", got)
+	}
+
+	q = newNodeQueue(testNodeID, *newTestConfig(v1alpha1.DropPolicyNewest), nil)
+	q.add(low, testInfo, v1alpha1.QoSClassLow)
+	q.add(normal, testInfo, v1alpha1.QoSClassNormal)
+	q.add(high, testInfo, v1alpha1.QoSClassHigh)
+	got = popAll(q)
+	if len(got) != 2 || got[0] != normal || got[1] != low {
+		t.Errorf("expected the arriving message to be dropped, but got %v", got)
+	}
+}
+
+func TestQueueGroupLimit(t *testing.T) {
+	limiters := map[string]*rate.Limiter{"twin": rate.NewLimiter(rate.Every(time.Hour), 1)}
+	config := newTestConfig(v1alpha1.DropPolicyLowestPriority)
+	config.QueueSize = 10
+	q := newNodeQueue(testNodeID, *config, limiters)
+
+	twin1 := newTestMessage("twin", "twin", "$hw/events/device/dev/twin/edge_updated")
+	twin2 := newTestMessage("twin", "twin", "$hw/events/device/dev/twin/edge_updated")
+	pod := newTestMessage("edged", "resource", "default/podstatus/pod")
+	q.add(twin1, testInfo, v1alpha1.QoSClassHigh)
+	q.add(twin2, testInfo, v1alpha1.QoSClassHigh)
+	q.add(pod, testInfo, v1alpha1.QoSClassNormal)
+
+	// the second device twin message is throttled by its group, which does not block the pod status
+	got := popAll(q)
+	if len(got) != 2 || got[0] != twin1 || got[1] != pod {
+		t.Errorf("expected the first device twin and the pod status messages, but got %v", got)
+	}
+	it, delay, empty := q.pop()
+	if it != nil || empty || delay <= 0 {
+		t.Errorf("expected the throttled message to wait, but got %v, %v, %v", it, delay, empty)
+	}
+}
+
+func TestShaperDispatch(t *testing.T) {
+	dispatched := make(chan *beehivemodel.Message, 10)
+	config := newTestConfig(v1alpha1.DropPolicyBlock)
+	s := NewShaper(config, func(msg *beehivemodel.Message, info *model.HubInfo) {
+		if info.NodeID != testNodeID {
+			t.Errorf("unexpected node %s", info.NodeID)
+		}
+		dispatched <- msg
+	})
+
+	var msgs []*beehivemodel.Message
+	for i := 0; i < 5; i++ {
+		msg := newTestMessage("edged", "resource", "default/podstatus/pod")
+		msgs = append(msgs, msg)
+		// the messages beyond the queue size wait for the worker instead of being dropped
+		s.Enqueue(msg, testInfo)
+	}
+	s.RemoveNode(testNodeID)
+
+	for _, want := range msgs {
+		select {
+		case got := <-dispatched:
+			if got != want {
+				t.Errorf("expected message %s, but got %s", want.GetID(), got.GetID())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout to wait for message %s", want.GetID())
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package cloudstream
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+
+	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/pkg/stream"
 )
@@ -33,3 +39,70 @@ type APIServerConnection interface {
 	// EdgePeerDone indicates whether edge peer ends
 	EdgePeerDone() chan struct{}
 }
+
+// serveHijackedConnection sends the connect message of c to the edge, then relays the data
+// of the connection hijacked from kube-apiserver to the edge until either side is done
+func serveHijackedConnection(ctx context.Context, c APIServerConnection, conn net.Conn) error {
+	connector, err := c.SendConnection()
+	if err != nil {
+		klog.Errorf("%s send connect message error %v", c.String(), err)
+		return err
+	}
+
+	// reading blocks until kube-apiserver sends something, so it runs on its own and
+	// the hijacked connection is closed by the caller once the edge peer is done
+	readDone := make(chan error, 1)
+	go func() {
+		readDone <- readFromAPIServer(c, conn, connector)
+	}()
+
+	select {
+	case <-ctx.Done():
+		// if apiserver request end, send close message to edge
+		sendCloseMessage(c)
+		return nil
+	case err := <-readDone:
+		sendCloseMessage(c)
+		return err
+	case <-c.EdgePeerDone():
+		klog.V(6).Infof("%s find edge peer done, so stop this connection", c.String())
+		return fmt.Errorf("%s find edge peer done, so stop this connection", c.String())
+	}
+}
+
+// readFromAPIServer relays the data received from kube-apiserver to the edge
+// until the connection is closed
+func readFromAPIServer(c APIServerConnection, conn net.Conn, connector stream.EdgedConnection) error {
+	var data [stream.StreamBufferSize]byte
+	for {
+		n, err := conn.Read(data[:])
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return fmt.Errorf("%s failed to read from client: %v", c.String(), err)
+			}
+			klog.V(6).Infof("%s read EOF from client", c.String())
+			return nil
+		}
+		if n <= 0 {
+			continue
+		}
+		msg := stream.NewMessage(connector.GetMessageID(), stream.MessageTypeData, data[:n])
+		if err := c.WriteToTunnel(msg); err != nil {
+			return fmt.Errorf("%s failed to write to tunnel server, err: %v", c.String(), err)
+		}
+	}
+}
+
+// sendCloseMessage tells the edge to close its side of the connection
+func sendCloseMessage(c APIServerConnection) {
+	msg := stream.NewMessage(c.GetMessageID(), stream.MessageTypeRemoveConnect, nil)
+	for retry := 0; retry < 3; retry++ {
+		err := c.WriteToTunnel(msg)
+		if err == nil {
+			klog.V(6).Infof("%s send close message to edge successfully", c.String())
+			return
+		}
+		klog.Warningf("%v failed send %s message to edge, err: %v", c, msg.MessageType, err)
+	}
+	klog.Errorf("max retry count reached when send %s message to edge", msg.MessageType)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+// fakeTunnel records the messages written to the tunnel
+type fakeTunnel struct {
+	lock     sync.Mutex
+	messages []*stream.Message
+}
+
+func (t *fakeTunnel) WriteMessage(m *stream.Message) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	// the data buffer is reused by the sender once the message is written
+	t.messages = append(t.messages, stream.NewMessage(m.ConnectID, m.MessageType, append([]byte(nil), m.Data...)))
+	return nil
+}
+
+func (t *fakeTunnel) WriteControl(int, []byte, time.Time) error {
+	return nil
+}
+
+func (t *fakeTunnel) NextReader() (int, io.Reader, error) {
+	return 0, nil, io.EOF
+}
+
+func (t *fakeTunnel) Close() error {
+	return nil
+}
+
+func (t *fakeTunnel) written() []*stream.Message {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return append([]*stream.Message(nil), t.messages...)
+}
+
+func newTestRequest(t *testing.T, url string) *restful.Request {
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Upgrade", "SPDY/3.1")
+	return restful.NewRequest(req)
+}
+
+func TestServeExecConnectionCopy(t *testing.T) {
+	tunnel := &fakeTunnel{}
+	server, client := net.Pipe()
+	defer server.Close()
+	c := &ContainerExecConnection{
+		MessageID:    7,
+		ctx:          context.Background(),
+		r:            newTestRequest(t, "https://10.0.0.1:10350/exec/default/nginx/nginx?command=tar&command=xf&command=-"),
+		Conn:         server,
+		session:      &Session{tunnel: tunnel},
+		edgePeerStop: make(chan struct{}, 2),
+		closeChan:    make(chan bool),
+	}
+
+	// kubectl cp streams a tar archive through exec, far larger than the relay buffer
+	archive := bytes.Repeat([]byte("0123456789abcdef"), 3*stream.StreamBufferSize/16+100)
+	go func() {
+		if _, err := client.Write(archive); err != nil {
+			t.Errorf("failed to write archive: %v", err)
+		}
+		client.Close()
+	}()
+
+	if err := c.Serve(); err != nil {
+		t.Fatalf("failed to serve: %v", err)
+	}
+
+	messages := tunnel.written()
+	if len(messages) < 3 || messages[0].MessageType != stream.MessageTypeExecConnect ||
+		messages[len(messages)-1].MessageType != stream.MessageTypeRemoveConnect {
+		t.Fatalf("expected the connect, data and remove messages, but got %d messages", len(messages))
+	}
+	var relayed []byte
+	for _, m := range messages[1 : len(messages)-1] {
+		if m.MessageType != stream.MessageTypeData || m.ConnectID != 7 || len(m.Data) > stream.StreamBufferSize {
+			t.Fatalf("unexpected data message %s with %d bytes", m.String(), len(m.Data))
+		}
+		relayed = append(relayed, m.Data...)
+	}
+	if !bytes.Equal(relayed, archive) {
+		t.Errorf("expected the archive of %d bytes to be relayed unchanged, but got %d bytes", len(archive), len(relayed))
+	}
+}
+
+func TestServePortForwardConnection(t *testing.T) {
+	tunnel := &fakeTunnel{}
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := &ContainerPortForwardConnection{
+		MessageID:    9,
+		ctx:          ctx,
+		r:            newTestRequest(t, "https://10.0.0.1:10350/portForward/default/nginx"),
+		Conn:         server,
+		session:      &Session{tunnel: tunnel},
+		edgePeerStop: make(chan struct{}, 2),
+		closeChan:    make(chan bool),
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- c.Serve()
+	}()
+	if _, err := client.Write([]byte("GET / HTTP/1.1\r\n\r\n")); err != nil {
+		t.Fatalf("failed to write to connection: %v", err)
+	}
+	// wait for the data to be relayed before the request of kube-apiserver is done
+	for deadline := time.Now().Add(5 * time.Second); len(tunnel.written()) < 2; {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the data to be relayed to the tunnel")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// the edge is told to close its side once the request is done
+	cancel()
+	if err := <-served; err != nil {
+		t.Fatalf("failed to serve: %v", err)
+	}
+
+	messages := tunnel.written()
+	if len(messages) != 3 {
+		t.Fatalf("expected the connect, data and remove messages, but got %d messages", len(messages))
+	}
+	if messages[0].MessageType != stream.MessageTypePortForwardConnect {
+		t.Fatalf("expected a port-forward connect message, but got %s", messages[0].String())
+	}
+	connector := &stream.EdgedPortForwardConnection{}
+	if err := json.Unmarshal(messages[0].Data, connector); err != nil {
+		t.Fatalf("failed to unmarshal connector: %v", err)
+	}
+	if connector.URL.String() != "http://127.0.0.1:10350/portForward/default/nginx" ||
+		connector.Header.Get("Upgrade") != "SPDY/3.1" || connector.Method != http.MethodPost {
+		t.Errorf("expected the request to be replayed to edged, but got %+v", connector)
+	}
+	if messages[1].MessageType != stream.MessageTypeData || string(messages[1].Data) != "GET / HTTP/1.1\r\n\r\n" {
+		t.Errorf("expected the data to be relayed, but got %s", messages[1].String())
+	}
+	if messages[2].MessageType != stream.MessageTypeRemoveConnect || messages[2].ConnectID != 9 {
+		t.Errorf("expected the remove message, but got %s", messages[2].String())
+	}
+
+	// the connection is done, so the edge peer must not block
+	c.SetEdgePeerDone()
+}
+
+func TestServePortForwardEdgePeerDone(t *testing.T) {
+	tunnel := &fakeTunnel{}
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	c := &ContainerPortForwardConnection{
+		MessageID:    10,
+		ctx:          context.Background(),
+		r:            newTestRequest(t, "https://10.0.0.1:10350/portForward/default/nginx"),
+		Conn:         server,
+		session:      &Session{tunnel: tunnel},
+		edgePeerStop: make(chan struct{}, 2),
+		closeChan:    make(chan bool),
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- c.Serve()
+	}()
+	c.SetEdgePeerDone()
+	if err := <-served; err == nil {
+		t.Errorf("expected the connection to stop with an error once the edge peer is done")
+	}
+	for _, m := range tunnel.written() {
+		if m.MessageType == stream.MessageTypeRemoveConnect {
+			t.Errorf("expected no remove message to the edge peer which is already done")
+		}
+	}
+}
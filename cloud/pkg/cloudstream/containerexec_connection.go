@@ -2,9 +2,7 @@ package cloudstream
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"io"
 	"net"
 
 	"github.com/emicklei/go-restful"
@@ -84,57 +82,7 @@ func (c *ContainerExecConnection) Serve() error {
 		klog.V(6).Infof("%s stop successfully", c.String())
 	}()
 
-	connector, err := c.SendConnection()
-	if err != nil {
-		klog.Errorf("%s send %s info error %v", c.String(), stream.MessageTypeExecConnect, err)
-		return err
-	}
-
-	sendCloseMessage := func() {
-		msg := stream.NewMessage(c.MessageID, stream.MessageTypeRemoveConnect, nil)
-		for retry := 0; retry < 3; retry++ {
-			if err := c.WriteToTunnel(msg); err == nil {
-				klog.V(6).Infof("%s send close message to edge successfully", c.String())
-				return
-			}
-			klog.Warningf("%v failed send %s message to edge, err: %v", c, msg.MessageType, err)
-		}
-		klog.Errorf("max retry count reached when send %s message to edge", msg.MessageType)
-	}
-
-	var data [256]byte
-	for {
-		select {
-		case <-c.ctx.Done():
-			// if apiserver request end, send close message to edge
-			sendCloseMessage()
-			return nil
-		case <-c.EdgePeerDone():
-			klog.V(6).Infof("%s find edge peer done, so stop this connection", c.String())
-			return fmt.Errorf("%s find edge peer done, so stop this connection", c.String())
-		default:
-		}
-		func() {
-			n, err := c.Conn.Read(data[:])
-			if err != nil {
-				if !errors.Is(err, io.EOF) {
-					klog.Errorf("%s failed to read from client: %v", c.String(), err)
-					return
-				}
-				klog.V(6).Infof("%s read EOF from client", c.String())
-				sendCloseMessage()
-				return
-			}
-			if n <= 0 {
-				return
-			}
-			msg := stream.NewMessage(connector.GetMessageID(), stream.MessageTypeData, data[:n])
-			if err := c.WriteToTunnel(msg); err != nil {
-				klog.Errorf("%s failed to write to tunnel server, err: %v", c.String(), err)
-				return
-			}
-		}()
-	}
+	return serveHijackedConnection(c.ctx, c, c.Conn)
 }
 
 var _ APIServerConnection = &ContainerExecConnection{}
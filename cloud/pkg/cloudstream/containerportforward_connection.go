@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+// ContainerPortForwardConnection indicates the pod port-forward request initiated by kube-apiserver
+type ContainerPortForwardConnection struct {
+	MessageID    uint64
+	ctx          context.Context
+	r            *restful.Request
+	Conn         net.Conn
+	session      *Session
+	edgePeerStop chan struct{}
+	closeChan    chan bool
+}
+
+func (pf *ContainerPortForwardConnection) String() string {
+	return fmt.Sprintf("APIServer_PortForwardConnection MessageID %v", pf.MessageID)
+}
+
+func (pf *ContainerPortForwardConnection) WriteToAPIServer(p []byte) (n int, err error) {
+	return pf.Conn.Write(p)
+}
+
+func (pf *ContainerPortForwardConnection) SetMessageID(id uint64) {
+	pf.MessageID = id
+}
+
+func (pf *ContainerPortForwardConnection) GetMessageID() uint64 {
+	return pf.MessageID
+}
+
+func (pf *ContainerPortForwardConnection) SetEdgePeerDone() {
+	select {
+	case <-pf.closeChan:
+		return
+	case pf.EdgePeerDone() <- struct{}{}:
+		klog.V(6).Infof("success send channel deleting connection with messageID %v", pf.MessageID)
+	}
+}
+
+func (pf *ContainerPortForwardConnection) EdgePeerDone() chan struct{} {
+	return pf.edgePeerStop
+}
+
+func (pf *ContainerPortForwardConnection) WriteToTunnel(m *stream.Message) error {
+	return pf.session.WriteMessageToTunnel(m)
+}
+
+func (pf *ContainerPortForwardConnection) SendConnection() (stream.EdgedConnection, error) {
+	connector := &stream.EdgedPortForwardConnection{
+		MessID: pf.MessageID,
+		Method: pf.r.Request.Method,
+		URL:    *pf.r.Request.URL,
+		Header: pf.r.Request.Header,
+	}
+	connector.URL.Scheme = httpScheme
+	connector.URL.Host = net.JoinHostPort(defaultServerHost, fmt.Sprintf("%v", constants.ServerPort))
+	m, err := connector.CreateConnectMessage()
+	if err != nil {
+		return nil, err
+	}
+	if err := pf.WriteToTunnel(m); err != nil {
+		klog.Errorf("%s failed to create port-forward connection: %s, err: %v", pf.String(), connector.String(), err)
+		return nil, err
+	}
+	return connector, nil
+}
+
+func (pf *ContainerPortForwardConnection) Serve() error {
+	defer func() {
+		close(pf.closeChan)
+		klog.V(6).Infof("%s stop successfully", pf.String())
+	}()
+
+	return serveHijackedConnection(pf.ctx, pf, pf.Conn)
+}
+
+var _ APIServerConnection = &ContainerPortForwardConnection{}
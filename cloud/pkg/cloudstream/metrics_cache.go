@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+const (
+	// maxCachedSamples is the max number of samples cached for each metrics path of a node
+	maxCachedSamples = 60
+	// maxCachedLength is the max length of the samples cached for each metrics path of a node,
+	// the latest sample is always cached
+	maxCachedLength = 4 << 20
+)
+
+// metricsCache caches the recent metrics forwarded by the edge nodes, they are used
+// to answer the metrics requests of the nodes whose tunnel is disconnected
+type metricsCache struct {
+	lock sync.RWMutex
+	// samples are the samples of each node and metrics path, sorted by timestamp
+	samples map[string]map[string][]stream.MetricsSample
+}
+
+func newMetricsCache() *metricsCache {
+	return &metricsCache{
+		samples: make(map[string]map[string][]stream.MetricsSample),
+	}
+}
+
+// add caches the samples of node, samples forwarded again by the edge are ignored
+func (c *metricsCache) add(node string, samples []stream.MetricsSample) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	paths, ok := c.samples[node]
+	if !ok {
+		paths = make(map[string][]stream.MetricsSample)
+		c.samples[node] = paths
+	}
+	for _, sample := range samples {
+		cached := paths[sample.Path]
+		i := sort.Search(len(cached), func(i int) bool {
+			return !cached[i].Timestamp.Before(sample.Timestamp)
+		})
+		if i < len(cached) && cached[i].Timestamp.Equal(sample.Timestamp) {
+			continue
+		}
+		cached = append(cached, stream.MetricsSample{})
+		copy(cached[i+1:], cached[i:])
+		cached[i] = sample
+		paths[sample.Path] = trimSamples(cached)
+	}
+}
+
+// trimSamples drops the oldest samples over maxCachedSamples or maxCachedLength
+func trimSamples(samples []stream.MetricsSample) []stream.MetricsSample {
+	length, start := 0, len(samples)
+	for start > 0 && len(samples)-start < maxCachedSamples {
+		if length += len(samples[start-1].Data); length > maxCachedLength && start < len(samples) {
+			break
+		}
+		start--
+	}
+	return samples[start:]
+}
+
+// latest returns the latest sample of the metrics path of node
+func (c *metricsCache) latest(node, path string) (stream.MetricsSample, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cached := c.samples[node][path]
+	if len(cached) == 0 {
+		return stream.MetricsSample{}, false
+	}
+	return cached[len(cached)-1], true
+}
+
+// list returns the samples of the metrics path of node scraped after since
+func (c *metricsCache) list(node, path string, since time.Time) []stream.MetricsSample {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cached := c.samples[node][path]
+	i := sort.Search(len(cached), func(i int) bool {
+		return cached[i].Timestamp.After(since)
+	})
+	samples := make([]stream.MetricsSample, len(cached)-i)
+	copy(samples, cached[i:])
+	return samples
+}
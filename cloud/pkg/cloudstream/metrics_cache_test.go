@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudstream
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+func TestMetricsCache(t *testing.T) {
+	c := newMetricsCache()
+	sample := func(sec int64, data string) stream.MetricsSample {
+		return stream.MetricsSample{Path: "/metrics/resource", Timestamp: time.Unix(sec, 0), Data: data}
+	}
+
+	if _, ok := c.latest("edge-node", "/metrics/resource"); ok {
+		t.Fatalf("expected no cached metrics")
+	}
+
+	// batches may arrive again and out of order
+	c.add("edge-node", []stream.MetricsSample{sample(2, "b"), sample(3, "c")})
+	c.add("edge-node", []stream.MetricsSample{sample(1, "a"), sample(2, "b")})
+	latest, ok := c.latest("edge-node", "/metrics/resource")
+	if !ok || latest.Data != "c" {
+		t.Fatalf("expected latest sample c, but got %v", latest)
+	}
+	if samples := c.list("edge-node", "/metrics/resource", time.Unix(1, 0)); len(samples) != 2 || samples[0].Data != "b" {
+		t.Fatalf("expected samples b and c, but got %v", samples)
+	}
+
+	for i := int64(0); i < maxCachedSamples; i++ {
+		c.add("edge-node", []stream.MetricsSample{sample(10+i, "d")})
+	}
+	if samples := c.list("edge-node", "/metrics/resource", time.Time{}); len(samples) != maxCachedSamples || samples[0].Data != "d" {
+		t.Fatalf("expected %d cached samples, but got %d", maxCachedSamples, len(samples))
+	}
+
+	// the latest sample is kept even if it is larger than maxCachedLength
+	c.add("edge-node", []stream.MetricsSample{sample(100, strings.Repeat("e", maxCachedLength+1))})
+	if samples := c.list("edge-node", "/metrics/resource", time.Time{}); len(samples) != 1 {
+		t.Fatalf("expected only the large sample cached, but got %d", len(samples))
+	}
+}
@@ -42,6 +42,9 @@ type Session struct {
 	// apiServerConn indicates a connection request made by multiple apiserver to one edgecore
 	apiServerConn map[uint64]APIServerConnection
 	apiConnlock   *sync.RWMutex
+
+	// metrics caches the metrics forwarded by edgecore
+	metrics *metricsCache
 }
 
 func (s *Session) WriteMessageToTunnel(m *stream.Message) error {
@@ -78,6 +81,11 @@ func (s *Session) Serve() {
 			return
 		}
 
+		if message.MessageType == stream.MessageTypeMetricsBatch {
+			s.receiveMetricsBatch(message)
+			continue
+		}
+
 		if err := s.ProxyTunnelMessageToApiserver(message); err != nil {
 			klog.Errorf("Proxy tunnel message [%s] to kube-apiserver error %v", message.String(), err)
 			continue
@@ -110,6 +118,22 @@ func (s *Session) ProxyTunnelMessageToApiserver(message *stream.Message) error {
 	return nil
 }
 
+// receiveMetricsBatch caches the metrics forwarded by edgecore and acknowledges them,
+// a batch that can not be decoded is acknowledged too so that edgecore does not resend it
+func (s *Session) receiveMetricsBatch(message *stream.Message) {
+	samples, err := stream.DecodeMetricsBatch(message.Data)
+	if err != nil {
+		klog.Errorf("%s failed to receive metrics batch %d: %v", s.String(), message.ConnectID, err)
+	} else if s.metrics != nil {
+		s.metrics.add(s.sessionID, samples)
+		klog.V(4).Infof("%s receive %d metrics samples", s.String(), len(samples))
+	}
+
+	if err := s.WriteMessageToTunnel(stream.NewMessage(message.ConnectID, stream.MessageTypeMetricsAck, nil)); err != nil {
+		klog.Errorf("%s failed to ack metrics batch %d: %v", s.String(), message.ConnectID, err)
+	}
+}
+
 func (s *Session) String() string {
 	return fmt.Sprintf("Tunnel session [%v]", s.sessionID)
 }
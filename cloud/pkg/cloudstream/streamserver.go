@@ -25,6 +25,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -84,6 +85,18 @@ func (s *StreamServer) installDebugHandler() {
 		To(s.getAttach))
 	s.container.Add(ws)
 
+	ws = new(restful.WebService)
+	ws.Path("/portForward")
+	ws.Route(ws.GET("/{podNamespace}/{podID}").
+		To(s.getPortForward))
+	ws.Route(ws.POST("/{podNamespace}/{podID}").
+		To(s.getPortForward))
+	ws.Route(ws.GET("/{podNamespace}/{podID}/{uid}").
+		To(s.getPortForward))
+	ws.Route(ws.POST("/{podNamespace}/{podID}/{uid}").
+		To(s.getPortForward))
+	s.container.Add(ws)
+
 	ws = new(restful.WebService)
 	ws.Path("/stats")
 	ws.Route(ws.GET("").
@@ -98,6 +111,13 @@ func (s *StreamServer) installDebugHandler() {
 		To(s.getMetrics))
 	s.container.Add(ws)
 
+	// recent metrics forwarded by the edge nodes, kept for the nodes disconnected temporarily
+	ws = new(restful.WebService)
+	ws.Path("/edgeMetrics")
+	ws.Route(ws.GET("/{nodeName}").
+		To(s.getCachedMetrics))
+	s.container.Add(ws)
+
 	// metrics api is widely used for Prometheus
 	ws = new(restful.WebService)
 	ws.Path("/metrics")
@@ -189,12 +209,14 @@ func (s *StreamServer) getMetrics(r *restful.Request, w *restful.Response) {
 	}
 	session, ok := s.tunnel.getSession(sessionKey)
 	if !ok {
+		// answer with the metrics forwarded before the node was disconnected
+		if s.serveCachedMetrics(sessionKey, r, w) {
+			return
+		}
 		err = fmt.Errorf("can not find %v session ", sessionKey)
 		return
 	}
 
-	w.WriteHeader(http.StatusOK)
-
 	metricsConnection, err := session.AddAPIServerConnection(s, &ContainerMetricsConnection{
 		r:            r,
 		writer:       w.ResponseWriter,
@@ -204,10 +226,16 @@ func (s *StreamServer) getMetrics(r *restful.Request, w *restful.Response) {
 		closeChan:    make(chan bool),
 	})
 	if err != nil {
+		if s.serveCachedMetrics(session.sessionID, r, w) {
+			err = nil
+			return
+		}
 		err = fmt.Errorf("add apiServer connection into %s error %v", session.String(), err)
 		return
 	}
 
+	w.WriteHeader(http.StatusOK)
+
 	defer func() {
 		if err != nil {
 			session.DeleteAPIServerConnection(metricsConnection)
@@ -222,6 +250,48 @@ func (s *StreamServer) getMetrics(r *restful.Request, w *restful.Response) {
 	}
 }
 
+// serveCachedMetrics writes the latest metrics forwarded by node, it reports
+// whether the metrics of the request path are cached
+func (s *StreamServer) serveCachedMetrics(node string, r *restful.Request, w *restful.Response) bool {
+	sample, ok := s.tunnel.metrics.latest(node, r.Request.URL.Path)
+	if !ok {
+		return false
+	}
+	klog.V(4).Infof("Node %s is disconnected, serve %s metrics cached at %v", node, sample.Path, sample.Timestamp)
+
+	contentType := "text/plain; version=0.0.4; charset=utf-8"
+	if strings.HasPrefix(sample.Path, "/stats") {
+		contentType = restful.MIME_JSON
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(sample.Data)); err != nil {
+		klog.Errorf("Failed to write cached metrics of node %s, err: %v", node, err)
+	}
+	return true
+}
+
+// getCachedMetrics returns the metrics history forwarded by a node for custom metrics adapters
+func (s *StreamServer) getCachedMetrics(r *restful.Request, w *restful.Response) {
+	node := r.PathParameter("nodeName")
+	path := r.QueryParameter("path")
+	if path == "" {
+		path = "/metrics/resource"
+	}
+	var since time.Time
+	if v := r.QueryParameter("since"); v != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, v); err != nil {
+			w.WriteErrorString(http.StatusBadRequest, fmt.Sprintf("invalid since %s: %v", v, err))
+			return
+		}
+	}
+
+	if err := w.WriteAsJson(s.tunnel.metrics.list(node, path, since)); err != nil {
+		klog.Errorf("Failed to write cached metrics of node %s, err: %v", node, err)
+	}
+}
+
 func (s *StreamServer) getExec(request *restful.Request, response *restful.Response) {
 	var err error
 	defer func() {
@@ -279,10 +349,8 @@ func (s *StreamServer) getExec(request *restful.Request, response *restful.Respo
 	}
 
 	defer func() {
-		if err != nil {
-			session.DeleteAPIServerConnection(execConnection)
-			klog.Infof("Delete %s from %s", execConnection.String(), session.String())
-		}
+		session.DeleteAPIServerConnection(execConnection)
+		klog.Infof("Delete %s from %s", execConnection.String(), session.String())
 	}()
 
 	if err = execConnection.Serve(); err != nil {
@@ -349,10 +417,8 @@ func (s *StreamServer) getAttach(request *restful.Request, response *restful.Res
 	}
 
 	defer func() {
-		if err != nil {
-			session.DeleteAPIServerConnection(attachConnection)
-			klog.Infof("Delete %s from %s", attachConnection.String(), session.String())
-		}
+		session.DeleteAPIServerConnection(attachConnection)
+		klog.Infof("Delete %s from %s", attachConnection.String(), session.String())
 	}()
 
 	if err = attachConnection.Serve(); err != nil {
@@ -362,6 +428,74 @@ func (s *StreamServer) getAttach(request *restful.Request, response *restful.Res
 	}
 }
 
+func (s *StreamServer) getPortForward(request *restful.Request, response *restful.Response) {
+	var err error
+	defer func() {
+		if err != nil {
+			response.WriteHeader(http.StatusInternalServerError)
+			klog.Errorf("Failed to get port-forward, err: %v", err)
+		}
+	}()
+
+	sessionKey, err := s.getSessionKey(request.Request.URL.Path)
+	if err != nil {
+		err = fmt.Errorf("can not get session key: %v", err)
+		return
+	}
+	session, ok := s.tunnel.getSession(sessionKey)
+	if !ok {
+		err = fmt.Errorf("port-forward: can not find %v session ", sessionKey)
+		return
+	}
+
+	if !httpstream.IsUpgradeRequest(request.Request) {
+		err = fmt.Errorf("request was not an upgrade")
+		return
+	}
+
+	// Once the connection is hijacked, the ErrorResponder will no longer work, so
+	// hijacking should be the last step in the upgrade.
+	requestHijacker, ok := response.ResponseWriter.(http.Hijacker)
+	if !ok {
+		klog.V(6).Infof("Unable to hijack response writer: %T", response.ResponseWriter)
+		err = fmt.Errorf("request connection cannot be hijacked: %T", response.ResponseWriter)
+		return
+	}
+
+	requestHijackedConn, _, err := requestHijacker.Hijack()
+	if err != nil {
+		klog.V(6).Infof("Unable to hijack response: %v", err)
+		err = fmt.Errorf("error hijacking connection: %v", err)
+		return
+	}
+	defer requestHijackedConn.Close()
+
+	portForwardConnection, err := session.AddAPIServerConnection(s, &ContainerPortForwardConnection{
+		r:            request,
+		Conn:         requestHijackedConn,
+		session:      session,
+		ctx:          request.Request.Context(),
+		edgePeerStop: make(chan struct{}, 2),
+		closeChan:    make(chan bool),
+	})
+
+	if err != nil {
+		err = fmt.Errorf("add apiServer port-forward connection into %s error %v", session.String(), err)
+		return
+	}
+
+	defer func() {
+		session.DeleteAPIServerConnection(portForwardConnection)
+		klog.Infof("Delete %s from %s", portForwardConnection.String(), session.String())
+	}()
+
+	if err = portForwardConnection.Serve(); err != nil {
+		err = fmt.Errorf("apiconnection Serve %s in %s error %v",
+			portForwardConnection.String(), session.String(), err)
+		return
+	}
+}
+
 func (s *StreamServer) getSessionKey(urlPath string) (string, error) {
 	// extract pod namespace and pod name from request
 	meta := strings.Split(urlPath, "/")
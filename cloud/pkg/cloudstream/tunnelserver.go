@@ -53,6 +53,7 @@ type TunnelServer struct {
 	sessions   map[string]*Session
 	nodeNameIP sync.Map
 	tunnelPort int
+	metrics    *metricsCache
 }
 
 func newTunnelServer(tunnelPort int) *TunnelServer {
@@ -60,6 +61,7 @@ func newTunnelServer(tunnelPort int) *TunnelServer {
 		container:  restful.NewContainer(),
 		sessions:   make(map[string]*Session),
 		tunnelPort: tunnelPort,
+		metrics:    newMetricsCache(),
 		upgrader: websocket.Upgrader{
 			HandshakeTimeout: time.Second * 2,
 			ReadBufferSize:   1024,
@@ -125,6 +127,7 @@ func (s *TunnelServer) connect(r *restful.Request, w *restful.Response) {
 		apiServerConn: make(map[uint64]APIServerConnection),
 		apiConnlock:   &sync.RWMutex{},
 		sessionID:     hostNameOverride,
+		metrics:       s.metrics,
 	}
 
 	err = s.updateNodeKubeletEndpoint(hostNameOverride)
@@ -150,15 +153,16 @@ func (s *TunnelServer) Start() {
 	var cert []byte
 
 	if streamconfig.Config.Ca != nil {
-		data = streamconfig.Config.Ca
+		data = pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: streamconfig.Config.Ca})
 		klog.Info("Succeed in loading TunnelCA from local directory")
 	} else {
-		data = hubconfig.Config.Ca
+		// trust the CA bundle of CloudHub as well while the CA is rotated
+		data = hubconfig.Config.TrustedCAs()
 		klog.Info("Succeed in loading TunnelCA from CloudHub")
 	}
 
 	pool := x509.NewCertPool()
-	pool.AppendCertsFromPEM(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: data}))
+	pool.AppendCertsFromPEM(data)
 
 	if streamconfig.Config.Key != nil && streamconfig.Config.Cert != nil {
 		cert = streamconfig.Config.Cert
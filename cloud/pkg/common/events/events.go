@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+)
+
+const (
+	// the events of a node are rate limited by component and reason, allowing a burst of
+	// spamBurst events and refilled by one event each 1/spamQPS seconds
+	spamBurst = 50
+	spamQPS   = 1. / 10.
+)
+
+var (
+	broadcaster     record.EventBroadcaster
+	broadcasterOnce sync.Once
+
+	recordersLock sync.Mutex
+	recorders     = make(map[string]record.EventRecorder)
+)
+
+// Recorder returns the recorder of the events the component of cloudcore records,
+// the events of all the components are sent to the apiserver by one broadcaster
+func Recorder(component string) record.EventRecorder {
+	broadcasterOnce.Do(func() {
+		broadcaster = record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{
+			BurstSize:   spamBurst,
+			QPS:         spamQPS,
+			SpamKeyFunc: spamKey,
+		})
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: client.GetKubeClient().CoreV1().Events("")})
+	})
+
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+	recorder, ok := recorders[component]
+	if !ok {
+		recorder = broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component})
+		recorders[component] = recorder
+	}
+	return recorder
+}
+
+// spamKey limits the events by reason besides the involved object, so that a chatty
+// reason does not suppress the other events of the node
+func spamKey(event *corev1.Event) string {
+	return event.Source.Component + "/" + event.InvolvedObject.Name + "/" + event.Reason
+}
+
+// NodeReference returns the reference of the node the events are recorded on, like kubelet does
+func NodeReference(nodeName string) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		Kind: "Node",
+		Name: nodeName,
+		UID:  types.UID(nodeName),
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSpamKey(t *testing.T) {
+	event := func(component, reason string) *corev1.Event {
+		return &corev1.Event{
+			Source:         corev1.EventSource{Component: component},
+			InvolvedObject: *NodeReference("edge-node"),
+			Reason:         reason,
+		}
+	}
+	if spamKey(event("router", "TemperatureAlert")) == spamKey(event("router", "DoorOpened")) {
+		t.Errorf("expected the events of different reasons to be limited separately")
+	}
+	if spamKey(event("router", "EdgeCertificateExpiring")) == spamKey(event("cloudhub", "EdgeCertificateExpiring")) {
+		t.Errorf("expected the events of different components to be limited separately")
+	}
+}
@@ -42,6 +42,8 @@ const (
 	ResourceDevice               = "device"
 	ResourceTypeTwinEdgeUpdated  = "twin/edge_updated"
 	ResourceTypeMembershipDetail = "membership/detail"
+	// ResourceTypeFirmwareEdgeUpdated is the resource type of the firmware state reported by the edge
+	ResourceTypeFirmwareEdgeUpdated = "firmware/edge_updated"
 )
 
 // BuildResource return a string as "beehive/pkg/core/model".Message.Router.Resource
@@ -143,6 +145,8 @@ func GetResourceTypeForDevice(resource string) (string, error) {
 		return ResourceTypeTwinEdgeUpdated, nil
 	} else if strings.Contains(resource, ResourceTypeMembershipDetail) {
 		return ResourceTypeMembershipDetail, nil
+	} else if strings.Contains(resource, ResourceTypeFirmwareEdgeUpdated) {
+		return ResourceTypeFirmwareEdgeUpdated, nil
 	}
 
 	return "", fmt.Errorf("unknown resource, found: %s", resource)
@@ -188,6 +188,14 @@ func TestGetResourceTypeForDevice(t *testing.T) {
 			ResourceTypeMembershipDetail,
 			nil,
 		},
+		{
+			"GetResourceTypeForDevice() ResourceTypeFirmwareEdgeUpdated: success",
+			args{
+				resource: fmt.Sprintf("node/%s/device/%s/%s", "nid", "default/dev", ResourceTypeFirmwareEdgeUpdated),
+			},
+			ResourceTypeFirmwareEdgeUpdated,
+			nil,
+		},
 		{
 			"GetResourceTypeForDevice() Case 2: no resourceType",
 			args{
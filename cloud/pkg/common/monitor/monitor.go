@@ -47,6 +47,67 @@ var (
 			Help:      "Number of nodes that connected to the cloudHub instance",
 		},
 	)
+
+	PersistedMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "persisted_messages",
+			Help:      "Number of downstream messages persisted for the node and waiting to be delivered",
+		},
+		[]string{"node"},
+	)
+
+	UpstreamQueuedMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "upstream_queued_messages",
+			Help:      "Number of upstream messages of the node queued by the traffic shaping, by QoS class",
+		},
+		[]string{"node", "class"},
+	)
+
+	UpstreamDroppedMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "upstream_dropped_messages_total",
+			Help:      "Number of upstream messages of the node dropped since its queue is full, by QoS class",
+		},
+		[]string{"node", "class"},
+	)
+
+	UpstreamThrottledMessages = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "upstream_throttled_messages_total",
+			Help:      "Number of upstream messages of the node delayed by the rate limit of the node or a message group",
+		},
+		[]string{"node", "limit"},
+	)
+
+	EdgeCertificateExpiration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "edge_certificate_expiration_timestamp_seconds",
+			Help:      "Expiration time of the latest certificate of the node in seconds since the Unix epoch",
+		},
+		[]string{"node"},
+	)
+
+	UpstreamQueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricNamespace,
+			Subsystem: CloudHubSubsystem,
+			Name:      "upstream_queue_duration_seconds",
+			Help:      "Duration the upstream messages wait in the queue of the traffic shaping, by QoS class",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+		},
+		[]string{"class"},
+	)
 )
 
 var registerOnce sync.Once
@@ -56,6 +117,12 @@ func registerMetrics() {
 	registerOnce.Do(func() {
 		prometheus.MustRegister(
 			ConnectedNodes,
+			PersistedMessages,
+			UpstreamQueuedMessages,
+			UpstreamDroppedMessages,
+			UpstreamThrottledMessages,
+			UpstreamQueueDuration,
+			EdgeCertificateExpiration,
 		)
 	})
 }
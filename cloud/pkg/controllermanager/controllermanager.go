@@ -62,6 +62,7 @@ func setupControllers(ctx context.Context, mgr manager.Manager) error {
 		StatusManager: statusmanager.NewStatusManager(ctx, mgr, cli, Serializer),
 		Overrider: &overridemanager.OverrideManager{
 			Overriders: []overridemanager.Overrider{
+				&overridemanager.ValuesOverrider{},
 				&overridemanager.NameOverrider{},
 				&overridemanager.ReplicasOverrider{},
 				&overridemanager.ImageOverrider{},
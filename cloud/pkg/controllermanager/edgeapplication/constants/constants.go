@@ -11,6 +11,13 @@ var OverriderTargetGVK = map[schema.GroupVersionKind]struct{}{
 	DeploymentGVK: {},
 }
 
+// ValuesTemplateTargetGVK are the gvks overridden for each nodegroup only when their
+// templates use values, which are rendered by the ValuesOverrider.
+var ValuesTemplateTargetGVK = map[schema.GroupVersionKind]struct{}{
+	ConfigMapGVK: {},
+	SecretGVK:    {},
+}
+
 var ServiceGVK = schema.GroupVersionKind{
 	Version: "v1",
 	Kind:    "Service",
@@ -21,3 +28,13 @@ var DeploymentGVK = schema.GroupVersionKind{
 	Version: "v1",
 	Kind:    "Deployment",
 }
+
+var ConfigMapGVK = schema.GroupVersionKind{
+	Version: "v1",
+	Kind:    "ConfigMap",
+}
+
+var SecretGVK = schema.GroupVersionKind{
+	Version: "v1",
+	Kind:    "Secret",
+}
@@ -115,7 +115,7 @@ func (c *Controller) syncEdgeApplication(ctx context.Context, edgeApp *appsv1alp
 			continue
 		}
 
-		if !needOverride(tmpl) {
+		if !utils.NeedOverride(edgeApp, tmpl) {
 			klog.V(4).Infof("obj %s/%s of gvk %s does not need override, skip override",
 				tmpl.GetNamespace(), tmpl.GetName(), tmpl.GroupVersionKind())
 			modifiedTmplInfos = append(modifiedTmplInfos, tmplInfo)
@@ -557,13 +557,6 @@ func isSameAsLastApplied(objInEdgeApp *unstructured.Unstructured, curObj runtime
 	return false, fmt.Errorf("cannot find last applied template in annotation, %v, possibly it is not created by EdgeApplication Controller", err)
 }
 
-// needOverride determines if a obj needs override, according to its gvk.
-func needOverride(obj runtime.Object) bool {
-	gvk := obj.GetObjectKind().GroupVersionKind()
-	_, ok := constants.OverriderTargetGVK[gvk]
-	return ok
-}
-
 // getDeletedResources will return a slice of all deleted resourceInfo, which
 // are in oldInfos but not in newInfos.
 func getDeletedResources(oldInfos, newInfos []utils.ResourceInfo) []utils.ResourceInfo {
@@ -20,6 +20,10 @@ const (
 	StatefulSetKind = "StatefulSet"
 	// DaemonSetKind indicates the target resource is a daemonset
 	DaemonSetKind = "DaemonSet"
+	// ConfigMapKind indicates the target resource is a configmap
+	ConfigMapKind = "ConfigMap"
+	// SecretKind indicates the target resource is a secret
+	SecretKind = "Secret"
 )
 
 const (
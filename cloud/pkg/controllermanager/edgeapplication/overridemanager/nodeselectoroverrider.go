@@ -27,6 +27,9 @@ func (o *NodeSelectorOverrider) ApplyOverrides(rawObj *unstructured.Unstructured
 			return fmt.Errorf("failed to convert Deployment to unstructured object: %v", err)
 		}
 		rawObj.Object = unstructured
+	case ConfigMapKind, SecretKind:
+		// configmaps and secrets rendered per nodegroup have no pods
+		return nil
 	default:
 		return fmt.Errorf("cannot override nodeselector for obj of gvk %s", rawObj.GroupVersionKind())
 	}
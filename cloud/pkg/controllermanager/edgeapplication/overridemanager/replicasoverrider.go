@@ -31,6 +31,9 @@ func (o *ReplicasOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, ov
 		}
 		return nil
 
+	case ConfigMapKind, SecretKind:
+		// configmaps and secrets rendered per nodegroup have no pods
+		return nil
 	default:
 		return fmt.Errorf("failed to apply replicas override on obj %s/%s, gvk: %s unsupported",
 			rawObj.GetNamespace(), rawObj.GetName(), rawObj.GroupVersionKind())
@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/helm"
+	"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1"
+)
+
+const (
+	// NodeGroupValueKey is the key of the values holding the name of the target node group,
+	// unless it is set by the ValuesOverrider.
+	NodeGroupValueKey = "nodeGroup"
+
+	templateDelim   = "{{"
+	valueFileScheme = "valuefile"
+	// valuesChartName is the name of the chart the templates of a manifest are rendered in
+	valuesChartName = "edgeapplication"
+)
+
+type ValuesOverrider struct{}
+
+// templatedValue is a string of the manifest to be rendered, set stores the result back
+type templatedValue struct {
+	path string
+	tpl  string
+	set  func(interface{})
+}
+
+func (o *ValuesOverrider) ApplyOverrides(rawObj *unstructured.Unstructured, overriders OverriderInfo) error {
+	valuesOverrider := overriders.Overriders.ValuesOverrider
+	if valuesOverrider == nil {
+		return nil
+	}
+	templated := findTemplatedValues(rawObj.Object)
+	if len(templated) == 0 {
+		return nil
+	}
+
+	values, err := mergeValues(valuesOverrider, overriders.TargetNodeGroup)
+	if err != nil {
+		return fmt.Errorf("failed to merge values of nodegroup %s, %v", overriders.TargetNodeGroup, err)
+	}
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: valuesChartName, Version: "0.0.0"},
+	}
+	for i, value := range templated {
+		ch.Templates = append(ch.Templates, &chart.File{Name: templateName(i), Data: []byte(value.tpl)})
+	}
+	rendered, err := engine.Render(ch, chartutil.Values{"Values": values})
+	if err != nil {
+		return fmt.Errorf("failed to render obj %s/%s for nodegroup %s, %v",
+			rawObj.GetNamespace(), rawObj.GetName(), overriders.TargetNodeGroup, err)
+	}
+
+	for i, value := range templated {
+		out := rendered[path.Join(valuesChartName, templateName(i))]
+		if !isSingleAction(value.tpl) {
+			value.set(out)
+			continue
+		}
+		parsed, err := parseRendered(out)
+		if err != nil {
+			return fmt.Errorf("failed to parse the rendered value of %s of obj %s/%s, %v",
+				value.path, rawObj.GetNamespace(), rawObj.GetName(), err)
+		}
+		value.set(parsed)
+	}
+	klog.V(4).Infof("Rendered %d templates of obj %s/%s for nodegroup %s", len(templated),
+		rawObj.GetNamespace(), rawObj.GetName(), overriders.TargetNodeGroup)
+	return nil
+}
+
+// HasValueTemplates reports whether obj has strings to be rendered by the ValuesOverrider.
+func HasValueTemplates(obj *unstructured.Unstructured) bool {
+	return len(findTemplatedValues(obj.Object)) != 0
+}
+
+// mergeValues merges the values of the ValuesOverrider the way helm does, reusing the value
+// files merging of keadm, and sets the name of the node group if the values do not have it.
+func mergeValues(valuesOverrider *v1alpha1.ValuesOverrider, nodeGroup string) (map[string]interface{}, error) {
+	files := make([]string, 0, len(valuesOverrider.ValueFiles))
+	for i := range valuesOverrider.ValueFiles {
+		files = append(files, fmt.Sprintf("%s://%d", valueFileScheme, i))
+	}
+	opts := &helm.Options{
+		ValueFiles:   files,
+		Values:       valuesOverrider.Values,
+		StringValues: valuesOverrider.StringValues,
+		Fetchers:     []helm.Fetcher{valueFileFetcher(valuesOverrider.ValueFiles)},
+	}
+	values, err := opts.MergeValues()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := values[NodeGroupValueKey]; !ok {
+		values[NodeGroupValueKey] = nodeGroup
+	}
+	return values, nil
+}
+
+// valueFileFetcher reads the value files given inline in the ValuesOverrider,
+// valuefile://1 being the second one.
+type valueFileFetcher []string
+
+func (valueFileFetcher) Scheme() string {
+	return valueFileScheme
+}
+
+func (f valueFileFetcher) Fetch(_ context.Context, uri string) ([]byte, error) {
+	index, err := strconv.Atoi(strings.TrimPrefix(uri, valueFileScheme+"://"))
+	if err != nil || index < 0 || index >= len(f) {
+		return nil, fmt.Errorf("value file %s does not exist", uri)
+	}
+	return []byte(f[index]), nil
+}
+
+// findTemplatedValues returns the strings of obj containing templates,
+// except the name and namespace which identify the object.
+func findTemplatedValues(obj map[string]interface{}) []*templatedValue {
+	var templated []*templatedValue
+	for key, value := range obj {
+		if key != "metadata" {
+			templated = appendTemplatedValues(templated, key, value, setMapValue(obj, key))
+			continue
+		}
+		metadata, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k, v := range metadata {
+			if k == "name" || k == "namespace" {
+				continue
+			}
+			templated = appendTemplatedValues(templated, "metadata."+k, v, setMapValue(metadata, k))
+		}
+	}
+	return templated
+}
+
+func appendTemplatedValues(templated []*templatedValue, fieldPath string, value interface{}, set func(interface{})) []*templatedValue {
+	switch v := value.(type) {
+	case string:
+		if strings.Contains(v, templateDelim) {
+			templated = append(templated, &templatedValue{path: fieldPath, tpl: v, set: set})
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			templated = appendTemplatedValues(templated, fieldPath+"."+key, item, setMapValue(v, key))
+		}
+	case []interface{}:
+		for i, item := range v {
+			templated = appendTemplatedValues(templated, fmt.Sprintf("%s[%d]", fieldPath, i), item, setSliceValue(v, i))
+		}
+	}
+	return templated
+}
+
+func setMapValue(m map[string]interface{}, key string) func(interface{}) {
+	return func(value interface{}) {
+		m[key] = value
+	}
+}
+
+func setSliceValue(s []interface{}, index int) func(interface{}) {
+	return func(value interface{}) {
+		s[index] = value
+	}
+}
+
+func templateName(index int) string {
+	return path.Join("templates", strconv.Itoa(index))
+}
+
+// isSingleAction reports whether the template is a single action, whose output replaces the whole value
+func isSingleAction(tpl string) bool {
+	tpl = strings.TrimSpace(tpl)
+	return strings.HasPrefix(tpl, templateDelim) && strings.HasSuffix(tpl, "}}") &&
+		strings.Count(tpl, templateDelim) == 1
+}
+
+// parseRendered parses the output of a single action as YAML, into the types of unstructured objects
+func parseRendered(out string) (interface{}, error) {
+	data, err := yaml.YAMLToJSON([]byte(out))
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := utiljson.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overridemanager
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1"
+)
+
+func TestValuesOverrider(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":   "app-config-{{ .Values.nodeGroup }}",
+			"labels": map[string]interface{}{"region": "{{ .Values.region }}"},
+		},
+		"data": map[string]interface{}{
+			"app.conf": "endpoint={{ .Values.endpoint }}\nnodegroup={{ .Values.nodeGroup }}",
+			"port":     "{{ .Values.port | quote }}",
+			"literal":  `{{ "{{" }} .Values.region }}`,
+		},
+		"spec": map[string]interface{}{
+			"env": "{{ toYaml .Values.env }}",
+		},
+	}}
+	info := OverriderInfo{
+		TargetNodeGroup: "beijing",
+		Overriders: &v1alpha1.Overriders{
+			ValuesOverrider: &v1alpha1.ValuesOverrider{
+				ValueFiles: []string{
+					"region: north\nendpoint: a.example.com\nport: 80\nenv:\n- name: LOG_LEVEL\n  value: info\n",
+					"endpoint: b.example.com\n",
+				},
+				Values: []string{"port=8080"},
+			},
+		},
+	}
+	if err := (&ValuesOverrider{}).ApplyOverrides(obj, info); err != nil {
+		t.Fatalf("failed to apply values overrides: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":   "app-config-{{ .Values.nodeGroup }}",
+			"labels": map[string]interface{}{"region": "north"},
+		},
+		"data": map[string]interface{}{
+			"app.conf": "endpoint=b.example.com\nnodegroup=beijing",
+			"port":     "8080",
+			"literal":  "{{ .Values.region }}",
+		},
+		"spec": map[string]interface{}{
+			"env": []interface{}{map[string]interface{}{"name": "LOG_LEVEL", "value": "info"}},
+		},
+	}
+	if !reflect.DeepEqual(obj.Object, expected) {
+		t.Errorf("expected %v, but got %v", expected, obj.Object)
+	}
+}
+
+func TestValuesOverriderWithoutValues(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "ConfigMap",
+		"data": map[string]interface{}{"region": "{{ .Values.region }}"},
+	}}
+	if err := (&ValuesOverrider{}).ApplyOverrides(obj, OverriderInfo{Overriders: &v1alpha1.Overriders{}}); err != nil {
+		t.Fatalf("failed to apply values overrides: %v", err)
+	}
+	if region := obj.Object["data"].(map[string]interface{})["region"]; region != "{{ .Values.region }}" {
+		t.Errorf("expected the template kept without a values overrider, but got %v", region)
+	}
+}
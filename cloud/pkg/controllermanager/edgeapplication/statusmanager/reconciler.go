@@ -60,7 +60,7 @@ func (r *statusReconciler) sync(ctx context.Context, edgeApp *appsv1alpha1.EdgeA
 			// it's not managed by this reconciler
 			continue
 		}
-		if _, ok := constants.OverriderTargetGVK[gvk]; !ok {
+		if !utils.NeedOverride(edgeApp, tmpl) {
 			if err := r.updateStatus(ctx, edgeApp, tmplInfo, availableIfExists{}); err != nil {
 				klog.Errorf("failed to update status for edgeApp %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
 				return controllerruntime.Result{Requeue: true}, err
@@ -77,11 +77,15 @@ func (r *statusReconciler) sync(ctx context.Context, edgeApp *appsv1alpha1.EdgeA
 					continue
 				}
 				// TODO:
-				// Currently, only deployment will be override. So, we just use the deploymentAvailable here.
-				// It a temporary strategy for convenience. When we need to support more GVK, a generic strategy
-				// is needed.
+				// Currently, only deployment will be override, besides configmaps and secrets rendered
+				// with values, which are available if they exist. It a temporary strategy for convenience.
+				// When we need to support more GVK, a generic strategy is needed.
+				var checker available = deploymentAvailable{}
+				if _, ok := constants.ValuesTemplateTargetGVK[gvk]; ok {
+					checker = availableIfExists{}
+				}
 				newTmplInfo := &utils.TemplateInfo{Ordinal: tmplInfo.Ordinal, Template: copy}
-				if err := r.updateStatus(ctx, edgeApp, newTmplInfo, deploymentAvailable{}); err != nil {
+				if err := r.updateStatus(ctx, edgeApp, newTmplInfo, checker); err != nil {
 					klog.Errorf("failed to update status for edgeApp %s/%s, %v", edgeApp.Namespace, edgeApp.Name, err)
 					return controllerruntime.Result{Requeue: true}, err
 				}
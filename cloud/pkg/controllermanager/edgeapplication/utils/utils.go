@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 
+	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/constants"
 	"github.com/kubeedge/kubeedge/cloud/pkg/controllermanager/edgeapplication/overridemanager"
 	appsv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1"
 )
@@ -44,6 +45,24 @@ func GetAllOverriders(edgeApp *appsv1alpha1.EdgeApplication) []overridemanager.O
 	return infos
 }
 
+// NeedOverride determines if a template needs override for each nodegroup, according to its gvk.
+// ConfigMaps and Secrets are only overridden if they use values and any nodegroup has a ValuesOverrider.
+func NeedOverride(edgeApp *appsv1alpha1.EdgeApplication, tmpl *unstructured.Unstructured) bool {
+	gvk := tmpl.GroupVersionKind()
+	if _, ok := constants.OverriderTargetGVK[gvk]; ok {
+		return true
+	}
+	if _, ok := constants.ValuesTemplateTargetGVK[gvk]; !ok {
+		return false
+	}
+	for _, nodeGroup := range edgeApp.Spec.WorkloadScope.TargetNodeGroups {
+		if nodeGroup.Overriders.ValuesOverrider != nil {
+			return overridemanager.HasValueTemplates(tmpl)
+		}
+	}
+	return false
+}
+
 func GetContainedResourceInfos(edgeApp *appsv1alpha1.EdgeApplication, yamlSerializer runtime.Serializer) ([]ResourceInfo, error) {
 	tmplInfos, err := GetTemplatesInfosOfEdgeApp(edgeApp, yamlSerializer)
 	if err != nil {
@@ -26,7 +26,7 @@ const (
 	// ControllerName is the controller name that will be used when reporting events.
 	ControllerName = "nodegroup-controller"
 
-	LabelBelongingTo              = "apps.kubeedge.io/belonging-to"
+	LabelBelongingTo              = appsv1alpha1.LabelBelongingTo
 	NodeGroupControllerFinalizer  = "apps.kubeedge.io/nodegroup-controller"
 	ServiceTopologyAnnotation     = "apps.kubeedge.io/service-topology"
 	ServiceTopologyRangeNodegroup = "range-nodegroup"
@@ -4,6 +4,8 @@ package constants
 const (
 	ResourceTypeTwinEdgeUpdated  = "twin/edge_updated"
 	ResourceTypeMembershipDetail = "membership/detail"
+	// ResourceTypeFirmwareEdgeUpdated is the resource type of the firmware state reported by the edge
+	ResourceTypeFirmwareEdgeUpdated = "firmware/edge_updated"
 
 	// Group
 	GroupTwin     = "twin"
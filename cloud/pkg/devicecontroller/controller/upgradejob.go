@@ -0,0 +1,434 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+	operationsv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
+	crdinformers "github.com/kubeedge/kubeedge/pkg/client/informers/externalversions"
+	devicelisters "github.com/kubeedge/kubeedge/pkg/client/listers/devices/v1beta1"
+	operationslisters "github.com/kubeedge/kubeedge/pkg/client/listers/operations/v1alpha1"
+)
+
+const (
+	// DefaultDeviceUpgradeTimeoutSeconds is the default timeout of the firmware upgrade of a device
+	DefaultDeviceUpgradeTimeoutSeconds = 600
+	// DefaultDeviceUpgradeBatchSize is the default number of devices upgraded in a batch
+	DefaultDeviceUpgradeBatchSize = 1
+)
+
+// DeviceFirmwareSpec is structure to patch the desired firmware of device spec
+type DeviceFirmwareSpec struct {
+	Spec struct {
+		Firmware *v1beta1.DeviceFirmware `json:"firmware"`
+	} `json:"spec"`
+}
+
+// UpgradeJobController rolls out the firmware of DeviceUpgradeJobs to the devices batch by batch.
+// It sets the desired firmware of the devices of a batch, the mapper upgrades the devices and
+// reports the firmware state, and the next batch is started after all the devices of the batch
+// finish the upgrade.
+type UpgradeJobController struct {
+	crdClient crdClientset.Interface
+	queue     workqueue.RateLimitingInterface
+
+	jobLister    operationslisters.DeviceUpgradeJobLister
+	jobSynced    cache.InformerSynced
+	deviceLister devicelisters.DeviceLister
+	deviceSynced cache.InformerSynced
+
+	// now returns the current time, it is replaced in tests
+	now func() time.Time
+}
+
+// NewUpgradeJobController create UpgradeJobController from the informer factory
+func NewUpgradeJobController(crdInformerFactory crdinformers.SharedInformerFactory) (*UpgradeJobController, error) {
+	return newUpgradeJobController(client.GetCRDClient(), crdInformerFactory)
+}
+
+func newUpgradeJobController(crdClient crdClientset.Interface, crdInformerFactory crdinformers.SharedInformerFactory) (*UpgradeJobController, error) {
+	jobInformer := crdInformerFactory.Operations().V1alpha1().DeviceUpgradeJobs()
+	deviceInformer := crdInformerFactory.Devices().V1beta1().Devices()
+	uc := &UpgradeJobController{
+		crdClient:    crdClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "deviceupgradejob"),
+		jobLister:    jobInformer.Lister(),
+		jobSynced:    jobInformer.Informer().HasSynced,
+		deviceLister: deviceInformer.Lister(),
+		deviceSynced: deviceInformer.Informer().HasSynced,
+		now:          time.Now,
+	}
+
+	_, err := jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: uc.enqueueJob,
+		UpdateFunc: func(old, new interface{}) {
+			uc.enqueueJob(new)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add event handler of DeviceUpgradeJob, err: %v", err)
+	}
+	_, err = deviceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: uc.deviceUpdated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add event handler of Device, err: %v", err)
+	}
+	return uc, nil
+}
+
+// Start UpgradeJobController
+func (uc *UpgradeJobController) Start() error {
+	klog.Info("Start device upgrade job controller")
+
+	go func() {
+		defer utilruntime.HandleCrash()
+		defer uc.queue.ShutDown()
+
+		if !cache.WaitForNamedCacheSync("DeviceUpgradeJob", beehiveContext.Done(), uc.jobSynced, uc.deviceSynced) {
+			return
+		}
+		go wait.Until(uc.worker, time.Second, beehiveContext.Done())
+		<-beehiveContext.Done()
+	}()
+	return nil
+}
+
+func (uc *UpgradeJobController) enqueueJob(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	uc.queue.Add(key)
+}
+
+// deviceUpdated enqueues the upgrading jobs in the namespace of the device when its firmware state changes
+func (uc *UpgradeJobController) deviceUpdated(old, new interface{}) {
+	oldDevice, ok := old.(*v1beta1.Device)
+	if !ok {
+		return
+	}
+	newDevice, ok := new.(*v1beta1.Device)
+	if !ok {
+		return
+	}
+	if apiequality.Semantic.DeepEqual(oldDevice.Status.Firmware, newDevice.Status.Firmware) {
+		return
+	}
+
+	jobs, err := uc.jobLister.DeviceUpgradeJobs(newDevice.Namespace).List(labels.Everything())
+	if err != nil {
+		klog.Warningf("failed to list DeviceUpgradeJobs in namespace %s, err: %v", newDevice.Namespace, err)
+		return
+	}
+	for _, job := range jobs {
+		if job.Status.State == operationsv1alpha1.DeviceUpgradeJobUpgrading {
+			uc.enqueueJob(job)
+		}
+	}
+}
+
+func (uc *UpgradeJobController) worker() {
+	for uc.processNextWorkItem() {
+	}
+}
+
+func (uc *UpgradeJobController) processNextWorkItem() bool {
+	key, quit := uc.queue.Get()
+	if quit {
+		return false
+	}
+	defer uc.queue.Done(key)
+
+	requeueAfter, err := uc.syncFunc(key.(string))
+	if err != nil {
+		uc.queue.AddRateLimited(key)
+		utilruntime.HandleError(fmt.Errorf("sync DeviceUpgradeJob %v failed with: %v", key, err))
+		return true
+	}
+
+	uc.queue.Forget(key)
+	if requeueAfter > 0 {
+		uc.queue.AddAfter(key, requeueAfter)
+	}
+	return true
+}
+
+func (uc *UpgradeJobController) syncFunc(key string) (time.Duration, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return 0, err
+	}
+	job, err := uc.jobLister.DeviceUpgradeJobs(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.V(4).Infof("DeviceUpgradeJob %s has been deleted", key)
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return uc.syncJob(job.DeepCopy())
+}
+
+// syncJob observes the firmware state of the upgrading devices and starts the next batch
+// once all the devices of the current batch finish. It returns the duration after which
+// the job must be synced again to check for the timeout of the upgrading devices.
+func (uc *UpgradeJobController) syncJob(job *operationsv1alpha1.DeviceUpgradeJob) (time.Duration, error) {
+	status := &job.Status
+	if status.State == operationsv1alpha1.DeviceUpgradeJobCompleted || status.State == operationsv1alpha1.DeviceUpgradeJobFailed {
+		return 0, nil
+	}
+	oldStatus := status.DeepCopy()
+
+	tolerate, err := parseFailureTolerate(job.Spec.FailureTolerate)
+	if err != nil {
+		status.State = operationsv1alpha1.DeviceUpgradeJobFailed
+		status.Reason = err.Error()
+		return 0, uc.updateJobStatus(job, oldStatus)
+	}
+	if status.State == "" {
+		devices, err := uc.selectDevices(job)
+		if err != nil {
+			return 0, err
+		}
+		status.State = operationsv1alpha1.DeviceUpgradeJobUpgrading
+		status.Devices = make([]operationsv1alpha1.DeviceUpgradeStatus, 0, len(devices))
+		for _, name := range devices {
+			status.Devices = append(status.Devices, operationsv1alpha1.DeviceUpgradeStatus{
+				DeviceName: name,
+				State:      operationsv1alpha1.DeviceUpgradePending,
+			})
+		}
+	}
+
+	timeout := time.Duration(DefaultDeviceUpgradeTimeoutSeconds) * time.Second
+	if job.Spec.TimeoutSeconds != nil && *job.Spec.TimeoutSeconds != 0 {
+		timeout = time.Duration(*job.Spec.TimeoutSeconds) * time.Second
+	}
+	now := uc.now()
+	for i := range status.Devices {
+		if status.Devices[i].State == operationsv1alpha1.DeviceUpgradeUpgrading {
+			uc.observeDevice(job, &status.Devices[i], timeout, now)
+		}
+	}
+
+	counts := countDevices(status.Devices)
+	total := len(status.Devices)
+	exceeded := total > 0 && float64(counts[operationsv1alpha1.DeviceUpgradeFailed]) > tolerate*float64(total)
+	switch {
+	case counts[operationsv1alpha1.DeviceUpgradeUpgrading] > 0:
+		// wait for the devices of the current batch
+	case exceeded:
+		status.State = operationsv1alpha1.DeviceUpgradeJobFailed
+		status.Reason = fmt.Sprintf("%d of %d devices failed to upgrade, exceeds the failure tolerance %v",
+			counts[operationsv1alpha1.DeviceUpgradeFailed], total, tolerate)
+	case counts[operationsv1alpha1.DeviceUpgradePending] == 0:
+		status.State = operationsv1alpha1.DeviceUpgradeJobCompleted
+	default:
+		uc.startBatch(job, now)
+	}
+
+	counts = countDevices(status.Devices)
+	status.Succeeded = int32(counts[operationsv1alpha1.DeviceUpgradeSucceeded])
+	status.Failed = int32(counts[operationsv1alpha1.DeviceUpgradeFailed])
+	if err := uc.updateJobStatus(job, oldStatus); err != nil {
+		return 0, err
+	}
+	return nextTimeout(status.Devices, timeout, now), nil
+}
+
+// selectDevices returns the names of the devices of the job in the order they are upgraded
+func (uc *UpgradeJobController) selectDevices(job *operationsv1alpha1.DeviceUpgradeJob) ([]string, error) {
+	var names []string
+	if len(job.Spec.DeviceNames) != 0 {
+		seen := make(map[string]bool, len(job.Spec.DeviceNames))
+		for _, name := range job.Spec.DeviceNames {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	} else if job.Spec.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(job.Spec.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse label selector of DeviceUpgradeJob %s, err: %v", job.Name, err)
+		}
+		devices, err := uc.deviceLister.Devices(job.Namespace).List(selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list devices of DeviceUpgradeJob %s, err: %v", job.Name, err)
+		}
+		for _, device := range devices {
+			names = append(names, device.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// observeDevice updates the upgrade state of the device with the firmware state reported by the mapper
+func (uc *UpgradeJobController) observeDevice(job *operationsv1alpha1.DeviceUpgradeJob, status *operationsv1alpha1.DeviceUpgradeStatus,
+	timeout time.Duration, now time.Time) {
+	version := job.Spec.Firmware.Version
+	device, err := uc.deviceLister.Devices(job.Namespace).Get(status.DeviceName)
+	if err != nil {
+		finishDevice(status, operationsv1alpha1.DeviceUpgradeFailed, fmt.Sprintf("failed to get device: %v", err), now)
+		return
+	}
+
+	if firmware := device.Status.Firmware; firmware != nil && firmware.DesiredVersion == version {
+		// the state reported before the upgrade is started belongs to an earlier upgrade
+		if status.StartTime == nil || !firmware.LastTransitionTime.Before(status.StartTime) {
+			status.FirmwareState = firmware.State
+			switch {
+			case firmware.State == v1beta1.FirmwareStateSucceeded && firmware.Version == version:
+				finishDevice(status, operationsv1alpha1.DeviceUpgradeSucceeded, "", now)
+				return
+			case firmware.State == v1beta1.FirmwareStateFailed:
+				finishDevice(status, operationsv1alpha1.DeviceUpgradeFailed, firmware.Reason, now)
+				return
+			}
+		}
+	}
+	if firmware := device.Status.Firmware; firmware != nil && firmware.Version == version && firmware.State != v1beta1.FirmwareStateFailed {
+		// the device already runs the firmware
+		finishDevice(status, operationsv1alpha1.DeviceUpgradeSucceeded, "", now)
+		return
+	}
+	if status.StartTime != nil && now.Sub(status.StartTime.Time) >= timeout {
+		finishDevice(status, operationsv1alpha1.DeviceUpgradeFailed, fmt.Sprintf("upgrade timed out after %v", timeout), now)
+	}
+}
+
+// startBatch sets the desired firmware of the next batch of the pending devices
+func (uc *UpgradeJobController) startBatch(job *operationsv1alpha1.DeviceUpgradeJob, now time.Time) {
+	batchSize := int(job.Spec.BatchSize)
+	if batchSize <= 0 {
+		batchSize = DefaultDeviceUpgradeBatchSize
+	}
+	status := &job.Status
+	status.CurrentBatch++
+	klog.Infof("start batch %d of DeviceUpgradeJob %s/%s", status.CurrentBatch, job.Namespace, job.Name)
+
+	patch := DeviceFirmwareSpec{}
+	patch.Spec.Firmware = &job.Spec.Firmware
+	body, err := json.Marshal(patch)
+	if err != nil {
+		status.State = operationsv1alpha1.DeviceUpgradeJobFailed
+		status.Reason = fmt.Sprintf("failed to marshal firmware: %v", err)
+		return
+	}
+
+	startTime := metav1.NewTime(now)
+	for i := range status.Devices {
+		if batchSize == 0 {
+			break
+		}
+		device := &status.Devices[i]
+		if device.State != operationsv1alpha1.DeviceUpgradePending {
+			continue
+		}
+		batchSize--
+		device.Batch = status.CurrentBatch
+		device.StartTime = &startTime
+		_, err := uc.crdClient.DevicesV1beta1().Devices(job.Namespace).Patch(context.Background(),
+			device.DeviceName, apitypes.MergePatchType, body, metav1.PatchOptions{})
+		if err != nil {
+			klog.Warningf("failed to set firmware of device %s/%s, err: %v", job.Namespace, device.DeviceName, err)
+			finishDevice(device, operationsv1alpha1.DeviceUpgradeFailed, fmt.Sprintf("failed to set firmware: %v", err), now)
+			continue
+		}
+		device.State = operationsv1alpha1.DeviceUpgradeUpgrading
+	}
+}
+
+func (uc *UpgradeJobController) updateJobStatus(job *operationsv1alpha1.DeviceUpgradeJob, oldStatus *operationsv1alpha1.DeviceUpgradeJobStatus) error {
+	if apiequality.Semantic.DeepEqual(oldStatus, &job.Status) {
+		return nil
+	}
+	_, err := uc.crdClient.OperationsV1alpha1().DeviceUpgradeJobs(job.Namespace).UpdateStatus(context.Background(), job, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update status of DeviceUpgradeJob %s/%s, err: %v", job.Namespace, job.Name, err)
+	}
+	return nil
+}
+
+func finishDevice(status *operationsv1alpha1.DeviceUpgradeStatus, state operationsv1alpha1.DeviceUpgradeState, reason string, now time.Time) {
+	completionTime := metav1.NewTime(now)
+	status.State = state
+	status.Reason = reason
+	status.CompletionTime = &completionTime
+}
+
+func countDevices(devices []operationsv1alpha1.DeviceUpgradeStatus) map[operationsv1alpha1.DeviceUpgradeState]int {
+	counts := make(map[operationsv1alpha1.DeviceUpgradeState]int)
+	for _, device := range devices {
+		counts[device.State]++
+	}
+	return counts
+}
+
+// nextTimeout returns the duration until the earliest timeout of the upgrading devices,
+// it returns 0 if no device is upgrading
+func nextTimeout(devices []operationsv1alpha1.DeviceUpgradeStatus, timeout time.Duration, now time.Time) time.Duration {
+	var next time.Duration
+	for _, device := range devices {
+		if device.State != operationsv1alpha1.DeviceUpgradeUpgrading || device.StartTime == nil {
+			continue
+		}
+		d := device.StartTime.Add(timeout).Sub(now)
+		if d <= 0 {
+			d = time.Second
+		}
+		if next == 0 || d < next {
+			next = d
+		}
+	}
+	return next
+}
+
+func parseFailureTolerate(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	tolerate, err := strconv.ParseFloat(value, 64)
+	if err != nil || tolerate < 0 || tolerate > 1 {
+		return 0, fmt.Errorf("failureTolerate %q must be a number in range [0, 1]", value)
+	}
+	return tolerate, nil
+}
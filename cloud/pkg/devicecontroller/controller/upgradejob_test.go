@@ -0,0 +1,217 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+	operationsv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/client/clientset/versioned/fake"
+	crdinformers "github.com/kubeedge/kubeedge/pkg/client/informers/externalversions"
+)
+
+const testNamespace = "default"
+
+var testFirmware = v1beta1.DeviceFirmware{
+	Version:  "v2.0.0",
+	URL:      "https://firmware.example.com/meter-v2.0.0.bin",
+	Checksum: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+}
+
+func newTestDevice(name string, deviceLabels map[string]string) *v1beta1.Device {
+	return &v1beta1.Device{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Labels: deviceLabels},
+	}
+}
+
+func newTestUpgradeJobController(t *testing.T, objects ...runtime.Object) (*UpgradeJobController, *fake.Clientset, crdinformers.SharedInformerFactory) {
+	cs := fake.NewSimpleClientset(objects...)
+	factory := crdinformers.NewSharedInformerFactory(cs, 0)
+	uc, err := newUpgradeJobController(cs, factory)
+	if err != nil {
+		t.Fatalf("failed to create controller: %v", err)
+	}
+	for _, obj := range objects {
+		var err error
+		switch o := obj.(type) {
+		case *v1beta1.Device:
+			err = factory.Devices().V1beta1().Devices().Informer().GetIndexer().Add(o)
+		case *operationsv1alpha1.DeviceUpgradeJob:
+			err = factory.Operations().V1alpha1().DeviceUpgradeJobs().Informer().GetIndexer().Add(o)
+		}
+		if err != nil {
+			t.Fatalf("failed to add object to indexer: %v", err)
+		}
+	}
+	return uc, cs, factory
+}
+
+// reportFirmware updates the firmware state of the device in the informer cache as the mapper does
+func reportFirmware(t *testing.T, factory crdinformers.SharedInformerFactory, name string, firmware *v1beta1.DeviceFirmwareStatus) {
+	indexer := factory.Devices().V1beta1().Devices().Informer().GetIndexer()
+	obj, exists, err := indexer.GetByKey(testNamespace + "/" + name)
+	if err != nil || !exists {
+		t.Fatalf("device %s is not found: %v", name, err)
+	}
+	device := obj.(*v1beta1.Device).DeepCopy()
+	device.Status.Firmware = firmware
+	if err := indexer.Update(device); err != nil {
+		t.Fatalf("failed to update device %s: %v", name, err)
+	}
+}
+
+func syncTestJob(t *testing.T, uc *UpgradeJobController, cs *fake.Clientset, name string) (*operationsv1alpha1.DeviceUpgradeJob, time.Duration) {
+	job, err := cs.OperationsV1alpha1().DeviceUpgradeJobs(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	}
+	requeueAfter, err := uc.syncJob(job)
+	if err != nil {
+		t.Fatalf("syncJob() error = %v", err)
+	}
+	job, err = cs.OperationsV1alpha1().DeviceUpgradeJobs(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get job: %v", err)
+	}
+	return job, requeueAfter
+}
+
+func expectDeviceStates(t *testing.T, job *operationsv1alpha1.DeviceUpgradeJob, want map[string]operationsv1alpha1.DeviceUpgradeState) {
+	t.Helper()
+	if len(job.Status.Devices) != len(want) {
+		t.Fatalf("expected %d devices, but got %v", len(want), job.Status.Devices)
+	}
+	for _, device := range job.Status.Devices {
+		if device.State != want[device.DeviceName] {
+			t.Errorf("expected device %s in state %s, but got %s", device.DeviceName, want[device.DeviceName], device.State)
+		}
+	}
+}
+
+func TestUpgradeJobBatches(t *testing.T) {
+	meter := map[string]string{"app": "meter"}
+	job := &operationsv1alpha1.DeviceUpgradeJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "upgrade-meters", Namespace: testNamespace},
+		Spec: operationsv1alpha1.DeviceUpgradeJobSpec{
+			Firmware:        testFirmware,
+			LabelSelector:   &metav1.LabelSelector{MatchLabels: meter},
+			BatchSize:       2,
+			FailureTolerate: "0.5",
+		},
+	}
+	uc, cs, factory := newTestUpgradeJobController(t, job,
+		newTestDevice("meter-a", meter), newTestDevice("meter-b", meter), newTestDevice("meter-c", meter),
+		newTestDevice("sensor", nil))
+	start := time.Now().Truncate(time.Second)
+	uc.now = func() time.Time { return start }
+
+	got, requeueAfter := syncTestJob(t, uc, cs, job.Name)
+	if got.Status.State != operationsv1alpha1.DeviceUpgradeJobUpgrading || got.Status.CurrentBatch != 1 {
+		t.Fatalf("expected the first batch to be upgrading, but got %+v", got.Status)
+	}
+	expectDeviceStates(t, got, map[string]operationsv1alpha1.DeviceUpgradeState{
+		"meter-a": operationsv1alpha1.DeviceUpgradeUpgrading,
+		"meter-b": operationsv1alpha1.DeviceUpgradeUpgrading,
+		"meter-c": operationsv1alpha1.DeviceUpgradePending,
+	})
+	if requeueAfter != DefaultDeviceUpgradeTimeoutSeconds*time.Second {
+		t.Errorf("expected to be synced again at the timeout, but got %v", requeueAfter)
+	}
+	device, err := cs.DevicesV1beta1().Devices(testNamespace).Get(context.Background(), "meter-a", metav1.GetOptions{})
+	if err != nil || device.Spec.Firmware == nil || *device.Spec.Firmware != testFirmware {
+		t.Errorf("expected the desired firmware of meter-a to be set, but got %+v, %v", device.Spec.Firmware, err)
+	}
+
+	// the mapper reports the result of the first batch
+	transition := metav1.NewTime(start.Add(time.Minute))
+	reportFirmware(t, factory, "meter-a", &v1beta1.DeviceFirmwareStatus{Version: "v2.0.0", DesiredVersion: "v2.0.0",
+		State: v1beta1.FirmwareStateSucceeded, LastTransitionTime: transition})
+	reportFirmware(t, factory, "meter-b", &v1beta1.DeviceFirmwareStatus{Version: "v1.0.0", DesiredVersion: "v2.0.0",
+		State: v1beta1.FirmwareStateFailed, Reason: "checksum mismatch", LastTransitionTime: transition})
+	uc.now = func() time.Time { return start.Add(2 * time.Minute) }
+
+	got, _ = syncTestJob(t, uc, cs, job.Name)
+	if got.Status.State != operationsv1alpha1.DeviceUpgradeJobUpgrading || got.Status.CurrentBatch != 2 {
+		t.Fatalf("expected the second batch to be upgrading within the failure tolerance, but got %+v", got.Status)
+	}
+	expectDeviceStates(t, got, map[string]operationsv1alpha1.DeviceUpgradeState{
+		"meter-a": operationsv1alpha1.DeviceUpgradeSucceeded,
+		"meter-b": operationsv1alpha1.DeviceUpgradeFailed,
+		"meter-c": operationsv1alpha1.DeviceUpgradeUpgrading,
+	})
+	if got.Status.Devices[1].Reason != "checksum mismatch" {
+		t.Errorf("expected the reason reported by the mapper, but got %q", got.Status.Devices[1].Reason)
+	}
+
+	// the device of the second batch does not report before the timeout
+	uc.now = func() time.Time { return start.Add(time.Hour) }
+	got, requeueAfter = syncTestJob(t, uc, cs, job.Name)
+	if got.Status.State != operationsv1alpha1.DeviceUpgradeJobFailed || got.Status.Succeeded != 1 || got.Status.Failed != 2 {
+		t.Errorf("expected the job to fail beyond the failure tolerance, but got %+v", got.Status)
+	}
+	if requeueAfter != 0 {
+		t.Errorf("expected the finished job not to be synced again, but got %v", requeueAfter)
+	}
+}
+
+func TestUpgradeJobStopsAtFailure(t *testing.T) {
+	job := &operationsv1alpha1.DeviceUpgradeJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "upgrade", Namespace: testNamespace},
+		Spec: operationsv1alpha1.DeviceUpgradeJobSpec{
+			Firmware:    testFirmware,
+			DeviceNames: []string{"missing", "meter"},
+		},
+	}
+	uc, cs, factory := newTestUpgradeJobController(t, job, newTestDevice("meter", nil))
+	start := time.Now().Truncate(time.Second)
+	uc.now = func() time.Time { return start }
+
+	// the devices are upgraded in the order of their names, the missing device fails to be patched
+	got, _ := syncTestJob(t, uc, cs, job.Name)
+	expectDeviceStates(t, got, map[string]operationsv1alpha1.DeviceUpgradeState{
+		"meter":   operationsv1alpha1.DeviceUpgradeUpgrading,
+		"missing": operationsv1alpha1.DeviceUpgradePending,
+	})
+
+	// the stale failure of an earlier upgrade is ignored
+	reportFirmware(t, factory, "meter", &v1beta1.DeviceFirmwareStatus{Version: "v1.0.0", DesiredVersion: "v2.0.0",
+		State: v1beta1.FirmwareStateFailed, LastTransitionTime: metav1.NewTime(start.Add(-time.Hour))})
+	got, _ = syncTestJob(t, uc, cs, job.Name)
+	expectDeviceStates(t, got, map[string]operationsv1alpha1.DeviceUpgradeState{
+		"meter":   operationsv1alpha1.DeviceUpgradeUpgrading,
+		"missing": operationsv1alpha1.DeviceUpgradePending,
+	})
+
+	reportFirmware(t, factory, "meter", &v1beta1.DeviceFirmwareStatus{Version: "v2.0.0", DesiredVersion: "v2.0.0",
+		State: v1beta1.FirmwareStateSucceeded, LastTransitionTime: metav1.NewTime(start)})
+	got, _ = syncTestJob(t, uc, cs, job.Name)
+	expectDeviceStates(t, got, map[string]operationsv1alpha1.DeviceUpgradeState{
+		"meter":   operationsv1alpha1.DeviceUpgradeSucceeded,
+		"missing": operationsv1alpha1.DeviceUpgradeFailed,
+	})
+
+	got, _ = syncTestJob(t, uc, cs, job.Name)
+	if got.Status.State != operationsv1alpha1.DeviceUpgradeJobFailed {
+		t.Errorf("expected the job to fail without failure tolerance, but got %+v", got.Status)
+	}
+}
@@ -19,8 +19,10 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/klog/v2"
 
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
@@ -41,6 +43,13 @@ type DeviceStatus struct {
 	Status v1beta1.DeviceStatus `json:"status"`
 }
 
+// DeviceFirmwareStatus is structure to patch the firmware state of device status
+type DeviceFirmwareStatus struct {
+	Status struct {
+		Firmware *v1beta1.DeviceFirmwareStatus `json:"firmware"`
+	} `json:"status"`
+}
+
 const (
 	// MergePatchType is patch type
 	MergePatchType = "application/merge-patch+json"
@@ -53,7 +62,8 @@ type UpstreamController struct {
 	crdClient    crdClientset.Interface
 	messageLayer messagelayer.MessageLayer
 	// message channel
-	deviceStatusChan chan model.Message
+	deviceStatusChan   chan model.Message
+	deviceFirmwareChan chan model.Message
 
 	// downstream controller to update device status in cache
 	dc *DownstreamController
@@ -64,10 +74,12 @@ func (uc *UpstreamController) Start() error {
 	klog.Info("Start upstream devicecontroller")
 
 	uc.deviceStatusChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStatus)
+	uc.deviceFirmwareChan = make(chan model.Message, config.Config.Buffer.UpdateDeviceStatus)
 	go uc.dispatchMessage()
 
 	for i := 0; i < int(config.Config.Load.UpdateDeviceStatusWorkers); i++ {
 		go uc.updateDeviceStatus()
+		go uc.updateDeviceFirmware()
 	}
 	return nil
 }
@@ -98,6 +110,8 @@ func (uc *UpstreamController) dispatchMessage() {
 		switch resourceType {
 		case constants.ResourceTypeTwinEdgeUpdated:
 			uc.deviceStatusChan <- msg
+		case constants.ResourceTypeFirmwareEdgeUpdated:
+			uc.deviceFirmwareChan <- msg
 		case constants.ResourceTypeMembershipDetail:
 		default:
 			klog.Warningf("Message: %s, with resource type: %s not intended for device controller", msg.GetID(), resourceType)
@@ -203,6 +217,66 @@ func (uc *UpstreamController) updateDeviceStatus() {
 	}
 }
 
+func (uc *UpstreamController) updateDeviceFirmware() {
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Info("Stop updateDeviceFirmware")
+			return
+		case msg := <-uc.deviceFirmwareChan:
+			klog.V(4).Infof("Message: %s, operation is: %s, and resource is: %s", msg.GetID(), msg.GetOperation(), msg.GetResource())
+			if err := uc.patchDeviceFirmware(msg); err != nil {
+				klog.Warningf("Message: %s process failure, %v", msg.GetID(), err)
+			}
+		}
+	}
+}
+
+// patchDeviceFirmware patches the firmware state reported by the mapper to the device status.
+// The mapper reports the state again with every twin report, so only the changes are patched.
+func (uc *UpstreamController) patchDeviceFirmware(msg model.Message) error {
+	contentData, err := msg.GetContentData()
+	if err != nil {
+		return fmt.Errorf("failed to get content data, err: %v", err)
+	}
+	firmware := &v1beta1.DeviceFirmwareStatus{}
+	if err := json.Unmarshal(contentData, firmware); err != nil {
+		return fmt.Errorf("failed to unmarshal firmware state, err: %v", err)
+	}
+	deviceID, err := messagelayer.GetDeviceID(msg.GetResource())
+	if err != nil {
+		return fmt.Errorf("failed to get device id, err: %v", err)
+	}
+	device, ok := uc.dc.deviceManager.Device.Load(deviceID)
+	if !ok {
+		return fmt.Errorf("device %s does not exist in downstream controller", deviceID)
+	}
+	cacheDevice, ok := device.(*v1beta1.Device)
+	if !ok {
+		return fmt.Errorf("failed to assert to CacheDevice type")
+	}
+	if cacheDevice.Status.Firmware != nil && apiequality.Semantic.DeepEqual(cacheDevice.Status.Firmware, firmware) {
+		return nil
+	}
+
+	patch := DeviceFirmwareStatus{}
+	patch.Status.Firmware = firmware
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal firmware state, err: %v", err)
+	}
+	err = uc.crdClient.DevicesV1beta1().RESTClient().Patch(MergePatchType).Namespace(cacheDevice.Namespace).Resource(ResourceTypeDevices).Name(cacheDevice.Name).Body(body).Do(context.Background()).Error()
+	if err != nil {
+		return fmt.Errorf("failed to patch firmware state of device %s, err: %v", deviceID, err)
+	}
+
+	// Store the status in cache so that the same state reported again is not patched
+	cacheDevice.Status.Firmware = firmware
+	uc.dc.deviceManager.Device.Store(deviceID, cacheDevice)
+	klog.V(4).Infof("Message: %s process successfully", msg.GetID())
+	return nil
+}
+
 func (uc *UpstreamController) unmarshalDeviceStatusMessage(msg model.Message) (*types.DeviceTwinUpdate, error) {
 	contentData, err := msg.GetContentData()
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 type DeviceController struct {
 	downstream *controller.DownstreamController
 	upstream   *controller.UpstreamController
+	upgradeJob *controller.UpgradeJobController
 	enable     bool
 }
 
@@ -34,9 +35,14 @@ func newDeviceController(enable bool) *DeviceController {
 	if err != nil {
 		klog.Exitf("New upstream controller failed with error: %s", err)
 	}
+	upgradeJob, err := controller.NewUpgradeJobController(informers.GetInformersManager().GetKubeEdgeInformerFactory())
+	if err != nil {
+		klog.Exitf("New device upgrade job controller failed with error: %s", err)
+	}
 	return &DeviceController{
 		downstream: downstream,
 		upstream:   upstream,
+		upgradeJob: upgradeJob,
 		enable:     enable,
 	}
 }
@@ -72,4 +78,7 @@ func (dc *DeviceController) Start() {
 	if err := dc.upstream.Start(); err != nil {
 		klog.Exitf("Start upstream failed with error: %s", err)
 	}
+	if err := dc.upgradeJob.Start(); err != nil {
+		klog.Exitf("Start device upgrade job controller failed with error: %s", err)
+	}
 }
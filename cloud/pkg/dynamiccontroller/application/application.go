@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/kubernetes"
@@ -29,14 +30,17 @@ type Center struct {
 	messageLayer  messagelayer.MessageLayer
 	dynamicClient dynamic.Interface
 	kubeClient    kubernetes.Interface
+	// policies restrict the resources edge nodes can get, list and watch
+	policies ResourcePolicies
 }
 
-func NewApplicationCenter(dynamicSharedInformerFactory dynamicinformer.DynamicSharedInformerFactory) *Center {
+func NewApplicationCenter(dynamicSharedInformerFactory dynamicinformer.DynamicSharedInformerFactory, policies ResourcePolicies) *Center {
 	a := &Center{
 		HandlerCenter: NewHandlerCenter(dynamicSharedInformerFactory),
 		dynamicClient: client.GetDynamicClient(),
 		kubeClient:    client.GetKubeClient(),
 		messageLayer:  messagelayer.DynamicControllerMessageLayer(),
+		policies:      policies,
 	}
 	return a
 }
@@ -95,13 +99,14 @@ func (c *Center) ProcessApplication(app *metaserver.Application) (interface{}, e
 		if err := app.OptionTo(option); err != nil {
 			return nil, err
 		}
-		list, err := c.dynamicClient.Resource(gvr).Namespace(ns).List(context.TODO(), *option)
+		policy, err := c.policies.authorize(gvr, ns, name)
 		if err != nil {
-			return nil, fmt.Errorf("get current list error: %v", err)
+			return nil, err
 		}
-		return list, nil
+		policy.pushDown(option)
+		return c.list(gvr, policy.listNamespaces(ns), *option)
 	case metaserver.Watch:
-		listener, err := applicationToListener(app)
+		listener, err := c.applicationToListener(app)
 		if err != nil {
 			return nil, err
 		}
@@ -115,10 +120,17 @@ func (c *Center) ProcessApplication(app *metaserver.Application) (interface{}, e
 		if err := app.OptionTo(option); err != nil {
 			return nil, err
 		}
+		policy, err := c.policies.authorize(gvr, ns, name)
+		if err != nil {
+			return nil, err
+		}
 		retObj, err := c.dynamicClient.Resource(gvr).Namespace(ns).Get(context.TODO(), name, *option)
 		if err != nil {
 			return nil, err
 		}
+		if !policy.allows(retObj) {
+			return nil, apierrors.NewForbidden(gvr.GroupResource(), name, fmt.Errorf("the object is not allowed by the resource policy"))
+		}
 		return retObj, nil
 	case metaserver.Create:
 		var option = new(metav1.CreateOptions)
@@ -198,6 +210,28 @@ func (c *Center) ProcessApplication(app *metaserver.Application) (interface{}, e
 	}
 }
 
+// list lists the objects of gvr in all the namespaces, the lists of multiple namespaces are merged
+func (c *Center) list(gvr schema.GroupVersionResource, namespaces []string, option metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	var res *unstructured.UnstructuredList
+	for _, ns := range namespaces {
+		list, err := c.dynamicClient.Resource(gvr).Namespace(ns).List(context.TODO(), option)
+		if err != nil {
+			return nil, fmt.Errorf("get current list error: %v", err)
+		}
+		if res == nil {
+			res = list
+			continue
+		}
+		res.Items = append(res.Items, list.Items...)
+	}
+	if len(namespaces) > 1 {
+		// the merged list has no consistent resourceVersion to continue from
+		res.SetResourceVersion("")
+		res.SetContinue("")
+	}
+	return res, nil
+}
+
 func (c *Center) passThroughRequest(app *metaserver.Application) (interface{}, error) {
 	kubeClient, ok := c.kubeClient.(*kubernetes.Clientset)
 	if !ok {
@@ -315,7 +349,7 @@ func (c *Center) getWatchDiff(allWatchAppInEdge map[string]metaserver.Applicatio
 
 func (c *Center) processWatchApp(watchApp *metaserver.Application) error {
 	watchApp.Status = metaserver.InProcessing
-	listener, err := applicationToListener(watchApp)
+	listener, err := c.applicationToListener(watchApp)
 	if err != nil {
 		return err
 	}
@@ -327,17 +361,23 @@ func (c *Center) processWatchApp(watchApp *metaserver.Application) error {
 	return nil
 }
 
-func applicationToListener(app *metaserver.Application) (*SelectorListener, error) {
+func (c *Center) applicationToListener(app *metaserver.Application) (*SelectorListener, error) {
 	var option = new(metav1.ListOptions)
 	if err := app.OptionTo(option); err != nil {
 		return nil, err
 	}
 
-	gvr, namespace, _ := metaserver.ParseKey(app.Key)
+	gvr, namespace, name := metaserver.ParseKey(app.Key)
+	policy, err := c.policies.authorize(gvr, namespace, name)
+	if err != nil {
+		return nil, err
+	}
 	selector := NewSelector(option.LabelSelector, option.FieldSelector)
 	if namespace != "" {
 		selector.Field = fields.AndSelectors(selector.Field, fields.OneTermEqualSelector("metadata.namespace", namespace))
 	}
 
-	return NewSelectorListener(app.ID, app.Nodename, gvr, selector), nil
+	listener := NewSelectorListener(app.ID, app.Nodename, gvr, selector)
+	listener.policy = policy
+	return listener, nil
 }
@@ -24,6 +24,8 @@ type SelectorListener struct {
 	gvr      schema.GroupVersionResource
 	// e.g. labels and fields(metadata.namespace metadata.name spec.nodename)
 	selector LabelFieldSelector
+	// policy restricts the objects sent to the node, nil if all objects are allowed
+	policy *resourcePolicy
 }
 
 func NewSelectorListener(ID, nodeName string, gvr schema.GroupVersionResource, selector LabelFieldSelector) *SelectorListener {
@@ -48,7 +50,7 @@ func (l *SelectorListener) sendObj(event watch.Event, messageLayer messagelayer.
 	}
 	klog.V(4).Infof("[dynamiccontroller/selectorListener] listener(%v) is sending obj %v", *l, accessor.GetName())
 	// do not send obj if obj does not match listener's selector
-	if !l.selector.MatchObj(event.Object) {
+	if !l.selector.MatchObj(event.Object) || !l.policy.allows(event.Object) {
 		return
 	}
 	// filter message
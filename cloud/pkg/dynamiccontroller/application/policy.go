@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	configv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+)
+
+// ResourcePolicies are the resources edge nodes can read through the dynamicController,
+// nil or empty ResourcePolicies allow all resources.
+type ResourcePolicies []*resourcePolicy
+
+// resourcePolicy restricts the objects of a resource edge nodes can read
+type resourcePolicy struct {
+	group    string
+	version  string
+	resource string
+	// namespaces the objects can be read from, all namespaces if empty
+	namespaces    sets.String
+	labelSelector string
+	fieldSelector string
+	labels        labels.Selector
+	fields        fields.Selector
+}
+
+// NewResourcePolicies parses the resource policies of the dynamicController config
+func NewResourcePolicies(policies []configv1alpha1.DynamicResourcePolicy) (ResourcePolicies, error) {
+	res := make(ResourcePolicies, 0, len(policies))
+	for _, policy := range policies {
+		labelSelector, err := labels.Parse(policy.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector of resource %s, err: %v", policy.Resource, err)
+		}
+		fieldSelector, err := fields.ParseSelector(policy.FieldSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field selector of resource %s, err: %v", policy.Resource, err)
+		}
+		res = append(res, &resourcePolicy{
+			group:         policy.Group,
+			version:       policy.Version,
+			resource:      policy.Resource,
+			namespaces:    sets.NewString(policy.Namespaces...),
+			labelSelector: policy.LabelSelector,
+			fieldSelector: policy.FieldSelector,
+			labels:        labelSelector,
+			fields:        fieldSelector,
+		})
+	}
+	return res, nil
+}
+
+// authorize returns the policy of gvr, or a forbidden error if edge nodes cannot read it
+// from namespace. The policy is nil if all resources are allowed.
+func (p ResourcePolicies) authorize(gvr schema.GroupVersionResource, namespace, name string) (*resourcePolicy, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	for _, policy := range p {
+		if policy.group != gvr.Group || policy.resource != gvr.Resource ||
+			(policy.version != "" && policy.version != gvr.Version) {
+			continue
+		}
+		if namespace != "" && policy.namespaces.Len() != 0 && !policy.namespaces.Has(namespace) {
+			return nil, apierrors.NewForbidden(gvr.GroupResource(), name,
+				fmt.Errorf("namespace %s is not allowed by the resource policy", namespace))
+		}
+		return policy, nil
+	}
+	return nil, apierrors.NewForbidden(gvr.GroupResource(), name,
+		fmt.Errorf("resource %s is not allowed by the resource policies", gvr.String()))
+}
+
+// listNamespaces returns the namespaces to list, namespace itself unless all namespaces
+// are requested and the policy restricts them
+func (p *resourcePolicy) listNamespaces(namespace string) []string {
+	if p == nil || namespace != "" || p.namespaces.Len() == 0 {
+		return []string{namespace}
+	}
+	return p.namespaces.List()
+}
+
+// pushDown adds the selectors of the policy to option, so that apiserver only returns the allowed objects
+func (p *resourcePolicy) pushDown(option *metav1.ListOptions) {
+	if p == nil {
+		return
+	}
+	option.LabelSelector = joinSelectors(option.LabelSelector, p.labelSelector)
+	option.FieldSelector = joinSelectors(option.FieldSelector, p.fieldSelector)
+}
+
+// allows reports whether the policy allows edge nodes to read obj
+func (p *resourcePolicy) allows(obj runtime.Object) bool {
+	if p == nil {
+		return true
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	if p.namespaces.Len() != 0 && accessor.GetNamespace() != "" && !p.namespaces.Has(accessor.GetNamespace()) {
+		return false
+	}
+	if !p.labels.Matches(labels.Set(accessor.GetLabels())) {
+		return false
+	}
+	if p.fields.Empty() {
+		return true
+	}
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	return p.fields.Matches(objectFields(unstrObj, p.fields))
+}
+
+// objectFields returns the values of the fields used by selector, like "spec.nodeName"
+func objectFields(obj *unstructured.Unstructured, selector fields.Selector) fields.Set {
+	set := fields.Set{}
+	for _, requirement := range selector.Requirements() {
+		value, found, err := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(requirement.Field, ".")...)
+		if !found || err != nil || value == nil {
+			continue
+		}
+		set[requirement.Field] = fmt.Sprint(value)
+	}
+	return set
+}
+
+func joinSelectors(selectors ...string) string {
+	var res []string
+	for _, selector := range selectors {
+		if selector != "" {
+			res = append(res, selector)
+		}
+	}
+	return strings.Join(res, ",")
+}
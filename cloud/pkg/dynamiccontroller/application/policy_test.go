@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
+)
+
+var (
+	configMapsGVR   = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	secretsGVR      = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+	deploymentsGVR  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	deploymentsBeta = schema.GroupVersionResource{Group: "apps", Version: "v1beta1", Resource: "deployments"}
+)
+
+func newTestPolicies(t *testing.T) ResourcePolicies {
+	policies, err := NewResourcePolicies([]configv1alpha1.DynamicResourcePolicy{
+		{
+			Resource:      "configmaps",
+			Namespaces:    []string{"edge-b", "edge-a"},
+			LabelSelector: "kubeedge.io/edge-visible=true",
+			FieldSelector: "data.mode!=debug",
+		},
+		{Group: "apps", Version: "v1", Resource: "deployments"},
+	})
+	if err != nil {
+		t.Fatalf("failed to parse resource policies: %v", err)
+	}
+	return policies
+}
+
+func TestResourcePoliciesAuthorize(t *testing.T) {
+	policies := newTestPolicies(t)
+	tests := []struct {
+		name      string
+		policies  ResourcePolicies
+		gvr       schema.GroupVersionResource
+		namespace string
+		wantErr   bool
+	}{
+		{name: "no policies", gvr: secretsGVR, namespace: "default"},
+		{name: "allowed namespace", policies: policies, gvr: configMapsGVR, namespace: "edge-a"},
+		{name: "all namespaces", policies: policies, gvr: configMapsGVR},
+		{name: "namespace not allowed", policies: policies, gvr: configMapsGVR, namespace: "default", wantErr: true},
+		{name: "resource not allowed", policies: policies, gvr: secretsGVR, namespace: "edge-a", wantErr: true},
+		{name: "allowed version", policies: policies, gvr: deploymentsGVR, namespace: "default"},
+		{name: "version not allowed", policies: policies, gvr: deploymentsBeta, namespace: "default", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.policies.authorize(tt.gvr, tt.namespace, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authorize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !apierrors.IsForbidden(err) {
+				t.Errorf("expected a forbidden error, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyPushDown(t *testing.T) {
+	policy, err := newTestPolicies(t).authorize(configMapsGVR, "", "")
+	if err != nil {
+		t.Fatalf("failed to authorize: %v", err)
+	}
+	option := metav1.ListOptions{LabelSelector: "app=demo"}
+	policy.pushDown(&option)
+	expected := metav1.ListOptions{LabelSelector: "app=demo,kubeedge.io/edge-visible=true", FieldSelector: "data.mode!=debug"}
+	if !reflect.DeepEqual(option, expected) {
+		t.Errorf("expected list options %v, but got %v", expected, option)
+	}
+	if namespaces := policy.listNamespaces(""); !reflect.DeepEqual(namespaces, []string{"edge-a", "edge-b"}) {
+		t.Errorf("expected to list the allowed namespaces, but got %v", namespaces)
+	}
+	if namespaces := policy.listNamespaces("edge-b"); !reflect.DeepEqual(namespaces, []string{"edge-b"}) {
+		t.Errorf("expected to list the requested namespace, but got %v", namespaces)
+	}
+}
+
+func TestResourcePolicyAllows(t *testing.T) {
+	policy, err := newTestPolicies(t).authorize(configMapsGVR, "", "")
+	if err != nil {
+		t.Fatalf("failed to authorize: %v", err)
+	}
+	newConfigMap := func(namespace string, labels map[string]interface{}, mode string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "demo", "namespace": namespace, "labels": labels},
+			"data":       map[string]interface{}{"mode": mode},
+		}}
+	}
+	visible := map[string]interface{}{"kubeedge.io/edge-visible": "true"}
+	tests := []struct {
+		name string
+		obj  *unstructured.Unstructured
+		want bool
+	}{
+		{name: "allowed", obj: newConfigMap("edge-a", visible, "release"), want: true},
+		{name: "namespace not allowed", obj: newConfigMap("default", visible, "release")},
+		{name: "labels not matched", obj: newConfigMap("edge-a", nil, "release")},
+		{name: "fields not matched", obj: newConfigMap("edge-a", visible, "debug")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.allows(tt.obj); got != tt.want {
+				t.Errorf("allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	var unrestricted *resourcePolicy
+	if !unrestricted.allows(newConfigMap("default", nil, "debug")) {
+		t.Errorf("expected nil policy to allow all objects")
+	}
+}
@@ -48,7 +48,7 @@ var (
 
 func Register(dc *configv1alpha1.DynamicController) {
 	config.InitConfigure(dc)
-	dynamicController = newDynamicController(dc.Enable)
+	dynamicController = newDynamicController(dc)
 	core.Register(dynamicController)
 }
 
@@ -81,13 +81,17 @@ func (dctl *DynamicController) Start() {
 	go dctl.receiveMessage()
 }
 
-func newDynamicController(enable bool) *DynamicController {
+func newDynamicController(dc *configv1alpha1.DynamicController) *DynamicController {
 	var dctl = &DynamicController{
-		enable:                       enable,
+		enable:                       dc.Enable,
 		messageLayer:                 messagelayer.DynamicControllerMessageLayer(),
 		dynamicSharedInformerFactory: informers.GetInformersManager().GetDynamicInformerFactory(),
 	}
-	dctl.applicationCenter = application.NewApplicationCenter(dctl.dynamicSharedInformerFactory)
+	policies, err := application.NewResourcePolicies(dc.ResourcePolicies)
+	if err != nil {
+		klog.Exitf("Failed to parse resource policies of dynamicController: %v", err)
+	}
+	dctl.applicationCenter = application.NewApplicationCenter(dctl.dynamicSharedInformerFactory, policies)
 	dctl.applicationCenter.ForResource(v1.SchemeGroupVersion.WithResource("nodes"))
 	dctl.applicationCenter.ForResource(v1.SchemeGroupVersion.WithResource("services"))
 	return dctl
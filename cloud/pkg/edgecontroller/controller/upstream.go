@@ -63,6 +63,7 @@ import (
 	rulesv1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
 	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
 	"github.com/kubeedge/kubeedge/pkg/metaserver/util"
+	nodeutil "github.com/kubeedge/kubeedge/pkg/util/node"
 )
 
 // SortedContainerStatuses define A type to help sort container statuses based on container names.
@@ -497,8 +498,16 @@ func (uc *UpstreamController) updateNodeStatus() {
 
 			switch msg.GetOperation() {
 			case model.InsertOperation:
-				_, err := uc.kubeClient.CoreV1().Nodes().Get(context.Background(), name, metaV1.GetOptions{})
+				existingNode, err := uc.kubeClient.CoreV1().Nodes().Get(context.Background(), name, metaV1.GetOptions{})
 				if err == nil {
+					// the node joins again after it is reset by keadm
+					if nodeutil.IsDeregistered(existingNode) {
+						if _, err := nodeutil.Reregister(context.Background(), uc.kubeClient, name); err != nil {
+							klog.Warningf("failed to remove the deregistered taint of node %s, err: %v", name, err)
+						} else {
+							klog.Infof("node: %s joins again, the deregistered taint is removed", name)
+						}
+					}
 					klog.Infof("node: %s already exists, do nothing", name)
 					uc.nodeMsgResponse(name, namespace, common.MessageSuccessfulContent, msg)
 					continue
@@ -1,13 +1,54 @@
 package constants
 
 const (
-	RestProvider       string = "rest"
-	EventbusProvider   string = "eventbus"
-	GroupResource      string = "resource"
-	ServicebusProvider string = "servicebus"
-	TargetURL          string = "target_url"
-	NodeName           string = "node_name"
-	Topic              string = "topic"
-	Path               string = "path"
-	Resource           string = "resource"
+	RestProvider            string = "rest"
+	EventbusProvider        string = "eventbus"
+	GroupResource           string = "resource"
+	ServicebusProvider      string = "servicebus"
+	WebhookProvider         string = "webhook"
+	KubernetesEventProvider string = "kubernetesevent"
+	CloudEventsProvider     string = "cloudevents"
+	TargetURL               string = "target_url"
+	NodeName                string = "node_name"
+	Topic                   string = "topic"
+	Path                    string = "path"
+	Resource                string = "resource"
+
+	// target resource attributes of the webhook target
+	Retries         string = "retries"
+	RetryBackoff    string = "retryBackoff"
+	MaxRetryBackoff string = "maxRetryBackoff"
+	Timeout         string = "timeout"
+	DeadLetter      string = "deadLetter"
+	// properties of the webhook ruleendpoint
+	Secret string = "secret"
+	// HMACKey is the key of the HMAC key in the secret of the webhook ruleendpoint
+	HMACKey string = "hmacKey"
+
+	// Extensions is the target resource attribute of the kubernetesevent and cloudevents targets
+	// mapping the message headers to the events, in the format "<extension>=<header>,..."
+	Extensions string = "extensions"
+	// target resource attributes of the kubernetesevent target
+	Reason    string = "reason"
+	EventType string = "eventType"
+	// target resource attributes of the cloudevents target
+	CloudEventType   string = "type"
+	CloudEventSource string = "source"
+	Mode             string = "mode"
+	// properties of the cloudevents ruleendpoint
+	Protocol string = "protocol"
+	Brokers  string = "brokers"
+	TLS      string = "tls"
+	// Username and Password are the keys of the SASL credentials in the secret of the cloudevents ruleendpoint
+	Username string = "username"
+	Password string = "password"
+
+	// headers of the messages from the eventbus source
+	HeaderMessageID string = "messageID"
+	HeaderTimestamp string = "timestamp"
+	HeaderNodeName  string = "nodeName"
+	HeaderNamespace string = "namespace"
+	HeaderTopic     string = "topic"
+	HeaderResource  string = "resource"
+	HeaderOperation string = "operation"
 )
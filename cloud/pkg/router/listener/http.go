@@ -9,10 +9,14 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc/codes"
 	"k8s.io/klog/v2"
 
 	routerConfig "github.com/kubeedge/kubeedge/cloud/pkg/router/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/router/utils"
+	httpUtils "github.com/kubeedge/kubeedge/cloud/pkg/router/utils/http"
 )
 
 const MaxMessageBytes = 12 * (1 << 20)
@@ -47,8 +51,9 @@ func (rh *RestHandler) Serve() {
 	mux.HandleFunc("/", rh.httpHandler)
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf("%s:%d", rh.bindAddress, rh.port),
-		Handler: mux,
+		Addr: fmt.Sprintf("%s:%d", rh.bindAddress, rh.port),
+		// serve HTTP/2 without TLS as well, which the gRPC calls are proxied over
+		Handler: h2c.NewHandler(mux, &http2.Server{}),
 		// TODO: add tls for router
 	}
 	klog.Infof("router server listening in %d...", rh.port)
@@ -98,6 +103,10 @@ func (rh *RestHandler) matchedPath(uri string) (string, bool) {
 }
 
 func (rh *RestHandler) httpHandler(w http.ResponseWriter, r *http.Request) {
+	if httpUtils.IsGRPCRequest(r) {
+		rh.grpcHandler(w, r)
+		return
+	}
 	uriSections := strings.Split(r.RequestURI, "/")
 	if len(uriSections) < 2 {
 		// URL format incorrect
@@ -180,6 +189,47 @@ func (rh *RestHandler) httpHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// grpcHandler proxies a gRPC call by the rule of the rest source named by the metadata of the call,
+// the handle streams the response itself, since the body of the call is not read beforehand
+func (rh *RestHandler) grpcHandler(w http.ResponseWriter, r *http.Request) {
+	uri, err := httpUtils.GRPCRequestURI(r)
+	if err != nil {
+		klog.Warningf("invalid gRPC call %s: %v", r.URL.Path, err)
+		httpUtils.WriteGRPCStatus(w, false, codes.InvalidArgument, err.Error())
+		return
+	}
+	matchPath, exist := rh.matchedPath(uri)
+	if !exist {
+		klog.Warningf("No matched rule for gRPC call: %s", uri)
+		httpUtils.WriteGRPCStatus(w, false, codes.Unimplemented, "No rule match")
+		return
+	}
+	v, ok := rh.handlers.Load(matchPath)
+	if !ok {
+		httpUtils.WriteGRPCStatus(w, false, codes.Unimplemented, "No rule match")
+		return
+	}
+	handle, ok := v.(Handle)
+	if !ok {
+		klog.Errorf("invalid convert to Handle. match path: %s", matchPath)
+		httpUtils.WriteGRPCStatus(w, false, codes.Internal, "invalid handler")
+		return
+	}
+
+	params := make(map[string]interface{})
+	msgID := uuid.New().String()
+	params["messageID"] = msgID
+	params["request"] = r
+	params["uri"] = uri
+	params["writer"] = w
+	params["timeout"] = rh.restTimeout
+	if _, err := handle(params); err != nil {
+		klog.Errorf("handle gRPC call error, msg id: %s, err: %v", msgID, err)
+		return
+	}
+	klog.Infof("gRPC call %s is proxied, msg id: %s", uri, msgID)
+}
+
 func (rh *RestHandler) IsMatch(key interface{}, message interface{}) bool {
 	res, ok := key.(string)
 	if !ok {
@@ -105,6 +105,14 @@ func (mh *MessageHandler) SetCallback(messageID string, callback func(message *m
 	mh.callbackHandlers.Store(messageID, callback)
 }
 
+// streamCallback is the callback of all the messages responding to a message, like the frames of a gRPC call
+type streamCallback func(message *model.Message)
+
+// SetStreamCallback sets the callback of all the messages responding to messageID, until DelCallback is called
+func (mh *MessageHandler) SetStreamCallback(messageID string, callback func(message *model.Message)) {
+	mh.callbackHandlers.Store(messageID, streamCallback(callback))
+}
+
 func (mh *MessageHandler) DelCallback(messageID string) {
 	mh.callbackHandlers.Delete(messageID)
 }
@@ -112,6 +120,10 @@ func (mh *MessageHandler) DelCallback(messageID string) {
 func (mh *MessageHandler) callback(message *model.Message) {
 	pID := message.GetParentID()
 	v, exist := mh.callbackHandlers.Load(pID)
+	if stream, ok := v.(streamCallback); ok {
+		stream(message)
+		return
+	}
 	if exist {
 		callback, ok := v.(func(message *model.Message))
 		if !ok {
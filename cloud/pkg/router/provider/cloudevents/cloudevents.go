@@ -0,0 +1,240 @@
+package cloudevents
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+const (
+	// ProtocolHTTP sends the events with the http protocol binding
+	ProtocolHTTP = "http"
+	// ProtocolKafka sends the events with the kafka protocol binding
+	ProtocolKafka = "kafka"
+
+	// ModeBinary carries the message as the event data and the attributes in the headers
+	ModeBinary = "binary"
+	// ModeStructured carries the whole event in the json event format
+	ModeStructured = "structured"
+
+	// DefaultType is the type of the events if the rule does not set one
+	DefaultType = "io.kubeedge.edge.message"
+
+	specVersion            = "1.0"
+	defaultRetries         = 3
+	defaultRetryBackoff    = time.Second
+	defaultMaxRetryBackoff = 10 * time.Second
+	defaultTimeout         = 10 * time.Second
+)
+
+// extensionName is the naming of the extension attributes by the spec
+var extensionName = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// reservedAttributes are the context attributes of the spec, which the extensions must not override
+var reservedAttributes = map[string]bool{
+	"specversion": true, "id": true, "source": true, "type": true, "subject": true, "time": true,
+	"datacontenttype": true, "dataschema": true, "data": true, "data_base64": true,
+}
+
+type cloudEventsFactory struct {
+}
+
+// Target sends the messages to an event sink as CloudEvents, over http or to a kafka topic.
+// The event is identified by the message id, its source is the edge node and its subject
+// is the topic of the message. The mapped message headers are sent as extension attributes.
+type Target struct {
+	Namespace string
+	// RuleEndpoint is the name of the target ruleendpoint
+	RuleEndpoint string
+	// Resource is the url of the event sink for http, or the topic for kafka
+	Resource string
+	Type     string
+	// Source overrides the source of the events, which is /kubeedge/nodes/<nodeName> by default
+	Source     string
+	Mode       string
+	Extensions map[string]string
+	Retries    int
+	Timeout    time.Duration
+
+	sender sender
+}
+
+// event is a CloudEvent built from a message
+type event struct {
+	ID              string
+	Source          string
+	Type            string
+	Subject         string
+	Time            time.Time
+	DataContentType string
+	Data            []byte
+	Extensions      map[string]string
+	// PartitionKey is the node the message comes from, which keeps the events of a node in order on kafka
+	PartitionKey string
+}
+
+// sender sends the events with a protocol binding
+type sender interface {
+	send(ctx context.Context, e *event) error
+	// close closes the connections to the event sink
+	close() error
+}
+
+func init() {
+	provider.RegisterTarget(&cloudEventsFactory{})
+}
+
+func (*cloudEventsFactory) Type() v1.RuleEndpointTypeDef {
+	return v1.RuleEndpointTypeCloudEvents
+}
+
+func (*cloudEventsFactory) GetTarget(ep *v1.RuleEndpoint, targetResource map[string]string) provider.Target {
+	target, err := NewTarget(ep, targetResource)
+	if err != nil {
+		klog.Errorf("create cloudevents target of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	switch protocol := ep.Spec.Properties[constants.Protocol]; protocol {
+	case "", ProtocolHTTP:
+		target.sender, err = newHTTPSender(target)
+	case ProtocolKafka:
+		target.sender, err = newKafkaSender(ep, target)
+	default:
+		err = fmt.Errorf("unsupported protocol %q", protocol)
+	}
+	if err != nil {
+		klog.Errorf("create cloudevents target of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	return target
+}
+
+// NewTarget creates the cloudevents target from the target resource of a rule
+func NewTarget(ep *v1.RuleEndpoint, targetResource map[string]string) (*Target, error) {
+	resource, exist := targetResource[constants.Resource]
+	if !exist || resource == "" {
+		return nil, fmt.Errorf("target resource attributes \"resource\" does not exist")
+	}
+	t := &Target{
+		Namespace:    ep.Namespace,
+		RuleEndpoint: ep.Name,
+		Resource:     resource,
+		Type:         DefaultType,
+		Source:       targetResource[constants.CloudEventSource],
+		Mode:         ModeBinary,
+		Retries:      defaultRetries,
+		Timeout:      defaultTimeout,
+	}
+	if v, exist := targetResource[constants.CloudEventType]; exist && v != "" {
+		t.Type = v
+	}
+	if v, exist := targetResource[constants.Mode]; exist {
+		if v != ModeBinary && v != ModeStructured {
+			return nil, fmt.Errorf("invalid %s %q, it must be %s or %s", constants.Mode, v, ModeBinary, ModeStructured)
+		}
+		t.Mode = v
+	}
+	var err error
+	if v, exist := targetResource[constants.Retries]; exist {
+		if t.Retries, err = strconv.Atoi(v); err != nil || t.Retries < 0 {
+			return nil, fmt.Errorf("invalid %s %q, it must be a non-negative integer", constants.Retries, v)
+		}
+	}
+	if v, exist := targetResource[constants.Timeout]; exist {
+		if t.Timeout, err = time.ParseDuration(v); err != nil || t.Timeout <= 0 {
+			return nil, fmt.Errorf("invalid %s %q, it must be a positive duration", constants.Timeout, v)
+		}
+	}
+	if t.Extensions, err = provider.ParseExtensions(targetResource[constants.Extensions]); err != nil {
+		return nil, err
+	}
+	if err := ValidateExtensions(t.Extensions); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ValidateExtensions validates the names of the extensions parsed by provider.ParseExtensions
+// against the naming of the extension attributes by the spec
+func ValidateExtensions(extensions map[string]string) error {
+	for name := range extensions {
+		if !extensionName.MatchString(name) || reservedAttributes[name] {
+			return fmt.Errorf("invalid extension %q, it must consist of lower-case letters and digits and not be a context attribute", name)
+		}
+	}
+	return nil
+}
+
+func (*Target) Name() string {
+	return constants.CloudEventsProvider
+}
+
+// Close closes the connections to the event sink, it is called once the rule is deleted
+func (t *Target) Close() error {
+	if t.sender == nil {
+		return nil
+	}
+	return t.sender.close()
+}
+
+// GoToTarget sends the message as a CloudEvent, it waits until the sink accepts the event or
+// the retries are exhausted
+func (t *Target) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	content, ok := data["data"].([]byte)
+	if !ok || len(content) == 0 {
+		return nil, fmt.Errorf("input data does not exist valid value \"data\"")
+	}
+	nodeName, _ := data["nodeName"].(string)
+	headers, _ := data["headers"].(map[string]string)
+	e := t.newEvent(content, nodeName, headers, time.Now())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if stop != nil {
+		go func() {
+			select {
+			case <-stop:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	if err := t.sender.send(ctx, e); err != nil {
+		return nil, fmt.Errorf("failed to send event %s to %s, err: %v", e.ID, t.Resource, err)
+	}
+	return nil, nil
+}
+
+func (t *Target) newEvent(content []byte, nodeName string, headers map[string]string, now time.Time) *event {
+	e := &event{
+		ID:              headers[constants.HeaderMessageID],
+		Source:          t.Source,
+		Type:            t.Type,
+		Subject:         strings.TrimPrefix(headers[constants.HeaderTopic], "/"),
+		Time:            now,
+		DataContentType: dataContentType(content),
+		Data:            content,
+		Extensions:      provider.MapExtensions(t.Extensions, headers),
+		PartitionKey:    nodeName,
+	}
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	if e.Source == "" {
+		e.Source = "/kubeedge/nodes/" + nodeName
+	}
+	if ms, err := strconv.ParseInt(headers[constants.HeaderTimestamp], 10, 64); err == nil && ms > 0 {
+		e.Time = time.UnixMilli(ms)
+	}
+	return e
+}
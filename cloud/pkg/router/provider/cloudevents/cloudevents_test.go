@@ -0,0 +1,243 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+func newRuleEndpoint() *v1.RuleEndpoint {
+	return &v1.RuleEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-sink", Namespace: "default"},
+		Spec:       v1.RuleEndpointSpec{RuleEndpointType: v1.RuleEndpointTypeCloudEvents},
+	}
+}
+
+func newTestData() map[string]interface{} {
+	return map[string]interface{}{
+		"data":     []byte(`{"temperature":80}`),
+		"nodeName": "edge-node",
+		"headers": map[string]string{
+			constants.HeaderMessageID: "0b6b8d2c",
+			constants.HeaderTimestamp: "1700000000000",
+			constants.HeaderTopic:     "/alerts",
+			constants.HeaderNodeName:  "edge-node",
+		},
+	}
+}
+
+func TestNewTarget(t *testing.T) {
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: "http://127.0.0.1/events"})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	if target.Type != DefaultType || target.Mode != ModeBinary || target.Retries != defaultRetries || target.Timeout != defaultTimeout {
+		t.Errorf("unexpected defaults of target: %+v", target)
+	}
+
+	cases := []struct {
+		name     string
+		resource map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "all options",
+			resource: map[string]string{constants.Resource: "edge-alerts", constants.CloudEventType: "com.example.alert",
+				constants.CloudEventSource: "/factory/1", constants.Mode: ModeStructured, constants.Retries: "0",
+				constants.Timeout: "1s", constants.Extensions: "edgenode=nodeName"},
+		},
+		{
+			name:     "no resource",
+			resource: map[string]string{},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid mode",
+			resource: map[string]string{constants.Resource: "edge-alerts", constants.Mode: "batch"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid timeout",
+			resource: map[string]string{constants.Resource: "edge-alerts", constants.Timeout: "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "upper-case extension",
+			resource: map[string]string{constants.Resource: "edge-alerts", constants.Extensions: "edgeNode=nodeName"},
+			wantErr:  true,
+		},
+		{
+			name:     "context attribute extension",
+			resource: map[string]string{constants.Resource: "edge-alerts", constants.Extensions: "source=nodeName"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTarget(newRuleEndpoint(), tc.resource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewTarget() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestHTTPBinary(t *testing.T) {
+	var requests int32
+	var header http.Header
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first request fails to test the retry
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		header = r.Header
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: server.URL,
+		constants.Extensions: "edgenode=nodeName,missing=parentID"})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	sender, err := newHTTPSender(target)
+	if err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+	sender.retryBackoff = time.Millisecond
+	target.sender = sender
+
+	if _, err := target.GoToTarget(newTestData(), nil); err != nil {
+		t.Fatalf("failed to send event: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected the event to be sent twice, but got %d requests", requests)
+	}
+	want := map[string]string{
+		"Content-Type":   contentTypeJSON,
+		"Ce-Specversion": "1.0",
+		"Ce-Id":          "0b6b8d2c",
+		"Ce-Source":      "/kubeedge/nodes/edge-node",
+		"Ce-Type":        DefaultType,
+		"Ce-Subject":     "alerts",
+		"Ce-Time":        "2023-11-14T22:13:20Z",
+		"Ce-Edgenode":    "edge-node",
+	}
+	for k, v := range want {
+		if got := header.Get(k); got != v {
+			t.Errorf("expected header %s to be %q, but got %q", k, v, got)
+		}
+	}
+	if header.Get("Ce-Missing") != "" {
+		t.Errorf("expected the extension of the missing header to be skipped")
+	}
+	if string(body) != `{"temperature":80}` {
+		t.Errorf("expected the message as the body, but got %s", body)
+	}
+}
+
+func TestHTTPStructured(t *testing.T) {
+	var contentType string
+	var event map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: server.URL, constants.Mode: ModeStructured})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	if target.sender, err = newHTTPSender(target); err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+	data := newTestData()
+	data["data"] = []byte("not json")
+	if _, err := target.GoToTarget(data, nil); err != nil {
+		t.Fatalf("failed to send event: %v", err)
+	}
+	if contentType != contentTypeStructured {
+		t.Errorf("expected content type %s, but got %s", contentTypeStructured, contentType)
+	}
+	if event["id"] != "0b6b8d2c" || event["datacontenttype"] != contentTypeOctetStream || event["data_base64"] != "bm90IGpzb24=" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+}
+
+func TestHTTPNotRetried(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: server.URL})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	if target.sender, err = newHTTPSender(target); err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+	if _, err := target.GoToTarget(newTestData(), nil); err == nil {
+		t.Errorf("expected the rejected event to fail")
+	}
+	if requests != 1 {
+		t.Errorf("expected the rejected event not to be retried, but got %d requests", requests)
+	}
+}
+
+func TestKafkaMessage(t *testing.T) {
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: "edge-alerts", constants.Extensions: "edgetopic=topic"})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	data := newTestData()
+	e := target.newEvent(data["data"].([]byte), "edge-node", data["headers"].(map[string]string), time.Now())
+
+	msg, err := kafkaMessage(e, ModeBinary)
+	if err != nil {
+		t.Fatalf("failed to build message: %v", err)
+	}
+	headers := make(map[string]string)
+	for _, h := range msg.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	if string(msg.Key) != "edge-node" || string(msg.Value) != `{"temperature":80}` || headers["content-type"] != contentTypeJSON ||
+		headers["ce_id"] != "0b6b8d2c" || headers["ce_specversion"] != "1.0" || headers["ce_edgetopic"] != "/alerts" {
+		t.Errorf("unexpected binary message: key %s, value %s, headers %v", msg.Key, msg.Value, headers)
+	}
+
+	if msg, err = kafkaMessage(e, ModeStructured); err != nil {
+		t.Fatalf("failed to build message: %v", err)
+	}
+	var event map[string]interface{}
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		t.Fatalf("failed to unmarshal structured message: %v", err)
+	}
+	if event["source"] != "/kubeedge/nodes/edge-node" || event["edgetopic"] != "/alerts" ||
+		event["data"].(map[string]interface{})["temperature"] != float64(80) {
+		t.Errorf("unexpected structured message: %s", msg.Value)
+	}
+}
+
+func TestEncodeHeaderValue(t *testing.T) {
+	if got := encodeHeaderValue(`temp 80% "high" é`); got != `temp%2080%25%20%22high%22%20%C3%A9` {
+		t.Errorf("unexpected encoded value %q", got)
+	}
+}
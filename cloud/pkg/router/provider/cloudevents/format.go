@@ -0,0 +1,88 @@
+package cloudevents
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeOctetStream = "application/octet-stream"
+	// contentTypeStructured is the content type of the events in the structured mode
+	contentTypeStructured = "application/cloudevents+json"
+)
+
+// dataContentType returns the content type of the message, the messages from the edge have no
+// content type of their own, so it is json if the message is a json value
+func dataContentType(content []byte) string {
+	if json.Valid(content) {
+		return contentTypeJSON
+	}
+	return contentTypeOctetStream
+}
+
+// attribute is a context attribute or an extension attribute of an event
+type attribute struct {
+	name  string
+	value string
+}
+
+// attributes returns the attributes of the event carried in the headers in the binary mode,
+// except the datacontenttype which is carried as the content type of the message
+func (e *event) attributes() []attribute {
+	attrs := []attribute{
+		{name: "specversion", value: specVersion},
+		{name: "id", value: e.ID},
+		{name: "source", value: e.Source},
+		{name: "type", value: e.Type},
+	}
+	if e.Subject != "" {
+		attrs = append(attrs, attribute{name: "subject", value: e.Subject})
+	}
+	attrs = append(attrs, attribute{name: "time", value: e.Time.UTC().Format(time.RFC3339Nano)})
+
+	names := make([]string, 0, len(e.Extensions))
+	for name := range e.Extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attrs = append(attrs, attribute{name: name, value: e.Extensions[name]})
+	}
+	return attrs
+}
+
+// structured encodes the event in the json event format, the data is embedded as json if it is
+// a json value, or base64 encoded otherwise
+func (e *event) structured() ([]byte, error) {
+	attrs := e.attributes()
+	obj := make(map[string]interface{}, len(attrs)+2)
+	for _, attr := range attrs {
+		obj[attr.name] = attr.value
+	}
+	obj["datacontenttype"] = e.DataContentType
+	if e.DataContentType == contentTypeJSON {
+		obj["data"] = json.RawMessage(e.Data)
+	} else {
+		obj["data_base64"] = base64.StdEncoding.EncodeToString(e.Data)
+	}
+	return json.Marshal(obj)
+}
+
+// encodeHeaderValue percent-encodes the characters of an attribute value which are not allowed
+// in a header value by the http protocol binding
+func encodeHeaderValue(value string) string {
+	var b strings.Builder
+	for _, c := range []byte(value) {
+		if c <= 0x20 || c > 0x7e || c == '"' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// httpSender posts the events to the sink url, it retries with backoff on the network errors,
+// the timeouts, the 429s and the 5xxs
+type httpSender struct {
+	url          string
+	mode         string
+	retries      int
+	retryBackoff time.Duration
+	client       *http.Client
+}
+
+func newHTTPSender(t *Target) (*httpSender, error) {
+	u, err := url.Parse(t.Resource)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("resource %q must be a http or https url", t.Resource)
+	}
+	return &httpSender{
+		url:          t.Resource,
+		mode:         t.Mode,
+		retries:      t.Retries,
+		retryBackoff: defaultRetryBackoff,
+		client:       &http.Client{Timeout: t.Timeout},
+	}, nil
+}
+
+func (s *httpSender) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+func (s *httpSender) send(ctx context.Context, e *event) error {
+	backoff := s.retryBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.post(ctx, e)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt > s.retries {
+			return err
+		}
+		klog.Warningf("send event %s to %s failed, retry in %v: %v", e.ID, s.url, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > defaultMaxRetryBackoff {
+			backoff = defaultMaxRetryBackoff
+		}
+	}
+}
+
+func (s *httpSender) post(ctx context.Context, e *event) (bool, error) {
+	req, err := s.newRequest(ctx, e)
+	if err != nil {
+		return false, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+	return retryable, fmt.Errorf("event sink responded %s: %s", resp.Status, bytes.TrimSpace(body))
+}
+
+// newRequest builds the request of the event with the http protocol binding
+func (s *httpSender) newRequest(ctx context.Context, e *event) (*http.Request, error) {
+	if s.mode == ModeStructured {
+		body, err := e.structured()
+		if err != nil {
+			return nil, err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", contentTypeStructured)
+		return req, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(e.Data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", e.DataContentType)
+	for _, attr := range e.attributes() {
+		req.Header.Set("ce-"+attr.name, encodeHeaderValue(attr.value))
+	}
+	return req, nil
+}
@@ -0,0 +1,110 @@
+package cloudevents
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+// kafkaBatchTimeout is how long the writer waits for more events before it writes a batch,
+// the events are sent one by one so it is kept short
+const kafkaBatchTimeout = 10 * time.Millisecond
+
+// kafkaSender writes the events to the topic, the events of a node are written to the same
+// partition to keep them in order
+type kafkaSender struct {
+	mode   string
+	writer *kafka.Writer
+}
+
+func newKafkaSender(ep *v1.RuleEndpoint, t *Target) (*kafkaSender, error) {
+	var brokers []string
+	for _, broker := range strings.Split(ep.Spec.Properties[constants.Brokers], ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("ruleendpoint properties \"%s\" does not exist", constants.Brokers)
+	}
+
+	transport := &kafka.Transport{}
+	if v, exist := ep.Spec.Properties[constants.TLS]; exist {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q, it must be true or false", constants.TLS, v)
+		}
+		if enabled {
+			transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+	}
+	if name := ep.Spec.Properties[constants.Secret]; name != "" {
+		secret, err := client.GetKubeClient().CoreV1().Secrets(ep.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = plain.Mechanism{
+			Username: string(secret.Data[constants.Username]),
+			Password: string(secret.Data[constants.Password]),
+		}
+	}
+
+	return &kafkaSender{
+		mode: t.Mode,
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        t.Resource,
+			Balancer:     &kafka.Hash{},
+			MaxAttempts:  t.Retries + 1,
+			BatchTimeout: kafkaBatchTimeout,
+			WriteTimeout: t.Timeout,
+			RequiredAcks: kafka.RequireAll,
+			Transport:    transport,
+		},
+	}, nil
+}
+
+// close flushes the pending events and closes the connections to the brokers
+func (s *kafkaSender) close() error {
+	return s.writer.Close()
+}
+
+func (s *kafkaSender) send(ctx context.Context, e *event) error {
+	msg, err := kafkaMessage(e, s.mode)
+	if err != nil {
+		return err
+	}
+	return s.writer.WriteMessages(ctx, msg)
+}
+
+// kafkaMessage builds the message of the event with the kafka protocol binding
+func kafkaMessage(e *event, mode string) (kafka.Message, error) {
+	msg := kafka.Message{Key: []byte(e.PartitionKey)}
+	if mode == ModeStructured {
+		value, err := e.structured()
+		if err != nil {
+			return msg, err
+		}
+		msg.Value = value
+		msg.Headers = []kafka.Header{{Key: "content-type", Value: []byte(contentTypeStructured)}}
+		return msg, nil
+	}
+
+	msg.Value = e.Data
+	msg.Headers = []kafka.Header{{Key: "content-type", Value: []byte(e.DataContentType)}}
+	for _, attr := range e.attributes() {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: "ce_" + attr.name, Value: []byte(attr.value)})
+	}
+	return msg, nil
+}
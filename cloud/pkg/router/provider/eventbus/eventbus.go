@@ -94,7 +94,7 @@ func (eb *EventBus) Name() string {
 	return constants.EventbusProvider
 }
 
-func (*EventBus) Forward(target provider.Target, data interface{}) (response interface{}, err error) {
+func (eb *EventBus) Forward(target provider.Target, data interface{}) (response interface{}, err error) {
 	message, ok := data.(*model.Message)
 	if !ok {
 		klog.Errorf("message type %T error", data)
@@ -107,6 +107,9 @@ func (*EventBus) Forward(target provider.Target, data interface{}) (response int
 		return nil, fmt.Errorf("get message %s content err: %v", message.GetID(), err)
 	}
 	res["data"] = content
+	res["messageID"] = message.GetID()
+	res["nodeName"] = eb.nodeName
+	res["headers"] = provider.MessageHeaders(message, eb.nodeName, eb.namespace, eb.subTopic)
 	resp, err := target.GoToTarget(res, nil)
 	if err != nil {
 		klog.Errorf("message is send to target failed. msgID: %s, target: %s, err:%v", message.GetID(), target.Name(), err)
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+)
+
+// MessageHeaders returns the headers of a message published on the topic of an edge node,
+// which the targets map their extensions from
+func MessageHeaders(message *model.Message, nodeName, namespace, topic string) map[string]string {
+	return map[string]string{
+		constants.HeaderMessageID: message.GetID(),
+		constants.HeaderTimestamp: strconv.FormatInt(message.GetTimestamp(), 10),
+		constants.HeaderNodeName:  nodeName,
+		constants.HeaderNamespace: namespace,
+		constants.HeaderTopic:     topic,
+		constants.HeaderResource:  message.GetResource(),
+		constants.HeaderOperation: message.GetOperation(),
+	}
+}
+
+// ParseExtensions parses the extensions mapping "<extension>=<header>,..." of a target resource
+// into a map from the extension names to the header names
+func ParseExtensions(mapping string) (map[string]string, error) {
+	extensions := make(map[string]string)
+	for _, pair := range strings.Split(mapping, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, header, ok := strings.Cut(pair, "=")
+		name, header = strings.TrimSpace(name), strings.TrimSpace(header)
+		if !ok || name == "" || header == "" {
+			return nil, fmt.Errorf("invalid extension %q, it must be in the format <extension>=<header>", pair)
+		}
+		if _, exist := extensions[name]; exist {
+			return nil, fmt.Errorf("duplicated extension %q", name)
+		}
+		extensions[name] = header
+	}
+	return extensions, nil
+}
+
+// MapExtensions returns the values of the extensions from the headers, the extensions whose
+// headers are missing or empty are skipped
+func MapExtensions(extensions, headers map[string]string) map[string]string {
+	values := make(map[string]string, len(extensions))
+	for name, header := range extensions {
+		if v := headers[header]; v != "" {
+			values[name] = v
+		}
+	}
+	return values
+}
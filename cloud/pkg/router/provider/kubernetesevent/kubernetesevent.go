@@ -0,0 +1,131 @@
+package kubernetesevent
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/events"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+const (
+	// EventComponent is the source component of the events recorded by the router
+	EventComponent = "router"
+
+	defaultReason = "EdgeMessage"
+	// maxMessageLength is the length the messages are truncated to as the event messages
+	maxMessageLength = 1024
+)
+
+type kubernetesEventFactory struct {
+}
+
+// Target records the messages as the events of the edge nodes they come from, so that they show
+// up with `kubectl describe node` and in the event pipelines of the cluster. The mapped message
+// headers are recorded as the annotations of the events.
+type Target struct {
+	Reason     string
+	EventType  string
+	Extensions map[string]string
+
+	recorder record.EventRecorder
+}
+
+func init() {
+	provider.RegisterTarget(&kubernetesEventFactory{})
+}
+
+func (*kubernetesEventFactory) Type() v1.RuleEndpointTypeDef {
+	return v1.RuleEndpointTypeKubernetesEvent
+}
+
+func (*kubernetesEventFactory) GetTarget(ep *v1.RuleEndpoint, targetResource map[string]string) provider.Target {
+	target, err := NewTarget(targetResource)
+	if err != nil {
+		klog.Errorf("create kubernetesevent target of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	target.recorder = events.Recorder(EventComponent)
+	return target
+}
+
+// NewTarget creates the kubernetesevent target from the target resource of a rule
+func NewTarget(targetResource map[string]string) (*Target, error) {
+	t := &Target{
+		Reason:    defaultReason,
+		EventType: corev1.EventTypeNormal,
+	}
+	if v, exist := targetResource[constants.Reason]; exist {
+		if v == "" {
+			return nil, fmt.Errorf("%s must not be empty", constants.Reason)
+		}
+		t.Reason = v
+	}
+	if v, exist := targetResource[constants.EventType]; exist {
+		if v != corev1.EventTypeNormal && v != corev1.EventTypeWarning {
+			return nil, fmt.Errorf("invalid %s %q, it must be %s or %s", constants.EventType, v, corev1.EventTypeNormal, corev1.EventTypeWarning)
+		}
+		t.EventType = v
+	}
+	extensions, err := provider.ParseExtensions(targetResource[constants.Extensions])
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateExtensions(extensions); err != nil {
+		return nil, err
+	}
+	t.Extensions = extensions
+	return t, nil
+}
+
+// ValidateExtensions validates the names of the extensions parsed by provider.ParseExtensions,
+// they are recorded as the annotations of the events
+func ValidateExtensions(extensions map[string]string) error {
+	for name := range extensions {
+		if errs := validation.IsQualifiedName(name); len(errs) != 0 {
+			return fmt.Errorf("extension %q is not a valid annotation key: %v", name, errs)
+		}
+	}
+	return nil
+}
+
+func (*Target) Name() string {
+	return constants.KubernetesEventProvider
+}
+
+// GoToTarget records the message as an event of the node it comes from, the events are sent
+// to the apiserver asynchronously
+func (t *Target) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	content, ok := data["data"].([]byte)
+	if !ok || len(content) == 0 {
+		return nil, fmt.Errorf("input data does not exist valid value \"data\"")
+	}
+	nodeName, ok := data["nodeName"].(string)
+	if !ok || nodeName == "" {
+		return nil, fmt.Errorf("input data does not exist valid value \"nodeName\"")
+	}
+	headers, _ := data["headers"].(map[string]string)
+
+	annotations := provider.MapExtensions(t.Extensions, headers)
+	t.recorder.AnnotatedEventf(events.NodeReference(nodeName), annotations, t.EventType, t.Reason, "%s", eventMessage(content))
+	return nil, nil
+}
+
+// eventMessage truncates the message to maxMessageLength bytes without splitting a character
+func eventMessage(content []byte) string {
+	if len(content) <= maxMessageLength {
+		return string(content)
+	}
+	n := maxMessageLength
+	for n > 0 && !utf8.RuneStart(content[n]) {
+		n--
+	}
+	return string(content[:n])
+}
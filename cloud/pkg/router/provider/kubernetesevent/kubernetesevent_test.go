@@ -0,0 +1,101 @@
+package kubernetesevent
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+)
+
+func TestNewTarget(t *testing.T) {
+	target, err := NewTarget(map[string]string{})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	if target.Reason != defaultReason || target.EventType != corev1.EventTypeNormal || len(target.Extensions) != 0 {
+		t.Errorf("unexpected defaults of target: %+v", target)
+	}
+
+	cases := []struct {
+		name     string
+		resource map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "all options",
+			resource: map[string]string{constants.Reason: "TemperatureAlert", constants.EventType: corev1.EventTypeWarning,
+				constants.Extensions: "kubeedge.io/topic=topic,msgid=messageID"},
+		},
+		{
+			name:     "empty reason",
+			resource: map[string]string{constants.Reason: ""},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid event type",
+			resource: map[string]string{constants.EventType: "Error"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid extension",
+			resource: map[string]string{constants.Extensions: "topic"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid annotation key",
+			resource: map[string]string{constants.Extensions: "edge topic=topic"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTarget(tc.resource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewTarget() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestGoToTarget(t *testing.T) {
+	target, err := NewTarget(map[string]string{constants.Reason: "TemperatureAlert", constants.EventType: corev1.EventTypeWarning,
+		constants.Extensions: "edgetopic=topic,missing=parentID"})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	recorder := record.NewFakeRecorder(1)
+	recorder.IncludeObject = true
+	target.recorder = recorder
+
+	data := map[string]interface{}{
+		"data":     []byte(`{"temperature":80}`),
+		"nodeName": "edge-node",
+		"headers":  map[string]string{constants.HeaderTopic: "alerts"},
+	}
+	if _, err := target.GoToTarget(data, nil); err != nil {
+		t.Fatalf("failed to record event: %v", err)
+	}
+	want := `Warning TemperatureAlert {"temperature":80} involvedObject{kind=Node,apiVersion=} map[edgetopic:alerts]`
+	if got := <-recorder.Events; got != want {
+		t.Errorf("expected event %q, but got %q", want, got)
+	}
+
+	delete(data, "nodeName")
+	if _, err := target.GoToTarget(data, nil); err == nil {
+		t.Errorf("expected the message without node name to be rejected")
+	}
+}
+
+func TestEventMessage(t *testing.T) {
+	if got := eventMessage([]byte("alert")); got != "alert" {
+		t.Errorf("expected the short message to be kept, but got %q", got)
+	}
+	// the multi-byte character across the limit is dropped
+	content := []byte(strings.Repeat("a", maxMessageLength-1) + "é")
+	if got := eventMessage(content); got != strings.Repeat("a", maxMessageLength-1) {
+		t.Errorf("expected the message to be truncated to %d bytes, but got %d bytes", maxMessageLength-1, len(got))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc/codes"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
@@ -86,6 +87,9 @@ func (r *Rest) UnregisterListener() {
 
 func (r *Rest) Forward(target provider.Target, data interface{}) (interface{}, error) {
 	d := data.(map[string]interface{})
+	if _, exist := d["writer"]; exist {
+		return r.forwardGRPC(target, d)
+	}
 	v, exist := d["request"]
 	if !exist {
 		return nil, errors.New("input data does not exist value \"request\"")
@@ -106,6 +110,9 @@ func (r *Rest) Forward(target provider.Target, data interface{}) (interface{}, e
 	if !ok {
 		return nil, errors.New("invalid convert to time.Duration")
 	}
+	if t, ok := target.(provider.TimeoutTarget); ok && t.Timeout() > 0 {
+		timeout = t.Timeout()
+	}
 	res := make(map[string]interface{})
 	messageID := d["messageID"].(string)
 	res["messageID"] = messageID
@@ -191,6 +198,39 @@ func (r *Rest) Forward(target provider.Target, data interface{}) (interface{}, e
 	return httpResponse, nil
 }
 
+// forwardGRPC proxies the gRPC call to the target, the response is streamed by the target
+func (r *Rest) forwardGRPC(target provider.Target, d map[string]interface{}) (interface{}, error) {
+	w, ok := d["writer"].(http.ResponseWriter)
+	if !ok {
+		return nil, errors.New("invalid convert to http.ResponseWriter")
+	}
+	request, ok := d["request"].(*http.Request)
+	if !ok {
+		return nil, errors.New("invalid convert to http.Request")
+	}
+	uri, ok := d["uri"].(string)
+	if !ok {
+		return nil, errors.New("input data does not exist value \"uri\"")
+	}
+	grpcTarget, ok := target.(provider.GRPCTarget)
+	if !ok {
+		httpUtils.WriteGRPCStatus(w, false, codes.Unimplemented, fmt.Sprintf("target %s does not support gRPC", target.Name()))
+		return nil, fmt.Errorf("target %s does not support gRPC", target.Name())
+	}
+	sections := strings.SplitN(uri, "/", 4)
+	if len(sections) < 4 {
+		httpUtils.WriteGRPCStatus(w, false, codes.InvalidArgument, "invalid format of gRPC call")
+		return nil, errors.New("invalid format of gRPC call")
+	}
+	res := map[string]interface{}{
+		"messageID": d["messageID"],
+		"param":     strings.TrimPrefix(sections[3], r.Path),
+		"nodeName":  sections[1],
+		"timeout":   d["timeout"],
+	}
+	return nil, grpcTarget.ProxyGRPC(w, request, res)
+}
+
 func (r *Rest) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
 	//TODO: need to get ACK
 	v, exist := data["data"]
@@ -0,0 +1,180 @@
+package servicebus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/listener"
+	httpUtils "github.com/kubeedge/kubeedge/cloud/pkg/router/utils/http"
+	commonType "github.com/kubeedge/kubeedge/common/types"
+)
+
+const (
+	// grpcChunkSize is the max size of the request body carried by a frame
+	grpcChunkSize = 32 * 1024
+	// grpcFrameBuffer is the number of the response frames buffered for a call, the call fails
+	// if the caller falls behind by more frames
+	grpcFrameBuffer = 64
+	// grpcOverflowError is the error of the call whose caller does not read the response in time
+	grpcOverflowError = "response frames of the call overflow, the caller is too slow"
+)
+
+// ProxyGRPC proxies the gRPC call r to the service on the edge node. The request body and the response
+// are carried by the frames of the call, so that the streaming RPCs work like the unary ones.
+// The call ends after the timeout of the route, or the rest timeout of the router if it is not set,
+// unless the grpc-timeout of the call is shorter.
+func (sb *ServiceBus) ProxyGRPC(w http.ResponseWriter, r *http.Request, data map[string]interface{}) error {
+	streamID, ok := data["messageID"].(string)
+	if !ok {
+		return errors.New("input data does not exist valid value \"messageID\"")
+	}
+	nodeName, _ := data["nodeName"].(string)
+	param, _ := data["param"].(string)
+	timeout, _ := data["timeout"].(time.Duration)
+	if sb.timeout > 0 {
+		timeout = sb.timeout
+	}
+	if t, ok := httpUtils.GRPCTimeout(r.Header); ok && (timeout <= 0 || t < timeout) {
+		timeout = t
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httpUtils.WriteGRPCStatus(w, false, codes.Internal, "streaming is not supported")
+		return errors.New("response writer does not support flush")
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	frames := make(chan *commonType.GRPCFrame, grpcFrameBuffer)
+	overflowed := make(chan struct{})
+	var overflowOnce sync.Once
+	// the callback is called by the message handler of router, it must not block on the caller
+	listener.MessageHandlerInstance.SetStreamCallback(streamID, func(message *model.Message) {
+		frame, err := decodeGRPCFrame(message)
+		if err != nil {
+			klog.Errorf("invalid gRPC frame of call %s: %v", streamID, err)
+			return
+		}
+		select {
+		case frames <- frame:
+		case <-ctx.Done():
+		default:
+			overflowOnce.Do(func() {
+				close(overflowed)
+			})
+		}
+	})
+	defer listener.MessageHandlerInstance.DelCallback(streamID)
+
+	resource := sb.resource(nodeName, param)
+	header := r.Header.Clone()
+	header.Del(httpUtils.GRPCNodeHeader)
+	header.Del(httpUtils.GRPCRouteHeader)
+	// let the service know the deadline set by the route
+	header.Set("Grpc-Timeout", fmt.Sprintf("%dm", timeout.Milliseconds()))
+	sendGRPCFrame(streamID, resource, &commonType.GRPCFrame{StreamID: streamID, Header: header, Timeout: timeout})
+	go sendGRPCBody(ctx, streamID, resource, r.Body)
+
+	wroteHeader := false
+	for {
+		select {
+		case <-ctx.Done():
+			sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID, Error: "canceled by the caller"})
+			if r.Context().Err() != nil {
+				return fmt.Errorf("gRPC call %s is canceled by the caller", streamID)
+			}
+			httpUtils.WriteGRPCStatus(w, wroteHeader, codes.DeadlineExceeded, "wait to get response time out")
+			return fmt.Errorf("gRPC call %s timeout after %v", streamID, timeout)
+		case <-overflowed:
+			sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID, Error: grpcOverflowError})
+			httpUtils.WriteGRPCStatus(w, wroteHeader, codes.ResourceExhausted, grpcOverflowError)
+			return fmt.Errorf("gRPC call %s failed: %s", streamID, grpcOverflowError)
+		case frame := <-frames:
+			if frame.Error != "" {
+				httpUtils.WriteGRPCStatus(w, wroteHeader, codes.Unavailable, frame.Error)
+				return fmt.Errorf("gRPC call %s failed on edge: %s", streamID, frame.Error)
+			}
+			if !wroteHeader && frame.StatusCode != 0 {
+				for key, values := range frame.Header {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(frame.StatusCode)
+				flusher.Flush()
+				wroteHeader = true
+			}
+			if len(frame.Data) != 0 {
+				if _, err := w.Write(frame.Data); err != nil {
+					sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID, Error: "caller is disconnected"})
+					return fmt.Errorf("failed to write the response of gRPC call %s, err: %v", streamID, err)
+				}
+				flusher.Flush()
+			}
+			if frame.End {
+				for key, values := range frame.Trailer {
+					w.Header()[http.TrailerPrefix+key] = values
+				}
+				return nil
+			}
+		}
+	}
+}
+
+// sendGRPCBody sends the request body of the call to edge, until it is closed by the caller
+func sendGRPCBody(ctx context.Context, streamID, resource string, body io.Reader) {
+	buf := make([]byte, grpcChunkSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID, Data: data})
+		}
+		if err == io.EOF {
+			sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID, End: true})
+			return
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				sendGRPCFrame("", resource, &commonType.GRPCFrame{StreamID: streamID,
+					Error: fmt.Sprintf("failed to read request body, %v", err)})
+			}
+			return
+		}
+	}
+}
+
+// sendGRPCFrame sends a frame of the call to edge, the message ID is generated if id is empty
+func sendGRPCFrame(id, resource string, frame *commonType.GRPCFrame) {
+	msg := model.NewMessage("")
+	if id != "" {
+		msg.BuildHeader(id, "", msg.GetTimestamp())
+	}
+	msg.SetResourceOperation(resource, commonType.OperationGRPC)
+	msg.FillBody(frame)
+	msg.SetRoute(modules.RouterSourceServiceBus, modules.UserGroup)
+	beehiveContext.Send(modules.CloudHubModuleName, *msg)
+}
+
+func decodeGRPCFrame(message *model.Message) (*commonType.GRPCFrame, error) {
+	content, err := message.GetContentData()
+	if err != nil {
+		return nil, err
+	}
+	frame := new(commonType.GRPCFrame)
+	if err := json.Unmarshal(content, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"path"
 	"strings"
+	"time"
 
 	"k8s.io/klog/v2"
 
@@ -28,6 +29,8 @@ type ServiceBus struct {
 	servicePort string
 	nodeName    string
 	TargetURL   string
+	// timeout of the route, which overrides the rest timeout of the router if set
+	timeout time.Duration
 }
 
 func init() {
@@ -117,9 +120,32 @@ func (sf *servicebusFactory) GetTarget(ep *v1.RuleEndpoint, targetResource map[s
 		targetPath:  targetPath,
 		servicePort: ep.Spec.Properties["service_port"],
 	}
+	if v, exist := targetResource[constants.Timeout]; exist {
+		timeout, err := time.ParseDuration(v)
+		if err != nil || timeout <= 0 {
+			klog.Errorf("target resource attributes \"timeout\" %q must be a positive duration", v)
+			return nil
+		}
+		cli.timeout = timeout
+	}
 	return cli
 }
 
+// Timeout returns the timeout of the route, zero if it is not set
+func (sb *ServiceBus) Timeout() time.Duration {
+	return sb.timeout
+}
+
+// resource returns the resource of the messages sent to the service on node,
+// param is the path of the request following the path of the rest source
+func (sb *ServiceBus) resource(nodeName, param string) string {
+	resource := "node/" + nodeName + "/" + sb.servicePort + ":"
+	if param == "" {
+		return resource + sb.targetPath
+	}
+	return resource + strings.TrimSuffix(sb.targetPath, "/") + "/" + strings.TrimPrefix(param, "/")
+}
+
 func (sb *ServiceBus) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
 	var response *model.Message
 	messageID, ok := data["messageID"].(string)
@@ -137,13 +163,7 @@ func (sb *ServiceBus) GoToTarget(data map[string]interface{}, stop chan struct{}
 
 	msg := model.NewMessage("")
 	msg.BuildHeader(messageID, "", msg.GetTimestamp())
-	resource := "node/" + nodeName + "/" + sb.servicePort + ":"
-	if !ok || param == "" {
-		resource = resource + sb.targetPath
-	} else {
-		resource = resource + strings.TrimSuffix(sb.targetPath, "/") + "/" + strings.TrimPrefix(param, "/")
-	}
-	msg.SetResourceOperation(resource, request.Method)
+	msg.SetResourceOperation(sb.resource(nodeName, param), request.Method)
 	msg.FillBody(request)
 	msg.SetRoute(modules.RouterSourceServiceBus, modules.UserGroup)
 	beehiveContext.Send(modules.CloudHubModuleName, *msg)
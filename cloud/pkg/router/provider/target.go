@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"net/http"
+	"time"
+
 	"k8s.io/klog/v2"
 
 	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
@@ -16,6 +19,23 @@ type Target interface {
 	GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error)
 }
 
+// GRPCTarget is a Target the gRPC calls can be proxied to
+type GRPCTarget interface {
+	// ProxyGRPC proxies the gRPC call r, and streams the response to w until the call ends
+	ProxyGRPC(w http.ResponseWriter, r *http.Request, data map[string]interface{}) error
+}
+
+// TimeoutTarget is a Target with a timeout of its own, which overrides the rest timeout of the router
+type TimeoutTarget interface {
+	Timeout() time.Duration
+}
+
+// CloseTarget is a Target holding connections of its own, which are closed once the rule of the
+// target is deleted or replaced
+type CloseTarget interface {
+	Close() error
+}
+
 var (
 	// Modules map
 	targets map[v1.RuleEndpointTypeDef]TargetFactory
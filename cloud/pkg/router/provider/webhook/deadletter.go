@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
+)
+
+const (
+	// TargetLabel labels the dead letters with the name of their target ruleendpoint
+	TargetLabel = "rules.kubeedge.io/target"
+
+	replayInterval = 30 * time.Second
+)
+
+var replayOnce sync.Once
+
+// createDeadLetter persists an undeliverable message as a RuleDeadLetter in the namespace of the target
+func (t *Target) createDeadLetter(data []byte, nodeName string, attempts int, err error) {
+	if !t.DeadLetter || t.crdClient == nil {
+		klog.Errorf("drop undeliverable message to %s, dead letter disabled: %v", t.URL, err)
+		return
+	}
+	deadLetter := &v1.RuleDeadLetter{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: t.RuleEndpoint + "-",
+			Namespace:    t.Namespace,
+			Labels:       map[string]string{TargetLabel: t.RuleEndpoint},
+		},
+		Spec: v1.RuleDeadLetterSpec{
+			Target:         t.RuleEndpoint,
+			TargetResource: t.Resource,
+			NodeName:       nodeName,
+			Data:           data,
+		},
+		Status: v1.RuleDeadLetterStatus{
+			Attempts:        int32(attempts),
+			LastError:       err.Error(),
+			LastAttemptTime: metav1.Now(),
+		},
+	}
+	created, cerr := t.crdClient.RulesV1().RuleDeadLetters(t.Namespace).Create(context.Background(), deadLetter, metav1.CreateOptions{})
+	if cerr != nil {
+		klog.Errorf("failed to create dead letter of message to %s, err: %v, delivery err: %v", t.URL, cerr, err)
+		return
+	}
+	klog.Warningf("message to %s is persisted as dead letter %s/%s: %v", t.URL, created.Namespace, created.Name, err)
+}
+
+// startReplay starts replaying the dead letters whose spec.replay is set, it only runs once
+func startReplay(crdClient crdClientset.Interface) {
+	if crdClient == nil {
+		return
+	}
+	replayOnce.Do(func() {
+		go wait.Until(func() {
+			replayDeadLetters(crdClient)
+		}, replayInterval, beehiveContext.Done())
+	})
+}
+
+func replayDeadLetters(crdClient crdClientset.Interface) {
+	deadLetters, err := crdClient.RulesV1().RuleDeadLetters(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		klog.Errorf("failed to list dead letters: %v", err)
+		return
+	}
+	for i := range deadLetters.Items {
+		if deadLetters.Items[i].Spec.Replay {
+			replayDeadLetter(crdClient, &deadLetters.Items[i])
+		}
+	}
+}
+
+// replayDeadLetter delivers the message of the dead letter again, the dead letter is deleted on success,
+// otherwise its delivery attempts are recorded and spec.replay is reset
+func replayDeadLetter(crdClient crdClientset.Interface, deadLetter *v1.RuleDeadLetter) {
+	key := fmt.Sprintf("%s/%s", deadLetter.Namespace, deadLetter.Name)
+	attempts, err := deliverDeadLetter(crdClient, deadLetter)
+	if err == nil {
+		if err := crdClient.RulesV1().RuleDeadLetters(deadLetter.Namespace).Delete(context.Background(), deadLetter.Name, metav1.DeleteOptions{}); err != nil {
+			klog.Errorf("failed to delete replayed dead letter %s: %v", key, err)
+			return
+		}
+		klog.Infof("dead letter %s is replayed successfully", key)
+		return
+	}
+
+	klog.Errorf("failed to replay dead letter %s: %v", key, err)
+	deadLetter.Spec.Replay = false
+	deadLetter.Status.Attempts += int32(attempts)
+	deadLetter.Status.LastError = err.Error()
+	deadLetter.Status.LastAttemptTime = metav1.Now()
+	if _, err := crdClient.RulesV1().RuleDeadLetters(deadLetter.Namespace).Update(context.Background(), deadLetter, metav1.UpdateOptions{}); err != nil {
+		klog.Errorf("failed to update dead letter %s: %v", key, err)
+	}
+}
+
+func deliverDeadLetter(crdClient crdClientset.Interface, deadLetter *v1.RuleDeadLetter) (int, error) {
+	ep, err := crdClient.RulesV1().RuleEndpoints(deadLetter.Namespace).Get(context.Background(), deadLetter.Spec.Target, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if ep.Spec.RuleEndpointType != v1.RuleEndpointTypeWebhook {
+		return 0, fmt.Errorf("ruleendpoint %s/%s is not a webhook", ep.Namespace, ep.Name)
+	}
+	target, err := NewTarget(ep, deadLetter.Spec.TargetResource)
+	if err != nil {
+		return 0, err
+	}
+	if target.key, err = getHMACKey(ep); err != nil {
+		return 0, err
+	}
+	return target.deliver(context.Background(), deadLetter.Spec.Data, deadLetter.Spec.NodeName)
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	httpUtils "github.com/kubeedge/kubeedge/cloud/pkg/router/utils/http"
+)
+
+const (
+	queueSize = 1024
+	workers   = 8
+)
+
+type delivery struct {
+	target   *Target
+	data     []byte
+	nodeName string
+}
+
+var (
+	queue     chan delivery
+	queueOnce sync.Once
+)
+
+// enqueue queues the message for the delivery workers, a message which does not fit
+// into the queue is persisted as a dead letter instead of being dropped
+func enqueue(t *Target, data []byte, nodeName string) {
+	queueOnce.Do(func() {
+		queue = make(chan delivery, queueSize)
+		for i := 0; i < workers; i++ {
+			go deliverQueued()
+		}
+	})
+	select {
+	case queue <- delivery{target: t, data: data, nodeName: nodeName}:
+	default:
+		klog.Errorf("webhook delivery queue is full, failed to deliver message to %s", t.URL)
+		t.deadLetter(data, nodeName, 0, fmt.Errorf("webhook delivery queue is full"))
+	}
+}
+
+func deliverQueued() {
+	for d := range queue {
+		if attempts, err := d.target.deliver(context.Background(), d.data, d.nodeName); err != nil {
+			klog.Errorf("failed to deliver message to %s after %d attempts: %v", d.target.URL, attempts, err)
+			d.target.deadLetter(d.data, d.nodeName, attempts, err)
+		}
+	}
+}
+
+// deliver posts the message to the webhook, it retries with backoff on the network errors, the
+// timeouts, the 429s and the 5xxs, and returns the number of attempts made
+func (t *Target) deliver(ctx context.Context, data []byte, nodeName string) (int, error) {
+	backoff := t.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		retryable, err := t.post(ctx, data, nodeName)
+		if err == nil {
+			return attempt, nil
+		}
+		if !retryable || attempt > t.Retries {
+			return attempt, err
+		}
+		klog.Warningf("deliver message to %s failed, retry in %v: %v", t.URL, backoff, err)
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > t.MaxRetryBackoff {
+			backoff = t.MaxRetryBackoff
+		}
+	}
+}
+
+func (t *Target) post(ctx context.Context, data []byte, nodeName string) (bool, error) {
+	req, err := httpUtils.BuildRequest(http.MethodPost, t.URL, bytes.NewReader(data), "", nodeName)
+	if err != nil {
+		return false, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if len(t.key) != 0 {
+		Sign(t.key, req.Header, data, time.Now())
+	}
+	resp, err := httpUtils.SendRequest(req, t.client)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retryable := resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+	return retryable, fmt.Errorf("webhook responded %s: %s", resp.Status, bytes.TrimSpace(body))
+}
@@ -0,0 +1,270 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/provider"
+	// the webhook source is built on the rest source
+	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/rest"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of "<timestamp>.<body>", in the format "sha256=<hex>"
+	SignatureHeader = "X-KubeEdge-Signature"
+	// TimestampHeader carries the unix time the request was signed at
+	TimestampHeader = "X-KubeEdge-Timestamp"
+
+	// signatureTolerance is how far the timestamp of a signed request may be from now
+	signatureTolerance = 5 * time.Minute
+
+	defaultRetries         = 3
+	defaultRetryBackoff    = time.Second
+	defaultMaxRetryBackoff = 30 * time.Second
+	defaultTimeout         = 10 * time.Second
+)
+
+type webhookFactory struct {
+}
+
+// Source receives the webhook requests on the router rest listener like the rest source,
+// and rejects the requests without a valid signature if the ruleendpoint has a secret
+type Source struct {
+	provider.Source
+	key []byte
+}
+
+// Target posts the messages to a webhook, retrying the transient failures with backoff
+// and persisting the undeliverable messages as RuleDeadLetters
+type Target struct {
+	Namespace string
+	// RuleEndpoint is the name of the target ruleendpoint
+	RuleEndpoint    string
+	Resource        map[string]string
+	URL             string
+	Retries         int
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	Timeout         time.Duration
+	DeadLetter      bool
+
+	key        []byte
+	client     *http.Client
+	crdClient  crdClientset.Interface
+	deadLetter func(data []byte, nodeName string, attempts int, err error)
+}
+
+func init() {
+	factory := &webhookFactory{}
+	provider.RegisterSource(factory)
+	provider.RegisterTarget(factory)
+}
+
+func (*webhookFactory) Type() v1.RuleEndpointTypeDef {
+	return v1.RuleEndpointTypeWebhook
+}
+
+func (*webhookFactory) GetSource(ep *v1.RuleEndpoint, sourceResource map[string]string) provider.Source {
+	rf, exist := provider.GetSourceFactory(v1.RuleEndpointTypeRest)
+	if !exist {
+		klog.Errorf("rest source is not registered")
+		return nil
+	}
+	source := rf.GetSource(ep, sourceResource)
+	if source == nil {
+		return nil
+	}
+	key, err := getHMACKey(ep)
+	if err != nil {
+		klog.Errorf("get hmac key of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	return &Source{Source: source, key: key}
+}
+
+func (*webhookFactory) GetTarget(ep *v1.RuleEndpoint, targetResource map[string]string) provider.Target {
+	target, err := NewTarget(ep, targetResource)
+	if err != nil {
+		klog.Errorf("create webhook target of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	key, err := getHMACKey(ep)
+	if err != nil {
+		klog.Errorf("get hmac key of ruleendpoint %s/%s failed: %v", ep.Namespace, ep.Name, err)
+		return nil
+	}
+	target.key = key
+	target.crdClient = client.GetCRDClient()
+	startReplay(target.crdClient)
+	return target
+}
+
+func (*Source) Name() string {
+	return constants.WebhookProvider
+}
+
+func (s *Source) Forward(target provider.Target, data interface{}) (interface{}, error) {
+	if len(s.key) != 0 {
+		d, ok := data.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("data type is %T", data)
+		}
+		request, ok := d["request"].(*http.Request)
+		if !ok {
+			return nil, fmt.Errorf("input data does not exist valid value \"request\"")
+		}
+		body, _ := d["data"].([]byte)
+		if err := VerifySignature(s.key, request.Header, body, time.Now()); err != nil {
+			klog.Warningf("reject webhook request %s: %v", request.RequestURI, err)
+			return &http.Response{
+				Request:    request,
+				StatusCode: http.StatusUnauthorized,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(err.Error())),
+			}, nil
+		}
+	}
+	return s.Source.Forward(target, data)
+}
+
+// NewTarget creates the webhook target from the target resource of a rule
+func NewTarget(ep *v1.RuleEndpoint, targetResource map[string]string) (*Target, error) {
+	url, exist := targetResource[constants.Resource]
+	if !exist {
+		return nil, fmt.Errorf("target resource attributes \"resource\" does not exist")
+	}
+	t := &Target{
+		Namespace:       ep.Namespace,
+		RuleEndpoint:    ep.Name,
+		Resource:        targetResource,
+		URL:             url,
+		Retries:         defaultRetries,
+		RetryBackoff:    defaultRetryBackoff,
+		MaxRetryBackoff: defaultMaxRetryBackoff,
+		Timeout:         defaultTimeout,
+		DeadLetter:      true,
+	}
+	var err error
+	if v, exist := targetResource[constants.Retries]; exist {
+		if t.Retries, err = strconv.Atoi(v); err != nil || t.Retries < 0 {
+			return nil, fmt.Errorf("invalid %s %q, it must be a non-negative integer", constants.Retries, v)
+		}
+	}
+	durations := map[string]*time.Duration{
+		constants.RetryBackoff:    &t.RetryBackoff,
+		constants.MaxRetryBackoff: &t.MaxRetryBackoff,
+		constants.Timeout:         &t.Timeout,
+	}
+	for name, d := range durations {
+		if v, exist := targetResource[name]; exist {
+			if *d, err = time.ParseDuration(v); err != nil || *d <= 0 {
+				return nil, fmt.Errorf("invalid %s %q, it must be a positive duration", name, v)
+			}
+		}
+	}
+	if v, exist := targetResource[constants.DeadLetter]; exist {
+		if t.DeadLetter, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid %s %q, it must be true or false", constants.DeadLetter, v)
+		}
+	}
+	t.client = &http.Client{Timeout: t.Timeout}
+	t.deadLetter = t.createDeadLetter
+	return t, nil
+}
+
+func (*Target) Name() string {
+	return constants.WebhookProvider
+}
+
+// GoToTarget delivers the message synchronously when the caller waits for the response, like the
+// requests of the rest source, or queues it otherwise so that the retries do not block the router
+func (t *Target) GoToTarget(data map[string]interface{}, stop chan struct{}) (interface{}, error) {
+	content, ok := data["data"].([]byte)
+	if !ok || len(content) == 0 {
+		return nil, fmt.Errorf("input data does not exist valid value \"data\"")
+	}
+	nodeName, _ := data["nodeName"].(string)
+	if stop == nil {
+		enqueue(t, content, nodeName)
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	if attempts, err := t.deliver(ctx, content, nodeName); err != nil {
+		t.deadLetter(content, nodeName, attempts, err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Sign signs the body at `now` and sets the signature headers of the request
+func Sign(key []byte, header http.Header, body []byte, now time.Time) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	header.Set(TimestampHeader, timestamp)
+	header.Set(SignatureHeader, "sha256="+hex.EncodeToString(signature(key, timestamp, body)))
+}
+
+// VerifySignature checks the signature headers of a request signed by Sign
+func VerifySignature(key []byte, header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get(TimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header %q", TimestampHeader, timestamp)
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > signatureTolerance || d < -signatureTolerance {
+		return fmt.Errorf("%s header %q is out of the tolerance %v", TimestampHeader, timestamp, signatureTolerance)
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(header.Get(SignatureHeader), "sha256="))
+	if err != nil || !hmac.Equal(sig, signature(key, timestamp, body)) {
+		return fmt.Errorf("invalid %s header", SignatureHeader)
+	}
+	return nil
+}
+
+func signature(key []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// getHMACKey reads the hmac key from the secret of the ruleendpoint, nil if the ruleendpoint has no secret
+func getHMACKey(ep *v1.RuleEndpoint) ([]byte, error) {
+	name, exist := ep.Spec.Properties[constants.Secret]
+	if !exist || name == "" {
+		return nil, nil
+	}
+	secret, err := client.GetKubeClient().CoreV1().Secrets(ep.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	key, exist := secret.Data[constants.HMACKey]
+	if !exist || len(key) == 0 {
+		return nil, fmt.Errorf("secret %s/%s does not have %q", ep.Namespace, name, constants.HMACKey)
+	}
+	return key, nil
+}
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/router/constants"
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	"github.com/kubeedge/kubeedge/pkg/client/clientset/versioned/fake"
+)
+
+func newRuleEndpoint() *v1.RuleEndpoint {
+	return &v1.RuleEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-webhook", Namespace: "default"},
+		Spec:       v1.RuleEndpointSpec{RuleEndpointType: v1.RuleEndpointTypeWebhook},
+	}
+}
+
+func newTestTarget(t *testing.T, url string) *Target {
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{
+		constants.Resource:        url,
+		constants.Retries:         "2",
+		constants.RetryBackoff:    "1ms",
+		constants.MaxRetryBackoff: "2ms",
+	})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	return target
+}
+
+func TestNewTarget(t *testing.T) {
+	target, err := NewTarget(newRuleEndpoint(), map[string]string{constants.Resource: "http://127.0.0.1/hook"})
+	if err != nil {
+		t.Fatalf("failed to create target: %v", err)
+	}
+	if target.Retries != defaultRetries || target.RetryBackoff != defaultRetryBackoff ||
+		target.MaxRetryBackoff != defaultMaxRetryBackoff || target.Timeout != defaultTimeout || !target.DeadLetter {
+		t.Errorf("unexpected defaults of target: %+v", target)
+	}
+
+	cases := []struct {
+		name     string
+		resource map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "all options",
+			resource: map[string]string{constants.Resource: "http://127.0.0.1/hook", constants.Retries: "0",
+				constants.RetryBackoff: "100ms", constants.MaxRetryBackoff: "1s", constants.Timeout: "5s", constants.DeadLetter: "false"},
+		},
+		{
+			name:     "no resource",
+			resource: map[string]string{},
+			wantErr:  true,
+		},
+		{
+			name:     "negative retries",
+			resource: map[string]string{constants.Resource: "http://127.0.0.1/hook", constants.Retries: "-1"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid backoff",
+			resource: map[string]string{constants.Resource: "http://127.0.0.1/hook", constants.RetryBackoff: "1"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid dead letter",
+			resource: map[string]string{constants.Resource: "http://127.0.0.1/hook", constants.DeadLetter: "yes"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewTarget(newRuleEndpoint(), tc.resource)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewTarget() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignature(t *testing.T) {
+	key := []byte("secret")
+	body := []byte(`{"msg":"hello"}`)
+	now := time.Now()
+	header := http.Header{}
+	Sign(key, header, body, now)
+
+	if err := VerifySignature(key, header, body, now.Add(time.Minute)); err != nil {
+		t.Errorf("failed to verify signature: %v", err)
+	}
+	if err := VerifySignature(key, header, []byte(`{"msg":"bye"}`), now); err == nil {
+		t.Errorf("tampered body should not be verified")
+	}
+	if err := VerifySignature([]byte("other"), header, body, now); err == nil {
+		t.Errorf("signature of another key should not be verified")
+	}
+	if err := VerifySignature(key, header, body, now.Add(signatureTolerance+time.Minute)); err == nil {
+		t.Errorf("expired signature should not be verified")
+	}
+	if err := VerifySignature(key, http.Header{}, body, now); err == nil {
+		t.Errorf("request without signature should not be verified")
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	var requests int32
+	key := []byte("secret")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := VerifySignature(key, r.Header, body, time.Now()); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	target := newTestTarget(t, server.URL+"/hook")
+	target.key = key
+	attempts, err := target.deliver(context.Background(), []byte("hello"), "node1")
+	if err != nil || attempts != 2 {
+		t.Errorf("expected success after 2 attempts, got %d attempts: %v", attempts, err)
+	}
+
+	target = newTestTarget(t, server.URL+"/bad")
+	target.key = key
+	attempts, err = target.deliver(context.Background(), []byte("hello"), "node1")
+	if err == nil || attempts != 1 {
+		t.Errorf("expected failure without retry, got %d attempts: %v", attempts, err)
+	}
+
+	target = newTestTarget(t, server.URL+"/hook")
+	attempts, err = target.deliver(context.Background(), []byte("hello"), "node1")
+	if err == nil || attempts != 1 {
+		t.Errorf("expected unsigned request to be rejected without retry, got %d attempts: %v", attempts, err)
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	crdClient := fake.NewSimpleClientset(newRuleEndpoint())
+	target := newTestTarget(t, server.URL)
+	target.crdClient = crdClient
+	if _, err := target.GoToTarget(map[string]interface{}{"data": []byte("hello"), "nodeName": "node1"}, make(chan struct{})); err == nil {
+		t.Fatalf("expected delivery to fail")
+	}
+
+	deadLetters, err := crdClient.RulesV1().RuleDeadLetters("default").List(context.Background(), metav1.ListOptions{})
+	if err != nil || len(deadLetters.Items) != 1 {
+		t.Fatalf("expected 1 dead letter, got %v: %v", deadLetters, err)
+	}
+	deadLetter := deadLetters.Items[0]
+	if deadLetter.Spec.Target != "my-webhook" || string(deadLetter.Spec.Data) != "hello" || deadLetter.Spec.NodeName != "node1" ||
+		deadLetter.Status.Attempts != 3 || deadLetter.Labels[TargetLabel] != "my-webhook" {
+		t.Errorf("unexpected dead letter: %+v", deadLetter)
+	}
+
+	// the fake clientset does not generate names, replay a named copy of the dead letter
+	deadLetter.Name = "my-webhook-replay"
+	deadLetter.Spec.Replay = true
+	if _, err := crdClient.RulesV1().RuleDeadLetters("default").Create(context.Background(), &deadLetter, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("failed to create dead letter: %v", err)
+	}
+	replayDeadLetters(crdClient)
+	replayed, err := crdClient.RulesV1().RuleDeadLetters("default").Get(context.Background(), deadLetter.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get replayed dead letter: %v", err)
+	}
+	if replayed.Spec.Replay || replayed.Status.Attempts != 6 {
+		t.Errorf("expected failed replay to be recorded, got %+v", replayed)
+	}
+}
@@ -7,12 +7,18 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
 	routerconfig "github.com/kubeedge/kubeedge/cloud/pkg/router/config"
 	"github.com/kubeedge/kubeedge/cloud/pkg/router/listener"
+	// init cloudevents
+	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/cloudevents"
 	// init eventbus
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/eventbus"
+	// init kubernetesevent
+	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/kubernetesevent"
 	// init rest
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/rest"
 	// init servicebus
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/servicebus"
+	// init webhook
+	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/provider/webhook"
 	// init rule
 	_ "github.com/kubeedge/kubeedge/cloud/pkg/router/rule"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/cloudcore/v1alpha1"
@@ -17,6 +17,8 @@ import (
 var (
 	rules         sync.Map
 	ruleEndpoints sync.Map
+	// ruleTargets are the targets of the rules, they are closed once the rules are deleted
+	ruleTargets sync.Map
 )
 
 func init() {
@@ -128,9 +130,13 @@ func addRule(rule *routerv1.Rule) error {
 		errMsg := ErrorMsg{Detail: err.Error(), Timestamp: time.Now()}
 		execResult := ExecResult{RuleID: rule.Name, ProjectID: rule.Namespace, Status: "FAIL", Error: errMsg}
 		ResultChannel <- execResult
+		closeTarget(ruleKey, target)
 		return nil
 	}
 
+	if old, loaded := ruleTargets.Swap(ruleKey, target); loaded {
+		closeTarget(ruleKey, old.(provider.Target))
+	}
 	rules.Store(ruleKey, rule)
 	klog.Infof("add rule success: %+v", rule)
 	return nil
@@ -155,10 +161,24 @@ func delRule(namespace, name string) {
 		klog.Warningf("delRule: source of rule:%s not exist, unnecessary do UnregisterListener:%v", rule.Spec.Source, err)
 	}
 
+	if target, loaded := ruleTargets.LoadAndDelete(ruleKey); loaded {
+		closeTarget(ruleKey, target.(provider.Target))
+	}
 	rules.Delete(ruleKey)
 	klog.V(4).Infof("delete rule success: %s", ruleKey)
 }
 
+// closeTarget closes the connections held by the target of the rule
+func closeTarget(ruleKey string, target provider.Target) {
+	closer, ok := target.(provider.CloseTarget)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		klog.Warningf("close target of rule %s failed, err: %v", ruleKey, err)
+	}
+}
+
 func getSourceOfRule(rule *routerv1.Rule) (provider.Source, error) {
 	sourceKey := getKey(rule.Namespace, rule.Spec.Source)
 	v, exist := ruleEndpoints.Load(sourceKey)
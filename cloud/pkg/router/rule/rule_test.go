@@ -0,0 +1,51 @@
+package rule
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	routerv1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+)
+
+type fakeTarget struct {
+	closed int
+}
+
+func (*fakeTarget) Name() string {
+	return "fake"
+}
+
+func (*fakeTarget) GoToTarget(map[string]interface{}, chan struct{}) (interface{}, error) {
+	return nil, nil
+}
+
+func (t *fakeTarget) Close() error {
+	t.closed++
+	return nil
+}
+
+func TestDelRuleClosesTarget(t *testing.T) {
+	rule := &routerv1.Rule{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rule"},
+		Spec:       routerv1.RuleSpec{Source: "source", Target: "target"},
+	}
+	key := getKey(rule.Namespace, rule.Name)
+	target := &fakeTarget{}
+	rules.Store(key, rule)
+	ruleTargets.Store(key, target)
+
+	delRule(rule.Namespace, rule.Name)
+	if target.closed != 1 {
+		t.Errorf("expected the target to be closed once, but closed %d times", target.closed)
+	}
+	if _, exist := ruleTargets.Load(key); exist {
+		t.Errorf("expected the target of the deleted rule to be removed")
+	}
+
+	// the rule is already deleted
+	delRule(rule.Namespace, rule.Name)
+	if target.closed != 1 {
+		t.Errorf("expected the target not to be closed again, but closed %d times", target.closed)
+	}
+}
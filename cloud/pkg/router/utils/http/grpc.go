@@ -0,0 +1,81 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// GRPCNodeHeader is the metadata of a gRPC call naming the edge node the call is proxied to
+	GRPCNodeHeader = "X-Kubeedge-Node"
+	// GRPCRouteHeader is the metadata of a gRPC call naming the rest source of the rule the call
+	// is routed by, formatted as {namespace}/{path}
+	GRPCRouteHeader = "X-Kubeedge-Route"
+
+	grpcContentType   = "application/grpc"
+	grpcStatusHeader  = "Grpc-Status"
+	grpcMessageHeader = "Grpc-Message"
+	grpcTimeoutHeader = "Grpc-Timeout"
+)
+
+// IsGRPCRequest reports whether r is a gRPC call
+func IsGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), grpcContentType)
+}
+
+// GRPCRequestURI returns the URI of the rest source a gRPC call is routed by,
+// formatted as /{node}/{namespace}/{path}/{service}/{method} like the rest requests
+func GRPCRequestURI(r *http.Request) (string, error) {
+	node := r.Header.Get(GRPCNodeHeader)
+	route := strings.Trim(r.Header.Get(GRPCRouteHeader), "/")
+	if node == "" || route == "" {
+		return "", fmt.Errorf("gRPC metadata %s and %s are required", strings.ToLower(GRPCNodeHeader),
+			strings.ToLower(GRPCRouteHeader))
+	}
+	return "/" + node + "/" + route + r.URL.Path, nil
+}
+
+// GRPCTimeout returns the timeout set by the grpc-timeout header of a gRPC call
+func GRPCTimeout(header http.Header) (time.Duration, bool) {
+	v := header.Get(grpcTimeoutHeader)
+	if len(v) < 2 {
+		return 0, false
+	}
+	units := map[byte]time.Duration{
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+		'm': time.Millisecond,
+		'u': time.Microsecond,
+		'n': time.Nanosecond,
+	}
+	unit, ok := units[v[len(v)-1]]
+	if !ok {
+		return 0, false
+	}
+	value, err := strconv.ParseInt(v[:len(v)-1], 10, 64)
+	if err != nil || value < 0 {
+		return 0, false
+	}
+	return time.Duration(value) * unit, true
+}
+
+// WriteGRPCStatus ends a gRPC call with the status, as a trailers-only response
+// if the response header is not written yet, otherwise in the trailers
+func WriteGRPCStatus(w http.ResponseWriter, wroteHeader bool, code codes.Code, msg string) {
+	if !wroteHeader {
+		w.Header().Set("Content-Type", grpcContentType)
+		w.Header().Set(grpcStatusHeader, strconv.Itoa(int(code)))
+		w.Header().Set(grpcMessageHeader, url.PathEscape(msg))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set(http.TrailerPrefix+grpcStatusHeader, strconv.Itoa(int(code)))
+	w.Header().Set(http.TrailerPrefix+grpcMessageHeader, url.PathEscape(msg))
+}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestGRPCTimeout(t *testing.T) {
+	cases := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{value: "", ok: false},
+		{value: "10S", want: 10 * time.Second, ok: true},
+		{value: "1500m", want: 1500 * time.Millisecond, ok: true},
+		{value: "2H", want: 2 * time.Hour, ok: true},
+		{value: "10s", ok: false},
+		{value: "-1S", ok: false},
+	}
+	for _, tc := range cases {
+		header := http.Header{}
+		if tc.value != "" {
+			header.Set("grpc-timeout", tc.value)
+		}
+		got, ok := GRPCTimeout(header)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("GRPCTimeout(%q) = %v, %v, want %v, %v", tc.value, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestGRPCRequestURI(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/helloworld.Greeter/SayHello", nil)
+	if _, err := GRPCRequestURI(r); err == nil {
+		t.Errorf("expected an error without the routing metadata")
+	}
+	r.Header.Set("x-kubeedge-node", "edge-node")
+	r.Header.Set("x-kubeedge-route", "/default/greeter/")
+	uri, err := GRPCRequestURI(r)
+	if err != nil {
+		t.Fatalf("GRPCRequestURI() error = %v", err)
+	}
+	if want := "/edge-node/default/greeter/helloworld.Greeter/SayHello"; uri != want {
+		t.Errorf("GRPCRequestURI() = %s, want %s", uri, want)
+	}
+}
+
+func TestWriteGRPCStatus(t *testing.T) {
+	w := httptest.NewRecorder()
+	WriteGRPCStatus(w, false, codes.Unavailable, "node offline")
+	if w.Code != http.StatusOK || w.Header().Get("Grpc-Status") != "14" || w.Header().Get("Grpc-Message") != "node%20offline" {
+		t.Errorf("expected a trailers-only response, but got %d %v", w.Code, w.Header())
+	}
+
+	w = httptest.NewRecorder()
+	w.WriteHeader(http.StatusOK)
+	WriteGRPCStatus(w, true, codes.DeadlineExceeded, "timeout")
+	if got := w.Header().Get(http.TrailerPrefix + "Grpc-Status"); got != "4" {
+		t.Errorf("expected the status in the trailers, but got %q", got)
+	}
+}
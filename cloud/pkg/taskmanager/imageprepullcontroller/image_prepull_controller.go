@@ -26,6 +26,7 @@ import (
 	"time"
 
 	jsonpatch "github.com/evanphx/json-patch"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryType "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -40,6 +41,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/controller"
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/manager"
 	commontypes "github.com/kubeedge/kubeedge/common/types"
+	appsv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/apps/v1alpha1"
 	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
 	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
@@ -88,6 +90,10 @@ func (ndc *ImagePrePullController) ReportNodeStatus(taskID, nodeID string, event
 	if err != nil {
 		return "", err
 	}
+	// the progress keeps the state of the node
+	if event.Type == api.EventProgress {
+		return state, nil
+	}
 	checkStatusChanged(nodeFSM, state)
 	state, err = nodeFSM.CurrentState()
 	if err != nil {
@@ -254,10 +260,14 @@ func (ndc *ImagePrePullController) imagePrePullJobAdded(imagePrePull *v1alpha1.I
 func (ndc *ImagePrePullController) processPrePull(imagePrePull *v1alpha1.ImagePrePullJob) {
 	imagePrePullTemplateInfo := imagePrePull.Spec.ImagePrePullTemplate
 	imagePrePullRequest := commontypes.ImagePrePullJobRequest{
-		Images:     imagePrePullTemplateInfo.Images,
-		Secret:     imagePrePullTemplateInfo.ImageSecret,
-		RetryTimes: imagePrePullTemplateInfo.RetryTimes,
-		CheckItems: imagePrePullTemplateInfo.CheckItems,
+		Images:      imagePrePullTemplateInfo.Images,
+		Secret:      imagePrePullTemplateInfo.ImageSecret,
+		RetryTimes:  imagePrePullTemplateInfo.RetryTimes,
+		CheckItems:  imagePrePullTemplateInfo.CheckItems,
+		PullWindows: imagePrePullTemplateInfo.PullWindows,
+	}
+	if imagePrePullTemplateInfo.BandwidthLimit != nil {
+		imagePrePullRequest.BandwidthLimit = imagePrePullTemplateInfo.BandwidthLimit.Value()
 	}
 	tolerate, err := strconv.ParseFloat(imagePrePull.Spec.ImagePrePullTemplate.FailureTolerate, 64)
 	if err != nil {
@@ -284,6 +294,39 @@ func (ndc *ImagePrePullController) processPrePull(imagePrePull *v1alpha1.ImagePr
 	}
 }
 
+// NodeRequest returns the image prepull request sent to the node,
+// with the image pull secrets of the node groups the node belongs to.
+func (ndc *ImagePrePullController) NodeRequest(taskID, nodeName string, req commontypes.ImagePrePullJobRequest) commontypes.ImagePrePullJobRequest {
+	v, ok := ndc.TaskManager.CacheMap.Load(taskID)
+	if !ok {
+		return req
+	}
+	secrets := v.(*v1alpha1.ImagePrePullJob).Spec.ImagePrePullTemplate.ImagePullSecrets
+	if len(secrets) == 0 {
+		return req
+	}
+	node, err := ndc.Informer.Core().V1().Nodes().Lister().Get(nodeName)
+	if err != nil {
+		klog.Warningf("failed to get node %s, only the image pull secrets of all the nodes are used, err: %v", nodeName, err)
+		node = nil
+	}
+	req.NodeName = nodeName
+	req.Secrets = nodeImagePullSecrets(secrets, node)
+	return req
+}
+
+// nodeImagePullSecrets returns the secrets of the entries without node group,
+// and the entries of the node group the node is selected as a member of
+func nodeImagePullSecrets(secrets []v1alpha1.NodeGroupImagePullSecret, node *v1.Node) []string {
+	var result []string
+	for _, s := range secrets {
+		if s.NodeGroup == "" || node != nil && node.Labels[appsv1alpha1.LabelBelongingTo] == s.NodeGroup {
+			result = append(result, s.Secrets...)
+		}
+	}
+	return result
+}
+
 // imagePrePullJobDeleted is used to process deleted ImagePrePullJob in apiserver
 func (ndc *ImagePrePullController) imagePrePullJobDeleted(imagePrePull *v1alpha1.ImagePrePullJob) {
 	// just need to delete from cache map
@@ -0,0 +1,278 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
+	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/util/fsm"
+)
+
+const canaryCheckInterval = 10 * time.Second
+
+// canaryController is the part of the NodeUpgradeController used by the canary gate
+type canaryController interface {
+	SelectCanaryNodes(nodes []string, canary *v1alpha1.CanaryStrategy) ([]string, error)
+	GetCanaryStatus(name string) (*v1alpha1.CanaryStatus, error)
+	UpdateCanaryStatus(name string, canary v1alpha1.CanaryStatus) error
+	TaskState(name string) (api.State, error)
+	CheckNodeHealth(name string) error
+}
+
+// canaryGate upgrades the canary nodes of a NodeUpgradeJob before the other nodes,
+// the other nodes are upgraded only after the canary nodes stay healthy for HealthySeconds.
+// The nodes are checked and backed up as usual, only the Upgrading stage is gated.
+type canaryGate struct {
+	strategy   v1alpha1.CanaryStrategy
+	controller canaryController
+	status     v1alpha1.CanaryStatus
+	nodes      map[string]bool
+	// verifying is true while the health gate is checking the canary nodes
+	verifying bool
+	result    chan error
+}
+
+// newCanaryGate loads the canary nodes of the task, or selects them if they are not selected yet.
+// The selected canary nodes are moved to the front of nodes, the returned bool is true if nodes
+// and the canary status need to be saved.
+func newCanaryGate(message util.TaskMessage, c canaryController, nodes []v1alpha1.TaskStatus) (*canaryGate, bool, error) {
+	status, err := c.GetCanaryStatus(message.Name)
+	if err != nil {
+		return nil, false, err
+	}
+	selected := false
+	if status == nil {
+		names := make([]string, len(nodes))
+		for i, node := range nodes {
+			names[i] = node.NodeName
+		}
+		canaryNodes, err := c.SelectCanaryNodes(names, message.Canary)
+		if err != nil {
+			return nil, false, err
+		}
+		status = &v1alpha1.CanaryStatus{
+			Nodes: canaryNodes,
+			Phase: v1alpha1.CanaryUpgrading,
+		}
+		selected = true
+	}
+
+	g := &canaryGate{
+		strategy:   *message.Canary,
+		controller: c,
+		status:     *status,
+		nodes:      make(map[string]bool, len(status.Nodes)),
+		result:     make(chan error, 1),
+	}
+	for _, name := range status.Nodes {
+		g.nodes[name] = true
+	}
+	if selected {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return g.nodes[nodes[i].NodeName] && !g.nodes[nodes[j].NodeName]
+		})
+	}
+	return g, selected, nil
+}
+
+func (g *canaryGate) healthySeconds() int32 {
+	if g.strategy.HealthySeconds > 0 {
+		return g.strategy.HealthySeconds
+	}
+	return util.DefaultCanaryHealthySeconds
+}
+
+func (g *canaryGate) gateTimeoutSeconds() int32 {
+	if g.strategy.GateTimeoutSeconds > 0 {
+		return g.strategy.GateTimeoutSeconds
+	}
+	return util.DefaultCanaryGateTimeoutSeconds
+}
+
+func (g *canaryGate) updateStatus(taskName string, phase v1alpha1.CanaryPhase, reason string) {
+	g.status.Phase = phase
+	g.status.Reason = reason
+	if err := g.controller.UpdateCanaryStatus(taskName, g.status); err != nil {
+		klog.Errorf("failed to update canary status of task %s to %s: %v", taskName, phase, err)
+	}
+}
+
+// allow reports whether node can be scheduled, the other nodes are not upgraded until the canary nodes pass the gate
+func (g *canaryGate) allow(node v1alpha1.TaskStatus) bool {
+	return g.status.Phase == v1alpha1.CanaryPassed || g.nodes[node.NodeName] || node.State != api.UpgradingState
+}
+
+// resume continues the canary phase saved before cloudcore restarts, it returns true if the task is finished
+func (g *canaryGate) resume(e *Executor) bool {
+	if g.status.Phase == v1alpha1.CanaryRollingBack {
+		return g.rollback(e)
+	}
+	g.wait(e)
+	return false
+}
+
+// wait returns true if no node can be scheduled until the health gate finishes,
+// it starts the health gate once all the canary nodes are upgraded
+func (g *canaryGate) wait(e *Executor) bool {
+	switch g.status.Phase {
+	case v1alpha1.CanaryPassed:
+		return false
+	case v1alpha1.CanaryRollingBack, v1alpha1.CanaryFailed:
+		return true
+	}
+	if g.verifying {
+		return true
+	}
+
+	state, err := g.controller.TaskState(e.task.Name)
+	if err != nil {
+		klog.Errorf("get task %s state failed: %v", e.task.Name, err)
+		return false
+	}
+	if state != api.UpgradingState || e.workers.running() != 0 {
+		return false
+	}
+	var failed []string
+	for _, node := range e.nodes {
+		if !g.nodes[node.NodeName] {
+			continue
+		}
+		if !fsm.TaskFinish(node.State) {
+			return false
+		}
+		if node.State == api.TaskFailed {
+			failed = append(failed, node.NodeName)
+		}
+	}
+
+	g.verifying = true
+	if len(failed) != 0 {
+		g.result <- fmt.Errorf("canary nodes %v failed to upgrade", failed)
+		return true
+	}
+	klog.Infof("canary nodes of task %s are upgraded, start the health gate", e.task.Name)
+	g.updateStatus(e.task.Name, v1alpha1.CanaryVerifying, "")
+	go func() {
+		err := g.verify()
+		if beehiveContext.GetContext().Err() != nil {
+			return
+		}
+		g.result <- err
+	}()
+	return true
+}
+
+// verify waits until all the canary nodes stay healthy for HealthySeconds, or returns an error after GateTimeoutSeconds
+func (g *canaryGate) verify() error {
+	healthy := time.Duration(g.healthySeconds()) * time.Second
+	timeout := time.Duration(g.gateTimeoutSeconds()) * time.Second
+	since := make(map[string]time.Time, len(g.nodes))
+	var lastErr error
+	err := wait.PollUntilContextTimeout(beehiveContext.GetContext(), canaryCheckInterval, timeout, true, func(context.Context) (bool, error) {
+		passed := true
+		lastErr = nil
+		for name := range g.nodes {
+			if err := g.controller.CheckNodeHealth(name); err != nil {
+				lastErr = fmt.Errorf("canary node %s is unhealthy: %v", name, err)
+				delete(since, name)
+				passed = false
+				continue
+			}
+			if _, ok := since[name]; !ok {
+				since[name] = time.Now()
+			}
+			if time.Since(since[name]) < healthy {
+				passed = false
+			}
+		}
+		return passed, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return lastErr
+		}
+		return fmt.Errorf("canary nodes are not healthy for %v in %v", healthy, timeout)
+	}
+	return nil
+}
+
+// finish handles the result of the health gate, it returns true if the task is finished
+func (g *canaryGate) finish(e *Executor, gateErr error) bool {
+	g.verifying = false
+	if gateErr == nil {
+		klog.Infof("canary nodes of task %s pass the health gate", e.task.Name)
+		g.updateStatus(e.task.Name, v1alpha1.CanaryPassed, "")
+		return false
+	}
+
+	klog.Warningf("canary nodes of task %s fail the health gate: %v", e.task.Name, gateErr)
+	if g.strategy.FailurePolicy == v1alpha1.CanaryFailureRollback {
+		g.updateStatus(e.task.Name, v1alpha1.CanaryRollingBack, gateErr.Error())
+		return g.rollback(e)
+	}
+
+	g.updateStatus(e.task.Name, v1alpha1.CanaryFailed, gateErr.Error())
+	_, err := e.controller.ReportTaskStatus(e.task.Name, fsm.Event{
+		Type:   "HealthCheck",
+		Action: api.ActionFailure,
+		Msg:    fmt.Sprintf("task is paused, %s", gateErr.Error()),
+	})
+	if err != nil {
+		klog.Errorf("pause task %s failed: %v", e.task.Name, err)
+	}
+	return true
+}
+
+// rollback rolls back the upgraded canary nodes from their backup, it returns true
+// if all of them are rolled back and the task is failed
+func (g *canaryGate) rollback(e *Executor) bool {
+	for i, node := range e.nodes {
+		if !g.nodes[node.NodeName] || node.State != api.TaskSuccessful {
+			continue
+		}
+		if !e.workers.startJob(node.NodeName, i) {
+			continue
+		}
+		klog.Infof("roll back canary node %s of task %s", node.NodeName, e.task.Name)
+		e.nodes[i].State = api.RollingBackState
+		e.sendJob(e.nodes[i], i)
+	}
+	if e.workers.running() != 0 {
+		return false
+	}
+
+	g.updateStatus(e.task.Name, v1alpha1.CanaryFailed, g.status.Reason)
+	_, err := e.controller.ReportTaskStatus(e.task.Name, fsm.Event{
+		Type:   "Rollback",
+		Action: api.ActionFailure,
+		Msg:    fmt.Sprintf("canary nodes are rolled back, %s", g.status.Reason),
+	})
+	if err != nil {
+		klog.Errorf("fail task %s failed: %v", e.task.Name, err)
+	}
+	return true
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/controller"
+	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/util/fsm"
+)
+
+type fakeCanaryController struct {
+	canaryNodes []string
+	status      *v1alpha1.CanaryStatus
+	state       api.State
+	healthErr   error
+	phases      []v1alpha1.CanaryPhase
+}
+
+func (c *fakeCanaryController) SelectCanaryNodes([]string, *v1alpha1.CanaryStrategy) ([]string, error) {
+	return c.canaryNodes, nil
+}
+
+func (c *fakeCanaryController) GetCanaryStatus(string) (*v1alpha1.CanaryStatus, error) {
+	return c.status, nil
+}
+
+func (c *fakeCanaryController) UpdateCanaryStatus(_ string, canary v1alpha1.CanaryStatus) error {
+	c.phases = append(c.phases, canary.Phase)
+	return nil
+}
+
+func (c *fakeCanaryController) TaskState(string) (api.State, error) {
+	return c.state, nil
+}
+
+func (c *fakeCanaryController) CheckNodeHealth(string) error {
+	return c.healthErr
+}
+
+// fakeTaskController records the task events reported by the canary gate
+type fakeTaskController struct {
+	controller.Controller
+	lock   sync.Mutex
+	events []fsm.Event
+}
+
+func (c *fakeTaskController) ReportTaskStatus(_ string, event fsm.Event) (api.State, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.events = append(c.events, event)
+	return api.TaskFailed, nil
+}
+
+func (c *fakeTaskController) ReportNodeStatus(string, string, fsm.Event) (api.State, error) {
+	return api.TaskFailed, nil
+}
+
+func newCanaryExecutor(gate *canaryGate, nodes []v1alpha1.TaskStatus) (*Executor, *fakeTaskController) {
+	executorMachine = &ExecutorMachine{downStreamChan: make(chan model.Message, len(nodes))}
+	timeout := uint32(1)
+	taskController := &fakeTaskController{}
+	return &Executor{
+		task:       util.TaskMessage{Name: "task", TimeOutSeconds: &timeout},
+		nodes:      nodes,
+		controller: taskController,
+		workers: workers{
+			number: 1,
+			jobs:   make(map[string]int),
+		},
+		canary: gate,
+	}, taskController
+}
+
+func TestNewCanaryGate(t *testing.T) {
+	c := &fakeCanaryController{canaryNodes: []string{"node-c"}}
+	nodes := []v1alpha1.TaskStatus{{NodeName: "node-a"}, {NodeName: "node-b"}, {NodeName: "node-c"}}
+	message := util.TaskMessage{Name: "task", Canary: &v1alpha1.CanaryStrategy{Percentage: 10}}
+
+	gate, selected, err := newCanaryGate(message, c, nodes)
+	if err != nil {
+		t.Fatalf("new canary gate failed: %v", err)
+	}
+	if !selected {
+		t.Errorf("expected the canary nodes to be selected")
+	}
+	if gate.status.Phase != v1alpha1.CanaryUpgrading {
+		t.Errorf("expected phase %s, but got %s", v1alpha1.CanaryUpgrading, gate.status.Phase)
+	}
+	var names []string
+	for _, node := range nodes {
+		names = append(names, node.NodeName)
+	}
+	if expected := []string{"node-c", "node-a", "node-b"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected the canary nodes first %v, but got %v", expected, names)
+	}
+
+	// the canary nodes selected before cloudcore restarts are kept
+	c.status = &v1alpha1.CanaryStatus{Nodes: []string{"node-a"}, Phase: v1alpha1.CanaryVerifying}
+	gate, selected, err = newCanaryGate(message, c, nodes)
+	if err != nil {
+		t.Fatalf("new canary gate failed: %v", err)
+	}
+	if selected || !gate.nodes["node-a"] || gate.nodes["node-c"] || gate.status.Phase != v1alpha1.CanaryVerifying {
+		t.Errorf("expected the saved canary status to be loaded, but got %+v", gate.status)
+	}
+}
+
+func TestCanaryGateAllow(t *testing.T) {
+	gate := &canaryGate{
+		status: v1alpha1.CanaryStatus{Phase: v1alpha1.CanaryUpgrading},
+		nodes:  map[string]bool{"canary": true},
+	}
+	cases := []struct {
+		node     v1alpha1.TaskStatus
+		expected bool
+	}{
+		{node: v1alpha1.TaskStatus{NodeName: "canary", State: api.UpgradingState}, expected: true},
+		{node: v1alpha1.TaskStatus{NodeName: "other", State: api.UpgradingState}, expected: false},
+		{node: v1alpha1.TaskStatus{NodeName: "other", State: api.TaskChecking}, expected: true},
+	}
+	for _, c := range cases {
+		if allowed := gate.allow(c.node); allowed != c.expected {
+			t.Errorf("expected allow %s in state %s to be %v, but got %v", c.node.NodeName, c.node.State, c.expected, allowed)
+		}
+	}
+
+	gate.status.Phase = v1alpha1.CanaryPassed
+	if !gate.allow(v1alpha1.TaskStatus{NodeName: "other", State: api.UpgradingState}) {
+		t.Errorf("expected the other nodes to be allowed once the canary nodes pass")
+	}
+}
+
+func TestCanaryGateWait(t *testing.T) {
+	cases := []struct {
+		name      string
+		phase     v1alpha1.CanaryPhase
+		state     api.State
+		running   bool
+		nodeState api.State
+		expected  bool
+		gateErr   bool
+	}{
+		{name: "passed", phase: v1alpha1.CanaryPassed, expected: false},
+		{name: "failed", phase: v1alpha1.CanaryFailed, expected: true},
+		{name: "task not upgrading", phase: v1alpha1.CanaryUpgrading, state: api.TaskChecking, nodeState: api.TaskSuccessful, expected: false},
+		{name: "canary job running", phase: v1alpha1.CanaryUpgrading, state: api.UpgradingState, running: true, nodeState: api.TaskSuccessful, expected: false},
+		{name: "canary node not finished", phase: v1alpha1.CanaryUpgrading, state: api.UpgradingState, nodeState: api.UpgradingState, expected: false},
+		{name: "canary node failed", phase: v1alpha1.CanaryUpgrading, state: api.UpgradingState, nodeState: api.TaskFailed, expected: true, gateErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gate := &canaryGate{
+				controller: &fakeCanaryController{state: c.state},
+				status:     v1alpha1.CanaryStatus{Phase: c.phase},
+				nodes:      map[string]bool{"canary": true},
+				result:     make(chan error, 1),
+			}
+			e, _ := newCanaryExecutor(gate, []v1alpha1.TaskStatus{{NodeName: "canary", State: c.nodeState}, {NodeName: "other", State: api.UpgradingState}})
+			if c.running {
+				e.workers.jobs["canary"] = 0
+			}
+
+			if waiting := gate.wait(e); waiting != c.expected {
+				t.Errorf("expected wait to be %v, but got %v", c.expected, waiting)
+			}
+			select {
+			case err := <-gate.result:
+				if !c.gateErr || err == nil {
+					t.Errorf("unexpected health gate result: %v", err)
+				}
+			default:
+				if c.gateErr {
+					t.Errorf("expected the health gate to fail")
+				}
+			}
+		})
+	}
+}
+
+func TestCanaryGateVerifyUnhealthy(t *testing.T) {
+	gate := &canaryGate{
+		strategy:   v1alpha1.CanaryStrategy{GateTimeoutSeconds: 1},
+		controller: &fakeCanaryController{healthErr: errors.New("node is not ready")},
+		nodes:      map[string]bool{"canary": true},
+	}
+	err := gate.verify()
+	if err == nil {
+		t.Fatalf("expected the unhealthy canary node to fail the health gate")
+	}
+	if expected := "canary node canary is unhealthy: node is not ready"; err.Error() != expected {
+		t.Errorf("expected error %q, but got %q", expected, err.Error())
+	}
+}
+
+func TestCanaryGateFinish(t *testing.T) {
+	newGate := func(policy v1alpha1.CanaryFailurePolicy) (*canaryGate, *fakeCanaryController) {
+		c := &fakeCanaryController{}
+		return &canaryGate{
+			strategy:   v1alpha1.CanaryStrategy{FailurePolicy: policy},
+			controller: c,
+			status:     v1alpha1.CanaryStatus{Phase: v1alpha1.CanaryVerifying},
+			nodes:      map[string]bool{"canary": true},
+			verifying:  true,
+		}, c
+	}
+	nodes := func() []v1alpha1.TaskStatus {
+		return []v1alpha1.TaskStatus{{NodeName: "canary", State: api.TaskSuccessful}, {NodeName: "other", State: api.UpgradingState}}
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		gate, c := newGate(v1alpha1.CanaryFailurePause)
+		e, taskController := newCanaryExecutor(gate, nodes())
+		if gate.finish(e, nil) {
+			t.Errorf("expected the task not to finish once the canary nodes pass")
+		}
+		if gate.verifying || !reflect.DeepEqual(c.phases, []v1alpha1.CanaryPhase{v1alpha1.CanaryPassed}) {
+			t.Errorf("expected phase %s, but got %v", v1alpha1.CanaryPassed, c.phases)
+		}
+		if len(taskController.events) != 0 {
+			t.Errorf("expected no task event, but got %v", taskController.events)
+		}
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		gate, c := newGate(v1alpha1.CanaryFailurePause)
+		e, taskController := newCanaryExecutor(gate, nodes())
+		if !gate.finish(e, errors.New("unhealthy")) {
+			t.Errorf("expected the task to finish once the canary nodes fail")
+		}
+		if !reflect.DeepEqual(c.phases, []v1alpha1.CanaryPhase{v1alpha1.CanaryFailed}) {
+			t.Errorf("expected phase %s, but got %v", v1alpha1.CanaryFailed, c.phases)
+		}
+		if len(taskController.events) != 1 || taskController.events[0].Action != api.ActionFailure {
+			t.Errorf("expected the task to fail, but got %v", taskController.events)
+		}
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		gate, c := newGate(v1alpha1.CanaryFailureRollback)
+		e, taskController := newCanaryExecutor(gate, nodes())
+		if gate.finish(e, errors.New("unhealthy")) {
+			t.Errorf("expected the task not to finish until the canary nodes are rolled back")
+		}
+		if !reflect.DeepEqual(c.phases, []v1alpha1.CanaryPhase{v1alpha1.CanaryRollingBack}) {
+			t.Errorf("expected phase %s, but got %v", v1alpha1.CanaryRollingBack, c.phases)
+		}
+		if e.nodes[0].State != api.RollingBackState || e.workers.running() != 1 {
+			t.Errorf("expected the canary node to be rolling back, but got %s with %d jobs", e.nodes[0].State, e.workers.running())
+		}
+		if e.nodes[1].State != api.UpgradingState {
+			t.Errorf("expected the other node not to be rolled back, but got %s", e.nodes[1].State)
+		}
+		if len(executorMachine.downStreamChan) != 1 {
+			t.Errorf("expected the rollback job to be sent, but got %d messages", len(executorMachine.downStreamChan))
+		}
+
+		// the job is not sent again while the canary node is rolling back
+		if gate.rollback(e) || len(executorMachine.downStreamChan) != 1 {
+			t.Errorf("expected the rollback job not to be sent again")
+		}
+
+		if _, err := e.workers.endJob("canary"); err != nil {
+			t.Fatalf("end job failed: %v", err)
+		}
+		if !gate.rollback(e) {
+			t.Errorf("expected the task to finish once the canary nodes are rolled back")
+		}
+		if !reflect.DeepEqual(c.phases, []v1alpha1.CanaryPhase{v1alpha1.CanaryRollingBack, v1alpha1.CanaryFailed}) {
+			t.Errorf("expected phase %s, but got %v", v1alpha1.CanaryFailed, c.phases)
+		}
+		taskController.lock.Lock()
+		defer taskController.lock.Unlock()
+		if len(taskController.events) != 1 || taskController.events[0].Type != "Rollback" {
+			t.Errorf("expected the task to fail after the rollback, but got %v", taskController.events)
+		}
+	})
+}
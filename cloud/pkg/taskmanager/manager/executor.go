@@ -32,6 +32,7 @@ import (
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/client"
 	"github.com/kubeedge/kubeedge/cloud/pkg/common/modules"
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/imageprepullcontroller"
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/nodeupgradecontroller"
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/controller"
@@ -52,6 +53,10 @@ type Executor struct {
 	maxFailedNodes float64
 	failedNodes    map[string]bool
 	workers        workers
+	// canary is not nil if the canary nodes of the task are upgraded before the other nodes
+	canary *canaryGate
+	// progress is the last time the nodes report the progress of the jobs
+	progress sync.Map
 }
 
 func NewExecutorMachine(messageChan chan util.TaskMessage, downStreamChan chan model.Message) (*ExecutorMachine, error) {
@@ -161,6 +166,10 @@ func (e *Executor) initMessage(node v1alpha1.TaskStatus) *model.Message {
 		taskReq.Item = commontypes.NodePreCheckRequest{
 			CheckItem: e.task.CheckItem,
 		}
+	} else if req, ok := e.task.Msg.(commontypes.ImagePrePullJobRequest); ok {
+		if prePullController, ok := e.controller.(*imageprepullcontroller.ImagePrePullController); ok {
+			taskReq.Item = prePullController.NodeRequest(e.task.Name, node.NodeName, req)
+		}
 	}
 	msg.BuildRouter(modules.TaskManagerModuleName, modules.TaskManagerModuleGroup, resource, e.task.Type).
 		FillBody(taskReq)
@@ -207,6 +216,7 @@ func initExecutor(message util.TaskMessage) (*Executor, error) {
 	if err != nil {
 		return nil, err
 	}
+	statusChanged := false
 	if len(nodeStatus) == 0 {
 		nodeList := controller.ValidateNode(message)
 		if len(nodeList) == 0 {
@@ -216,11 +226,29 @@ func initExecutor(message util.TaskMessage) (*Executor, error) {
 		for i, node := range nodeList {
 			nodeStatus[i] = v1alpha1.TaskStatus{NodeName: node.Name}
 		}
+		statusChanged = true
+	}
+	var canary *canaryGate
+	canarySelected := false
+	if message.Type == util.TaskUpgrade && message.Canary != nil {
+		upgradeController, ok := controller.(*nodeupgradecontroller.NodeUpgradeController)
+		if !ok {
+			return nil, fmt.Errorf("controller %s does not support canary upgrade", controller.Name())
+		}
+		canary, canarySelected, err = newCanaryGate(message, upgradeController, nodeStatus)
+		if err != nil {
+			return nil, fmt.Errorf("init canary nodes failed: %v", err)
+		}
+	}
+	if statusChanged || canarySelected {
 		err = controller.UpdateNodeStatus(message.Name, nodeStatus)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if canarySelected {
+		canary.updateStatus(message.Name, v1alpha1.CanaryUpgrading, "")
+	}
 	e := &Executor{
 		task:           message,
 		statusChan:     make(chan *v1alpha1.TaskStatus, 10),
@@ -234,6 +262,7 @@ func initExecutor(message util.TaskMessage) (*Executor, error) {
 			shuttingDown: false,
 			Mutex:        sync.Mutex{},
 		},
+		canary: canary,
 	}
 	go e.start()
 	executorMachine.executors[fmt.Sprintf("%s::%s", message.Type, message.Name)] = e
@@ -246,11 +275,26 @@ func (e *Executor) start() {
 		klog.Errorf(err.Error())
 		return
 	}
+	// the canary nodes may have been upgraded or rolling back before cloudcore restarts
+	if e.canary != nil && e.canary.resume(e) {
+		DeleteExecutor(e.task)
+		return
+	}
 	for {
 		select {
 		case <-beehiveContext.Done():
 			klog.Info("stop sync tasks")
 			return
+		case gateErr := <-e.canaryResult():
+			if e.canary.finish(e, gateErr) {
+				DeleteExecutor(e.task)
+				klog.Infof("task %s is finish", e.task.Name)
+				return
+			}
+			var finished bool
+			if index, finished = e.next(index); finished {
+				return
+			}
 		case status := <-e.statusChan:
 			if reflect.DeepEqual(*status, v1alpha1.TaskStatus{}) {
 				break
@@ -266,38 +310,67 @@ func (e *Executor) start() {
 			}
 
 			e.nodes[endNode] = *status
+			if e.canary != nil && e.canary.status.Phase == v1alpha1.CanaryRollingBack {
+				if e.canary.rollback(e) {
+					DeleteExecutor(e.task)
+					klog.Infof("task %s is finish", e.task.Name)
+					return
+				}
+				break
+			}
 			err = e.dealFailedNode(*status)
 			if err != nil {
 				klog.Warning(err.Error())
 				break
 			}
 
-			if index >= len(e.nodes) {
-				if len(e.workers.jobs) != 0 {
-					break
-				}
-				var state api.State
-				state, err = e.completedTaskStage()
-				if err != nil {
-					klog.Errorf(err.Error())
-					break
-				}
-				if fsm.TaskFinish(state) {
-					DeleteExecutor(e.task)
-					klog.Infof("task %s is finish", e.task.Name)
-					return
-				}
-
-				// next stage
-				index = 0
+			var finished bool
+			if index, finished = e.next(index); finished {
+				return
 			}
+		}
+	}
+}
 
-			index, err = e.initWorker(index)
-			if err != nil {
-				klog.Errorf(err.Error())
-			}
+// next schedules the nodes from index, or moves the task to the next stage once
+// all the nodes complete the current stage. It returns true if the task is finished.
+func (e *Executor) next(index int) (int, bool) {
+	if e.canary != nil && e.canary.wait(e) {
+		return index, false
+	}
+
+	if index >= len(e.nodes) {
+		if len(e.workers.jobs) != 0 {
+			return index, false
+		}
+		state, err := e.completedTaskStage()
+		if err != nil {
+			klog.Errorf(err.Error())
+			return index, false
 		}
+		if fsm.TaskFinish(state) {
+			DeleteExecutor(e.task)
+			klog.Infof("task %s is finish", e.task.Name)
+			return index, true
+		}
+
+		// next stage
+		index = 0
 	}
+
+	index, err := e.initWorker(index)
+	if err != nil {
+		klog.Errorf(err.Error())
+	}
+	return index, false
+}
+
+// canaryResult returns the channel of the health gate result, it is nil if the task has no canary nodes
+func (e *Executor) canaryResult() <-chan error {
+	if e.canary == nil {
+		return nil
+	}
+	return e.canary.result
 }
 
 func (e *Executor) dealFailedNode(node v1alpha1.TaskStatus) error {
@@ -353,6 +426,9 @@ func (e *Executor) initWorker(index int) (int, error) {
 			}
 			continue
 		}
+		if e.canary != nil && !e.canary.allow(node) {
+			break
+		}
 		err := e.workers.addJob(node, index, e)
 		if err != nil {
 			klog.V(4).Info(err.Error())
@@ -380,10 +456,33 @@ func (w *workers) addJob(node v1alpha1.TaskStatus, index int, e *Executor) error
 	}
 	w.jobs[node.NodeName] = index
 	w.Unlock()
+	e.sendJob(node, index)
+	return nil
+}
+
+// startJob adds the job of the node regardless of the number of workers,
+// it returns false if the job of the node is already running
+func (w *workers) startJob(nodeName string, index int) bool {
+	w.Lock()
+	defer w.Unlock()
+	if _, ok := w.jobs[nodeName]; ok {
+		return false
+	}
+	w.jobs[nodeName] = index
+	return true
+}
+
+// running returns the number of the running jobs
+func (w *workers) running() int {
+	w.Lock()
+	defer w.Unlock()
+	return len(w.jobs)
+}
+
+func (e *Executor) sendJob(node v1alpha1.TaskStatus, index int) {
 	msg := e.initMessage(node)
 	go e.handelTimeOutJob(index)
 	executorMachine.downStreamChan <- *msg
-	return nil
 }
 
 func (e *Executor) handelTimeOutJob(index int) {
@@ -392,10 +491,16 @@ func (e *Executor) handelTimeOutJob(index int) {
 	if timeoutSecond == 0 {
 		timeoutSecond = TimeOutSecond
 	}
-	err := wait.Poll(1*time.Second, time.Duration(timeoutSecond)*time.Second, func() (bool, error) {
+	timeout := time.Duration(timeoutSecond) * time.Second
+	start := time.Now()
+	err := wait.PollInfinite(1*time.Second, func() (bool, error) {
 		if lastState != e.nodes[index].State || fsm.TaskFinish(e.nodes[index].State) {
 			return true, nil
 		}
+		// the timeout counts from the last progress reported by the node
+		if time.Since(e.lastProgress(e.nodes[index].NodeName, start)) > timeout {
+			return false, wait.ErrWaitTimeout
+		}
 		klog.V(4).Infof("node %s stage is not completed", e.nodes[index].NodeName)
 		return false, nil
 	})
@@ -411,6 +516,25 @@ func (e *Executor) handelTimeOutJob(index int) {
 	}
 }
 
+// lastProgress returns the last time the node reports the progress of the job started at start
+func (e *Executor) lastProgress(nodeName string, start time.Time) time.Time {
+	v, ok := e.progress.Load(nodeName)
+	if !ok || v.(time.Time).Before(start) {
+		return start
+	}
+	return v.(time.Time)
+}
+
+// reportProgress records the progress reported by the node, which resets the timeout of the node job
+func reportProgress(taskType, taskID, nodeID string) {
+	executorMachine.Lock()
+	e, ok := executorMachine.executors[fmt.Sprintf("%s::%s", taskType, taskID)]
+	executorMachine.Unlock()
+	if ok && e != nil {
+		e.progress.Store(nodeID, time.Now())
+	}
+}
+
 func (w *workers) endJob(job string) (int, error) {
 	index, ok := w.jobs[job]
 	if !ok {
@@ -32,6 +32,7 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
 	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/controller"
 	"github.com/kubeedge/kubeedge/common/types"
+	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
 	crdClientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
 	"github.com/kubeedge/kubeedge/pkg/util/fsm"
 )
@@ -128,6 +129,9 @@ func (uc *UpstreamController) updateTaskStatus() {
 				klog.Errorf("Failed to report status: %v", err)
 				continue
 			}
+			if event.Type == api.EventProgress {
+				reportProgress(msg.GetOperation(), taskID, nodeID)
+			}
 		}
 	}
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeupgradecontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util"
+	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+// SelectCanaryNodes selects the canary nodes from nodes by the canary strategy
+func (ndc *NodeUpgradeController) SelectCanaryNodes(nodes []string, canary *v1alpha1.CanaryStrategy) ([]string, error) {
+	if canary.LabelSelector == nil {
+		count := (len(nodes)*int(canary.Percentage) + 99) / 100
+		if count < 1 {
+			count = 1
+		}
+		if count > len(nodes) {
+			count = len(nodes)
+		}
+		return nodes[:count], nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(canary.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("canary labelSelector(%s) is not valid: %v", canary.LabelSelector, err)
+	}
+	var canaryNodes []string
+	for _, name := range nodes {
+		node, err := ndc.Informer.Core().V1().Nodes().Lister().Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node with name %s: %v", name, err)
+		}
+		if selector.Matches(labels.Set(node.Labels)) {
+			canaryNodes = append(canaryNodes, name)
+		}
+	}
+	if len(canaryNodes) == 0 {
+		return nil, fmt.Errorf("no node matches the canary labelSelector %s", selector.String())
+	}
+	return canaryNodes, nil
+}
+
+// GetCanaryStatus returns the canary status of the NodeUpgradeJob, it is nil if the canary nodes are not selected
+func (ndc *NodeUpgradeController) GetCanaryStatus(name string) (*v1alpha1.CanaryStatus, error) {
+	nodeUpgrade, err := ndc.CrdClient.OperationsV1alpha1().NodeUpgradeJobs().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return nodeUpgrade.Status.Canary, nil
+}
+
+// UpdateCanaryStatus updates the canary status of the NodeUpgradeJob
+func (ndc *NodeUpgradeController) UpdateCanaryStatus(name string, canary v1alpha1.CanaryStatus) error {
+	nodeUpgrade, err := ndc.CrdClient.OperationsV1alpha1().NodeUpgradeJobs().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	status := nodeUpgrade.Status
+	canary.Time = time.Now().Format(util.ISO8601UTC)
+	status.Canary = &canary
+	return patchStatus(nodeUpgrade, status, ndc.CrdClient)
+}
+
+// TaskState returns the current state of the NodeUpgradeJob
+func (ndc *NodeUpgradeController) TaskState(name string) (api.State, error) {
+	return NewUpgradeTaskFSM(name).CurrentState()
+}
+
+// CheckNodeHealth returns an error if the node or any pod running on it is not ready
+func (ndc *NodeUpgradeController) CheckNodeHealth(name string) error {
+	node, err := ndc.Informer.Core().V1().Nodes().Lister().Get(name)
+	if err != nil {
+		return fmt.Errorf("failed to get node: %v", err)
+	}
+	if !conditionTrue(node.Status.Conditions, v1.NodeReady) {
+		return fmt.Errorf("node is not ready")
+	}
+
+	pods, err := ndc.KubeClient.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %v", err)
+	}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if !podReady(&pod) {
+			return fmt.Errorf("pod %s/%s is not ready", pod.Namespace, pod.Name)
+		}
+	}
+	return nil
+}
+
+func conditionTrue(conditions []v1.NodeCondition, conditionType v1.NodeConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodeupgradecontroller
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeedge/kubeedge/cloud/pkg/taskmanager/util/controller"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+func newNode(name string, labels map[string]string, ready v1.ConditionStatus) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func newPod(name, nodeName string, phase v1.PodPhase, ready v1.ConditionStatus) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       v1.PodSpec{NodeName: nodeName},
+		Status: v1.PodStatus{
+			Phase:      phase,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func deletingPod(pod *v1.Pod) *v1.Pod {
+	now := metav1.Now()
+	pod.DeletionTimestamp = &now
+	return pod
+}
+
+func newTestController(t *testing.T, nodes []*v1.Node, pods ...*v1.Pod) *NodeUpgradeController {
+	kubeClient := fake.NewSimpleClientset()
+	for _, pod := range pods {
+		if err := kubeClient.Tracker().Add(pod); err != nil {
+			t.Fatalf("add pod %s failed: %v", pod.Name, err)
+		}
+	}
+	factory := informers.NewSharedInformerFactory(kubeClient, 0)
+	indexer := factory.Core().V1().Nodes().Informer().GetIndexer()
+	for _, node := range nodes {
+		if err := indexer.Add(node); err != nil {
+			t.Fatalf("add node %s failed: %v", node.Name, err)
+		}
+	}
+	return &NodeUpgradeController{
+		BaseController: &controller.BaseController{
+			Informer:   factory,
+			KubeClient: kubeClient,
+		},
+	}
+}
+
+func TestSelectCanaryNodes(t *testing.T) {
+	nodes := []*v1.Node{
+		newNode("node-1", map[string]string{"canary": "true"}, v1.ConditionTrue),
+		newNode("node-2", nil, v1.ConditionTrue),
+		newNode("node-3", map[string]string{"canary": "true"}, v1.ConditionTrue),
+	}
+	ndc := newTestController(t, nodes)
+	names := []string{"node-1", "node-2", "node-3"}
+
+	cases := []struct {
+		name      string
+		nodes     []string
+		canary    v1alpha1.CanaryStrategy
+		expected  []string
+		expectErr bool
+	}{
+		{name: "round up", nodes: names, canary: v1alpha1.CanaryStrategy{Percentage: 34}, expected: []string{"node-1", "node-2"}},
+		{name: "exact percentage", nodes: names, canary: v1alpha1.CanaryStrategy{Percentage: 100}, expected: names},
+		{name: "at least one node", nodes: names, canary: v1alpha1.CanaryStrategy{Percentage: 1}, expected: []string{"node-1"}},
+		{name: "no more than the nodes", nodes: names[:1], canary: v1alpha1.CanaryStrategy{Percentage: 50}, expected: []string{"node-1"}},
+		{
+			name:     "label selector",
+			nodes:    names,
+			canary:   v1alpha1.CanaryStrategy{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+			expected: []string{"node-1", "node-3"},
+		},
+		{
+			name:      "no node matches",
+			nodes:     names,
+			canary:    v1alpha1.CanaryStrategy{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "false"}}},
+			expectErr: true,
+		},
+		{
+			name:      "node not found",
+			nodes:     []string{"node-4"},
+			canary:    v1alpha1.CanaryStrategy{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+			expectErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			canaryNodes, err := ndc.SelectCanaryNodes(c.nodes, &c.canary)
+			if (err != nil) != c.expectErr {
+				t.Fatalf("expected error %v, but got %v", c.expectErr, err)
+			}
+			if !c.expectErr && !reflect.DeepEqual(canaryNodes, c.expected) {
+				t.Errorf("expected canary nodes %v, but got %v", c.expected, canaryNodes)
+			}
+		})
+	}
+}
+
+func TestCheckNodeHealth(t *testing.T) {
+	cases := []struct {
+		name      string
+		node      *v1.Node
+		pods      []*v1.Pod
+		expectErr bool
+	}{
+		{
+			name: "healthy",
+			node: newNode("node", nil, v1.ConditionTrue),
+			pods: []*v1.Pod{
+				newPod("running", "node", v1.PodRunning, v1.ConditionTrue),
+				newPod("succeeded", "node", v1.PodSucceeded, v1.ConditionFalse),
+				deletingPod(newPod("deleting", "node", v1.PodRunning, v1.ConditionFalse)),
+			},
+		},
+		{name: "node not ready", node: newNode("node", nil, v1.ConditionFalse), expectErr: true},
+		{name: "node not found", node: newNode("other", nil, v1.ConditionTrue), expectErr: true},
+		{
+			name:      "pod not ready",
+			node:      newNode("node", nil, v1.ConditionTrue),
+			pods:      []*v1.Pod{newPod("running", "node", v1.PodRunning, v1.ConditionFalse)},
+			expectErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ndc := newTestController(t, []*v1.Node{c.node}, c.pods...)
+			err := ndc.CheckNodeHealth("node")
+			if (err != nil) != c.expectErr {
+				t.Errorf("expected error %v, but got %v", c.expectErr, err)
+			}
+		})
+	}
+}
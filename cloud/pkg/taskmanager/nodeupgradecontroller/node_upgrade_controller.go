@@ -264,6 +264,11 @@ func (ndc *NodeUpgradeController) nodeUpgradeJobAdded(upgrade *v1alpha1.NodeUpgr
 		klog.Warning("The nodeUpgradeJob is completed, don't send upgrade message again")
 		return
 	}
+	// the job is paused by the canary health gate, the other nodes must not be upgraded
+	if upgrade.Status.State == api.TaskPause {
+		klog.Warningf("The nodeUpgradeJob %s is paused, don't send upgrade message again", upgrade.Name)
+		return
+	}
 
 	ndc.processUpgrade(upgrade)
 }
@@ -312,6 +317,7 @@ func (ndc *NodeUpgradeController) processUpgrade(upgrade *v1alpha1.NodeUpgradeJo
 		FailureTolerate: tolerate,
 		NodeNames:       upgrade.Spec.NodeNames,
 		LabelSelector:   upgrade.Spec.LabelSelector,
+		Canary:          upgrade.Spec.Canary,
 		Status:          v1alpha1.TaskStatus{},
 		Msg:             upgradeReq,
 	}
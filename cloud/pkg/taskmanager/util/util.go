@@ -45,6 +45,11 @@ const (
 	ISO8601UTC = "2006-01-02T15:04:05Z"
 )
 
+const (
+	DefaultCanaryHealthySeconds     = 300
+	DefaultCanaryGateTimeoutSeconds = 900
+)
+
 type TaskMessage struct {
 	Type            string
 	Name            string
@@ -55,6 +60,7 @@ type TaskMessage struct {
 	FailureTolerate float64
 	NodeNames       []string
 	LabelSelector   *v1.LabelSelector
+	Canary          *v1alpha1.CanaryStrategy
 	Status          v1alpha1.TaskStatus
 	Msg             interface{}
 }
@@ -23,11 +23,24 @@ const (
 	RemoteContainerRuntime = "remote"
 )
 
+// Bootstrap tokens are per-node tokens issued by "keadm token create", each of them
+// is recorded in a secret of SystemNamespace which is deleted to revoke the token.
+const (
+	BootstrapTokenSecretType    v1.SecretType = "kubeedge.io/bootstrap-token"
+	BootstrapTokenSecretPrefix                = "bootstrap-token-"
+	BootstrapTokenIDKey                       = "token-id"
+	BootstrapTokenNodeNameKey                 = "node-name"
+	BootstrapTokenExpirationKey               = "expiration"
+)
+
 // Resources
 const (
 	DefaultCAURL                = "/ca.crt"
 	DefaultCertURL              = "/edge.crt"
+	DefaultCABundleURL          = "/ca-bundle.crt"
+	DefaultReadyzURL            = "/readyz"
 	DefaultNodeUpgradeURL       = "/nodeupgrade"
+	DefaultNodeDeregisterURL    = "/nodederegister"
 	DefaultTaskStateReportURL   = "/task/{taskType}/name/{taskID}/node/{nodeID}/status"
 	DefaultServiceAccountIssuer = "https://kubernetes.default.svc.cluster.local"
 
@@ -38,10 +51,7 @@ const (
 	DefaultEdgedMemoryCapacity = 7852396000
 	// DefaultMosquittoImage ...
 	// Deprecated: the mqtt broker is alreay managed by the DaemonSet in the cloud
-	DefaultMosquittoImage = "eclipse-mosquitto:1.6.15"
-	// update PodSandboxImage version when bumping k8s vendor version, consistent with vendor/k8s.io/kubernetes/cmd/kubelet/app/options/container_runtime.go defaultPodSandboxImageVersion
-	// When this value are updated, also update comments in pkg/apis/componentconfig/edgecore/v1alpha1/types.go
-	DefaultPodSandboxImage             = "kubeedge/pause:3.6"
+	DefaultMosquittoImage              = "eclipse-mosquitto:1.6.15"
 	DefaultImagePullProgressDeadline   = time.Minute
 	DefaultImageGCHighThreshold        = 80
 	DefaultImageGCLowThreshold         = 40
@@ -57,6 +67,13 @@ const (
 
 	CurrentSupportK8sVersion = "v1.28.6"
 
+	// BoltOpenTimeout bounds the wait for the file lock of a bbolt database held by another process
+	BoltOpenTimeout = 5 * time.Second
+
+	// Supervisor
+	DefaultEdgeCoreHealthzAddr = "127.0.0.1:10360"
+	DefaultModuleStuckTimeout  = 120
+
 	// MetaManager
 	DefaultRemoteQueryTimeout = 60
 	DefaultMetaServerAddr     = "127.0.0.1:10550"
@@ -70,6 +87,15 @@ const (
 	DefaultNodeLimit               = 500
 	DefaultKubeUpdateNodeFrequency = 20
 
+	// LeaderElection
+	DefaultLeaderElectionLeaseDuration = 15
+	DefaultLeaderElectionRenewDeadline = 10
+	DefaultLeaderElectionRetryPeriod   = 2
+	DefaultLeaderElectionResourceName  = "cloudcore"
+
+	// CloudHub
+	DefaultCloudHubMessageStorePath = "/var/lib/kubeedge/cloudhub/messages.db"
+
 	// EdgeController
 	DefaultUpdatePodStatusWorkers            = 1
 	DefaultUpdateNodeStatusWorkers           = 1
@@ -158,6 +184,13 @@ const (
 	EdgeNodeRoleKey   = "node-role.kubernetes.io/edge"
 	EdgeNodeRoleValue = ""
 
+	// NodeDeregisteredTaintKey is the NoExecute taint of the edge node which is being reset
+	// by keadm, it evicts the pods of the node and is removed when the node registers again
+	NodeDeregisteredTaintKey = "node.kubeedge.io/deregistered"
+	// NodeDeregisterCordonedAnnotation marks the edge node which is cordoned by the deregistering,
+	// the node is only uncordoned when it registers again if it has the annotation
+	NodeDeregisterCordonedAnnotation = "node.kubeedge.io/deregister-cordoned"
+
 	// DefaultMosquittoContainerName ...
 	// Deprecated: the mqtt broker is alreay managed by the DaemonSet in the cloud
 	DefaultMosquittoContainerName = "mqtt-kubeedge"
@@ -171,4 +204,7 @@ const (
 
 	// DeviceTwin
 	DefaultDMISockPath = "/etc/kubeedge/dmi.sock"
+
+	// EdgeStream
+	DefaultEdgeStreamMetricsBufferPath = "/var/lib/kubeedge/edgestream/metrics.db"
 )
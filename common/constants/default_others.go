@@ -33,4 +33,8 @@ const (
 
 	// DefaultManifestsDir edge node default static pod path
 	DefaultManifestsDir = "/etc/kubeedge/manifests"
+
+	// update PodSandboxImage version when bumping k8s vendor version, consistent with vendor/k8s.io/kubernetes/cmd/kubelet/app/options/container_runtime.go defaultPodSandboxImageVersion
+	// When this value are updated, also update comments in pkg/apis/componentconfig/edgecore/v1alpha1/types.go
+	DefaultPodSandboxImage = "kubeedge/pause:3.6"
 )
@@ -36,4 +36,12 @@ const (
 
 	// DefaultManifestsDir edge node default static pod path
 	DefaultManifestsDir = "c:\\etc\\kubeedge\\manifests\\"
+	// DefaultPodSandboxImage is the pause image for the windows containers, it must match the
+	// windows version of the host, the image is multi-arch for the windows server versions
+	DefaultPodSandboxImage = "mcr.microsoft.com/oss/kubernetes/pause:3.6"
+
+	// EdgeCoreServiceName is the name of the windows service of edgecore
+	EdgeCoreServiceName = "edgecore"
+	// DefaultEdgeCoreLogFile is the log file of edgecore when it runs as a windows service
+	DefaultEdgeCoreLogFile = "c:\\var\\log\\kubeedge\\edgecore.log"
 )
@@ -1,6 +1,9 @@
 package types
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // HTTPRequest is used structure used to unmarshal message content from cloud
 type HTTPRequest struct {
@@ -21,3 +24,27 @@ const (
 	AuthorizationKey = "Authorization"
 	NodeNameKey      = "NodeName"
 )
+
+// OperationGRPC is the operation of the messages carrying the frames of gRPC calls proxied by servicebus
+const OperationGRPC = "grpc"
+
+// GRPCFrame is a part of a gRPC call proxied by servicebus between cloud and edge. The frames of a call
+// share the StreamID, which is the ID of the message carrying the first frame from cloud.
+type GRPCFrame struct {
+	StreamID string `json:"stream_id"`
+	// Header is the request header in the first frame from cloud,
+	// or the response header in the first frame from edge
+	Header http.Header `json:"header,omitempty"`
+	// Timeout is the deadline of the call, set in the first frame from cloud
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// StatusCode is the HTTP status code of the response, set in the first frame from edge
+	StatusCode int `json:"status_code,omitempty"`
+	// Data is a part of the HTTP/2 body, which holds the length-prefixed gRPC messages
+	Data []byte `json:"data,omitempty"`
+	// Trailer is the trailers of the response, set in the last frame from edge
+	Trailer http.Header `json:"trailer,omitempty"`
+	// End indicates the sender finished sending the body
+	End bool `json:"end,omitempty"`
+	// Error indicates the call is canceled by the caller in cloud, or failed on edge
+	Error string `json:"error,omitempty"`
+}
@@ -96,6 +96,11 @@ type ImagePrePullJobRequest struct {
 	Secret     string
 	RetryTimes int32
 	CheckItems []string
+	// Secrets are the image pull secrets of the node groups the node belongs to
+	Secrets []string
+	// BandwidthLimit is the maximum download rate in bytes per second, 0 means unlimited
+	BandwidthLimit int64
+	PullWindows    []v1alpha1.PullWindow
 }
 
 // ImagePrePullJobResponse is used to report status msg to cloudhub https service from each node
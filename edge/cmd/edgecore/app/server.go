@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	ps "github.com/shirou/gopsutil/v3/process"
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/kubeedge/beehive/pkg/core"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
@@ -26,6 +28,7 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager"
 	"github.com/kubeedge/kubeedge/edge/pkg/servicebus"
+	"github.com/kubeedge/kubeedge/edge/pkg/supervisor"
 	"github.com/kubeedge/kubeedge/edge/test"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2/validation"
@@ -53,6 +56,9 @@ is the message processor between edged and edgehub. It is also responsible for s
 to/from a lightweight database (SQLite).ServiceBus is a HTTP client to interact with HTTP servers (REST),
 offering HTTP client capabilities to components of cloud to reach HTTP servers running at edge. `,
 		Run: func(cmd *cobra.Command, args []string) {
+			if err := initService(); err != nil {
+				klog.Exit(err)
+			}
 			flag.PrintMinConfigAndExitIfRequested(v1alpha2.NewMinEdgeCoreConfig())
 			flag.PrintDefaultConfigAndExitIfRequested(v1alpha2.NewDefaultEdgeCoreConfig())
 			flag.PrintFlags(cmd.Flags())
@@ -126,9 +132,13 @@ offering HTTP client capabilities to components of cloud to reach HTTP servers r
 			if features.DefaultFeatureGate.Enabled(features.ModuleRestart) {
 				core.EnableModuleRestart()
 			}
+			if config.Supervisor != nil && config.Supervisor.Enable {
+				startSupervisor(config)
+			}
 
 			// start all modules
 			core.Run()
+			stopService()
 		},
 	}
 	fs := cmd.Flags()
@@ -193,6 +203,15 @@ func environmentCheck(skipCheck bool) error {
 	return nil
 }
 
+// startSupervisor serves the health of the modules locally and reports it as node conditions
+func startSupervisor(c *v1alpha2.EdgeCoreConfig) {
+	core.SetModuleStuckTimeout(time.Duration(c.Supervisor.StuckTimeout) * time.Second)
+	go supervisor.ServeHealthz(c.Supervisor.HealthzBindAddress, beehiveContext.Done())
+	if c.Modules.Edged.Enable && c.Modules.MetaManager.Enable {
+		go supervisor.ReportNodeConditions(c.Modules.Edged.HostnameOverride, beehiveContext.Done())
+	}
+}
+
 // registerModules register all the modules started in edgecore
 func registerModules(c *v1alpha2.EdgeCoreConfig) {
 	devicetwin.Register(c.Modules.DeviceTwin, c.Modules.Edged.HostnameOverride)
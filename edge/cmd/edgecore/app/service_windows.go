@@ -0,0 +1,111 @@
+//go:build windows
+
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows/svc"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core"
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+const (
+	// the log file is rotated when it reaches the size in megabytes
+	serviceLogMaxSize    = 100
+	serviceLogMaxBackups = 5
+)
+
+// serviceHandler reports the status of edgecore to the Windows service control manager,
+// a stop request shuts down the modules the same way as a signal does
+type serviceHandler struct {
+	// stopped is closed after the modules are cleaned up
+	stopped chan struct{}
+	// exited receives the result of svc.Run after the service is reported as stopped
+	exited chan error
+}
+
+var service *serviceHandler
+
+// initService runs edgecore as a Windows service when it is started by the service control
+// manager, the logs are written to the log file since a service has no console
+func initService() error {
+	isService, err := svc.IsWindowsService()
+	if err != nil {
+		return fmt.Errorf("failed to determine if edgecore is running as a windows service: %v", err)
+	}
+	if !isService {
+		return nil
+	}
+
+	klog.LogToStderr(false)
+	klog.SetOutput(&lumberjack.Logger{
+		Filename:   constants.DefaultEdgeCoreLogFile,
+		MaxSize:    serviceLogMaxSize,
+		MaxBackups: serviceLogMaxBackups,
+	})
+
+	service = &serviceHandler{
+		stopped: make(chan struct{}),
+		exited:  make(chan error, 1),
+	}
+	go func() {
+		service.exited <- svc.Run(constants.EdgeCoreServiceName, service)
+	}()
+	klog.Infof("Running edgecore as windows service %s", constants.EdgeCoreServiceName)
+	return nil
+}
+
+// stopService reports the service as stopped after the modules are cleaned up
+func stopService() {
+	if service == nil {
+		return
+	}
+	close(service.stopped)
+	if err := <-service.exited; err != nil {
+		klog.Errorf("windows service %s exited with error: %v", constants.EdgeCoreServiceName, err)
+	}
+	klog.Flush()
+}
+
+func (h *serviceHandler) Execute(_ []string, r <-chan svc.ChangeRequest, s chan<- svc.Status) (bool, uint32) {
+	s <- svc.Status{State: svc.StartPending}
+	s <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+	for {
+		select {
+		case <-h.stopped:
+			// the modules are shut down by themselves, e.g. by a signal
+			return false, 0
+		case c := <-r:
+			switch c.Cmd {
+			case svc.Interrogate:
+				s <- c.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				klog.Infof("windows service %s is stopping", constants.EdgeCoreServiceName)
+				s <- svc.Status{State: svc.StopPending}
+				core.RequestShutdown()
+				<-h.stopped
+				return false, 0
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbm
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// boltKV is the KV of a bbolt database file.
+type boltKV struct {
+	db *bolt.DB
+}
+
+// NewBoltKV opens the bbolt database file at path, creating it if needed.
+func NewBoltKV(path string) (KV, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create the directory of %s, err: %v", path, err)
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: constants.BoltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bbolt database %s, err: %v", path, err)
+	}
+	return &boltKV{db: db}, nil
+}
+
+func (b *boltKV) View(fn func(tx Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+func (b *boltKV) Update(fn func(tx Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+func (b *boltKV) Size() (int64, error) {
+	var size int64
+	err := b.db.View(func(tx *bolt.Tx) error {
+		size = tx.Size()
+		return nil
+	})
+	return size, err
+}
+
+func (b *boltKV) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) Get(bucket, key string) []byte {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	// values are only valid during the transaction
+	return copyBytes(b.Get([]byte(key)))
+}
+
+func (t boltTx) Put(bucket, key string, value []byte) error {
+	if !t.tx.Writable() {
+		return ErrTxNotWritable
+	}
+	b, err := t.tx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), value)
+}
+
+func (t boltTx) Delete(bucket, key string) error {
+	if !t.tx.Writable() {
+		return ErrTxNotWritable
+	}
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
+
+func (t boltTx) ForEach(bucket, prefix string, fn func(key string, value []byte) error) error {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return nil
+	}
+	c := b.Cursor()
+	p := []byte(prefix)
+	for k, v := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, v = c.Next() {
+		if err := fn(string(k), copyBytes(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t boltTx) NextSequence(bucket string) (uint64, error) {
+	if !t.tx.Writable() {
+		return 0, ErrTxNotWritable
+	}
+	b, err := t.tx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return 0, err
+	}
+	return b.NextSequence()
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbm
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// The KV drivers store the orm models as JSON, these helpers give them the column
+// semantics of QuerySeter.Filter and QuerySeter.Update. A column is matched by
+// the name in its orm column() tag or by its field name, ignoring case.
+
+// ColumnValue returns the value of column col of the model pointed to by obj.
+func ColumnValue(obj interface{}, col string) (interface{}, error) {
+	f, err := columnField(obj, col)
+	if err != nil {
+		return nil, err
+	}
+	return f.Interface(), nil
+}
+
+// MatchColumn reports whether column col of the model pointed to by obj equals condition.
+func MatchColumn(obj interface{}, col string, condition interface{}) (bool, error) {
+	v, err := ColumnValue(obj, col)
+	if err != nil {
+		return false, err
+	}
+	if b, ok := v.(bool); ok {
+		// sqlite stores booleans as integers
+		if c := fmt.Sprint(condition); c == "0" || c == "1" {
+			return (c == "1") == b, nil
+		}
+	}
+	return fmt.Sprint(v) == fmt.Sprint(condition), nil
+}
+
+// SetColumns sets the columns of the model pointed to by obj to the values of cols.
+func SetColumns(obj interface{}, cols map[string]interface{}) error {
+	for col, value := range cols {
+		f, err := columnField(obj, col)
+		if err != nil {
+			return err
+		}
+		if err := setField(f, value); err != nil {
+			return fmt.Errorf("failed to set column %s, err: %v", col, err)
+		}
+	}
+	return nil
+}
+
+func columnField(obj interface{}, col string) (reflect.Value, error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("model must be a pointer to a struct, got %T", obj)
+	}
+	v = v.Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.EqualFold(ormColumn(f), col) || strings.EqualFold(f.Name, col) {
+			return v.Field(i), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("%s has no column %s", t.Name(), col)
+}
+
+// ormColumn returns the column name of the orm tag of f.
+func ormColumn(f reflect.StructField) string {
+	for _, opt := range strings.Split(f.Tag.Get("orm"), ";") {
+		opt = strings.TrimSpace(opt)
+		if strings.HasPrefix(opt, "column(") && strings.HasSuffix(opt, ")") {
+			return strings.TrimSuffix(strings.TrimPrefix(opt, "column("), ")")
+		}
+	}
+	return ""
+}
+
+func setField(f reflect.Value, value interface{}) error {
+	if value == nil {
+		f.Set(reflect.Zero(f.Type()))
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(f.Type()) {
+		f.Set(v)
+		return nil
+	}
+	s, isString := value.(string)
+	switch f.Kind() {
+	case reflect.String:
+		if b, ok := value.([]byte); ok {
+			f.SetString(string(b))
+		} else {
+			f.SetString(fmt.Sprint(value))
+		}
+		return nil
+	case reflect.Bool:
+		if isString {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return err
+			}
+			f.SetBool(b)
+			return nil
+		}
+		if v.CanInt() {
+			f.SetBool(v.Int() != 0)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if isString {
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			f.SetInt(n)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if isString {
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return err
+			}
+			f.SetUint(n)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if isString {
+			n, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return err
+			}
+			f.SetFloat(n)
+			return nil
+		}
+	}
+	if f.Kind() != reflect.Bool && (v.CanInt() || v.CanUint() || v.CanFloat()) && v.Type().ConvertibleTo(f.Type()) {
+		f.Set(v.Convert(f.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot assign %T to %s", value, f.Type())
+}
@@ -13,9 +13,17 @@ import (
 var DBAccess orm.Ormer
 var once sync.Once
 
-// InitDBConfig Init DB info
+// InitDBConfig Init DB info, a KV driver sets KVAccess instead of DBAccess
 func InitDBConfig(driverName, dbName, dataSource string) {
 	once.Do(func() {
+		if newKV, ok := kvDriver(driverName); ok {
+			kv, err := newKV(dataSource)
+			if err != nil {
+				klog.Exitf("Failed to open %s db: %v", driverName, err)
+			}
+			KVAccess = kv
+			return
+		}
 		if err := orm.RegisterDriver(driverName, orm.DRSqlite); err != nil {
 			klog.Exitf("Failed to register driver: %v", err)
 		}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// The storage drivers of the edge database, configured by DataBase.DriverName.
+// A driver name without a registered KV driver is registered as an alias of sqlite3.
+const (
+	DriverSQLite = "sqlite3"
+	// DriverBolt stores the database in a bbolt file at the data source,
+	// without depending on CGO
+	DriverBolt = "bbolt"
+	// DriverMemory keeps the database in memory, it is lost when edgecore exits
+	DriverMemory = "memory"
+)
+
+var (
+	// ErrKeyExists is returned when inserting a record whose primary key is already stored
+	ErrKeyExists = errors.New("key already exists")
+	// ErrTxNotWritable is returned when writing in a read-only transaction
+	ErrTxNotWritable = errors.New("transaction is not writable")
+)
+
+// KVAccess is the store of the database when a KV driver is configured,
+// it is nil when the database is accessed through DBAccess.
+var KVAccess KV
+
+// KV is a transactional key-value store, the backend of the drivers other than sqlite3.
+type KV interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(tx Tx) error) error
+	// Update runs fn in a read-write transaction, which is rolled back if fn returns an error.
+	Update(fn func(tx Tx) error) error
+	// Size returns the size of the store in bytes.
+	Size() (int64, error)
+	// Close releases the store.
+	Close() error
+}
+
+// Tx is a transaction of a KV. The records of a table are stored in the bucket
+// named after it, buckets are created when they are first written.
+type Tx interface {
+	// Get returns the value of key in bucket, or nil if it is not stored.
+	Get(bucket, key string) []byte
+	// Put stores value as the value of key in bucket.
+	Put(bucket, key string, value []byte) error
+	// Delete removes key from bucket, deleting a missing key is not an error.
+	Delete(bucket, key string) error
+	// ForEach calls fn for the keys of bucket starting with prefix in ascending order.
+	// fn must not modify the bucket.
+	ForEach(bucket, prefix string, fn func(key string, value []byte) error) error
+	// NextSequence returns the next auto increment ID of bucket.
+	NextSequence(bucket string) (uint64, error)
+}
+
+// NewKVFunc opens the KV of a data source.
+type NewKVFunc func(dataSource string) (KV, error)
+
+var (
+	kvDriversLock sync.Mutex
+	kvDrivers     = map[string]NewKVFunc{
+		DriverBolt:   NewBoltKV,
+		DriverMemory: func(string) (KV, error) { return NewMemoryKV(), nil },
+	}
+)
+
+// RegisterKVDriver registers a KV driver, so that it can be configured as DataBase.DriverName.
+// It must be called before InitDBConfig.
+func RegisterKVDriver(driverName string, newFunc NewKVFunc) error {
+	kvDriversLock.Lock()
+	defer kvDriversLock.Unlock()
+	if driverName == DriverSQLite {
+		return fmt.Errorf("driver %s is reserved for sqlite", driverName)
+	}
+	if _, ok := kvDrivers[driverName]; ok {
+		return fmt.Errorf("driver %s is already registered", driverName)
+	}
+	kvDrivers[driverName] = newFunc
+	return nil
+}
+
+func kvDriver(driverName string) (NewKVFunc, bool) {
+	kvDriversLock.Lock()
+	defer kvDriversLock.Unlock()
+	newFunc, ok := kvDrivers[driverName]
+	return newFunc, ok
+}
+
+// IsKVDriver reports whether driverName names a KV driver rather than sqlite.
+func IsKVDriver(driverName string) bool {
+	_, ok := kvDriver(driverName)
+	return ok
+}
+
+// GetJSON decodes the JSON value of key in bucket into obj, it reports whether the key is stored.
+func GetJSON(tx Tx, bucket, key string, obj interface{}) (bool, error) {
+	data := tx.Get(bucket, key)
+	if data == nil {
+		return false, nil
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return true, fmt.Errorf("failed to decode %s %s, err: %v", bucket, key, err)
+	}
+	return true, nil
+}
+
+// PutJSON stores obj encoded as JSON as the value of key in bucket.
+func PutJSON(tx Tx, bucket, key string, obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s %s, err: %v", bucket, key, err)
+	}
+	return tx.Put(bucket, key, data)
+}
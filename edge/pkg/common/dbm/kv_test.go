@@ -0,0 +1,174 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbm
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testKVs(t *testing.T) map[string]KV {
+	bolt, err := NewBoltKV(filepath.Join(t.TempDir(), "edgecore.db"))
+	if err != nil {
+		t.Fatalf("failed to open bbolt kv: %v", err)
+	}
+	t.Cleanup(func() { bolt.Close() })
+	return map[string]KV{
+		DriverBolt:   bolt,
+		DriverMemory: NewMemoryKV(),
+	}
+}
+
+func TestKV(t *testing.T) {
+	for name, kv := range testKVs(t) {
+		t.Run(name, func(t *testing.T) {
+			err := kv.Update(func(tx Tx) error {
+				for _, k := range []string{"a/2", "a/1", "b/1"} {
+					if err := tx.Put("bucket", k, []byte("value-"+k)); err != nil {
+						return err
+					}
+				}
+				return tx.Delete("bucket", "missing")
+			})
+			if err != nil {
+				t.Fatalf("update failed: %v", err)
+			}
+
+			err = kv.View(func(tx Tx) error {
+				if got := string(tx.Get("bucket", "a/1")); got != "value-a/1" {
+					t.Errorf("Get() = %q, want %q", got, "value-a/1")
+				}
+				if got := tx.Get("bucket", "missing"); got != nil {
+					t.Errorf("Get() of a missing key = %q, want nil", got)
+				}
+				if got := tx.Get("missing", "a/1"); got != nil {
+					t.Errorf("Get() of a missing bucket = %q, want nil", got)
+				}
+				var keys []string
+				if err := tx.ForEach("bucket", "a/", func(key string, _ []byte) error {
+					keys = append(keys, key)
+					return nil
+				}); err != nil {
+					return err
+				}
+				if want := []string{"a/1", "a/2"}; !reflect.DeepEqual(keys, want) {
+					t.Errorf("ForEach() keys = %v, want %v", keys, want)
+				}
+				if err := tx.Put("bucket", "c", nil); !errors.Is(err, ErrTxNotWritable) {
+					t.Errorf("Put() in a read-only transaction returned %v, want %v", err, ErrTxNotWritable)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("view failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestKVRollback(t *testing.T) {
+	for name, kv := range testKVs(t) {
+		t.Run(name, func(t *testing.T) {
+			err := kv.Update(func(tx Tx) error {
+				if _, err := tx.NextSequence("bucket"); err != nil {
+					return err
+				}
+				return tx.Put("bucket", "kept", []byte("old"))
+			})
+			if err != nil {
+				t.Fatalf("update failed: %v", err)
+			}
+
+			errAbort := errors.New("abort")
+			err = kv.Update(func(tx Tx) error {
+				if err := tx.Put("bucket", "kept", []byte("new")); err != nil {
+					return err
+				}
+				if err := tx.Put("bucket", "added", []byte("new")); err != nil {
+					return err
+				}
+				if _, err := tx.NextSequence("bucket"); err != nil {
+					return err
+				}
+				return errAbort
+			})
+			if !errors.Is(err, errAbort) {
+				t.Fatalf("Update() returned %v, want %v", err, errAbort)
+			}
+
+			err = kv.Update(func(tx Tx) error {
+				if got := string(tx.Get("bucket", "kept")); got != "old" {
+					t.Errorf("Get() after rollback = %q, want %q", got, "old")
+				}
+				if got := tx.Get("bucket", "added"); got != nil {
+					t.Errorf("Get() of a rolled back key = %q, want nil", got)
+				}
+				seq, err := tx.NextSequence("bucket")
+				if seq != 2 {
+					t.Errorf("NextSequence() after rollback = %d, want 2", seq)
+				}
+				return err
+			})
+			if err != nil {
+				t.Fatalf("update failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestSetColumns(t *testing.T) {
+	type model struct {
+		ID       int64  `orm:"column(id);size(64);auto;pk"`
+		DeviceID string `orm:"column(deviceid); null; type(text)"`
+		Optional bool   `orm:"column(optional);null;type(integer)"`
+		Version  uint64 `orm:"column(resourceversion); size(256)"`
+	}
+
+	m := &model{}
+	err := SetColumns(m, map[string]interface{}{
+		"deviceid":        "device",
+		"optional":        true,
+		"ResourceVersion": "18446744073709551615",
+		"id":              3,
+	})
+	if err != nil {
+		t.Fatalf("SetColumns() failed: %v", err)
+	}
+	want := &model{ID: 3, DeviceID: "device", Optional: true, Version: 18446744073709551615}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("SetColumns() = %+v, want %+v", m, want)
+	}
+
+	if err := SetColumns(m, map[string]interface{}{"missing": "x"}); err == nil {
+		t.Errorf("SetColumns() of an unknown column should fail")
+	}
+
+	for _, cond := range []interface{}{"device", "DEVICE"} {
+		match, err := MatchColumn(m, "deviceid", cond)
+		if err != nil {
+			t.Fatalf("MatchColumn() failed: %v", err)
+		}
+		if match != (cond == "device") {
+			t.Errorf("MatchColumn(deviceid, %v) = %v", cond, match)
+		}
+	}
+	if match, _ := MatchColumn(m, "optional", "1"); !match {
+		t.Errorf("MatchColumn() should match booleans stored as integers")
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbm
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// memoryKV is a KV which keeps its buckets in memory, such as for tests.
+type memoryKV struct {
+	lock      sync.RWMutex
+	buckets   map[string]map[string][]byte
+	sequences map[string]uint64
+}
+
+// NewMemoryKV returns an empty in-memory KV.
+func NewMemoryKV() KV {
+	return &memoryKV{
+		buckets:   map[string]map[string][]byte{},
+		sequences: map[string]uint64{},
+	}
+}
+
+func (m *memoryKV) View(fn func(tx Tx) error) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return fn(&memoryTx{kv: m})
+}
+
+func (m *memoryKV) Update(fn func(tx Tx) error) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	tx := &memoryTx{kv: m, writable: true}
+	if err := fn(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	return nil
+}
+
+func (m *memoryKV) Size() (int64, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	var size int64
+	for _, b := range m.buckets {
+		for k, v := range b {
+			size += int64(len(k) + len(v))
+		}
+	}
+	return size, nil
+}
+
+func (m *memoryKV) Close() error {
+	return nil
+}
+
+// memoryUndo restores a key or, for sequence undos, the sequence of a bucket.
+type memoryUndo struct {
+	bucket   string
+	key      string
+	value    []byte
+	existed  bool
+	sequence bool
+	seq      uint64
+}
+
+type memoryTx struct {
+	kv       *memoryKV
+	writable bool
+	undo     []memoryUndo
+}
+
+func (t *memoryTx) Get(bucket, key string) []byte {
+	return copyBytes(t.kv.buckets[bucket][key])
+}
+
+func (t *memoryTx) Put(bucket, key string, value []byte) error {
+	if !t.writable {
+		return ErrTxNotWritable
+	}
+	b, ok := t.kv.buckets[bucket]
+	if !ok {
+		b = map[string][]byte{}
+		t.kv.buckets[bucket] = b
+	}
+	old, existed := b[key]
+	t.undo = append(t.undo, memoryUndo{bucket: bucket, key: key, value: old, existed: existed})
+	if value == nil {
+		value = []byte{}
+	}
+	b[key] = copyBytes(value)
+	return nil
+}
+
+func (t *memoryTx) Delete(bucket, key string) error {
+	if !t.writable {
+		return ErrTxNotWritable
+	}
+	old, existed := t.kv.buckets[bucket][key]
+	if !existed {
+		return nil
+	}
+	t.undo = append(t.undo, memoryUndo{bucket: bucket, key: key, value: old, existed: true})
+	delete(t.kv.buckets[bucket], key)
+	return nil
+}
+
+func (t *memoryTx) ForEach(bucket, prefix string, fn func(key string, value []byte) error) error {
+	b := t.kv.buckets[bucket]
+	keys := make([]string, 0, len(b))
+	for k := range b {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn(k, copyBytes(b[k])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *memoryTx) NextSequence(bucket string) (uint64, error) {
+	if !t.writable {
+		return 0, ErrTxNotWritable
+	}
+	t.undo = append(t.undo, memoryUndo{bucket: bucket, sequence: true, seq: t.kv.sequences[bucket]})
+	t.kv.sequences[bucket]++
+	return t.kv.sequences[bucket], nil
+}
+
+// rollback reverts the writes of the transaction in reverse order.
+func (t *memoryTx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		u := t.undo[i]
+		switch {
+		case u.sequence:
+			t.kv.sequences[u.bucket] = u.seq
+		case u.existed:
+			t.kv.buckets[u.bucket][u.key] = u.value
+		default:
+			delete(t.kv.buckets[u.bucket], u.key)
+		}
+	}
+	t.undo = nil
+}
@@ -133,17 +133,23 @@ func (s *server) ReportDeviceStatus(ctx context.Context, in *pb.ReportDeviceStat
 		return nil, fmt.Errorf("fail to report device status because of too many request: %s", in.DeviceName)
 	}
 
-	if in != nil && in.ReportedDevice != nil && in.ReportedDevice.Twins != nil {
-		for _, twin := range in.ReportedDevice.Twins {
-			msg, err := CreateMessageTwinUpdate(twin)
-			if err != nil {
-				klog.Errorf("fail to create message data for property %s of device %s with err: %v", twin.PropertyName, in.DeviceName, err)
-				return nil, err
-			}
-			handleDeviceTwin(in, msg)
+	if in == nil || in.ReportedDevice == nil || (in.ReportedDevice.Twins == nil && in.ReportedDevice.Firmware == nil) {
+		return &pb.ReportDeviceStatusResponse{}, fmt.Errorf("ReportDeviceStatusRequest does not have twin or firmware data")
+	}
+
+	for _, twin := range in.ReportedDevice.Twins {
+		msg, err := CreateMessageTwinUpdate(twin)
+		if err != nil {
+			klog.Errorf("fail to create message data for property %s of device %s with err: %v", twin.PropertyName, in.DeviceName, err)
+			return nil, err
+		}
+		handleDeviceTwin(in, msg)
+	}
+	if in.ReportedDevice.Firmware != nil {
+		if err := handleDeviceFirmware(in); err != nil {
+			klog.Errorf("fail to report firmware state of device %s with err: %v", in.DeviceName, err)
+			return nil, err
 		}
-	} else {
-		return &pb.ReportDeviceStatusResponse{}, fmt.Errorf("ReportDeviceStatusRequest does not have twin data")
 	}
 
 	return &pb.ReportDeviceStatusResponse{}, nil
@@ -161,6 +167,27 @@ func handleDeviceTwin(in *pb.ReportDeviceStatusRequest, payload []byte) {
 	beehiveContext.SendToGroup(target, *message)
 }
 
+// handleDeviceFirmware sends the firmware state reported by the mapper to the cloud,
+// the mapper reports the state again with the twins, so the state lost while the
+// edge node is disconnected is synced later.
+func handleDeviceFirmware(in *pb.ReportDeviceStatusRequest) error {
+	data, err := json.Marshal(in.ReportedDevice.Firmware)
+	if err != nil {
+		return err
+	}
+	var firmware v1beta1.DeviceFirmwareStatus
+	if err := json.Unmarshal(data, &firmware); err != nil {
+		return err
+	}
+
+	deviceID := util.GetResourceID(in.DeviceNamespace, in.DeviceName)
+	resource := "device/" + deviceID + dtcommon.FirmwareETEdgeSyncSuffix
+	message := beehiveModel.NewMessage("").BuildRouter(modules.TwinGroup, deviceconst.GroupResource,
+		resource, beehiveModel.UpdateOperation).FillBody(firmware)
+	beehiveContext.Send(modules.EdgeHubModuleName, *message)
+	return nil
+}
+
 // CreateMessageTwinUpdate create twin update message.
 func CreateMessageTwinUpdate(twin *pb.Twin) ([]byte, error) {
 	var updateMsg DeviceTwinUpdate
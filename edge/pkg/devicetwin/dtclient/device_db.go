@@ -36,13 +36,15 @@ func DeleteDeviceByID(obm orm.Ormer, id string) error {
 
 // UpdateDeviceField update special field
 func UpdateDeviceField(deviceID string, col string, value interface{}) error {
-	num, err := dbm.DBAccess.QueryTable(DeviceTableName).Filter("id", deviceID).Update(map[string]interface{}{col: value})
-	klog.V(4).Infof("Update affected Num: %d, %s", num, err)
-	return err
+	return getStore().UpdateDeviceFields(deviceID, map[string]interface{}{col: value})
 }
 
 // UpdateDeviceFields update special fields
 func UpdateDeviceFields(deviceID string, cols map[string]interface{}) error {
+	return getStore().UpdateDeviceFields(deviceID, cols)
+}
+
+func (sqliteStore) UpdateDeviceFields(deviceID string, cols map[string]interface{}) error {
 	num, err := dbm.DBAccess.QueryTable(DeviceTableName).Filter("id", deviceID).Update(cols)
 	klog.V(4).Infof("Update affected Num: %d, %s", num, err)
 	return err
@@ -50,6 +52,10 @@ func UpdateDeviceFields(deviceID string, cols map[string]interface{}) error {
 
 // QueryDevice query Device
 func QueryDevice(key string, condition string) (*[]Device, error) {
+	return getStore().QueryDevice(key, condition)
+}
+
+func (sqliteStore) QueryDevice(key string, condition string) (*[]Device, error) {
 	devices := new([]Device)
 	_, err := dbm.DBAccess.QueryTable(DeviceTableName).Filter(key, condition).All(devices)
 	if err != nil {
@@ -60,6 +66,10 @@ func QueryDevice(key string, condition string) (*[]Device, error) {
 
 // QueryDeviceAll query twin
 func QueryDeviceAll() (*[]Device, error) {
+	return getStore().QueryDeviceAll()
+}
+
+func (sqliteStore) QueryDeviceAll() (*[]Device, error) {
 	devices := new([]Device)
 	_, err := dbm.DBAccess.QueryTable(DeviceTableName).All(devices)
 	if err != nil {
@@ -88,6 +98,10 @@ func UpdateDeviceMulti(updates []DeviceUpdate) error {
 
 // AddDeviceTrans the transaction of add device
 func AddDeviceTrans(adds []Device, addAttrs []DeviceAttr, addTwins []DeviceTwin) error {
+	return getStore().AddDeviceTrans(adds, addAttrs, addTwins)
+}
+
+func (sqliteStore) AddDeviceTrans(adds []Device, addAttrs []DeviceAttr, addTwins []DeviceTwin) error {
 	obm := dbm.DefaultOrmFunc()
 	err := obm.Begin()
 	if err != nil {
@@ -134,6 +148,10 @@ func AddDeviceTrans(adds []Device, addAttrs []DeviceAttr, addTwins []DeviceTwin)
 
 // DeleteDeviceTrans the transaction of delete device
 func DeleteDeviceTrans(deletes []string) error {
+	return getStore().DeleteDeviceTrans(deletes)
+}
+
+func (sqliteStore) DeleteDeviceTrans(deletes []string) error {
 	obm := dbm.DefaultOrmFunc()
 	err := obm.Begin()
 	if err != nil {
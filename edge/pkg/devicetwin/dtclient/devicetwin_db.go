@@ -55,9 +55,7 @@ func DeleteDeviceTwin(obm orm.Ormer, deviceID string, name string) error {
 
 // UpdateDeviceTwinField update special field
 func UpdateDeviceTwinField(deviceID string, name string, col string, value interface{}) error {
-	num, err := dbm.DBAccess.QueryTable(DeviceTwinTableName).Filter("deviceid", deviceID).Filter("name", name).Update(map[string]interface{}{col: value})
-	klog.V(4).Infof("Update affected Num: %d, %s", num, err)
-	return err
+	return getStore().UpdateDeviceTwinFields(deviceID, name, map[string]interface{}{col: value})
 }
 
 // UpdateDeviceTwinFields update special fields
@@ -69,6 +67,10 @@ func UpdateDeviceTwinFields(obm orm.Ormer, deviceID string, name string, cols ma
 
 // QueryDeviceTwin query Device
 func QueryDeviceTwin(key string, condition string) (*[]DeviceTwin, error) {
+	return getStore().QueryDeviceTwin(key, condition)
+}
+
+func (sqliteStore) QueryDeviceTwin(key string, condition string) (*[]DeviceTwin, error) {
 	twin := new([]DeviceTwin)
 	_, err := dbm.DBAccess.QueryTable(DeviceTwinTableName).Filter(key, condition).All(twin)
 	if err != nil {
@@ -88,7 +90,7 @@ type DeviceTwinUpdate struct {
 func UpdateDeviceTwinMulti(updates []DeviceTwinUpdate) error {
 	var err error
 	for _, update := range updates {
-		err = UpdateDeviceTwinFields(dbm.DBAccess, update.DeviceID, update.Name, update.Cols)
+		err = getStore().UpdateDeviceTwinFields(update.DeviceID, update.Name, update.Cols)
 		if err != nil {
 			return err
 		}
@@ -98,6 +100,10 @@ func UpdateDeviceTwinMulti(updates []DeviceTwinUpdate) error {
 
 // DeviceTwinTrans transaction of device twin
 func DeviceTwinTrans(adds []DeviceTwin, deletes []DeviceDelete, updates []DeviceTwinUpdate) error {
+	return getStore().DeviceTwinTrans(adds, deletes, updates)
+}
+
+func (sqliteStore) DeviceTwinTrans(adds []DeviceTwin, deletes []DeviceDelete, updates []DeviceTwinUpdate) error {
 	obm := dbm.DefaultOrmFunc()
 	err := obm.Begin()
 	if err != nil {
@@ -139,3 +145,7 @@ func DeviceTwinTrans(adds []DeviceTwin, deletes []DeviceDelete, updates []Device
 
 	return err
 }
+
+func (sqliteStore) UpdateDeviceTwinFields(deviceID string, name string, cols map[string]interface{}) error {
+	return UpdateDeviceTwinFields(dbm.DBAccess, deviceID, name, cols)
+}
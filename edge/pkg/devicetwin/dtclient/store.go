@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtclient
+
+import (
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+// twinStore persists the devices with their attributes and twins,
+// it is implemented for the sqlite database and for the KV drivers
+type twinStore interface {
+	UpdateDeviceFields(deviceID string, cols map[string]interface{}) error
+	QueryDevice(key string, condition string) (*[]Device, error)
+	QueryDeviceAll() (*[]Device, error)
+	AddDeviceTrans(adds []Device, addAttrs []DeviceAttr, addTwins []DeviceTwin) error
+	DeleteDeviceTrans(deletes []string) error
+
+	UpdateDeviceAttrFields(deviceID string, name string, cols map[string]interface{}) error
+	QueryDeviceAttr(key string, condition string) (*[]DeviceAttr, error)
+	DeviceAttrTrans(adds []DeviceAttr, deletes []DeviceDelete, updates []DeviceAttrUpdate) error
+
+	UpdateDeviceTwinFields(deviceID string, name string, cols map[string]interface{}) error
+	QueryDeviceTwin(key string, condition string) (*[]DeviceTwin, error)
+	DeviceTwinTrans(adds []DeviceTwin, deletes []DeviceDelete, updates []DeviceTwinUpdate) error
+}
+
+// getStore returns the store of the configured database driver
+func getStore() twinStore {
+	if dbm.KVAccess != nil {
+		return kvStore{kv: dbm.KVAccess}
+	}
+	return sqliteStore{}
+}
+
+// sqliteStore is the twinStore of the sqlite database, its transactions use the Ormer of dbm.DefaultOrmFunc
+type sqliteStore struct{}
@@ -0,0 +1,302 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+// kvStore is the twinStore of the KV drivers. The devices are stored by id, their
+// attributes and twins by device id and name, so that those of a device share a prefix.
+type kvStore struct {
+	kv dbm.KV
+}
+
+// kvKeySep separates the device id and the name of attribute and twin keys,
+// device ids are object names which cannot contain it
+const kvKeySep = "/"
+
+func devicePrefix(deviceID string) string {
+	return deviceID + kvKeySep
+}
+
+func memberKey(deviceID, name string) string {
+	return deviceID + kvKeySep + name
+}
+
+func (s kvStore) UpdateDeviceFields(deviceID string, cols map[string]interface{}) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		var device Device
+		ok, err := dbm.GetJSON(tx, DeviceTableName, deviceID, &device)
+		if err != nil || !ok {
+			return err
+		}
+		if err := dbm.SetColumns(&device, cols); err != nil {
+			return err
+		}
+		if device.ID != deviceID {
+			return fmt.Errorf("the id of device %s cannot be updated", deviceID)
+		}
+		return dbm.PutJSON(tx, DeviceTableName, device.ID, &device)
+	})
+}
+
+func (s kvStore) QueryDevice(key string, condition string) (*[]Device, error) {
+	devices := new([]Device)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		if strings.EqualFold(key, "id") {
+			var device Device
+			ok, err := dbm.GetJSON(tx, DeviceTableName, condition, &device)
+			if ok && err == nil {
+				*devices = append(*devices, device)
+			}
+			return err
+		}
+		return queryRecords(tx, DeviceTableName, "", key, condition, devices)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (s kvStore) QueryDeviceAll() (*[]Device, error) {
+	devices := new([]Device)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		return queryRecords(tx, DeviceTableName, "", "", "", devices)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return devices, nil
+}
+
+func (s kvStore) AddDeviceTrans(adds []Device, addAttrs []DeviceAttr, addTwins []DeviceTwin) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		for i := range adds {
+			if tx.Get(DeviceTableName, adds[i].ID) != nil {
+				return fmt.Errorf("failed to save device %s, err: %v", adds[i].ID, dbm.ErrKeyExists)
+			}
+			if err := dbm.PutJSON(tx, DeviceTableName, adds[i].ID, &adds[i]); err != nil {
+				return err
+			}
+		}
+		for i := range addAttrs {
+			if err := saveDeviceAttr(tx, addAttrs[i]); err != nil {
+				return err
+			}
+		}
+		for i := range addTwins {
+			if err := saveDeviceTwin(tx, addTwins[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s kvStore) DeleteDeviceTrans(deletes []string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		for _, id := range deletes {
+			if err := tx.Delete(DeviceTableName, id); err != nil {
+				return err
+			}
+			for _, bucket := range []string{DeviceAttrTableName, DeviceTwinTableName} {
+				if err := deletePrefix(tx, bucket, devicePrefix(id)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s kvStore) UpdateDeviceAttrFields(deviceID string, name string, cols map[string]interface{}) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return updateMember(tx, DeviceAttrTableName, deviceID, name, cols, &DeviceAttr{})
+	})
+}
+
+func (s kvStore) QueryDeviceAttr(key string, condition string) (*[]DeviceAttr, error) {
+	attrs := new([]DeviceAttr)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		return queryMembers(tx, DeviceAttrTableName, key, condition, attrs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func (s kvStore) DeviceAttrTrans(adds []DeviceAttr, deletes []DeviceDelete, updates []DeviceAttrUpdate) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		for i := range adds {
+			if err := saveDeviceAttr(tx, adds[i]); err != nil {
+				return err
+			}
+		}
+		for _, d := range deletes {
+			if err := tx.Delete(DeviceAttrTableName, memberKey(d.DeviceID, d.Name)); err != nil {
+				return err
+			}
+		}
+		for _, u := range updates {
+			if err := updateMember(tx, DeviceAttrTableName, u.DeviceID, u.Name, u.Cols, &DeviceAttr{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s kvStore) UpdateDeviceTwinFields(deviceID string, name string, cols map[string]interface{}) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return updateMember(tx, DeviceTwinTableName, deviceID, name, cols, &DeviceTwin{})
+	})
+}
+
+func (s kvStore) QueryDeviceTwin(key string, condition string) (*[]DeviceTwin, error) {
+	twins := new([]DeviceTwin)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		return queryMembers(tx, DeviceTwinTableName, key, condition, twins)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return twins, nil
+}
+
+func (s kvStore) DeviceTwinTrans(adds []DeviceTwin, deletes []DeviceDelete, updates []DeviceTwinUpdate) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		for i := range adds {
+			if err := saveDeviceTwin(tx, adds[i]); err != nil {
+				return err
+			}
+		}
+		for _, d := range deletes {
+			if err := tx.Delete(DeviceTwinTableName, memberKey(d.DeviceID, d.Name)); err != nil {
+				return err
+			}
+		}
+		for _, u := range updates {
+			if err := updateMember(tx, DeviceTwinTableName, u.DeviceID, u.Name, u.Cols, &DeviceTwin{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// saveDeviceAttr stores attr, assigning it an auto increment id like the sqlite table
+func saveDeviceAttr(tx dbm.Tx, attr DeviceAttr) error {
+	if attr.ID == 0 {
+		id, err := tx.NextSequence(DeviceAttrTableName)
+		if err != nil {
+			return err
+		}
+		attr.ID = int64(id)
+	}
+	return dbm.PutJSON(tx, DeviceAttrTableName, memberKey(attr.DeviceID, attr.Name), &attr)
+}
+
+// saveDeviceTwin stores twin, assigning it an auto increment id like the sqlite table
+func saveDeviceTwin(tx dbm.Tx, twin DeviceTwin) error {
+	if twin.ID == 0 {
+		id, err := tx.NextSequence(DeviceTwinTableName)
+		if err != nil {
+			return err
+		}
+		twin.ID = int64(id)
+	}
+	return dbm.PutJSON(tx, DeviceTwinTableName, memberKey(twin.DeviceID, twin.Name), &twin)
+}
+
+// updateMember sets the columns of the attribute or twin of deviceID and name,
+// record points to an empty DeviceAttr or DeviceTwin to decode it into
+func updateMember(tx dbm.Tx, bucket, deviceID, name string, cols map[string]interface{}, record interface{}) error {
+	key := memberKey(deviceID, name)
+	ok, err := dbm.GetJSON(tx, bucket, key, record)
+	if err != nil || !ok {
+		return err
+	}
+	if err := dbm.SetColumns(record, cols); err != nil {
+		return err
+	}
+	newDeviceID, err := dbm.ColumnValue(record, "deviceid")
+	if err != nil {
+		return err
+	}
+	newName, err := dbm.ColumnValue(record, "name")
+	if err != nil {
+		return err
+	}
+	if newKey := memberKey(fmt.Sprint(newDeviceID), fmt.Sprint(newName)); newKey != key {
+		if err := tx.Delete(bucket, key); err != nil {
+			return err
+		}
+		key = newKey
+	}
+	return dbm.PutJSON(tx, bucket, key, record)
+}
+
+// queryMembers queries the attributes or twins, using the key prefix of the device when filtering by device id
+func queryMembers[T any](tx dbm.Tx, bucket, key, condition string, records *[]T) error {
+	if strings.EqualFold(key, "deviceid") {
+		return queryRecords(tx, bucket, devicePrefix(condition), "", "", records)
+	}
+	return queryRecords(tx, bucket, "", key, condition, records)
+}
+
+// queryRecords appends the records of bucket under prefix to records,
+// only those whose column key equals condition if key is not empty
+func queryRecords[T any](tx dbm.Tx, bucket, prefix, key, condition string, records *[]T) error {
+	return tx.ForEach(bucket, prefix, func(_ string, value []byte) error {
+		var record T
+		if err := json.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		if key != "" {
+			match, err := dbm.MatchColumn(&record, key, condition)
+			if err != nil || !match {
+				return err
+			}
+		}
+		*records = append(*records, record)
+		return nil
+	})
+}
+
+func deletePrefix(tx dbm.Tx, bucket, prefix string) error {
+	var keys []string
+	err := tx.ForEach(bucket, prefix, func(key string, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := tx.Delete(bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
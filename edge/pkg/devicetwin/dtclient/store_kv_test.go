@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dtclient
+
+import (
+	"testing"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+func TestKVStore(t *testing.T) {
+	dbm.KVAccess = dbm.NewMemoryKV()
+	t.Cleanup(func() { dbm.KVAccess = nil })
+
+	err := AddDeviceTrans(
+		[]Device{{ID: "sensor", Name: "sensor"}, {ID: "sensor-2", Name: "sensor-2"}},
+		[]DeviceAttr{{DeviceID: "sensor", Name: "unit", Value: "C"}, {DeviceID: "sensor-2", Name: "unit", Value: "F"}},
+		[]DeviceTwin{{DeviceID: "sensor", Name: "temperature", Expected: "20"}},
+	)
+	if err != nil {
+		t.Fatalf("AddDeviceTrans() failed: %v", err)
+	}
+	// the device id is the primary key, a failed transaction leaves nothing behind
+	if err := AddDeviceTrans([]Device{{ID: "other"}, {ID: "sensor"}}, nil, nil); err == nil {
+		t.Fatalf("AddDeviceTrans() of an existing device should fail")
+	}
+	if devices, _ := QueryDevice("id", "other"); len(*devices) != 0 {
+		t.Errorf("QueryDevice() of a rolled back device = %v, want none", devices)
+	}
+
+	if err := UpdateDeviceField("sensor", "state", "online"); err != nil {
+		t.Fatalf("UpdateDeviceField() failed: %v", err)
+	}
+	devices, err := QueryDevice("state", "online")
+	if err != nil || len(*devices) != 1 || (*devices)[0].ID != "sensor" {
+		t.Errorf("QueryDevice(state) = %v, %v", devices, err)
+	}
+
+	attrs, err := QueryDeviceAttr("deviceid", "sensor")
+	if err != nil || len(*attrs) != 1 || (*attrs)[0].Value != "C" || (*attrs)[0].ID == 0 {
+		t.Fatalf("QueryDeviceAttr() = %v, %v", attrs, err)
+	}
+	err = DeviceAttrTrans(
+		[]DeviceAttr{{DeviceID: "sensor", Name: "range", Value: "0-100"}},
+		[]DeviceDelete{{DeviceID: "sensor", Name: "unit"}},
+		[]DeviceAttrUpdate{{DeviceID: "sensor-2", Name: "unit", Cols: map[string]interface{}{"value": "K", "optional": true}}},
+	)
+	if err != nil {
+		t.Fatalf("DeviceAttrTrans() failed: %v", err)
+	}
+	if attrs, _ := QueryDeviceAttr("deviceid", "sensor"); len(*attrs) != 1 || (*attrs)[0].Name != "range" {
+		t.Errorf("QueryDeviceAttr() after DeviceAttrTrans() = %v", attrs)
+	}
+	if attrs, _ := QueryDeviceAttr("deviceid", "sensor-2"); len(*attrs) != 1 || (*attrs)[0].Value != "K" || !(*attrs)[0].Optional {
+		t.Errorf("QueryDeviceAttr() of the updated attribute = %v", attrs)
+	}
+
+	if err := UpdateDeviceTwinMulti([]DeviceTwinUpdate{{DeviceID: "sensor", Name: "temperature",
+		Cols: map[string]interface{}{"actual": "21"}}}); err != nil {
+		t.Fatalf("UpdateDeviceTwinMulti() failed: %v", err)
+	}
+	twins, err := QueryDeviceTwin("deviceid", "sensor")
+	if err != nil || len(*twins) != 1 || (*twins)[0].Actual != "21" || (*twins)[0].Expected != "20" {
+		t.Errorf("QueryDeviceTwin() = %v, %v", twins, err)
+	}
+
+	if err := DeleteDeviceTrans([]string{"sensor"}); err != nil {
+		t.Fatalf("DeleteDeviceTrans() failed: %v", err)
+	}
+	if devices, _ := QueryDeviceAll(); len(*devices) != 1 || (*devices)[0].ID != "sensor-2" {
+		t.Errorf("QueryDeviceAll() after DeleteDeviceTrans() = %v", devices)
+	}
+	if twins, _ := QueryDeviceTwin("deviceid", "sensor"); len(*twins) != 0 {
+		t.Errorf("QueryDeviceTwin() of a deleted device = %v, want none", twins)
+	}
+	if attrs, _ := QueryDeviceAttr("deviceid", "sensor-2"); len(*attrs) != 1 {
+		t.Errorf("DeleteDeviceTrans() deleted the attributes of another device: %v", attrs)
+	}
+}
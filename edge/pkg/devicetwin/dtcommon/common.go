@@ -44,6 +44,8 @@ const (
 	TwinETDeltaSuffix = "/twin/update/delta"
 	// TwinETDocumentSuffix the topic suffix for twin document event
 	TwinETDocumentSuffix = "/twin/update/document"
+	// FirmwareETEdgeSyncSuffix the topic suffix for firmware state sync event
+	FirmwareETEdgeSyncSuffix = "/firmware/edge_updated"
 
 	// DeviceETUpdatedSuffix the topic suffix for device updated event
 	DeviceETUpdatedSuffix = "/updated"
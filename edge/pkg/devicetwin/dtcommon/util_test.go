@@ -19,6 +19,10 @@ package dtcommon
 import (
 	"errors"
 	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
 )
 
 // TestValidateValue is function to test ValidateValue
@@ -177,3 +181,83 @@ func TestValidateTwinValue(t *testing.T) {
 		})
 	}
 }
+
+// TestConvertDeviceOpcUA is function to test ConvertDevice keeps the OPC UA protocol config and visitors
+func TestConvertDeviceOpcUA(t *testing.T) {
+	device := &v1beta1.Device{}
+	device.Name = "opcua-device"
+	device.Spec.DeviceModelRef = &v1.LocalObjectReference{Name: "opcua-model"}
+	device.Spec.Protocol = v1beta1.ProtocolConfig{
+		ProtocolName: v1beta1.ProtocolNameOpcUA,
+		OpcUA: &v1beta1.ProtocolConfigOpcUA{
+			URL:            "opc.tcp://127.0.0.1:4840",
+			SecurityPolicy: v1beta1.OpcUASecurityPolicyBasic256Sha256,
+			SecurityMode:   v1beta1.OpcUASecurityModeSignAndEncrypt,
+			Certificate:    "/etc/kubeedge/opcua/client.crt",
+			PrivateKey:     "/etc/kubeedge/opcua/client.key",
+			Authentication: &v1beta1.OpcUAAuthentication{
+				Type:     v1beta1.OpcUAAuthenticationUserName,
+				UserName: "edge",
+			},
+			Timeout: 5000,
+		},
+	}
+	device.Spec.Properties = []v1beta1.DeviceProperty{{
+		Name: "temperature",
+		Visitors: v1beta1.VisitorConfig{
+			ProtocolName: v1beta1.ProtocolNameOpcUA,
+			OpcUA: &v1beta1.VisitorConfigOPCUA{
+				NodeID:               "ns=2;s=Temperature",
+				SubscriptionInterval: 1000,
+			},
+		},
+	}}
+
+	edgeDevice, err := ConvertDevice(device)
+	if err != nil {
+		t.Fatalf("ConvertDevice() error = %v", err)
+	}
+	config := edgeDevice.Spec.Protocol.GetOpcua()
+	if config.GetUrl() != "opc.tcp://127.0.0.1:4840" || config.GetSecurityPolicy() != "Basic256Sha256" ||
+		config.GetSecurityMode() != "SignAndEncrypt" || config.GetPrivateKey() != "/etc/kubeedge/opcua/client.key" ||
+		config.GetTimeout() != 5000 {
+		t.Errorf("unexpected opcua protocol config %v", config)
+	}
+	if auth := config.GetAuthentication(); auth.GetType() != "UserName" || auth.GetUserName() != "edge" {
+		t.Errorf("unexpected opcua authentication %v", auth)
+	}
+	visitor := edgeDevice.Spec.Properties[0].GetVisitors().GetOpcua()
+	if visitor.GetNodeID() != "ns=2;s=Temperature" || visitor.GetSubscriptionInterval() != 1000 {
+		t.Errorf("unexpected opcua visitor %v", visitor)
+	}
+}
+
+// TestConvertDeviceDataPlane is function to test ConvertDevice keeps the data plane sinks
+func TestConvertDeviceDataPlane(t *testing.T) {
+	device := &v1beta1.Device{}
+	device.Name = "sensor"
+	device.Spec.DeviceModelRef = &v1.LocalObjectReference{Name: "sensor-model"}
+	device.Spec.DataPlane = &v1beta1.DeviceDataPlane{
+		Properties: []string{"vibration"},
+		SampleRate: 1000,
+		Sinks: []v1beta1.DataPlaneSink{
+			{Name: "influx", InfluxDB: &v1beta1.DataPlaneSinkInfluxDB{URL: "http://127.0.0.1:8086", Bucket: "telemetry", Tags: map[string]string{"line": "1"}}},
+			{Name: "csv", CSV: &v1beta1.DataPlaneSinkCSV{Path: "/var/lib/kubeedge/telemetry", MaxFiles: 3}},
+		},
+	}
+
+	edgeDevice, err := ConvertDevice(device)
+	if err != nil {
+		t.Fatalf("ConvertDevice() error = %v", err)
+	}
+	dataPlane := edgeDevice.Spec.GetDataPlane()
+	if dataPlane.GetSampleRate() != 1000 || len(dataPlane.GetProperties()) != 1 || len(dataPlane.GetSinks()) != 2 {
+		t.Fatalf("unexpected data plane %v", dataPlane)
+	}
+	if influx := dataPlane.GetSinks()[0].GetInfluxdb(); influx.GetBucket() != "telemetry" || influx.GetTags()["line"] != "1" {
+		t.Errorf("unexpected influxdb sink %v", influx)
+	}
+	if csv := dataPlane.GetSinks()[1].GetCsv(); csv.GetPath() != "/var/lib/kubeedge/telemetry" || csv.GetMaxFiles() != 3 {
+		t.Errorf("unexpected csv sink %v", csv)
+	}
+}
@@ -187,6 +187,9 @@ func newEdged(enable bool, nodeName, namespace string) (*edged, error) {
 		return nil, fmt.Errorf("failed to construct kubelet configuration")
 	}
 	edgedconfig.ConvertConfigEdgedFlagToConfigKubeletFlag(&edgedconfig.Config.TailoredKubeletFlag, &kubeletFlags)
+	if err := validateRuntimeEndpoint(kubeletConfig.ContainerRuntimeEndpoint); err != nil {
+		return nil, err
+	}
 	// Set Kubelet RegisterNode Parameter in KubeletConfiguration.
 	// The parameter `registerNode` has been migrated to Kubelet Configuration.
 	// `registerNode` in KubeletFlag will be retained for next version(1.13), and removed in 1.14 and later.
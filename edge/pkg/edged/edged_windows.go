@@ -0,0 +1,33 @@
+//go:build windows
+
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edged
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateRuntimeEndpoint checks that edged talks to the runtime over a named pipe,
+// containerd runs the windows containers with HCS and only listens on a named pipe on windows
+func validateRuntimeEndpoint(endpoint string) error {
+	if !strings.HasPrefix(endpoint, "npipe://") {
+		return fmt.Errorf("container runtime endpoint %s is not supported on windows, a named pipe endpoint like npipe://./pipe/containerd-containerd is required", endpoint)
+	}
+	return nil
+}
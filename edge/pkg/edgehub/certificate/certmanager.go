@@ -43,6 +43,9 @@ var jitteryDuration = func(totalDuration float64) time.Duration {
 
 var CleanupTokenChan = make(chan struct{}, 1)
 
+// caBundleSyncPeriod is the period to sync the CA bundle from cloudcore
+const caBundleSyncPeriod = time.Hour
+
 type CertManager struct {
 	RotateCertificates bool
 	NodeName           string
@@ -56,9 +59,12 @@ type CertManager struct {
 	// Set to time.Now but can be stubbed out for testing
 	now func() time.Time
 
-	caURL   string
-	certURL string
-	Done    chan struct{}
+	caURL       string
+	certURL     string
+	caBundleURL string
+	Done        chan struct{}
+	// rotateNow triggers the certificate rotation before the rotation deadline
+	rotateNow chan struct{}
 }
 
 // NewCertManager creates a CertManager for edge certificate management according to EdgeHub config
@@ -83,7 +89,9 @@ func NewCertManager(edgehub v1alpha2.EdgeHub, nodename string) CertManager {
 		now:                time.Now,
 		caURL:              edgehub.HTTPServer + constants.DefaultCAURL,
 		certURL:            edgehub.HTTPServer + constants.DefaultCertURL,
+		caBundleURL:        edgehub.HTTPServer + constants.DefaultCABundleURL,
 		Done:               make(chan struct{}),
+		rotateNow:          make(chan struct{}, 1),
 	}
 }
 
@@ -98,6 +106,7 @@ func (cm *CertManager) Start() {
 		// inform to cleanup token in configuration edgecore.yaml
 		CleanupTokenChan <- struct{}{}
 	}
+	go wait.Forever(cm.syncCABundle, caBundleSyncPeriod)
 	if cm.RotateCertificates {
 		cm.rotate()
 	}
@@ -174,7 +183,11 @@ func (cm *CertManager) rotate() {
 			timer := time.NewTimer(sleepInterval)
 			defer timer.Stop()
 
-			<-timer.C // unblock when deadline expires
+			select {
+			case <-timer.C: // unblock when deadline expires
+			case <-cm.rotateNow:
+				klog.Infof("Rotating certificate before the rotation deadline since it is not signed by the CA of CloudCore")
+			}
 		}
 
 		backoff := wait.Backoff{
@@ -241,6 +254,81 @@ func (cm *CertManager) rotateCert() (bool, error) {
 	return true, nil
 }
 
+// syncCABundle replaces the local CA with the CA bundle of cloudcore, so that the edge node trusts
+// the new CA before cloudcore is switched to it when the CA of cloudcore is rotated. The first CA
+// of the bundle is the CA of cloudcore, the certificate is rotated when it is signed by another CA.
+func (cm *CertManager) syncCABundle() {
+	tlsCert, err := cm.getCurrent()
+	if err != nil {
+		klog.Errorf("failed to get current certificate: %v", err)
+		return
+	}
+	caPem, err := cm.getCA()
+	if err != nil {
+		klog.Errorf("failed to get CA certificate locally: %v", err)
+		return
+	}
+	bundle, err := cm.GetCABundle(cm.caBundleURL, caPem, *tlsCert)
+	if err != nil {
+		klog.Errorf("failed to get CA bundle from CloudCore: %v", err)
+		return
+	}
+	if bundle == nil {
+		klog.V(4).Infof("CloudCore does not serve the CA bundle")
+		return
+	}
+	cas, err := cert.ParseCertsPEM(bundle)
+	if err != nil {
+		klog.Errorf("failed to parse CA bundle: %v", err)
+		return
+	}
+
+	if !bytes.Equal(bundle, caPem) {
+		if err := cert.WriteCert(cm.caFile, bundle); err != nil {
+			klog.Errorf("failed to save CA bundle to file %s: %v", cm.caFile, err)
+			return
+		}
+		klog.Infof("succeeded to update CA bundle with %d certificates", len(cas))
+	}
+
+	if cm.RotateCertificates && tlsCert.Leaf.CheckSignatureFrom(cas[0]) != nil {
+		select {
+		case cm.rotateNow <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// GetCABundle gets the CA bundle from cloudcore, it returns nil if cloudcore does not serve the CA bundle
+func (cm *CertManager) GetCABundle(url string, capem []byte, cert tls.Certificate) ([]byte, error) {
+	client, err := http.NewHTTPClientWithCA(capem, cert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client:%v", err)
+	}
+	req, err := http.BuildRequest(nethttp.MethodGet, url, nil, "", cm.NodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate http request:%v", err)
+	}
+	res, err := http.SendRequest(req, client)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// cloudcore before the CA bundle is supported
+	if res.StatusCode == nethttp.StatusNotFound {
+		return nil, nil
+	}
+	content, err := io.ReadAll(io.LimitReader(res.Body, constants.MaxRespBodyLength))
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != nethttp.StatusOK {
+		return nil, fmt.Errorf(string(content))
+	}
+	return content, nil
+}
+
 // getCA returns the CA in pem format.
 func (cm *CertManager) getCA() ([]byte, error) {
 	return os.ReadFile(cm.caFile)
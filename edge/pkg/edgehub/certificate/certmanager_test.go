@@ -17,12 +17,20 @@ limitations under the License.
 package certificate
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
+	certutil "k8s.io/client-go/util/cert"
+
+	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/util"
 )
 
@@ -79,3 +87,73 @@ func TestValidateCACerts(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncCABundle(t *testing.T) {
+	otherCA, err := os.ReadFile("/tmp/edge.crt")
+	if err != nil {
+		t.Fatalf("Failed to load certificate: %v", err)
+	}
+	var bundle []byte
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != constants.DefaultCABundleURL {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, err := w.Write(bundle); err != nil {
+			t.Errorf("Failed to write CA bundle: %v", err)
+		}
+	}))
+	defer server.Close()
+	serverCA := pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: server.Certificate().Raw})
+	bundle = append(append([]byte{}, serverCA...), otherCA...)
+
+	newCertManager := func(caBundleURL string) *CertManager {
+		caFile := filepath.Join(t.TempDir(), "rootCA.crt")
+		if err := os.WriteFile(caFile, serverCA, 0600); err != nil {
+			t.Fatalf("Failed to write CA: %v", err)
+		}
+		return &CertManager{
+			RotateCertificates: true,
+			caFile:             caFile,
+			certFile:           "/tmp/edge.crt",
+			keyFile:            "/tmp/edge.key",
+			caBundleURL:        caBundleURL,
+			rotateNow:          make(chan struct{}, 1),
+		}
+	}
+
+	t.Run("cloudcore serves the CA bundle", func(t *testing.T) {
+		cm := newCertManager(server.URL + constants.DefaultCABundleURL)
+		cm.syncCABundle()
+
+		got, err := os.ReadFile(cm.caFile)
+		if err != nil {
+			t.Fatalf("Failed to read CA: %v", err)
+		}
+		if !bytes.Equal(got, bundle) {
+			t.Errorf("expected the CA to be replaced with the CA bundle")
+		}
+		// the certificate of the edge node is not signed by the CA of cloudcore
+		select {
+		case <-cm.rotateNow:
+		default:
+			t.Errorf("expected the certificate to be rotated")
+		}
+	})
+
+	t.Run("cloudcore does not serve the CA bundle", func(t *testing.T) {
+		cm := newCertManager(server.URL + "/unknown")
+		cm.syncCABundle()
+
+		got, err := os.ReadFile(cm.caFile)
+		if err != nil {
+			t.Fatalf("Failed to read CA: %v", err)
+		}
+		if !bytes.Equal(got, serverCA) {
+			t.Errorf("expected the CA to be kept")
+		}
+		if len(cm.rotateNow) != 0 {
+			t.Errorf("expected the certificate not to be rotated")
+		}
+	})
+}
@@ -4,41 +4,117 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/grpcclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/quicclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients/wsclient"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
 )
 
-// GetClient returns an Adapter object with new web socket
-func GetClient() (Adapter, error) {
+// clientFactory creates the Adapter of a protocol, if the protocol is enabled
+type clientFactory struct {
+	enabled func(config *config.Configure) bool
+	// server returns the configured cloudhub server of the protocol
+	server  func(config *config.Configure) string
+	newFunc func(config *config.Configure, server string) Adapter
+}
+
+// factories are the protocols edgehub supports, in the order they are picked
+var factories = []clientFactory{
+	{
+		enabled: func(config *config.Configure) bool { return config.WebSocket.Enable },
+		server:  func(config *config.Configure) string { return config.WebSocket.Server },
+		newFunc: newWebSocketClient,
+	},
+	{
+		enabled: func(config *config.Configure) bool { return config.Quic.Enable },
+		server:  func(config *config.Configure) string { return config.Quic.Server },
+		newFunc: newQuicClient,
+	},
+	{
+		enabled: func(config *config.Configure) bool { return config.GRPC != nil && config.GRPC.Enable },
+		server:  func(config *config.Configure) string { return config.GRPC.Server },
+		newFunc: newGRPCClient,
+	},
+}
+
+// GetClient returns an Adapter object of the enabled protocol connecting to server,
+// the configured server of the protocol if server is empty
+func GetClient(server string) (Adapter, error) {
 	config := config.Config
-	switch {
-	case config.WebSocket.Enable:
-		websocketConf := wsclient.WebSocketConfig{
-			URL:              config.WebSocketURL,
-			CertFilePath:     config.TLSCertFile,
-			KeyFilePath:      config.TLSPrivateKeyFile,
-			HandshakeTimeout: time.Duration(config.WebSocket.HandshakeTimeout) * time.Second,
-			ReadDeadline:     time.Duration(config.WebSocket.ReadDeadline) * time.Second,
-			WriteDeadline:    time.Duration(config.WebSocket.WriteDeadline) * time.Second,
-			ProjectID:        config.ProjectID,
-			NodeID:           config.NodeName,
-		}
-		return wsclient.NewWebSocketClient(&websocketConf), nil
-	case config.Quic.Enable:
-		quicConfig := quicclient.QuicConfig{
-			Addr:             config.Quic.Server,
-			CaFilePath:       config.TLSCAFile,
-			CertFilePath:     config.TLSCertFile,
-			KeyFilePath:      config.TLSPrivateKeyFile,
-			HandshakeTimeout: time.Duration(config.Quic.HandshakeTimeout) * time.Second,
-			ReadDeadline:     time.Duration(config.Quic.ReadDeadline) * time.Second,
-			WriteDeadline:    time.Duration(config.Quic.WriteDeadline) * time.Second,
-			ProjectID:        config.ProjectID,
-			NodeID:           config.NodeName,
+	f, err := enabledFactory(&config)
+	if err != nil {
+		return nil, err
+	}
+	if server == "" {
+		server = f.server(&config)
+	}
+	return f.newFunc(&config, server), nil
+}
+
+// GetServer returns the configured cloudhub server of the enabled protocol
+func GetServer() (string, error) {
+	config := config.Config
+	f, err := enabledFactory(&config)
+	if err != nil {
+		return "", err
+	}
+	return f.server(&config), nil
+}
+
+func enabledFactory(config *config.Configure) (*clientFactory, error) {
+	for i := range factories {
+		if factories[i].enabled(config) {
+			return &factories[i], nil
 		}
-		return quicclient.NewQuicClient(&quicConfig), nil
 	}
+	return nil, fmt.Errorf("Websocket, Quic and GRPC are all disabled")
+}
 
-	return nil, fmt.Errorf("Websocket and Quic are both disabled")
+func newWebSocketClient(c *config.Configure, server string) Adapter {
+	url := c.WebSocketURL
+	if server != c.WebSocket.Server {
+		url = config.WebSocketURL(server, c.ProjectID, c.NodeName)
+	}
+	websocketConf := wsclient.WebSocketConfig{
+		URL:              url,
+		CertFilePath:     c.TLSCertFile,
+		KeyFilePath:      c.TLSPrivateKeyFile,
+		HandshakeTimeout: time.Duration(c.WebSocket.HandshakeTimeout) * time.Second,
+		ReadDeadline:     time.Duration(c.WebSocket.ReadDeadline) * time.Second,
+		WriteDeadline:    time.Duration(c.WebSocket.WriteDeadline) * time.Second,
+		ProjectID:        c.ProjectID,
+		NodeID:           c.NodeName,
+	}
+	return wsclient.NewWebSocketClient(&websocketConf)
+}
+
+func newQuicClient(config *config.Configure, server string) Adapter {
+	quicConfig := quicclient.QuicConfig{
+		Addr:             server,
+		CaFilePath:       config.TLSCAFile,
+		CertFilePath:     config.TLSCertFile,
+		KeyFilePath:      config.TLSPrivateKeyFile,
+		HandshakeTimeout: time.Duration(config.Quic.HandshakeTimeout) * time.Second,
+		ReadDeadline:     time.Duration(config.Quic.ReadDeadline) * time.Second,
+		WriteDeadline:    time.Duration(config.Quic.WriteDeadline) * time.Second,
+		ProjectID:        config.ProjectID,
+		NodeID:           config.NodeName,
+	}
+	return quicclient.NewQuicClient(&quicConfig)
+}
+
+func newGRPCClient(config *config.Configure, server string) Adapter {
+	grpcConfig := grpcclient.GRPCConfig{
+		Addr:             server,
+		CaFilePath:       config.TLSCAFile,
+		CertFilePath:     config.TLSCertFile,
+		KeyFilePath:      config.TLSPrivateKeyFile,
+		HandshakeTimeout: time.Duration(config.GRPC.HandshakeTimeout) * time.Second,
+		WriteDeadline:    time.Duration(config.GRPC.WriteDeadline) * time.Second,
+		KeepaliveTime:    time.Duration(config.GRPC.KeepaliveTime) * time.Second,
+		KeepaliveTimeout: time.Duration(config.GRPC.KeepaliveTimeout) * time.Second,
+		ProjectID:        config.ProjectID,
+		NodeID:           config.NodeName,
+	}
+	return grpcclient.NewGRPCClient(&grpcConfig)
 }
@@ -0,0 +1,185 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
+)
+
+const (
+	retryCount       = 5
+	cloudAccessSleep = 5 * time.Second
+)
+
+// GRPCClient a grpc client
+type GRPCClient struct {
+	config *GRPCConfig
+	conn   *grpc.ClientConn
+	stream grpc.ClientStream
+	writer *grpchub.FrameWriter
+	cancel context.CancelFunc
+}
+
+// GRPCConfig config for grpc
+type GRPCConfig struct {
+	Addr             string
+	CaFilePath       string
+	CertFilePath     string
+	KeyFilePath      string
+	HandshakeTimeout time.Duration
+	WriteDeadline    time.Duration
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+	NodeID           string
+	ProjectID        string
+}
+
+// NewGRPCClient initializes a new grpc client instance
+func NewGRPCClient(conf *GRPCConfig) *GRPCClient {
+	return &GRPCClient{config: conf}
+}
+
+// Init initializes grpc client
+func (gc *GRPCClient) Init() error {
+	klog.Infof("GRPC start to connect Access")
+	cert, err := tls.LoadX509KeyPair(gc.config.CertFilePath, gc.config.KeyFilePath)
+	if err != nil {
+		klog.Errorf("Failed to load x509 key pair: %v", err)
+		return fmt.Errorf("failed to load x509 key pair, error: %v", err)
+	}
+	caCert, err := os.ReadFile(gc.config.CaFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to load ca file, error: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if ok := pool.AppendCertsFromPEM(caCert); !ok {
+		return fmt.Errorf("cannot parse the certificates")
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	for i := 0; i < retryCount; i++ {
+		if err := gc.connect(tlsConfig); err != nil {
+			klog.Errorf("Init grpc connection failed %s", err.Error())
+		} else {
+			klog.Infof("GRPC connect to cloud access successful")
+			return nil
+		}
+		time.Sleep(cloudAccessSleep)
+	}
+	return errors.New("max retry count reached when connecting to cloud")
+}
+
+func (gc *GRPCClient) connect(tlsConfig *tls.Config) error {
+	dialCtx, dialCancel := context.WithTimeout(context.Background(), gc.config.HandshakeTimeout)
+	defer dialCancel()
+	clientConn, err := grpc.DialContext(dialCtx, gc.config.Addr,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                gc.config.KeepaliveTime,
+			Timeout:             gc.config.KeepaliveTimeout,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(grpchub.Codec{}),
+			grpc.MaxCallRecvMsgSize(grpchub.MaxMessageSize),
+			grpc.MaxCallSendMsgSize(grpchub.MaxMessageSize),
+		),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		grpchub.MetadataNodeID, gc.config.NodeID,
+		grpchub.MetadataProjectID, gc.config.ProjectID)
+	stream, err := clientConn.NewStream(ctx, &grpchub.ServiceDesc.Streams[0], grpchub.ConnectMethod)
+	if err != nil {
+		cancel()
+		clientConn.Close()
+		return err
+	}
+
+	gc.conn = clientConn
+	gc.stream = stream
+	gc.cancel = cancel
+	gc.writer = grpchub.NewFrameWriter(stream, cancel)
+	return nil
+}
+
+// UnInit closes the grpc connection
+func (gc *GRPCClient) UnInit() {
+	if gc.cancel != nil {
+		gc.cancel()
+	}
+	if gc.conn != nil {
+		gc.conn.Close()
+	}
+}
+
+// Send sends the message through the stream
+func (gc *GRPCClient) Send(message model.Message) error {
+	if gc.writer == nil {
+		return fmt.Errorf("grpc connection is closed and message %v will not be sent", message.GetID())
+	}
+	message.Header.Sync = false
+	frame, err := grpchub.EncodeMessage(&message)
+	if err != nil {
+		return err
+	}
+	var deadline time.Time
+	if gc.config.WriteDeadline > 0 {
+		deadline = time.Now().Add(gc.config.WriteDeadline)
+	}
+	return gc.writer.Write(frame, deadline)
+}
+
+// Receive reads the message through the stream
+func (gc *GRPCClient) Receive() (model.Message, error) {
+	message := model.Message{}
+	frame := &grpchub.Frame{}
+	if err := gc.stream.RecvMsg(frame); err != nil {
+		return message, err
+	}
+	err := grpchub.DecodeMessage(frame, &message)
+	return message, err
+}
+
+// Notify logs info
+func (gc *GRPCClient) Notify(authInfo map[string]string) {
+	klog.Infof("no op")
+}
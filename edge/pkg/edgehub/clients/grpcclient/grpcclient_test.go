@@ -0,0 +1,224 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/cloud/pkg/cloudhub/servers/grpcserver"
+	"github.com/kubeedge/viaduct/pkg/conn"
+	"github.com/kubeedge/viaduct/pkg/mux"
+)
+
+type certPair struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newCert(t *testing.T, template *x509.Certificate, parent *certPair) *certPair {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return &certPair{cert: cert, key: key, der: der}
+}
+
+func (p *certPair) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{p.der}, PrivateKey: p.key}
+}
+
+func (p *certPair) writeFiles(t *testing.T, dir, name string) (string, string) {
+	keyDER, err := x509.MarshalECPrivateKey(p.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: p.der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+type handlerFunc func(req *mux.MessageRequest, writer mux.ResponseWriter)
+
+func (f handlerFunc) ServeConn(req *mux.MessageRequest, writer mux.ResponseWriter) {
+	f(req, writer)
+}
+
+func TestGRPCClientRoundTrip(t *testing.T) {
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := time.Now().Add(time.Hour)
+	ca := newCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "KubeEdge"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil)
+	serverCert := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "cloudcore"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	clientCert := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "system:node:edge-node"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	connections := make(chan conn.Connection, 1)
+	requests := make(chan *mux.MessageRequest, 1)
+	server := &grpcserver.Server{
+		Addr: listener.Addr().String(),
+		TLSConfig: &tls.Config{
+			ClientCAs:    pool,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			Certificates: []tls.Certificate{serverCert.tlsCertificate()},
+			MinVersion:   tls.VersionTLS12,
+		},
+		KeepaliveTime:    30 * time.Second,
+		KeepaliveTimeout: 10 * time.Second,
+		ConnNotify: func(c conn.Connection) {
+			connections <- c
+		},
+		Handler: handlerFunc(func(req *mux.MessageRequest, writer mux.ResponseWriter) {
+			requests <- req
+		}),
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Close()
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := clientCert.writeFiles(t, dir, "edge")
+	client := NewGRPCClient(&GRPCConfig{
+		Addr:             listener.Addr().String(),
+		CaFilePath:       caFile,
+		CertFilePath:     certFile,
+		KeyFilePath:      keyFile,
+		HandshakeTimeout: 10 * time.Second,
+		WriteDeadline:    10 * time.Second,
+		KeepaliveTime:    30 * time.Second,
+		KeepaliveTimeout: 10 * time.Second,
+		NodeID:           "edge-node",
+		ProjectID:        "e632aba927ea4ac2b575ec1603d56f10",
+	})
+	if err := client.Init(); err != nil {
+		t.Fatalf("failed to init client: %v", err)
+	}
+	defer client.UnInit()
+
+	var connection conn.Connection
+	select {
+	case connection = <-connections:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the connection")
+	}
+	state := connection.ConnectionState()
+	if got := state.Headers.Get("node_id"); got != "edge-node" {
+		t.Errorf("expected node_id edge-node, got %q", got)
+	}
+	if len(state.PeerCertificates) == 0 || state.PeerCertificates[0].Subject.CommonName != "system:node:edge-node" {
+		t.Errorf("expected the peer certificate of the edge node, got %v", state.PeerCertificates)
+	}
+
+	upstream := model.NewMessage("").BuildRouter("edged", "resource", "node/edge-node/pod/nginx", model.UpdateOperation).
+		FillBody("upstream")
+	if err := client.Send(*upstream); err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	select {
+	case req := <-requests:
+		if req.Message.GetID() != upstream.GetID() || req.Header.Get("project_id") != "e632aba927ea4ac2b575ec1603d56f10" {
+			t.Errorf("unexpected request %+v", req)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the upstream message")
+	}
+
+	downstream := model.NewMessage("").BuildRouter("edgecontroller", "resource", "node/edge-node/pod/nginx", model.DeleteOperation).
+		FillBody("downstream")
+	if err := connection.SetWriteDeadline(time.Now().Add(10 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := connection.WriteMessageAsync(downstream); err != nil {
+		t.Fatalf("failed to write message: %v", err)
+	}
+	received, err := client.Receive()
+	if err != nil {
+		t.Fatalf("failed to receive message: %v", err)
+	}
+	if received.GetID() != downstream.GetID() || received.GetOperation() != model.DeleteOperation {
+		t.Errorf("received %+v, expected %+v", received, downstream)
+	}
+
+	// closing the connection on the cloud ends the stream of the edge node
+	_ = connection.Close()
+	if _, err := client.Receive(); err == nil {
+		t.Error("expected an error receiving from a closed stream")
+	}
+}
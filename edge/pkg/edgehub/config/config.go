@@ -20,8 +20,13 @@ func InitConfigure(eh *v1alpha2.EdgeHub, nodeName string) {
 	once.Do(func() {
 		Config = Configure{
 			EdgeHub:      *eh,
-			WebSocketURL: strings.Join([]string{"wss:/", eh.WebSocket.Server, eh.ProjectID, nodeName, "events"}, "/"),
+			WebSocketURL: WebSocketURL(eh.WebSocket.Server, eh.ProjectID, nodeName),
 			NodeName:     nodeName,
 		}
 	})
 }
+
+// WebSocketURL returns the url of the websocket server of cloudhub at server (ip:port)
+func WebSocketURL(server, projectID, nodeName string) string {
+	return strings.Join([]string{"wss:/", server, projectID, nodeName, "events"}, "/")
+}
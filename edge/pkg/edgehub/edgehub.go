@@ -9,10 +9,12 @@ import (
 
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	connect "github.com/kubeedge/kubeedge/edge/pkg/common/cloudconnection"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/certificate"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/clients"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/config"
+	"github.com/kubeedge/kubeedge/edge/pkg/edgehub/endpoints"
 	// register Task handler
 	_ "github.com/kubeedge/kubeedge/edge/pkg/edgehub/task"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
@@ -53,6 +55,21 @@ func newEdgeHub(enable bool) *EdgeHub {
 	}
 }
 
+// newEndpointSelector returns the selector of the cloudcore endpoints to connect to.
+// Only endpoints of protocols over tcp are probed before connecting to them.
+func (eh *EdgeHub) newEndpointSelector() (*endpoints.Selector, error) {
+	server, err := clients.GetServer()
+	if err != nil {
+		return nil, err
+	}
+	var probe endpoints.ProbeFunc
+	if !config.Config.Quic.Enable {
+		probe = endpoints.TCPProbe
+	}
+	wait := time.Duration(config.Config.Heartbeat) * time.Second * 2
+	return endpoints.NewSelector(server, config.Config.Endpoints, probe, wait), nil
+}
+
 // Register register edgehub
 func Register(eh *v1alpha2.EdgeHub, nodeName string) {
 	config.InitConfigure(eh, nodeName)
@@ -74,6 +91,12 @@ func (eh *EdgeHub) Enable() bool {
 	return eh.enable
 }
 
+// QueuePaused returns true while EdgeHub is disconnected from the cloud, the messages
+// to the cloud are left pending until it reconnects
+func (eh *EdgeHub) QueuePaused() bool {
+	return !connect.IsConnected()
+}
+
 // Start sets context and starts the controller
 func (eh *EdgeHub) Start() {
 	eh.certManager = certificate.NewCertManager(config.Config.EdgeHub, config.Config.NodeName)
@@ -85,6 +108,12 @@ func (eh *EdgeHub) Start() {
 
 	go eh.ifRotationDone()
 
+	selector, err := eh.newEndpointSelector()
+	if err != nil {
+		klog.Exitf("failed to init controller: %v", err)
+		return
+	}
+
 	for {
 		select {
 		case <-beehiveContext.Done():
@@ -92,7 +121,14 @@ func (eh *EdgeHub) Start() {
 			return
 		default:
 		}
-		err := eh.initial()
+		server, err := selector.Next()
+		if err != nil {
+			waitTime := selector.Wait()
+			klog.Errorf("%v, will retry after %s", err, waitTime.String())
+			time.Sleep(waitTime)
+			continue
+		}
+		err = eh.initial(server)
 		if err != nil {
 			klog.Exitf("failed to init controller: %v", err)
 			return
@@ -102,10 +138,13 @@ func (eh *EdgeHub) Start() {
 
 		err = eh.chClient.Init()
 		if err != nil {
-			klog.Errorf("connection failed: %v, will reconnect after %s", err, waitTime.String())
-			time.Sleep(waitTime)
+			selector.Failed(server)
+			retryTime := selector.Wait()
+			klog.Errorf("connection to %s failed: %v, will reconnect after %s", server, err, retryTime.String())
+			time.Sleep(retryTime)
 			continue
 		}
+		selector.Connected(server)
 		// execute hook func after connect
 		eh.pubConnectInfo(true)
 		go eh.routeToEdge()
@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package endpoints picks the cloudcore endpoint edgehub connects to when more
+// than one is configured, failing over to the next healthy one when it breaks.
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+// ErrNoHealthyEndpoint is returned by Next when all endpoints were tried.
+var ErrNoHealthyEndpoint = errors.New("no healthy cloudcore endpoint")
+
+// ProbeFunc checks whether an endpoint accepts connections.
+type ProbeFunc func(address string, timeout time.Duration) error
+
+// TCPProbe dials the endpoint, it can't be used for protocols running over udp.
+func TCPProbe(address string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", address, timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Selector iterates the endpoints in rounds. A round tries every endpoint once,
+// starting with the one connected last, and ends when one could be connected to.
+// Every round which ended without a connection doubles the wait before the next.
+type Selector struct {
+	primary        string
+	servers        []string
+	srvRecord      string
+	strategy       v1alpha2.EndpointStrategy
+	probeTimeout   time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	// wait is the fixed wait between the attempts if no other endpoints are configured
+	wait time.Duration
+
+	probe     ProbeFunc
+	lookupSRV func(name string) ([]*net.SRV, error)
+	shuffle   func(endpoints []string)
+
+	candidates []string
+	// next is the index of the next candidate to try
+	next int
+	// tried is the number of candidates tried in the current round
+	tried int
+	// failures is the number of consecutive rounds without a connection
+	failures int
+}
+
+// NewSelector returns a selector of primary, the server of the enabled protocol, and
+// the endpoints of cfg. The endpoints are probed with probe if it is not nil.
+// If cfg configures no other endpoints, the selector always returns primary and
+// waits wait between the attempts, as edgehub does with a single endpoint.
+func NewSelector(primary string, cfg *v1alpha2.EdgeHubEndpoints, probe ProbeFunc, wait time.Duration) *Selector {
+	s := &Selector{
+		primary:   primary,
+		wait:      wait,
+		probe:     probe,
+		lookupSRV: lookupSRV,
+		shuffle: func(endpoints []string) {
+			rand.Shuffle(len(endpoints), func(i, j int) {
+				endpoints[i], endpoints[j] = endpoints[j], endpoints[i]
+			})
+		},
+		strategy:       v1alpha2.EndpointStrategyFailover,
+		probeTimeout:   3 * time.Second,
+		initialBackoff: 5 * time.Second,
+		maxBackoff:     300 * time.Second,
+	}
+	if cfg != nil {
+		s.servers = cfg.Servers
+		s.srvRecord = cfg.SRVRecord
+		if cfg.Strategy != "" {
+			s.strategy = cfg.Strategy
+		}
+		if cfg.ProbeTimeout > 0 {
+			s.probeTimeout = time.Duration(cfg.ProbeTimeout) * time.Second
+		}
+		if cfg.InitialBackoff > 0 {
+			s.initialBackoff = time.Duration(cfg.InitialBackoff) * time.Second
+		}
+		if cfg.MaxBackoff > 0 {
+			s.maxBackoff = time.Duration(cfg.MaxBackoff) * time.Second
+		}
+	}
+	return s
+}
+
+// Enabled reports whether endpoints other than the primary one are configured.
+func (s *Selector) Enabled() bool {
+	return len(s.servers) > 0 || s.srvRecord != ""
+}
+
+// Next returns the next healthy endpoint of the current round, or ErrNoHealthyEndpoint
+// once the round tried all endpoints, after which the following call starts a new round.
+func (s *Selector) Next() (string, error) {
+	if !s.Enabled() {
+		return s.primary, nil
+	}
+	if s.candidates == nil {
+		s.refresh()
+	}
+	for s.tried < len(s.candidates) {
+		address := s.candidates[s.next]
+		if s.probe == nil {
+			return address, nil
+		}
+		err := s.probe(address, s.probeTimeout)
+		if err == nil {
+			return address, nil
+		}
+		klog.Warningf("cloudcore endpoint %s is unhealthy: %v", address, err)
+		s.advance()
+	}
+	s.endRound()
+	return "", ErrNoHealthyEndpoint
+}
+
+// Failed records that connecting to address failed, so that the next endpoint is tried.
+func (s *Selector) Failed(address string) {
+	if !s.Enabled() || s.tried >= len(s.candidates) || s.candidates[s.next] != address {
+		return
+	}
+	s.advance()
+	if s.tried >= len(s.candidates) {
+		s.endRound()
+	}
+}
+
+// Connected records that address was connected to, it is tried first in the next round.
+func (s *Selector) Connected(address string) {
+	if !s.Enabled() {
+		return
+	}
+	klog.Infof("connected to cloudcore endpoint %s", address)
+	s.tried = 0
+	s.failures = 0
+}
+
+// Wait returns how long to wait before the next attempt. It is zero while the
+// current round has endpoints left, and backs off exponentially after rounds
+// without a connection.
+func (s *Selector) Wait() time.Duration {
+	if !s.Enabled() {
+		return s.wait
+	}
+	if s.failures == 0 {
+		return 0
+	}
+	wait := s.initialBackoff
+	for i := 1; i < s.failures && wait < s.maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > s.maxBackoff {
+		wait = s.maxBackoff
+	}
+	return wait
+}
+
+func (s *Selector) advance() {
+	s.tried++
+	s.next = (s.next + 1) % len(s.candidates)
+}
+
+// endRound starts a new round with the endpoints looked up again.
+func (s *Selector) endRound() {
+	s.failures++
+	s.refresh()
+}
+
+// refresh builds the candidates of a new round, the primary endpoint, the configured
+// servers and those listed by the SRV record, without duplicates.
+func (s *Selector) refresh() {
+	endpoints := append([]string{s.primary}, s.servers...)
+	if s.srvRecord != "" {
+		records, err := s.lookupSRV(s.srvRecord)
+		if err != nil {
+			klog.Warningf("failed to look up the cloudcore endpoints of %s: %v", s.srvRecord, err)
+		}
+		for _, r := range records {
+			endpoints = append(endpoints, net.JoinHostPort(strings.TrimSuffix(r.Target, "."), strconv.Itoa(int(r.Port))))
+		}
+	}
+
+	seen := make(map[string]bool, len(endpoints))
+	candidates := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		if e == "" || seen[e] {
+			continue
+		}
+		seen[e] = true
+		candidates = append(candidates, e)
+	}
+	if s.strategy == v1alpha2.EndpointStrategyRandom {
+		s.shuffle(candidates)
+	}
+	s.candidates = candidates
+	s.next = 0
+	s.tried = 0
+}
+
+// lookupSRV looks up the SRV record name, the records are sorted by priority
+// and randomized by weight.
+func lookupSRV(name string) ([]*net.SRV, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	return records, err
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoints
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+// fakeProbe reports the endpoints in down as unhealthy and records the probed ones.
+type fakeProbe struct {
+	down   map[string]bool
+	probed []string
+}
+
+func (p *fakeProbe) probe(address string, timeout time.Duration) error {
+	p.probed = append(p.probed, address)
+	if p.down[address] {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func TestSelectorDisabled(t *testing.T) {
+	s := NewSelector("10.0.0.1:10000", &v1alpha2.EdgeHubEndpoints{}, TCPProbe, 30*time.Second)
+	for i := 0; i < 3; i++ {
+		server, err := s.Next()
+		if err != nil || server != "10.0.0.1:10000" {
+			t.Fatalf("expected the primary endpoint, got %q, %v", server, err)
+		}
+		s.Failed(server)
+		if wait := s.Wait(); wait != 30*time.Second {
+			t.Errorf("expected the fixed wait, got %s", wait)
+		}
+	}
+}
+
+func TestSelectorFailover(t *testing.T) {
+	probe := &fakeProbe{down: map[string]bool{"10.0.0.1:10000": true}}
+	s := NewSelector("10.0.0.1:10000", &v1alpha2.EdgeHubEndpoints{
+		Servers: []string{"10.0.0.2:10000", "10.0.0.1:10000", "10.0.0.3:10000"},
+	}, probe.probe, 30*time.Second)
+
+	// the primary endpoint is down, so the next one is used right away
+	server, err := s.Next()
+	if err != nil || server != "10.0.0.2:10000" {
+		t.Fatalf("expected 10.0.0.2:10000, got %q, %v", server, err)
+	}
+	if wait := s.Wait(); wait != 0 {
+		t.Errorf("expected no wait within a round, got %s", wait)
+	}
+
+	// connecting fails although the probe succeeded
+	s.Failed(server)
+	server, err = s.Next()
+	if err != nil || server != "10.0.0.3:10000" {
+		t.Fatalf("expected 10.0.0.3:10000, got %q, %v", server, err)
+	}
+	s.Connected(server)
+
+	// after the connection broke, the endpoint connected last is tried first
+	server, err = s.Next()
+	if err != nil || server != "10.0.0.3:10000" {
+		t.Fatalf("expected 10.0.0.3:10000 again, got %q, %v", server, err)
+	}
+	if want := []string{"10.0.0.1:10000", "10.0.0.2:10000", "10.0.0.3:10000", "10.0.0.3:10000"}; !reflect.DeepEqual(probe.probed, want) {
+		t.Errorf("expected probes of %v, got %v", want, probe.probed)
+	}
+}
+
+func TestSelectorBackoff(t *testing.T) {
+	probe := &fakeProbe{down: map[string]bool{"10.0.0.1:10000": true, "10.0.0.2:10000": true}}
+	s := NewSelector("10.0.0.1:10000", &v1alpha2.EdgeHubEndpoints{
+		Servers:        []string{"10.0.0.2:10000"},
+		InitialBackoff: 5,
+		MaxBackoff:     30,
+	}, probe.probe, 30*time.Second)
+
+	var waits []time.Duration
+	for i := 0; i < 5; i++ {
+		if _, err := s.Next(); !errors.Is(err, ErrNoHealthyEndpoint) {
+			t.Fatalf("expected ErrNoHealthyEndpoint, got %v", err)
+		}
+		waits = append(waits, s.Wait())
+	}
+	want := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 30 * time.Second, 30 * time.Second}
+	if !reflect.DeepEqual(waits, want) {
+		t.Errorf("expected waits %v, got %v", want, waits)
+	}
+
+	// the backoff is reset once connected
+	delete(probe.down, "10.0.0.2:10000")
+	server, err := s.Next()
+	if err != nil || server != "10.0.0.2:10000" {
+		t.Fatalf("expected 10.0.0.2:10000, got %q, %v", server, err)
+	}
+	s.Connected(server)
+	if wait := s.Wait(); wait != 0 {
+		t.Errorf("expected no wait after connecting, got %s", wait)
+	}
+}
+
+func TestSelectorSRVRecord(t *testing.T) {
+	s := NewSelector("10.0.0.1:10000", &v1alpha2.EdgeHubEndpoints{
+		Servers:   []string{"10.0.0.2:10000"},
+		SRVRecord: "_cloudhub._tcp.kubeedge.example.com",
+	}, nil, 30*time.Second)
+	lookups := 0
+	s.lookupSRV = func(name string) ([]*net.SRV, error) {
+		lookups++
+		if name != "_cloudhub._tcp.kubeedge.example.com" {
+			t.Errorf("unexpected SRV record %s", name)
+		}
+		return []*net.SRV{
+			{Target: "cloudcore-0.kubeedge.example.com.", Port: 10000},
+			{Target: "10.0.0.2", Port: 10000},
+		}, nil
+	}
+
+	var servers []string
+	for i := 0; i < 3; i++ {
+		server, err := s.Next()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		servers = append(servers, server)
+		s.Failed(server)
+	}
+	want := []string{"10.0.0.1:10000", "10.0.0.2:10000", "cloudcore-0.kubeedge.example.com:10000"}
+	if !reflect.DeepEqual(servers, want) {
+		t.Errorf("expected endpoints %v, got %v", want, servers)
+	}
+	// the record is looked up again for the next round
+	if _, err := s.Next(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lookups != 2 {
+		t.Errorf("expected 2 lookups, got %d", lookups)
+	}
+}
+
+func TestSelectorRandom(t *testing.T) {
+	s := NewSelector("10.0.0.1:10000", &v1alpha2.EdgeHubEndpoints{
+		Servers:  []string{"10.0.0.2:10000", "10.0.0.3:10000"},
+		Strategy: v1alpha2.EndpointStrategyRandom,
+	}, nil, 30*time.Second)
+	s.shuffle = func(endpoints []string) {
+		endpoints[0], endpoints[2] = endpoints[2], endpoints[0]
+	}
+	server, err := s.Next()
+	if err != nil || server != "10.0.0.3:10000" {
+		t.Fatalf("expected the shuffled endpoint 10.0.0.3:10000, got %q, %v", server, err)
+	}
+}
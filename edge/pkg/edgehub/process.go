@@ -30,8 +30,8 @@ var (
 	longThrottleLatency = 1 * time.Second
 )
 
-func (eh *EdgeHub) initial() (err error) {
-	cloudHubClient, err := clients.GetClient()
+func (eh *EdgeHub) initial(server string) (err error) {
+	cloudHubClient, err := clients.GetClient(server)
 	if err != nil {
 		return err
 	}
@@ -17,13 +17,18 @@ limitations under the License.
 package taskexecutor
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	refdocker "github.com/containerd/containerd/reference/docker"
 	v1 "k8s.io/api/core/v1"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/credentialprovider"
 
 	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/common/types"
@@ -31,7 +36,6 @@ import (
 	"github.com/kubeedge/kubeedge/edge/cmd/edgecore/app/options"
 	edgeutil "github.com/kubeedge/kubeedge/edge/pkg/common/util"
 	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
-	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
 	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/util/fsm"
@@ -39,6 +43,9 @@ import (
 
 const (
 	TaskPrePull = "prepull"
+
+	// progressReportPeriod is the period to report the progress of the image prepull
+	progressReportPeriod = 15 * time.Second
 )
 
 type PrePull struct {
@@ -80,9 +87,16 @@ func pullImages(taskReq types.NodeTaskRequest) fsm.Event {
 		event.Action = api.ActionFailure
 		return event
 	}
+	windows, err := parsePullWindows(prePullReq.PullWindows)
+	if err != nil {
+		event.Msg = err.Error()
+		event.Action = api.ActionFailure
+		return event
+	}
 
 	// pull images
-	container, err := util.NewContainerRuntime(edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.ContainerRuntimeEndpoint, edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.CgroupDriver)
+	puller, err := newImagePuller(edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.ContainerRuntimeEndpoint,
+		edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.CgroupDriver, prePullReq.BandwidthLimit)
 	if err != nil {
 		event.Msg = err.Error()
 		event.Action = api.ActionFailure
@@ -90,7 +104,13 @@ func pullImages(taskReq types.NodeTaskRequest) fsm.Event {
 	}
 
 	go func() {
-		errorStr, imageStatus := prePullImages(*prePullReq, container)
+		defer puller.Close()
+		nodeName := edgeCoreConfig.Modules.Edged.HostnameOverride
+		prePuller := newImagePrePuller(*prePullReq, puller, windows)
+		stopCh := make(chan struct{})
+		go prePuller.reportProgress(taskReq, nodeName, stopCh)
+		errorStr, imageStatus := prePuller.prePullImages()
+		close(stopCh)
 		if errorStr != "" {
 			event.Action = api.ActionFailure
 			event.Msg = errorStr
@@ -101,7 +121,7 @@ func pullImages(taskReq types.NodeTaskRequest) fsm.Event {
 			klog.Warningf("marshal imageStatus failed: %v", err)
 		}
 		resp := commontypes.NodeTaskResponse{
-			NodeName:        edgeCoreConfig.Modules.Edged.HostnameOverride,
+			NodeName:        nodeName,
 			Event:           event.Type,
 			Action:          event.Action,
 			Reason:          event.Msg,
@@ -125,20 +145,47 @@ func getImagePrePullJobRequest(taskReq commontypes.NodeTaskRequest) (*commontype
 	return &prePullReq, err
 }
 
-func prePullImages(prePullReq commontypes.ImagePrePullJobRequest, container util.ContainerRuntime) (string, []v1alpha1.ImageStatus) {
+// imagePrePuller pulls the images of an image prepull job in the pull windows, and
+// records the progress of them
+type imagePrePuller struct {
+	req     commontypes.ImagePrePullJobRequest
+	puller  imagePuller
+	windows []pullWindow
+
+	sync.Mutex
+	imageStatus []v1alpha1.ImageStatus
+	layers      []*layerProgress
+	// waiting is the reason when waiting for the pull window
+	waiting string
+}
+
+func newImagePrePuller(req commontypes.ImagePrePullJobRequest, puller imagePuller, windows []pullWindow) *imagePrePuller {
+	p := &imagePrePuller{
+		req:         req,
+		puller:      puller,
+		windows:     windows,
+		imageStatus: make([]v1alpha1.ImageStatus, len(req.Images)),
+		layers:      make([]*layerProgress, len(req.Images)),
+	}
+	for i, image := range req.Images {
+		p.imageStatus[i].Image = image
+		p.layers[i] = &layerProgress{}
+	}
+	return p
+}
+
+func (p *imagePrePuller) prePullImages() (string, []v1alpha1.ImageStatus) {
 	errorStr := ""
-	authConfig, err := makeAuthConfig(prePullReq.Secret)
+	creds, err := makeImageCredentials(append([]string{p.req.Secret}, p.req.Secrets...))
 	if err != nil {
-		return errorStr, []v1alpha1.ImageStatus{}
+		klog.Errorf("make image pull credentials failed, err: %v", err)
+		return err.Error(), []v1alpha1.ImageStatus{}
 	}
 
-	var imageStatus []v1alpha1.ImageStatus
-	for _, image := range prePullReq.Images {
-		prePullStatus := v1alpha1.ImageStatus{
-			Image: image,
-		}
-		for i := 0; i <= int(prePullReq.RetryTimes); i++ {
-			err = container.PullImage(image, authConfig, nil)
+	for i, image := range p.req.Images {
+		p.setImageState(i, api.PullingState, "")
+		for j := 0; j <= int(p.req.RetryTimes); j++ {
+			err = p.pullImage(i, image, creds.lookup(image))
 			if err == nil {
 				break
 			}
@@ -146,39 +193,195 @@ func prePullImages(prePullReq commontypes.ImagePrePullJobRequest, container util
 		if err != nil {
 			klog.Errorf("pull image %s failed, err: %v", image, err)
 			errorStr = fmt.Sprintf("pull image failed, err: %v", err)
-			prePullStatus.State = api.TaskFailed
-			prePullStatus.Reason = err.Error()
+			p.setImageState(i, api.TaskFailed, err.Error())
 		} else {
 			klog.Infof("pull image %s successfully!", image)
-			prePullStatus.State = api.TaskSuccessful
+			p.setImageState(i, api.TaskSuccessful, "")
 		}
-		imageStatus = append(imageStatus, prePullStatus)
 	}
 
+	_, imageStatus := p.progress()
 	return errorStr, imageStatus
 }
 
-func makeAuthConfig(pullsecret string) (*runtimeapi.AuthConfig, error) {
-	if pullsecret == "" {
-		return nil, nil
+// pullImage pulls the image in the pull windows, the pull is canceled when the window closes
+// and resumed in the next window, containerd keeps the downloaded content of the layers
+func (p *imagePrePuller) pullImage(index int, image string, auths []*runtimeapi.AuthConfig) error {
+	// the credentials are tried in order as kubelet does
+	if len(auths) == 0 {
+		auths = []*runtimeapi.AuthConfig{nil}
+	}
+	for {
+		ctx, cancel := p.waitPullWindow()
+		var err error
+		for _, auth := range auths {
+			if err = p.puller.PullImage(ctx, image, auth, p.layers[index]); err == nil {
+				break
+			}
+		}
+		closed := ctx.Err() == context.DeadlineExceeded
+		cancel()
+		if err == nil || !closed {
+			return err
+		}
+		klog.Infof("the pull window is closed, pause pulling image %s", image)
+	}
+}
+
+// waitPullWindow waits until the time is in a pull window, and returns the context done when the window closes
+func (p *imagePrePuller) waitPullWindow() (context.Context, context.CancelFunc) {
+	if len(p.windows) == 0 {
+		return context.WithCancel(context.Background())
+	}
+	for {
+		start, end := nextPullWindow(p.windows, time.Now())
+		wait := time.Until(start)
+		if wait <= 0 {
+			p.setWaiting("")
+			return context.WithDeadline(context.Background(), end)
+		}
+		p.setWaiting(fmt.Sprintf("waiting for the pull window from %s", start.Format(time.RFC3339)))
+		// check the windows again in case the clock of the node is changed
+		if wait > time.Minute {
+			wait = time.Minute
+		}
+		time.Sleep(wait)
+	}
+}
+
+func (p *imagePrePuller) setImageState(index int, state api.State, reason string) {
+	p.Lock()
+	defer p.Unlock()
+	p.imageStatus[index].State = state
+	p.imageStatus[index].Reason = reason
+}
+
+func (p *imagePrePuller) setWaiting(reason string) {
+	p.Lock()
+	defer p.Unlock()
+	p.waiting = reason
+}
+
+// progress returns the reason if waiting for the pull window, and the status of the images
+func (p *imagePrePuller) progress() (string, []v1alpha1.ImageStatus) {
+	p.Lock()
+	defer p.Unlock()
+	imageStatus := make([]v1alpha1.ImageStatus, len(p.imageStatus))
+	for i, status := range p.imageStatus {
+		imageStatus[i] = status
+		imageStatus[i].Layers = p.layers[i].status()
 	}
+	return p.waiting, imageStatus
+}
 
-	secretSli := strings.Split(pullsecret, constants.ResourceSep)
-	if len(secretSli) != 2 {
-		return nil, fmt.Errorf("pull secret format is not correct")
+// reportProgress reports the progress of the images periodically until stopCh is closed. The progress
+// resets the timeout of the node task in the cloud, it is reported while waiting for the pull window,
+// or if the images are pulled since the last report.
+func (p *imagePrePuller) reportProgress(taskReq types.NodeTaskRequest, nodeName string, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(progressReportPeriod)
+	defer ticker.Stop()
+	var last string
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+		waiting, imageStatus := p.progress()
+		data, err := json.Marshal(imageStatus)
+		if err != nil {
+			klog.Warningf("marshal imageStatus failed: %v", err)
+			continue
+		}
+		if waiting == "" && string(data) == last {
+			continue
+		}
+		last = string(data)
+		edgeutil.ReportTaskResult(taskReq.Type, taskReq.TaskID, commontypes.NodeTaskResponse{
+			NodeName:        nodeName,
+			Event:           api.EventProgress,
+			Action:          api.ActionSuccess,
+			Reason:          waiting,
+			ExternalMessage: last,
+		})
 	}
+}
 
+// imageCredentials are the credentials of the image pull secrets
+type imageCredentials struct {
+	keyring *credentialprovider.BasicDockerKeyring
+	// legacy are the secrets in the format of the CRI auth config, they are used for all the images
+	legacy []*runtimeapi.AuthConfig
+}
+
+func makeImageCredentials(pullSecrets []string) (*imageCredentials, error) {
+	creds := &imageCredentials{keyring: &credentialprovider.BasicDockerKeyring{}}
 	client := metaclient.New()
-	secret, err := client.Secrets(secretSli[0]).Get(secretSli[1])
-	if err != nil {
-		return nil, fmt.Errorf("get secret %s failed, %v", secretSli[1], err)
+	for _, pullSecret := range pullSecrets {
+		if pullSecret == "" {
+			continue
+		}
+		secretSli := strings.Split(pullSecret, constants.ResourceSep)
+		if len(secretSli) != 2 {
+			return nil, fmt.Errorf("pull secret %s format is not correct", pullSecret)
+		}
+		secret, err := client.Secrets(secretSli[0]).Get(secretSli[1])
+		if err != nil {
+			return nil, fmt.Errorf("get secret %s failed, %v", secretSli[1], err)
+		}
+		if err := creds.add(secret); err != nil {
+			return nil, fmt.Errorf("unmarshal secret %s to auth file failed, %v", secretSli[1], err)
+		}
+	}
+	return creds, nil
+}
+
+// add adds the docker config of the secret to the keyring, the secrets in the format of the
+// CRI auth config are supported for compatibility
+func (c *imageCredentials) add(secret *v1.Secret) error {
+	if data, ok := secret.Data[v1.DockerConfigKey]; ok {
+		var cfg credentialprovider.DockerConfig
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return err
+		}
+		c.keyring.Add(cfg)
+		return nil
 	}
 
+	data := secret.Data[v1.DockerConfigJsonKey]
+	var cfg credentialprovider.DockerConfigJSON
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+	if len(cfg.Auths) > 0 {
+		c.keyring.Add(cfg.Auths)
+		return nil
+	}
 	var auth runtimeapi.AuthConfig
-	err = json.Unmarshal(secret.Data[v1.DockerConfigJsonKey], &auth)
-	if err != nil {
-		return nil, fmt.Errorf("unmarshal secret %s to auth file failed, %v", secretSli[1], err)
+	if err := json.Unmarshal(data, &auth); err != nil {
+		return err
 	}
+	c.legacy = append(c.legacy, &auth)
+	return nil
+}
 
-	return &auth, nil
+// lookup returns the credentials matching the registry of the image
+func (c *imageCredentials) lookup(image string) []*runtimeapi.AuthConfig {
+	repo := image
+	if named, err := refdocker.ParseDockerRef(image); err == nil {
+		repo = named.Name()
+	}
+	auths, _ := c.keyring.Lookup(repo)
+	result := make([]*runtimeapi.AuthConfig, 0, len(auths)+len(c.legacy))
+	for _, auth := range auths {
+		result = append(result, &runtimeapi.AuthConfig{
+			Username:      auth.Username,
+			Password:      auth.Password,
+			Auth:          auth.Auth,
+			ServerAddress: auth.ServerAddress,
+			IdentityToken: auth.IdentityToken,
+			RegistryToken: auth.RegistryToken,
+		})
+	}
+	return append(result, c.legacy...)
 }
@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskexecutor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+
+	commontypes "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+func commonPrePullRequest(images ...string) commontypes.ImagePrePullJobRequest {
+	return commontypes.ImagePrePullJobRequest{Images: images}
+}
+
+func TestNextPullWindow(t *testing.T) {
+	loc := time.FixedZone("test", 8*3600)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 3, day, hour, minute, 0, 0, loc)
+	}
+	cases := []struct {
+		name      string
+		windows   []v1alpha1.PullWindow
+		now       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "in the window",
+			windows:   []v1alpha1.PullWindow{{Start: "02:00", End: "04:00"}},
+			now:       at(10, 3, 0),
+			wantStart: at(10, 3, 0),
+			wantEnd:   at(10, 4, 0),
+		},
+		{
+			name:      "before the window",
+			windows:   []v1alpha1.PullWindow{{Start: "02:00", End: "04:00"}},
+			now:       at(10, 1, 30),
+			wantStart: at(10, 2, 0),
+			wantEnd:   at(10, 4, 0),
+		},
+		{
+			name:      "after the window",
+			windows:   []v1alpha1.PullWindow{{Start: "02:00", End: "04:00"}},
+			now:       at(10, 4, 0),
+			wantStart: at(11, 2, 0),
+			wantEnd:   at(11, 4, 0),
+		},
+		{
+			name:      "window spans midnight",
+			windows:   []v1alpha1.PullWindow{{Start: "23:00", End: "01:00"}},
+			now:       at(10, 0, 30),
+			wantStart: at(10, 0, 30),
+			wantEnd:   at(10, 1, 0),
+		},
+		{
+			name:      "earliest of the windows",
+			windows:   []v1alpha1.PullWindow{{Start: "22:00", End: "23:00"}, {Start: "12:00", End: "13:00"}},
+			now:       at(10, 9, 0),
+			wantStart: at(10, 12, 0),
+			wantEnd:   at(10, 13, 0),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			windows, err := parsePullWindows(tc.windows)
+			if err != nil {
+				t.Fatalf("parsePullWindows() error = %v", err)
+			}
+			start, end := nextPullWindow(windows, tc.now)
+			if !start.Equal(tc.wantStart) || !end.Equal(tc.wantEnd) {
+				t.Errorf("expected window %v-%v, but got %v-%v", tc.wantStart, tc.wantEnd, start, end)
+			}
+		})
+	}
+
+	if _, err := parsePullWindows([]v1alpha1.PullWindow{{Start: "2:00am", End: "04:00"}}); err == nil {
+		t.Errorf("expected an error for the invalid pull window")
+	}
+}
+
+func TestImageCredentials(t *testing.T) {
+	creds := &imageCredentials{keyring: &credentialprovider.BasicDockerKeyring{}}
+	secrets := []*v1.Secret{
+		{
+			Type: v1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{v1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"username":"user","password":"pass"}}}`)},
+		},
+		{
+			Type: v1.SecretTypeDockercfg,
+			Data: map[string][]byte{v1.DockerConfigKey: []byte(`{"index.docker.io/v1/":{"auth":"ZG9ja2VyOmh1Yg=="}}`)},
+		},
+		{
+			Data: map[string][]byte{v1.DockerConfigJsonKey: []byte(`{"username":"legacy","password":"secret"}`)},
+		},
+	}
+	for _, secret := range secrets {
+		if err := creds.add(secret); err != nil {
+			t.Fatalf("add() error = %v", err)
+		}
+	}
+
+	cases := []struct {
+		image string
+		want  []string
+	}{
+		{image: "registry.example.com/edge/app:v1", want: []string{"user", "legacy"}},
+		{image: "nginx:latest", want: []string{"docker", "legacy"}},
+		{image: "quay.io/edge/app:v1", want: []string{"legacy"}},
+	}
+	for _, tc := range cases {
+		var users []string
+		for _, auth := range creds.lookup(tc.image) {
+			user, _, err := parseAuth(auth)
+			if err != nil {
+				t.Fatalf("parseAuth() error = %v", err)
+			}
+			users = append(users, user)
+		}
+		if !reflect.DeepEqual(users, tc.want) {
+			t.Errorf("image %s: expected credentials of %v, but got %v", tc.image, tc.want, users)
+		}
+	}
+}
+
+func TestParseAuth(t *testing.T) {
+	cases := []struct {
+		auth         *runtimeapi.AuthConfig
+		wantUser     string
+		wantPassword string
+	}{
+		{auth: &runtimeapi.AuthConfig{Username: "user", Password: "pass"}, wantUser: "user", wantPassword: "pass"},
+		{auth: &runtimeapi.AuthConfig{Auth: "dXNlcjpwYXNz"}, wantUser: "user", wantPassword: "pass"},
+		{auth: &runtimeapi.AuthConfig{IdentityToken: "token"}, wantPassword: "token"},
+	}
+	for _, tc := range cases {
+		user, password, err := parseAuth(tc.auth)
+		if err != nil {
+			t.Fatalf("parseAuth() error = %v", err)
+		}
+		if user != tc.wantUser || password != tc.wantPassword {
+			t.Errorf("expected %s:%s, but got %s:%s", tc.wantUser, tc.wantPassword, user, password)
+		}
+	}
+}
+
+func TestThrottledTransport(t *testing.T) {
+	const digest = "sha256:0123456789abcdef"
+	blob := bytes.Repeat([]byte("a"), 3000)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/edge/app/blobs/"+digest, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/storage/blob", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/storage/blob", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(blob)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	layers := &layerProgress{}
+	layers.add(digest, int64(len(blob)))
+	limiter := rate.NewLimiter(rate.Limit(2000), 1000)
+	// drain the initial burst so that the download is limited from the start
+	_ = limiter.WaitN(context.Background(), 1000)
+	client := &http.Client{Transport: &throttledTransport{base: http.DefaultTransport, limiter: limiter, layers: layers}}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL + "/v2/edge/app/blobs/" + digest)
+	if err != nil {
+		t.Fatalf("failed to get the blob: %v", err)
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("failed to read the blob: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the download of 3000 bytes at 2000 bytes per second to take more than 1s, but got %v", elapsed)
+	}
+	if !bytes.Equal(data, blob) {
+		t.Errorf("expected the blob to be downloaded")
+	}
+	want := []v1alpha1.LayerStatus{{Digest: digest, Size: int64(len(blob)), Downloaded: int64(len(blob))}}
+	if got := layers.status(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected layer status %v, but got %v", want, got)
+	}
+}
+
+func TestBlobDigest(t *testing.T) {
+	blobReq := httptest.NewRequest(http.MethodGet, "https://registry.example.com/v2/edge/app/blobs/sha256:abc", nil)
+	redirected := httptest.NewRequest(http.MethodGet, "https://storage.example.com/data?sig=123", nil)
+	redirected.Response = &http.Response{Request: blobReq}
+	manifestReq := httptest.NewRequest(http.MethodGet, "https://registry.example.com/v2/edge/app/manifests/v1", nil)
+
+	for req, want := range map[*http.Request]string{blobReq: "sha256:abc", redirected: "sha256:abc", manifestReq: ""} {
+		if got := blobDigest(req); got != want {
+			t.Errorf("%s: expected digest %q, but got %q", req.URL, want, got)
+		}
+	}
+}
+
+type fakeImagePuller struct {
+	pulled []string
+	fail   map[string]bool
+}
+
+func (p *fakeImagePuller) PullImage(_ context.Context, image string, auth *runtimeapi.AuthConfig, layers *layerProgress) error {
+	user := ""
+	if auth != nil {
+		user = auth.Username
+	}
+	p.pulled = append(p.pulled, image+"@"+user)
+	if p.fail[image+"@"+user] {
+		return io.ErrUnexpectedEOF
+	}
+	layers.add("sha256:"+image, 10)
+	layers.complete()
+	return nil
+}
+
+func (p *fakeImagePuller) Close() error {
+	return nil
+}
+
+func TestImagePrePullerPullImage(t *testing.T) {
+	puller := &fakeImagePuller{fail: map[string]bool{"app@first": true}}
+	p := newImagePrePuller(commonPrePullRequest("app"), puller, nil)
+	auths := []*runtimeapi.AuthConfig{{Username: "first"}, {Username: "second"}}
+	if err := p.pullImage(0, "app", auths); err != nil {
+		t.Fatalf("pullImage() error = %v", err)
+	}
+	if want := []string{"app@first", "app@second"}; !reflect.DeepEqual(puller.pulled, want) {
+		t.Errorf("expected the credentials to be tried in order %v, but got %v", want, puller.pulled)
+	}
+	_, imageStatus := p.progress()
+	if len(imageStatus) != 1 || len(imageStatus[0].Layers) != 1 || imageStatus[0].Layers[0].Downloaded != 10 {
+		t.Errorf("expected the layer progress in the image status, but got %+v", imageStatus)
+	}
+}
+
+func TestImagePrePullerWaitPullWindow(t *testing.T) {
+	now := time.Now()
+	inWindow, err := parsePullWindows([]v1alpha1.PullWindow{{
+		Start: now.Add(-time.Hour).Format("15:04"),
+		End:   now.Add(time.Hour).Format("15:04"),
+	}})
+	if err != nil {
+		t.Fatalf("parsePullWindows() error = %v", err)
+	}
+	p := newImagePrePuller(commonPrePullRequest("app"), &fakeImagePuller{}, inWindow)
+	ctx, cancel := p.waitPullWindow()
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || deadline.Before(now.Add(30*time.Minute)) {
+		t.Errorf("expected the pull to be canceled at the end of the window, but got deadline %v", deadline)
+	}
+	if waiting, _ := p.progress(); waiting != "" {
+		t.Errorf("expected not to wait in the pull window, but got %q", waiting)
+	}
+}
@@ -0,0 +1,356 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskexecutor
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	contentapi "github.com/containerd/containerd/api/services/content/v1"
+	leasesapi "github.com/containerd/containerd/api/services/leases/v1"
+	"github.com/containerd/containerd/content"
+	contentproxy "github.com/containerd/containerd/content/proxy"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/leases"
+	leasesproxy "github.com/containerd/containerd/leases/proxy"
+	"github.com/containerd/containerd/namespaces"
+	"github.com/containerd/containerd/platforms"
+	refdocker "github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	dockerconfig "github.com/containerd/containerd/remotes/docker/config"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+const (
+	// containerdNamespace is the namespace of the images managed by the CRI plugin of containerd
+	containerdNamespace = "k8s.io"
+	// containerdHostsDir is the default directory of the registry hosts configuration of containerd
+	containerdHostsDir = "/etc/containerd/certs.d"
+	// prefetchLeaseExpiration keeps the prefetched blobs from the garbage collection of containerd
+	// if edgecore exits before the image is pulled
+	prefetchLeaseExpiration = 24 * time.Hour
+
+	// throttleBurst is the maximum bytes read at once from a bandwidth limited download
+	throttleBurst = 32 * 1024
+)
+
+// imagePuller pulls the images of the image prepull jobs
+type imagePuller interface {
+	// PullImage pulls the image with the auth, the download progress of the layers
+	// is recorded in layers if the puller supports it
+	PullImage(ctx context.Context, image string, auth *runtimeapi.AuthConfig, layers *layerProgress) error
+	Close() error
+}
+
+// newImagePuller returns the containerd image puller if the runtime is containerd, otherwise
+// the images are pulled through CRI, without the bandwidth limit and the layer progress
+func newImagePuller(endpoint, cgroupDriver string, bandwidthLimit int64) (imagePuller, error) {
+	runtime, err := util.NewContainerRuntime(endpoint, cgroupDriver)
+	if err != nil {
+		return nil, err
+	}
+	puller, err := newContainerdImagePuller(endpoint, runtime, bandwidthLimit)
+	if err == nil {
+		return puller, nil
+	}
+	if bandwidthLimit > 0 {
+		return nil, fmt.Errorf("the bandwidth limit of image pull requires containerd, err: %v", err)
+	}
+	klog.V(4).Infof("pull images through CRI, %v", err)
+	return &criImagePuller{runtime: runtime}, nil
+}
+
+type criImagePuller struct {
+	runtime util.ContainerRuntime
+}
+
+func (p *criImagePuller) PullImage(_ context.Context, image string, auth *runtimeapi.AuthConfig, _ *layerProgress) error {
+	return p.runtime.PullImage(image, auth, nil)
+}
+
+func (p *criImagePuller) Close() error {
+	return nil
+}
+
+// containerdImagePuller downloads the blobs of the images into the content store of containerd
+// with the bandwidth limit, then pulls the images through CRI, which finds the blobs in the
+// content store and only unpacks them. The blobs are downloaded from the registries configured
+// by the hosts configuration of containerd.
+//
+// Only the content and the leases services of containerd are used, the containerd client is
+// not linked into edgecore because its API types conflict with the ones vendored by cadvisor.
+type containerdImagePuller struct {
+	runtime util.ContainerRuntime
+	conn    *grpc.ClientConn
+	content content.Store
+	leases  leases.Manager
+	// limiter limits the download rate of the images, it is nil if the rate is not limited
+	limiter *rate.Limiter
+}
+
+func newContainerdImagePuller(endpoint string, runtime util.ContainerRuntime, bandwidthLimit int64) (*containerdImagePuller, error) {
+	if !strings.HasPrefix(endpoint, "unix://") {
+		return nil, fmt.Errorf("runtime endpoint %s is not a unix socket", endpoint)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s, err: %v", endpoint, err)
+	}
+	p := &containerdImagePuller{
+		runtime: runtime,
+		conn:    conn,
+		content: contentproxy.NewContentStore(contentapi.NewContentClient(conn)),
+		leases:  leasesproxy.NewLeaseManager(leasesapi.NewLeasesClient(conn)),
+	}
+	// the other runtimes do not serve the leases service of containerd
+	if _, err := p.leases.List(namespaces.WithNamespace(ctx, containerdNamespace)); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("runtime endpoint %s is not containerd, err: %v", endpoint, err)
+	}
+
+	if bandwidthLimit > 0 {
+		burst := throttleBurst
+		if bandwidthLimit < throttleBurst {
+			burst = int(bandwidthLimit)
+		}
+		p.limiter = rate.NewLimiter(rate.Limit(bandwidthLimit), burst)
+	}
+	return p, nil
+}
+
+func (p *containerdImagePuller) PullImage(ctx context.Context, image string, auth *runtimeapi.AuthConfig, layers *layerProgress) error {
+	ctx = namespaces.WithNamespace(ctx, containerdNamespace)
+	lease, err := p.leases.Create(ctx, leases.WithRandomID(), leases.WithExpiration(prefetchLeaseExpiration))
+	if err != nil {
+		return fmt.Errorf("failed to create lease, err: %v", err)
+	}
+	defer func() {
+		// the blobs are referenced by the image after it is pulled
+		if err := p.leases.Delete(namespaces.WithNamespace(context.Background(), containerdNamespace), lease); err != nil {
+			klog.Warningf("failed to delete lease %s, err: %v", lease.ID, err)
+		}
+	}()
+
+	if err := p.prefetch(leases.WithLease(ctx, lease.ID), image, auth, layers); err != nil {
+		return err
+	}
+	// the layers which exist in containerd are not downloaded
+	layers.complete()
+	return p.runtime.PullImage(image, auth, nil)
+}
+
+// prefetch downloads the blobs of the image for the platform of the node into the content store,
+// the partially downloaded blobs are resumed by the next prefetch.
+func (p *containerdImagePuller) prefetch(ctx context.Context, image string, auth *runtimeapi.AuthConfig, layers *layerProgress) error {
+	named, err := refdocker.ParseDockerRef(image)
+	if err != nil {
+		return fmt.Errorf("failed to parse image %s, err: %v", image, err)
+	}
+	domain := refdocker.Domain(named)
+	hosts := dockerconfig.ConfigureHosts(ctx, dockerconfig.HostOptions{
+		HostDir: dockerconfig.HostDirFromRoot(containerdHostsDir),
+		Credentials: func(host string) (string, string, error) {
+			// the mirrors configured in the hosts configuration are pulled anonymously
+			if auth == nil || host != domain && (domain != "docker.io" || host != "registry-1.docker.io") {
+				return "", "", nil
+			}
+			return parseAuth(auth)
+		},
+		UpdateClient: func(client *http.Client) error {
+			client.Transport = &throttledTransport{base: client.Transport, limiter: p.limiter, layers: layers}
+			return nil
+		},
+	})
+	resolver := docker.NewResolver(docker.ResolverOptions{Hosts: hosts})
+
+	name, desc, err := resolver.Resolve(ctx, named.String())
+	if err != nil {
+		return fmt.Errorf("failed to resolve image %s, err: %v", image, err)
+	}
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to get fetcher of image %s, err: %v", image, err)
+	}
+	handler := images.Handlers(
+		images.HandlerFunc(func(_ context.Context, desc ocispec.Descriptor) ([]ocispec.Descriptor, error) {
+			if images.IsLayerType(desc.MediaType) {
+				layers.add(desc.Digest.String(), desc.Size)
+			}
+			return nil, nil
+		}),
+		remotes.FetchHandler(p.content, fetcher),
+		images.LimitManifests(images.FilterPlatforms(images.ChildrenHandler(p.content), platforms.Default()), platforms.Default(), 1),
+	)
+	if err := images.Dispatch(ctx, handler, nil, desc); err != nil {
+		return fmt.Errorf("failed to download image %s, err: %v", image, err)
+	}
+	return nil
+}
+
+func (p *containerdImagePuller) Close() error {
+	return p.conn.Close()
+}
+
+// parseAuth returns the username and the secret of the auth, in the same way as the CRI plugin of containerd
+func parseAuth(auth *runtimeapi.AuthConfig) (string, string, error) {
+	if auth.IdentityToken != "" {
+		return "", auth.IdentityToken, nil
+	}
+	if auth.Username != "" || auth.Auth == "" {
+		return auth.Username, auth.Password, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode auth, err: %v", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", fmt.Errorf("auth is not in the format of username:password")
+	}
+	return username, password, nil
+}
+
+// throttledTransport limits the download rate of the responses from the registries,
+// and records the downloaded bytes of the layers
+type throttledTransport struct {
+	base    http.RoundTripper
+	limiter *rate.Limiter
+	layers  *layerProgress
+}
+
+func (t *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		return resp, err
+	}
+	resp.Body = &throttledReader{
+		ReadCloser: resp.Body,
+		ctx:        req.Context(),
+		limiter:    t.limiter,
+		digest:     blobDigest(req),
+		layers:     t.layers,
+	}
+	return resp, nil
+}
+
+// blobDigest returns the digest of the blob the request downloads, the blobs may be
+// redirected to a storage out of the registry
+func blobDigest(req *http.Request) string {
+	for r := req; r != nil; {
+		if _, digest, ok := strings.Cut(r.URL.Path, "/blobs/"); ok {
+			return digest
+		}
+		if r.Response == nil {
+			break
+		}
+		r = r.Response.Request
+	}
+	return ""
+}
+
+type throttledReader struct {
+	io.ReadCloser
+	ctx     context.Context
+	limiter *rate.Limiter
+	digest  string
+	layers  *layerProgress
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if r.limiter != nil && len(p) > r.limiter.Burst() {
+		p = p[:r.limiter.Burst()]
+	}
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		if r.digest != "" {
+			r.layers.downloaded(r.digest, int64(n))
+		}
+		// the registry is not read again until the rate allows, which slows down the sender
+		if r.limiter != nil {
+			if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil && err == nil {
+				err = waitErr
+			}
+		}
+	}
+	return n, err
+}
+
+// layerProgress records the download progress of the layers of an image
+type layerProgress struct {
+	sync.Mutex
+	layers []v1alpha1.LayerStatus
+}
+
+func (l *layerProgress) add(digest string, size int64) {
+	l.Lock()
+	defer l.Unlock()
+	for _, layer := range l.layers {
+		if layer.Digest == digest {
+			return
+		}
+	}
+	l.layers = append(l.layers, v1alpha1.LayerStatus{Digest: digest, Size: size})
+}
+
+func (l *layerProgress) downloaded(digest string, n int64) {
+	l.Lock()
+	defer l.Unlock()
+	for i := range l.layers {
+		if l.layers[i].Digest == digest {
+			// the layers are downloaded again if the pull is retried
+			l.layers[i].Downloaded += n
+			if l.layers[i].Downloaded > l.layers[i].Size {
+				l.layers[i].Downloaded = l.layers[i].Size
+			}
+			return
+		}
+	}
+}
+
+func (l *layerProgress) complete() {
+	l.Lock()
+	defer l.Unlock()
+	for i := range l.layers {
+		l.layers[i].Downloaded = l.layers[i].Size
+	}
+}
+
+func (l *layerProgress) status() []v1alpha1.LayerStatus {
+	l.Lock()
+	defer l.Unlock()
+	if len(l.layers) == 0 {
+		return nil
+	}
+	return append([]v1alpha1.LayerStatus(nil), l.layers...)
+}
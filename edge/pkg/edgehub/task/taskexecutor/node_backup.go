@@ -33,6 +33,9 @@ import (
 	"github.com/kubeedge/kubeedge/pkg/version"
 )
 
+// backupVersionFile records the version of the latest backup
+var backupVersionFile = filepath.Join(util.KubeEdgeBackupPath, "version")
+
 func backupNode(taskReq commontypes.NodeTaskRequest) (event fsm.Event) {
 	event = fsm.Event{
 		Type:   "Backup",
@@ -54,9 +57,24 @@ func backupNode(taskReq commontypes.NodeTaskRequest) (event fsm.Event) {
 		}
 		return
 	}
+	// record the backup version, the node is rolled back to it after being upgraded
+	err = os.WriteFile(backupVersionFile, []byte(version.Get().String()), 0600)
+	if err != nil {
+		err = fmt.Errorf("failed to record backup version: %v", err)
+		return
+	}
 	return event
 }
 
+// backupVersion returns the version of the latest backup, or the current version if no backup is recorded
+func backupVersion() string {
+	data, err := os.ReadFile(backupVersionFile)
+	if err != nil || len(data) == 0 {
+		return version.Get().String()
+	}
+	return string(data)
+}
+
 func backup(backupPath string) error {
 	config := options.GetEdgeCoreConfig()
 	klog.Infof("backup start, backup path: %s", backupPath)
@@ -25,7 +25,6 @@ import (
 	commontypes "github.com/kubeedge/kubeedge/common/types"
 	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
 	"github.com/kubeedge/kubeedge/pkg/util/fsm"
-	"github.com/kubeedge/kubeedge/pkg/version"
 )
 
 func rollbackNode(taskReq commontypes.NodeTaskRequest) (event fsm.Event) {
@@ -57,7 +56,7 @@ func rollbackNode(taskReq commontypes.NodeTaskRequest) (event fsm.Event) {
 func rollback(upgradeReq *commontypes.NodeUpgradeJobRequest) error {
 	klog.Infof("Begin to run rollback command")
 	rollBackCmd := fmt.Sprintf("keadm rollback edge --name %s --history %s >> /tmp/keadm.log 2>&1",
-		upgradeReq.UpgradeID, version.Get())
+		upgradeReq.UpgradeID, backupVersion())
 
 	// run upgrade cmd to upgrade edge node
 	// use nohup command to start a child progress
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskexecutor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/operations/v1alpha1"
+)
+
+// pullWindow is a daily time window, start and end are the offsets from midnight
+type pullWindow struct {
+	start, end time.Duration
+}
+
+func parsePullWindows(windows []v1alpha1.PullWindow) ([]pullWindow, error) {
+	result := make([]pullWindow, 0, len(windows))
+	for _, w := range windows {
+		start, err := parseClock(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of pull window %s-%s, err: %v", w.Start, w.End, err)
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of pull window %s-%s, err: %v", w.Start, w.End, err)
+		}
+		result = append(result, pullWindow{start: start, end: end})
+	}
+	return result, nil
+}
+
+func parseClock(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(clock))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// nextPullWindow returns the end of the window now is in, or the start and the end of the
+// next window if now is not in any window. The windows are in the location of now.
+func nextPullWindow(windows []pullWindow, now time.Time) (start, end time.Time) {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	at := func(day int, offset time.Duration) time.Time {
+		d := midnight.AddDate(0, 0, day)
+		return time.Date(d.Year(), d.Month(), d.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, d.Location())
+	}
+	for _, w := range windows {
+		// the window of yesterday may span midnight into today
+		for day := -1; day <= 1; day++ {
+			s, e := at(day, w.start), at(day, w.end)
+			if w.end <= w.start {
+				e = at(day+1, w.end)
+			}
+			if !now.Before(s) && now.Before(e) {
+				if start.IsZero() || now.Before(start) || e.After(end) {
+					start, end = now, e
+				}
+				continue
+			}
+			if s.After(now) && (start.IsZero() || s.Before(start)) {
+				start, end = s, e
+			}
+		}
+	}
+	return start, end
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/kubeedge/beehive/pkg/core"
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgehub"
 	"github.com/kubeedge/kubeedge/edge/pkg/edgestream/config"
@@ -40,6 +41,8 @@ type edgestream struct {
 	enable           bool
 	hostnameOverride string
 	nodeIP           string
+	// metrics forwards the node metrics to cloudcore, nil if it is disabled
+	metrics *metricsForwarder
 }
 
 var _ core.Module = (*edgestream)(nil)
@@ -86,6 +89,18 @@ func (e *edgestream) Enable() bool {
 }
 
 func (e *edgestream) Start() {
+	if m := config.Config.Metrics; m != nil && m.Enable {
+		buffer, err := dbm.NewBoltKV(m.BufferPath)
+		if err != nil {
+			klog.Exitf("Failed to open metrics buffer %s: %v", m.BufferPath, err)
+		}
+		e.metrics, err = newMetricsForwarder(*m, buffer)
+		if err != nil {
+			klog.Exitf("Failed to create metrics forwarder: %v", err)
+		}
+		go e.metrics.Run()
+	}
+
 	serverURL := url.URL{
 		Scheme: "wss",
 		Host:   config.Config.TunnelServer,
@@ -138,5 +153,10 @@ func (e *edgestream) TLSClientConnect(url url.URL, tlsConfig *tls.Config) error
 		return err
 	}
 	session := NewTunnelSession(con)
+	if e.metrics != nil {
+		session.metrics = e.metrics
+		e.metrics.attach(session.Tunnel)
+		defer e.metrics.detach(session.Tunnel)
+	}
 	return session.Serve()
 }
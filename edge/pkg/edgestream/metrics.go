@@ -0,0 +1,328 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgestream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+const (
+	metricsBucket = "metrics"
+	// metricsAckTimeout is how long a forwarded batch waits for the ack of cloudcore before it is sent again
+	metricsAckTimeout = 30 * time.Second
+	// maxMetricsBatchRawLength bounds the uncompressed length of a batch, so that
+	// the compressed batch fits in one tunnel message
+	maxMetricsBatchRawLength = 8 * constants.MaxRespBodyLength
+)
+
+var errStopIteration = errors.New("stop iteration")
+
+// metricsForwarder scrapes the metrics endpoints of edged and buffers the samples
+// on disk, the samples are forwarded to cloudcore in compressed batches through
+// the tunnel and deleted once cloudcore acknowledges them
+type metricsForwarder struct {
+	config   v1alpha2.EdgeStreamMetrics
+	endpoint string
+	client   *http.Client
+	buffer   dbm.KV
+
+	lock sync.Mutex
+	// buffered is the number of samples in buffer
+	buffered int
+	tunnel   stream.SafeWriteTunneler
+	// inflight is the sequence of the last sample of the batch waiting for ack, 0 if none
+	inflight   uint64
+	inflightAt time.Time
+}
+
+func newMetricsForwarder(config v1alpha2.EdgeStreamMetrics, buffer dbm.KV) (*metricsForwarder, error) {
+	f := &metricsForwarder{
+		config:   config,
+		endpoint: "http://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(constants.ServerPort)),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		buffer:   buffer,
+	}
+	err := buffer.View(func(tx dbm.Tx) error {
+		return tx.ForEach(metricsBucket, "", func(string, []byte) error {
+			f.buffered++
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load buffered metrics, err: %v", err)
+	}
+	klog.Infof("%d buffered metrics samples are waiting to be forwarded", f.buffered)
+	return f, nil
+}
+
+func metricsKey(seq uint64) string {
+	return fmt.Sprintf("%020d", seq)
+}
+
+// Run scrapes the metrics every ScrapeInterval until edgecore exits
+func (f *metricsForwarder) Run() {
+	ticker := time.NewTicker(time.Duration(f.config.ScrapeInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-beehiveContext.Done():
+			if err := f.buffer.Close(); err != nil {
+				klog.Errorf("failed to close metrics buffer, err: %v", err)
+			}
+			return
+		case <-ticker.C:
+			f.scrape()
+			f.flush()
+		}
+	}
+}
+
+func (f *metricsForwarder) scrape() {
+	for _, path := range f.config.Paths {
+		sample, err := f.scrapePath(path)
+		if err != nil {
+			klog.Warningf("failed to scrape metrics %s, err: %v", path, err)
+			continue
+		}
+		if err := f.store(sample); err != nil {
+			klog.Errorf("failed to buffer metrics %s, err: %v", path, err)
+		}
+	}
+}
+
+func (f *metricsForwarder) scrapePath(path string) (*stream.MetricsSample, error) {
+	resp, err := f.client.Get(f.endpoint + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxMetricsBatchRawLength))
+	if err != nil {
+		return nil, err
+	}
+	return &stream.MetricsSample{
+		Path:      path,
+		Timestamp: time.Now(),
+		Data:      string(data),
+	}, nil
+}
+
+// store buffers sample, the oldest samples are dropped when MaxBufferedSamples is reached
+func (f *metricsForwarder) store(sample *stream.MetricsSample) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var dropped int
+	err := f.buffer.Update(func(tx dbm.Tx) error {
+		seq, err := tx.NextSequence(metricsBucket)
+		if err != nil {
+			return err
+		}
+		if err := dbm.PutJSON(tx, metricsBucket, metricsKey(seq), sample); err != nil {
+			return err
+		}
+
+		over := f.buffered + 1 - int(f.config.MaxBufferedSamples)
+		if over <= 0 {
+			return nil
+		}
+		var keys []string
+		err = tx.ForEach(metricsBucket, "", func(key string, _ []byte) error {
+			keys = append(keys, key)
+			if len(keys) == over {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			return err
+		}
+		for _, key := range keys {
+			if err := tx.Delete(metricsBucket, key); err != nil {
+				return err
+			}
+		}
+		dropped = len(keys)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if dropped > 0 {
+		klog.Warningf("metrics buffer is full, drop %d oldest samples", dropped)
+	}
+	f.buffered += 1 - dropped
+	return nil
+}
+
+// nextBatch returns the oldest buffered samples and the sequence of the last one
+func (f *metricsForwarder) nextBatch(limit int) ([]stream.MetricsSample, uint64, error) {
+	var samples []stream.MetricsSample
+	var last uint64
+	var length int
+	err := f.buffer.View(func(tx dbm.Tx) error {
+		return tx.ForEach(metricsBucket, "", func(key string, value []byte) error {
+			seq, err := strconv.ParseUint(key, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid metrics key %s", key)
+			}
+			var sample stream.MetricsSample
+			if err := json.Unmarshal(value, &sample); err != nil {
+				return fmt.Errorf("failed to decode metrics sample %s, err: %v", key, err)
+			}
+			samples, last = append(samples, sample), seq
+			length += len(sample.Data)
+			if len(samples) >= limit || length >= maxMetricsBatchRawLength {
+				return errStopIteration
+			}
+			return nil
+		})
+	})
+	if err != nil && !errors.Is(err, errStopIteration) {
+		return nil, 0, err
+	}
+	return samples, last, nil
+}
+
+// deleteUntil deletes the buffered samples whose sequence is not greater than seq
+func (f *metricsForwarder) deleteUntil(seq uint64) error {
+	var keys []string
+	err := f.buffer.Update(func(tx dbm.Tx) error {
+		err := tx.ForEach(metricsBucket, "", func(key string, _ []byte) error {
+			if key > metricsKey(seq) {
+				return errStopIteration
+			}
+			keys = append(keys, key)
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			return err
+		}
+		for _, key := range keys {
+			if err := tx.Delete(metricsBucket, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	f.buffered -= len(keys)
+	return nil
+}
+
+// flush forwards the oldest buffered samples if no batch is waiting for ack
+func (f *metricsForwarder) flush() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.tunnel == nil || (f.inflight != 0 && time.Since(f.inflightAt) < metricsAckTimeout) {
+		return
+	}
+
+	limit := int(f.config.BatchSize)
+	for {
+		samples, last, err := f.nextBatch(limit)
+		if err != nil {
+			klog.Errorf("failed to read buffered metrics, err: %v", err)
+			return
+		}
+		if len(samples) == 0 {
+			f.inflight = 0
+			return
+		}
+		data, err := stream.EncodeMetricsBatch(samples)
+		if err != nil {
+			klog.Errorf("failed to encode metrics batch, err: %v", err)
+			return
+		}
+		if len(data) > constants.MaxRespBodyLength {
+			if len(samples) > 1 {
+				limit = (len(samples) + 1) / 2
+				continue
+			}
+			klog.Warningf("metrics sample %s of %d bytes is too large to forward, drop it", samples[0].Path, len(samples[0].Data))
+			if err := f.deleteUntil(last); err != nil {
+				klog.Errorf("failed to delete metrics sample, err: %v", err)
+				return
+			}
+			continue
+		}
+
+		if err := f.tunnel.WriteMessage(stream.NewMessage(last, stream.MessageTypeMetricsBatch, data)); err != nil {
+			klog.Errorf("failed to forward metrics batch, err: %v", err)
+			return
+		}
+		klog.V(4).Infof("forward %d metrics samples in %d bytes", len(samples), len(data))
+		f.inflight, f.inflightAt = last, time.Now()
+		return
+	}
+}
+
+// ack deletes the samples acknowledged by cloudcore and forwards the next batch
+func (f *metricsForwarder) ack(seq uint64) {
+	f.lock.Lock()
+	if err := f.deleteUntil(seq); err != nil {
+		klog.Errorf("failed to delete acknowledged metrics, err: %v", err)
+	}
+	if seq == f.inflight {
+		f.inflight = 0
+	}
+	f.lock.Unlock()
+
+	f.flush()
+}
+
+// attach starts forwarding the buffered samples through tunnel
+func (f *metricsForwarder) attach(tunnel stream.SafeWriteTunneler) {
+	f.lock.Lock()
+	f.tunnel, f.inflight = tunnel, 0
+	f.lock.Unlock()
+
+	f.flush()
+}
+
+// detach stops forwarding through tunnel, the samples are buffered until the next tunnel is attached
+func (f *metricsForwarder) detach(tunnel stream.SafeWriteTunneler) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.tunnel == tunnel {
+		f.tunnel = nil
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package edgestream
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/stream"
+)
+
+type fakeTunnel struct {
+	messages []*stream.Message
+}
+
+func (t *fakeTunnel) WriteMessage(m *stream.Message) error {
+	t.messages = append(t.messages, m)
+	return nil
+}
+
+func (t *fakeTunnel) WriteControl(int, []byte, time.Time) error {
+	return nil
+}
+
+func (t *fakeTunnel) NextReader() (int, io.Reader, error) {
+	return 0, nil, io.EOF
+}
+
+func (t *fakeTunnel) Close() error {
+	return nil
+}
+
+func TestMetricsForwarder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.db")
+	buffer, err := dbm.NewBoltKV(path)
+	if err != nil {
+		t.Fatalf("failed to open metrics buffer: %v", err)
+	}
+	config := v1alpha2.EdgeStreamMetrics{
+		Enable:             true,
+		BatchSize:          2,
+		MaxBufferedSamples: 3,
+	}
+	f, err := newMetricsForwarder(config, buffer)
+	if err != nil {
+		t.Fatalf("failed to create metrics forwarder: %v", err)
+	}
+
+	// samples are buffered while the tunnel is disconnected, the oldest one is dropped
+	for i := 0; i < 4; i++ {
+		sample := &stream.MetricsSample{Path: "/metrics/resource", Timestamp: time.Unix(int64(i), 0), Data: "node_cpu_usage_seconds_total 1"}
+		if err := f.store(sample); err != nil {
+			t.Fatalf("failed to store sample: %v", err)
+		}
+	}
+	if f.buffered != 3 {
+		t.Fatalf("expected 3 buffered samples, but got %d", f.buffered)
+	}
+
+	tunnel := &fakeTunnel{}
+	f.attach(tunnel)
+	if len(tunnel.messages) != 1 || tunnel.messages[0].MessageType != stream.MessageTypeMetricsBatch {
+		t.Fatalf("expected a metrics batch, but got %v", tunnel.messages)
+	}
+	samples, err := stream.DecodeMetricsBatch(tunnel.messages[0].Data)
+	if err != nil {
+		t.Fatalf("failed to decode metrics batch: %v", err)
+	}
+	if len(samples) != 2 || !samples[0].Timestamp.Equal(time.Unix(1, 0)) {
+		t.Fatalf("expected the 2 oldest samples, but got %v", samples)
+	}
+
+	// the next batch is not forwarded until the first one is acknowledged
+	f.flush()
+	if len(tunnel.messages) != 1 {
+		t.Fatalf("expected no batch forwarded before ack, but got %d", len(tunnel.messages))
+	}
+	f.ack(tunnel.messages[0].ConnectID)
+	if len(tunnel.messages) != 2 || f.buffered != 1 {
+		t.Fatalf("expected the next batch after ack, but got %d messages and %d buffered samples", len(tunnel.messages), f.buffered)
+	}
+
+	// unacknowledged samples survive a restart
+	f.detach(tunnel)
+	if err := buffer.Close(); err != nil {
+		t.Fatalf("failed to close metrics buffer: %v", err)
+	}
+	if buffer, err = dbm.NewBoltKV(path); err != nil {
+		t.Fatalf("failed to reopen metrics buffer: %v", err)
+	}
+	defer buffer.Close()
+	if f, err = newMetricsForwarder(config, buffer); err != nil {
+		t.Fatalf("failed to create metrics forwarder: %v", err)
+	}
+	if f.buffered != 1 {
+		t.Fatalf("expected 1 buffered sample after restart, but got %d", f.buffered)
+	}
+}
@@ -36,6 +36,8 @@ type TunnelSession struct {
 	closed        bool // tunnel whether closed
 	localCons     map[uint64]stream.EdgedConnection
 	localConsLock sync.RWMutex
+	// metrics forwards the buffered node metrics, nil if it is disabled
+	metrics *metricsForwarder
 }
 
 func NewTunnelSession(c *websocket.Conn) *TunnelSession {
@@ -91,6 +93,21 @@ func (s *TunnelSession) serveContainerAttachConnection(m *stream.Message) error
 	return attachCon.Serve(s.Tunnel)
 }
 
+func (s *TunnelSession) servePortForwardConnection(m *stream.Message) error {
+	portForwardCon := &stream.EdgedPortForwardConnection{
+		ReadChan: make(chan *stream.Message, 128),
+		Stop:     make(chan struct{}, 2),
+	}
+	if err := json.Unmarshal(m.Data, portForwardCon); err != nil {
+		klog.Errorf("unmarshal connector data error %v", err)
+		return err
+	}
+
+	s.AddLocalConnection(m.ConnectID, portForwardCon)
+	klog.V(6).Infof("Get PortForward Connection info: %+v", *portForwardCon)
+	return portForwardCon.Serve(s.Tunnel)
+}
+
 func (s *TunnelSession) serveMetricsConnection(m *stream.Message) error {
 	metricsCon := &stream.EdgedMetricsConnection{
 		ReadChan: make(chan *stream.Message, 128),
@@ -123,6 +140,10 @@ func (s *TunnelSession) ServeConnection(m *stream.Message) {
 		if err := s.serveContainerAttachConnection(m); err != nil {
 			klog.Errorf("Serve Attach connection error %s", m.String())
 		}
+	case stream.MessageTypePortForwardConnect:
+		if err := s.servePortForwardConnection(m); err != nil {
+			klog.Errorf("Serve PortForward connection error %s", m.String())
+		}
 	default:
 		panic(fmt.Sprintf("Wrong message type %v", m.MessageType))
 	}
@@ -191,7 +212,14 @@ func (s *TunnelSession) Serve() error {
 			return fmt.Errorf("close tunnel stream connection, error:%s", string(mess.Data))
 		}
 
-		if (mess.MessageType < stream.MessageTypeData) || (mess.MessageType >= stream.MessageTypeAttachConnect) {
+		if mess.MessageType == stream.MessageTypeMetricsAck {
+			if s.metrics != nil {
+				s.metrics.ack(mess.ConnectID)
+			}
+			continue
+		}
+
+		if mess.MessageType.IsConnect() {
 			go s.ServeConnection(mess)
 		}
 		s.WriteToLocalConnection(mess)
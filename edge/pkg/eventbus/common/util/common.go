@@ -4,7 +4,9 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	MQTT "github.com/eclipse/paho.mqtt.golang"
@@ -18,6 +20,8 @@ var (
 	TokenWaitTime = 120 * time.Second
 	// Loop connect to wait
 	LoopConnectPeriord = 5 * time.Second
+	// DisconnectQuiesce is the milliseconds to wait for the pending work when disconnecting
+	DisconnectQuiesce uint = 250
 )
 
 // CheckKeyExist check dis info format
@@ -49,6 +53,18 @@ func PathExist(path string) bool {
 // HubClientInit create mqtt client config
 func HubClientInit(server, clientID, username, password string) *MQTT.ClientOptions {
 	opts := MQTT.NewClientOptions().AddBroker(server).SetClientID(clientID).SetCleanSession(true)
+	if broker := eventconfig.Config.MqttExternalBroker; broker != nil {
+		for _, s := range broker.Servers {
+			opts.AddBroker(s)
+		}
+		if broker.KeepAlive > 0 {
+			opts.SetKeepAlive(time.Duration(broker.KeepAlive) * time.Second)
+		}
+		if broker.ConnectTimeout > 0 {
+			opts.SetConnectTimeout(time.Duration(broker.ConnectTimeout) * time.Second)
+		}
+	}
+	username, password = HubCredentials(username, password)
 	if username != "" {
 		opts.SetUsername(username)
 		if password != "" {
@@ -57,42 +73,93 @@ func HubClientInit(server, clientID, username, password string) *MQTT.ClientOpti
 	}
 
 	klog.V(4).Infof("Start to set TLS configuration for MQTT client")
-	tlsConfig := &tls.Config{}
-	if eventconfig.Config.TLS.Enable {
+	tlsConfig, err := HubTLSConfig()
+	if err != nil {
+		klog.Errorf("Failed to set TLS configuration for MQTT client: %v", err)
+		return nil
+	}
+	opts.SetTLSConfig(tlsConfig)
+	klog.V(4).Infof("set TLS configuration for MQTT client successfully")
+
+	return opts
+}
+
+// HubTLSConfig creates the tls config of the connection with the external mqtt broker
+func HubTLSConfig() (*tls.Config, error) {
+	if eventconfig.Config.TLS == nil || !eventconfig.Config.TLS.Enable {
+		return &tls.Config{InsecureSkipVerify: true, ClientAuth: tls.NoClientCert}, nil
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         eventconfig.Config.TLS.TLSMqttServerName,
+		InsecureSkipVerify: false,
+	}
+	// the client certificate is optional, the broker may authenticate edgecore with username and password instead
+	if eventconfig.Config.TLS.TLSMqttCertFile != "" || eventconfig.Config.TLS.TLSMqttPrivateKeyFile != "" {
 		cert, err := tls.LoadX509KeyPair(eventconfig.Config.TLS.TLSMqttCertFile, eventconfig.Config.TLS.TLSMqttPrivateKeyFile)
 		if err != nil {
-			klog.Errorf("Failed to load x509 key pair: %v", err)
-			return nil
+			return nil, fmt.Errorf("failed to load x509 key pair, err: %v", err)
 		}
-
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	// the system root CAs are used if no CA file is set
+	if eventconfig.Config.TLS.TLSMqttCAFile != "" {
 		caCert, err := os.ReadFile(eventconfig.Config.TLS.TLSMqttCAFile)
 		if err != nil {
-			klog.Errorf("Failed to read TLSMqttCAFile")
-			return nil
+			return nil, fmt.Errorf("failed to read TLSMqttCAFile, err: %v", err)
 		}
-
 		pool := x509.NewCertPool()
 		if ok := pool.AppendCertsFromPEM(caCert); !ok {
-			klog.Errorf("Cannot parse the certificates")
-			return nil
+			return nil, errors.New("cannot parse the certificates")
 		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
 
-		tlsConfig = &tls.Config{
-			RootCAs:            pool,
-			Certificates:       []tls.Certificate{cert},
-			InsecureSkipVerify: false,
+// HubCredentials returns the username and password to connect to the external mqtt broker with,
+// the ones read from mqttUsernameFile and mqttPasswordFile take precedence over the given ones
+func HubCredentials(username, password string) (string, string) {
+	if file := eventconfig.Config.MqttUsernameFile; file != "" {
+		if data, err := os.ReadFile(file); err != nil {
+			klog.Errorf("Failed to read mqtt username file %s: %v", file, err)
+		} else {
+			username = strings.TrimSpace(string(data))
 		}
-	} else {
-		tlsConfig = &tls.Config{InsecureSkipVerify: true, ClientAuth: tls.NoClientCert}
 	}
-	opts.SetTLSConfig(tlsConfig)
-	klog.V(4).Infof("set TLS configuration for MQTT client successfully")
+	if file := eventconfig.Config.MqttPasswordFile; file != "" {
+		if data, err := os.ReadFile(file); err != nil {
+			klog.Errorf("Failed to read mqtt password file %s: %v", file, err)
+		} else {
+			password = strings.TrimSpace(string(data))
+		}
+	}
+	return username, password
+}
 
-	return opts
+// ConnectBackoff returns the initial and the max wait time after failing to connect to the external mqtt broker
+func ConnectBackoff() (time.Duration, time.Duration) {
+	broker := eventconfig.Config.MqttExternalBroker
+	if broker == nil || broker.InitialBackoff <= 0 {
+		return LoopConnectPeriord, LoopConnectPeriord
+	}
+	initial := time.Duration(broker.InitialBackoff) * time.Second
+	if broker.MaxBackoff < broker.InitialBackoff {
+		return initial, initial
+	}
+	return initial, time.Duration(broker.MaxBackoff) * time.Second
+}
+
+// NextBackoff doubles the wait time `cur` until `max`
+func NextBackoff(cur, max time.Duration) time.Duration {
+	if cur*2 > max {
+		return max
+	}
+	return cur * 2
 }
 
 // LoopConnect connect to mqtt server
 func LoopConnect(clientID string, client MQTT.Client) {
+	backoff, maxBackoff := ConnectBackoff()
 	for {
 		klog.Infof("start connect to mqtt server with client id: %s", clientID)
 		token := client.Connect()
@@ -102,6 +169,7 @@ func LoopConnect(clientID string, client MQTT.Client) {
 		} else {
 			return
 		}
-		time.Sleep(LoopConnectPeriord)
+		time.Sleep(backoff)
+		backoff = NextBackoff(backoff, maxBackoff)
 	}
 }
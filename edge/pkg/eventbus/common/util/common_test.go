@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -222,3 +224,94 @@ func TestLoopConnect(t *testing.T) {
 		})
 	}
 }
+
+// TestHubCredentials checks the credentials read from mqttUsernameFile and mqttPasswordFile
+func TestHubCredentials(t *testing.T) {
+	dir := t.TempDir()
+	usernameFile := filepath.Join(dir, "username")
+	passwordFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(usernameFile, []byte("file_user\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(passwordFile, []byte("file_password\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		eventconfig.Config.MqttUsernameFile = ""
+		eventconfig.Config.MqttPasswordFile = ""
+	}()
+
+	tests := []struct {
+		name         string
+		usernameFile string
+		passwordFile string
+		wantUsername string
+		wantPassword string
+	}{
+		{
+			name:         "TestHubCredentials: no files",
+			wantUsername: "test_user",
+			wantPassword: "123456789",
+		},
+		{
+			name:         "TestHubCredentials: files take precedence",
+			usernameFile: usernameFile,
+			passwordFile: passwordFile,
+			wantUsername: "file_user",
+			wantPassword: "file_password",
+		},
+		{
+			name:         "TestHubCredentials: missing file falls back",
+			passwordFile: filepath.Join(dir, "missing"),
+			wantUsername: "test_user",
+			wantPassword: "123456789",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventconfig.Config.MqttUsernameFile = tt.usernameFile
+			eventconfig.Config.MqttPasswordFile = tt.passwordFile
+			username, password := HubCredentials("test_user", "123456789")
+			if username != tt.wantUsername || password != tt.wantPassword {
+				t.Errorf("expected %s/%s, but got %s/%s", tt.wantUsername, tt.wantPassword, username, password)
+			}
+		})
+	}
+}
+
+// TestConnectBackoff checks the backoff of connecting to the external mqtt broker
+func TestConnectBackoff(t *testing.T) {
+	backoff, maxBackoff := ConnectBackoff()
+	if backoff != 5*time.Second || maxBackoff != 60*time.Second {
+		t.Fatalf("expected backoff from 5s to 60s, but got from %v to %v", backoff, maxBackoff)
+	}
+	var got []time.Duration
+	for i := 0; i < 6; i++ {
+		got = append(got, backoff)
+		backoff = NextBackoff(backoff, maxBackoff)
+	}
+	want := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, 60 * time.Second, 60 * time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected backoff %v, but got %v", want, got)
+	}
+}
+
+// TestHubClientInitExternalBroker checks the servers of mqttExternalBroker are added to the clientOptions
+func TestHubClientInitExternalBroker(t *testing.T) {
+	eventconfig.Config.MqttExternalBroker.Servers = []string{"tcp://127.0.0.2:1883", "ssl://127.0.0.3:8883"}
+	defer func() {
+		eventconfig.Config.MqttExternalBroker.Servers = nil
+	}()
+	got := HubClientInit("tcp://127.0.0.1:1883", "12345", "", "")
+	var servers []string
+	for _, s := range got.Servers {
+		servers = append(servers, s.String())
+	}
+	want := []string{"tcp://127.0.0.1:1883", "tcp://127.0.0.2:1883", "ssl://127.0.0.3:8883"}
+	if !reflect.DeepEqual(servers, want) {
+		t.Errorf("expected servers %v, but got %v", want, servers)
+	}
+	if got.KeepAlive != 30 || got.ConnectTimeout != 30*time.Second {
+		t.Errorf("unexpected keepAlive %v or connectTimeout %v", got.KeepAlive, got.ConnectTimeout)
+	}
+}
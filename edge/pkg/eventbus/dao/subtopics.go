@@ -30,22 +30,52 @@ type SubTopics struct {
 	Topic string `orm:"column(topic); type(text); pk"`
 }
 
+// topicsStore persists the subscribed topics, it is implemented for the sqlite database and for the KV drivers
+type topicsStore interface {
+	Insert(topic string) error
+	Delete(topic string) error
+	QueryAll() (*[]string, error)
+}
+
+// getTopicsStore returns the store of the configured database driver
+func getTopicsStore() topicsStore {
+	if dbm.KVAccess != nil {
+		return kvTopicsStore{kv: dbm.KVAccess}
+	}
+	return sqliteTopicsStore{}
+}
+
 // InsertTopics insert sub_topics
 func InsertTopics(topic string) error {
+	return getTopicsStore().Insert(topic)
+}
+
+// DeleteTopicsByKey delete sub_topics by key
+func DeleteTopicsByKey(key string) error {
+	return getTopicsStore().Delete(key)
+}
+
+// QueryAllTopics return all sub_topics, if no error, SubTopics not null
+func QueryAllTopics() (*[]string, error) {
+	return getTopicsStore().QueryAll()
+}
+
+// sqliteTopicsStore is the topicsStore of the sqlite database
+type sqliteTopicsStore struct{}
+
+func (sqliteTopicsStore) Insert(topic string) error {
 	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO sub_topics (topic) VALUES (?)", topic).Exec()
 	klog.V(4).Infof("INSERT result %v", err)
 	return err
 }
 
-// DeleteTopicsByKey delete sub_topics by key
-func DeleteTopicsByKey(key string) error {
+func (sqliteTopicsStore) Delete(key string) error {
 	num, err := dbm.DBAccess.QueryTable(SubTopicsName).Filter("topic", key).Delete()
 	klog.V(4).Infof("Delete affected Num: %d, %v", num, err)
 	return err
 }
 
-// QueryAllTopics return all sub_topics, if no error, SubTopics not null
-func QueryAllTopics() (*[]string, error) {
+func (sqliteTopicsStore) QueryAll() (*[]string, error) {
 	event := new([]SubTopics)
 	_, err := dbm.DBAccess.QueryTable(SubTopicsName).All(event)
 	if err != nil {
@@ -57,3 +87,34 @@ func QueryAllTopics() (*[]string, error) {
 	}
 	return &result, nil
 }
+
+// kvTopicsStore is the topicsStore of the KV drivers, the topics are the keys of the sub_topics bucket
+type kvTopicsStore struct {
+	kv dbm.KV
+}
+
+func (s kvTopicsStore) Insert(topic string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return dbm.PutJSON(tx, SubTopicsName, topic, &SubTopics{Topic: topic})
+	})
+}
+
+func (s kvTopicsStore) Delete(key string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return tx.Delete(SubTopicsName, key)
+	})
+}
+
+func (s kvTopicsStore) QueryAll() (*[]string, error) {
+	var result []string
+	err := s.kv.View(func(tx dbm.Tx) error {
+		return tx.ForEach(SubTopicsName, "", func(key string, _ []byte) error {
+			result = append(result, key)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -194,3 +194,22 @@ func TestQueryAllTopics(t *testing.T) {
 		})
 	}
 }
+
+// TestKVTopicsStore tests the topics with a KV driver
+func TestKVTopicsStore(t *testing.T) {
+	dbm.KVAccess = dbm.NewMemoryKV()
+	defer func() { dbm.KVAccess = nil }()
+
+	for _, topic := range []string{"b/topic", "a/topic", "a/topic"} {
+		if err := InsertTopics(topic); err != nil {
+			t.Fatalf("InsertTopics() failed: %v", err)
+		}
+	}
+	if err := DeleteTopicsByKey("b/topic"); err != nil {
+		t.Fatalf("DeleteTopicsByKey() failed: %v", err)
+	}
+	topics, err := QueryAllTopics()
+	if err != nil || len(*topics) != 1 || (*topics)[0] != "a/topic" {
+		t.Errorf("QueryAllTopics() = %v, %v, want [a/topic]", topics, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/beego/beego/orm"
 	"k8s.io/klog/v2"
@@ -12,7 +13,6 @@ import (
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	messagepkg "github.com/kubeedge/kubeedge/edge/pkg/common/message"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
-	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/common/util"
 	eventconfig "github.com/kubeedge/kubeedge/edge/pkg/eventbus/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/dao"
 	mqttBus "github.com/kubeedge/kubeedge/edge/pkg/eventbus/mqtt"
@@ -24,9 +24,14 @@ var mqttServer *mqttBus.Server
 // eventbus struct
 type eventbus struct {
 	enable bool
+
+	lock sync.Mutex
+	// stop is closed once eventbus is stopped
+	stop chan struct{}
 }
 
 var _ core.Module = (*eventbus)(nil)
+var _ core.Stopper = (*eventbus)(nil)
 
 func newEventbus(enable bool) *eventbus {
 	return &eventbus{
@@ -55,6 +60,11 @@ func (eb *eventbus) Enable() bool {
 }
 
 func (eb *eventbus) Start() {
+	stop := make(chan struct{})
+	eb.lock.Lock()
+	eb.stop = stop
+	eb.lock.Unlock()
+
 	mqttBus.RegisterMsgHandler()
 
 	if eventconfig.Config.MqttMode >= v1alpha2.MqttModeBoth {
@@ -87,24 +97,45 @@ func (eb *eventbus) Start() {
 		klog.Infof("Launch internal mqtt broker %v successfully", eventconfig.Config.MqttServerInternal)
 	}
 
-	eb.pubCloudMsgToEdge()
+	eb.pubCloudMsgToEdge(stop)
+}
+
+// Stop closes the internal mqtt broker and the clients of the external one, and makes Start
+// return once the message in process is handled, so that eventbus can be started again
+func (eb *eventbus) Stop() {
+	eb.lock.Lock()
+	defer eb.lock.Unlock()
+	if eb.stop == nil {
+		return
+	}
+	close(eb.stop)
+	eb.stop = nil
+
+	if eventconfig.Config.MqttMode <= v1alpha2.MqttModeBoth && mqttServer != nil {
+		mqttServer.Close()
+	}
+	if eventconfig.Config.MqttMode >= v1alpha2.MqttModeBoth && mqttBus.MQTTHub != nil {
+		mqttBus.MQTTHub.Close()
+	}
 }
 
 func pubMQTT(topic string, payload []byte) {
-	token := mqttBus.MQTTHub.PubCli.Publish(topic, 1, false, payload)
-	if token.WaitTimeout(util.TokenWaitTime) && token.Error() != nil {
-		klog.Errorf("Error in pubMQTT with topic: %s, %v", topic, token.Error())
+	if err := mqttBus.MQTTHub.Publish(topic, 1, payload); err != nil {
+		klog.Errorf("Error in pubMQTT with topic: %s, %v", topic, err)
 	} else {
 		klog.Infof("Success in pubMQTT with topic: %s", topic)
 	}
 }
 
-func (eb *eventbus) pubCloudMsgToEdge() {
+func (eb *eventbus) pubCloudMsgToEdge(stop <-chan struct{}) {
 	for {
 		select {
 		case <-beehiveContext.Done():
 			klog.Warning("EventBus PubCloudMsg To Edge stop")
 			return
+		case <-stop:
+			klog.Warning("EventBus is stopped")
+			return
 		default:
 		}
 		accessInfo, err := beehiveContext.Receive(eb.Name())
@@ -190,8 +221,7 @@ func (eb *eventbus) subscribe(topic string) {
 
 	if eventconfig.Config.MqttMode >= v1alpha2.MqttModeBoth {
 		// subscribe topic to external mqtt broker.
-		token := mqttBus.MQTTHub.SubCli.Subscribe(topic, 1, mqttBus.OnSubMessageReceived)
-		if rs, err := util.CheckClientToken(token); !rs {
+		if err := mqttBus.MQTTHub.Subscribe(topic, 1); err != nil {
 			klog.Errorf("Edge-hub-cli subscribe topic: %s, %v", topic, err)
 			return
 		}
@@ -209,8 +239,7 @@ func (eb *eventbus) unsubscribe(topic string) {
 	}
 
 	if eventconfig.Config.MqttMode >= v1alpha2.MqttModeBoth {
-		token := mqttBus.MQTTHub.SubCli.Unsubscribe(topic)
-		if rs, err := util.CheckClientToken(token); !rs {
+		if err := mqttBus.MQTTHub.Unsubscribe(topic); err != nil {
 			klog.Errorf("Edge-hub-cli unsubscribe topic: %s, %v", topic, err)
 			return
 		}
@@ -9,7 +9,9 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/common/util"
+	eventconfig "github.com/kubeedge/kubeedge/edge/pkg/eventbus/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/dao"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
 )
 
 const UploadTopic = "SYS/dis/upload_records"
@@ -59,6 +61,9 @@ type Client struct {
 	Password    string
 	PubCli      MQTT.Client
 	SubCli      MQTT.Client
+	// pubV5 and subV5 take the place of PubCli and SubCli when the broker is connected with MQTT 5
+	pubV5 *v5Client
+	subV5 *v5Client
 }
 
 // AccessInfo that deliver between edge-hub and cloud-hub
@@ -80,9 +85,17 @@ func onSubConnectionLost(client MQTT.Client, err error) {
 }
 
 func onSubConnect(client MQTT.Client) {
+	subscribeTopics(func(topic string) error {
+		token := client.Subscribe(topic, 1, OnSubMessageReceived)
+		_, err := util.CheckClientToken(token)
+		return err
+	})
+}
+
+// subscribeTopics subscribes the topics edge-hub-cli is interested in after connecting to the broker
+func subscribeTopics(subscribe func(topic string) error) {
 	for _, t := range SubTopics {
-		token := client.Subscribe(t, 1, OnSubMessageReceived)
-		if rs, err := util.CheckClientToken(token); !rs {
+		if err := subscribe(t); err != nil {
 			klog.Errorf("edge-hub-cli subscribe topic: %s, %v", t, err)
 			return
 		}
@@ -98,8 +111,7 @@ func onSubConnect(client MQTT.Client) {
 		return
 	}
 	for _, t := range *topics {
-		token := client.Subscribe(t, 1, OnSubMessageReceived)
-		if rs, err := util.CheckClientToken(token); !rs {
+		if err := subscribe(t); err != nil {
 			klog.Errorf("edge-hub-cli subscribe topic: %s, %v", t, err)
 			return
 		}
@@ -125,6 +137,16 @@ func (mq *Client) InitSubClient() {
 	if mq.SubClientID == "" {
 		mq.SubClientID = fmt.Sprintf("hub-client-sub-%s", timeStr[0:right])
 	}
+	if eventconfig.Config.MqttProtocolVersion == v1alpha2.MqttProtocolVersion5 {
+		mq.subV5 = newV5Client(mq.MQTTUrl, mq.SubClientID, mq.Username, mq.Password, func(c *v5Client) {
+			subscribeTopics(func(topic string) error {
+				return c.Subscribe(topic, 1)
+			})
+		})
+		mq.subV5.loopConnect()
+		klog.Info("finish hub-client sub")
+		return
+	}
 	subOpts := util.HubClientInit(mq.MQTTUrl, mq.SubClientID, mq.Username, mq.Password)
 	subOpts.OnConnect = onSubConnect
 	subOpts.AutoReconnect = false
@@ -145,6 +167,12 @@ func (mq *Client) InitPubClient() {
 	if mq.PubClientID == "" {
 		mq.PubClientID = fmt.Sprintf("hub-client-pub-%s", timeStr[0:right])
 	}
+	if eventconfig.Config.MqttProtocolVersion == v1alpha2.MqttProtocolVersion5 {
+		mq.pubV5 = newV5Client(mq.MQTTUrl, mq.PubClientID, mq.Username, mq.Password, nil)
+		mq.pubV5.loopConnect()
+		klog.Info("finish hub-client pub")
+		return
+	}
 	pubOpts := util.HubClientInit(mq.MQTTUrl, mq.PubClientID, mq.Username, mq.Password)
 	pubOpts.OnConnectionLost = onPubConnectionLost
 	pubOpts.AutoReconnect = false
@@ -152,3 +180,51 @@ func (mq *Client) InitPubClient() {
 	util.LoopConnect(mq.PubClientID, mq.PubCli)
 	klog.Info("finish hub-client pub")
 }
+
+// Publish publishes the payload to the topic of the external mqtt broker
+func (mq *Client) Publish(topic string, qos byte, payload []byte) error {
+	if mq.pubV5 != nil {
+		return mq.pubV5.Publish(topic, qos, payload)
+	}
+	token := mq.PubCli.Publish(topic, qos, false, payload)
+	if token.WaitTimeout(util.TokenWaitTime) && token.Error() != nil {
+		return token.Error()
+	}
+	return nil
+}
+
+// Close disconnects the clients from the external mqtt broker, they are not reconnected
+func (mq *Client) Close() {
+	if mq.pubV5 != nil {
+		mq.pubV5.close()
+	}
+	if mq.subV5 != nil {
+		mq.subV5.close()
+	}
+	if mq.PubCli != nil {
+		mq.PubCli.Disconnect(util.DisconnectQuiesce)
+	}
+	if mq.SubCli != nil {
+		mq.SubCli.Disconnect(util.DisconnectQuiesce)
+	}
+}
+
+// Subscribe subscribes the topic of the external mqtt broker
+func (mq *Client) Subscribe(topic string, qos byte) error {
+	if mq.subV5 != nil {
+		return mq.subV5.Subscribe(topic, qos)
+	}
+	token := mq.SubCli.Subscribe(topic, qos, OnSubMessageReceived)
+	_, err := util.CheckClientToken(token)
+	return err
+}
+
+// Unsubscribe unsubscribes the topic of the external mqtt broker
+func (mq *Client) Unsubscribe(topic string) error {
+	if mq.subV5 != nil {
+		return mq.subV5.Unsubscribe(topic)
+	}
+	token := mq.SubCli.Unsubscribe(topic)
+	_, err := util.CheckClientToken(token)
+	return err
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/common/util"
+	eventconfig "github.com/kubeedge/kubeedge/edge/pkg/eventbus/config"
+)
+
+const (
+	defaultV5KeepAlive      = 30
+	defaultV5ConnectTimeout = 30 * time.Second
+)
+
+// v5Client is a client of an MQTT 5 broker, it connects to mqttServerExternal and the servers
+// of mqttExternalBroker in order, and reconnects with backoff when the connection is lost
+type v5Client struct {
+	clientID string
+	servers  []string
+	username string
+	password string
+	// onConnect is called after every successful connection
+	onConnect func(c *v5Client)
+
+	mu      sync.Mutex
+	cli     *paho.Client
+	aliases *topicAliases
+	// closed is true once the client is closed, it is not reconnected then
+	closed bool
+}
+
+func newV5Client(server, clientID, username, password string, onConnect func(c *v5Client)) *v5Client {
+	servers := []string{server}
+	if broker := eventconfig.Config.MqttExternalBroker; broker != nil {
+		servers = append(servers, broker.Servers...)
+	}
+	return &v5Client{
+		clientID:  clientID,
+		servers:   servers,
+		username:  username,
+		password:  password,
+		onConnect: onConnect,
+	}
+}
+
+// loopConnect connects to the brokers until one of them succeeds
+func (c *v5Client) loopConnect() {
+	backoff, maxBackoff := util.ConnectBackoff()
+	for !c.isClosed() {
+		for _, server := range c.servers {
+			klog.Infof("start connect to mqtt server %s with client id: %s", server, c.clientID)
+			if err := c.connect(server); err != nil {
+				klog.Errorf("connect to mqtt server %s error: %v", server, err)
+				continue
+			}
+			klog.Infof("client %s is connected to mqtt server %s", c.clientID, server)
+			if c.onConnect != nil {
+				c.onConnect(c)
+			}
+			return
+		}
+		time.Sleep(backoff)
+		backoff = util.NextBackoff(backoff, maxBackoff)
+	}
+}
+
+func (c *v5Client) connect(server string) error {
+	keepAlive, timeout := uint16(defaultV5KeepAlive), defaultV5ConnectTimeout
+	var sessionExpiry uint32
+	var aliasMax uint16
+	if broker := eventconfig.Config.MqttExternalBroker; broker != nil {
+		if broker.KeepAlive > 0 {
+			keepAlive = uint16(broker.KeepAlive)
+		}
+		if broker.ConnectTimeout > 0 {
+			timeout = time.Duration(broker.ConnectTimeout) * time.Second
+		}
+		sessionExpiry = broker.SessionExpiryInterval
+		aliasMax = broker.TopicAliasMaximum
+	}
+
+	conn, err := dialBroker(server, timeout)
+	if err != nil {
+		return err
+	}
+	var cli *paho.Client
+	cli = paho.NewClient(paho.ClientConfig{
+		ClientID:      c.clientID,
+		Conn:          packets.NewThreadSafeConn(conn),
+		Router:        newV5Router(),
+		PacketTimeout: util.TokenWaitTime,
+		OnClientError: func(err error) {
+			c.connectionLost(cli, err)
+		},
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			c.connectionLost(cli, fmt.Errorf("disconnected by server with reason code %d", d.ReasonCode))
+		},
+	})
+
+	cp := &paho.Connect{
+		ClientID:  c.clientID,
+		KeepAlive: keepAlive,
+		// the broker resumes the session of the client if it has not expired
+		CleanStart: sessionExpiry == 0,
+		Properties: &paho.ConnectProperties{
+			SessionExpiryInterval: &sessionExpiry,
+			TopicAliasMaximum:     &aliasMax,
+		},
+	}
+	if username, password := util.HubCredentials(c.username, c.password); username != "" {
+		cp.Username, cp.UsernameFlag = username, true
+		if password != "" {
+			cp.Password, cp.PasswordFlag = []byte(password), true
+		}
+	}
+
+	// hold the lock until the client is set, so that the connection lost callbacks can tell
+	// whether they come from the current client
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ca, err := cli.Connect(ctx, cp)
+	if err != nil {
+		return err
+	}
+	var serverAliasMax uint16
+	if ca.Properties != nil && ca.Properties.TopicAliasMaximum != nil {
+		serverAliasMax = *ca.Properties.TopicAliasMaximum
+	}
+	if serverAliasMax < aliasMax {
+		aliasMax = serverAliasMax
+	}
+	if c.closed {
+		_ = cli.Disconnect(&paho.Disconnect{ReasonCode: 0})
+		return fmt.Errorf("mqtt client %s is closed", c.clientID)
+	}
+	c.cli = cli
+	c.aliases = newTopicAliases(aliasMax)
+	return nil
+}
+
+func (c *v5Client) connectionLost(cli *paho.Client, err error) {
+	c.mu.Lock()
+	if cli == nil || c.cli != cli {
+		// the connection never came up or the loss is already handled
+		c.mu.Unlock()
+		return
+	}
+	c.cli = nil
+	c.mu.Unlock()
+	klog.Errorf("connection of mqtt client %s lost with error: %v", c.clientID, err)
+	go c.loopConnect()
+}
+
+// close disconnects the client from the broker, it is not reconnected then
+func (c *v5Client) close() {
+	c.mu.Lock()
+	cli := c.cli
+	c.cli, c.closed = nil, true
+	c.mu.Unlock()
+	if cli != nil {
+		if err := cli.Disconnect(&paho.Disconnect{ReasonCode: 0}); err != nil {
+			klog.Warningf("disconnect mqtt client %s error: %v", c.clientID, err)
+		}
+	}
+}
+
+func (c *v5Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+func (c *v5Client) client() (*paho.Client, *topicAliases, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cli == nil {
+		return nil, nil, fmt.Errorf("mqtt client %s is not connected", c.clientID)
+	}
+	return c.cli, c.aliases, nil
+}
+
+// Publish publishes the payload to the topic, replacing the topic with its alias if it has one
+func (c *v5Client) Publish(topic string, qos byte, payload []byte) error {
+	cli, aliases, err := c.client()
+	if err != nil {
+		return err
+	}
+	p := &paho.Publish{Topic: topic, QoS: qos, Payload: payload}
+	established := aliases.apply(p)
+	ctx, cancel := context.WithTimeout(context.Background(), util.TokenWaitTime)
+	defer cancel()
+	if _, err := cli.Publish(ctx, p); err != nil {
+		return err
+	}
+	established()
+	return nil
+}
+
+// Subscribe subscribes the topic
+func (c *v5Client) Subscribe(topic string, qos byte) error {
+	cli, _, err := c.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), util.TokenWaitTime)
+	defer cancel()
+	_, err = cli.Subscribe(ctx, &paho.Subscribe{
+		Subscriptions: map[string]paho.SubscribeOptions{topic: {QoS: qos}},
+	})
+	return err
+}
+
+// Unsubscribe unsubscribes the topic
+func (c *v5Client) Unsubscribe(topic string) error {
+	cli, _, err := c.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), util.TokenWaitTime)
+	defer cancel()
+	_, err = cli.Unsubscribe(ctx, &paho.Unsubscribe{Topics: []string{topic}})
+	return err
+}
+
+// dialBroker opens the network connection to the broker, with tls for the ssl, tls and tcps schemes
+func dialBroker(server string, timeout time.Duration) (net.Conn, error) {
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid broker url %s, err: %v", server, err)
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	switch u.Scheme {
+	case "tcp":
+		return dialer.Dial("tcp", u.Host)
+	case "ssl", "tls", "tcps":
+		tlsConfig, err := util.HubTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		return tls.DialWithDialer(dialer, "tcp", u.Host, tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of broker url %s", u.Scheme, server)
+	}
+}
+
+// v5Router dispatches the messages received from the broker, resolving the topic aliases
+// the broker assigned on this connection
+type v5Router struct {
+	mu      sync.Mutex
+	aliases map[uint16]string
+}
+
+var _ paho.Router = (*v5Router)(nil)
+
+func newV5Router() *v5Router {
+	return &v5Router{aliases: make(map[uint16]string)}
+}
+
+func (r *v5Router) RegisterHandler(string, paho.MessageHandler) {}
+
+func (r *v5Router) UnregisterHandler(string) {}
+
+func (r *v5Router) SetDebugLogger(paho.Logger) {}
+
+func (r *v5Router) Route(pb *packets.Publish) {
+	topic := pb.Topic
+	if pb.Properties != nil && pb.Properties.TopicAlias != nil {
+		alias := *pb.Properties.TopicAlias
+		r.mu.Lock()
+		if topic != "" {
+			r.aliases[alias] = topic
+		} else {
+			topic = r.aliases[alias]
+		}
+		r.mu.Unlock()
+	}
+	if topic == "" {
+		klog.Errorf("OnSubMessageReceived receive msg with unknown topic alias")
+		return
+	}
+	klog.Infof("OnSubMessageReceived receive msg from topic: %s", topic)
+
+	NewMessageMux().Dispatch(topic, pb.Payload)
+}
+
+// topicAliases assigns topic aliases to the published topics, up to the topic alias maximum of
+// both edgecore and the broker. An alias is only used without its topic once the broker has
+// received a message carrying both.
+type topicAliases struct {
+	mu          sync.Mutex
+	max         uint16
+	assigned    map[string]uint16
+	established map[string]bool
+}
+
+func newTopicAliases(max uint16) *topicAliases {
+	return &topicAliases{
+		max:         max,
+		assigned:    make(map[string]uint16),
+		established: make(map[string]bool),
+	}
+}
+
+// apply sets the topic alias of `p`, the returned func must be called after `p` is published
+func (t *topicAliases) apply(p *paho.Publish) func() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	topic := p.Topic
+	alias, ok := t.assigned[topic]
+	if ok && t.established[topic] {
+		p.Topic = ""
+		p.Properties = &paho.PublishProperties{TopicAlias: &alias}
+		return func() {}
+	}
+	if !ok {
+		if len(t.assigned) >= int(t.max) {
+			return func() {}
+		}
+		alias = uint16(len(t.assigned) + 1)
+		t.assigned[topic] = alias
+	}
+	// send the topic together with its alias until the broker has received one of them
+	p.Properties = &paho.PublishProperties{TopicAlias: &alias}
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.established[topic] = true
+	}
+}
@@ -0,0 +1,51 @@
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+)
+
+func TestTopicAliases(t *testing.T) {
+	aliases := newTopicAliases(1)
+	alias := func(p *paho.Publish) uint16 {
+		if p.Properties == nil || p.Properties.TopicAlias == nil {
+			return 0
+		}
+		return *p.Properties.TopicAlias
+	}
+
+	// the first message carries both the topic and the alias
+	first := &paho.Publish{Topic: "a"}
+	established := aliases.apply(first)
+	if first.Topic != "a" || alias(first) != 1 {
+		t.Fatalf("expected topic a with alias 1, but got topic %q with alias %d", first.Topic, alias(first))
+	}
+
+	// the alias is not used alone before the first message is published
+	pending := &paho.Publish{Topic: "a"}
+	aliases.apply(pending)
+	if pending.Topic != "a" || alias(pending) != 1 {
+		t.Fatalf("expected topic a with alias 1, but got topic %q with alias %d", pending.Topic, alias(pending))
+	}
+
+	established()
+	next := &paho.Publish{Topic: "a"}
+	aliases.apply(next)
+	if next.Topic != "" || alias(next) != 1 {
+		t.Fatalf("expected alias 1 without topic, but got topic %q with alias %d", next.Topic, alias(next))
+	}
+
+	// no more aliases left
+	other := &paho.Publish{Topic: "b"}
+	aliases.apply(other)
+	if other.Topic != "b" || alias(other) != 0 {
+		t.Fatalf("expected topic b without alias, but got topic %q with alias %d", other.Topic, alias(other))
+	}
+
+	disabled := &paho.Publish{Topic: "a"}
+	newTopicAliases(0).apply(disabled)
+	if disabled.Topic != "a" || alias(disabled) != 0 {
+		t.Fatalf("expected topic a without alias, but got topic %q with alias %d", disabled.Topic, alias(disabled))
+	}
+}
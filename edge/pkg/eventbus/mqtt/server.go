@@ -17,6 +17,8 @@ limitations under the License.
 package mqtt
 
 import (
+	"time"
+
 	"github.com/256dpi/gomqtt/broker"
 	"github.com/256dpi/gomqtt/packet"
 	"github.com/256dpi/gomqtt/topic"
@@ -26,6 +28,9 @@ import (
 	"github.com/kubeedge/kubeedge/edge/pkg/eventbus/dao"
 )
 
+// closeTimeout is how long Close waits for the connected clients to be closed
+const closeTimeout = 5 * time.Second
+
 // Server serve as an internal mqtt broker.
 type Server struct {
 	// Internal mqtt url
@@ -37,6 +42,9 @@ type Server struct {
 	// A server accepts incoming connections.
 	server transport.Server
 
+	// An engine handles the accepted connections.
+	engine *broker.Engine
+
 	// A MemoryBackend stores all in memory.
 	backend *broker.MemoryBackend
 
@@ -86,12 +94,27 @@ func (m *Server) Run() error {
 		}
 	}
 
-	engine := broker.NewEngine(m.backend)
-	engine.Accept(m.server)
+	m.engine = broker.NewEngine(m.backend)
+	m.engine.Accept(m.server)
 
 	return nil
 }
 
+// Close stops accepting connections and closes the connected clients, the url can be
+// listened on again once it returns.
+func (m *Server) Close() {
+	if m.server == nil {
+		return
+	}
+	if err := m.server.Close(); err != nil {
+		klog.Errorf("Close transport failed %v", err)
+	}
+	m.engine.Close()
+	if !m.backend.Close(closeTimeout) {
+		klog.Warningf("Timed out closing the clients of internal mqtt broker")
+	}
+}
+
 // onSubscribe will be called if the topic is matched in topic tree.
 func (m *Server) onSubscribe(msg *packet.Message) {
 	klog.Infof("OnSubscribe recevie msg from topic: %s", msg.Topic)
@@ -0,0 +1,47 @@
+package mqtt
+
+import (
+	"net"
+	"testing"
+
+	"github.com/256dpi/gomqtt/packet"
+	"github.com/256dpi/gomqtt/transport"
+)
+
+func TestServerClose(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	url := "tcp://" + ln.Addr().String()
+	ln.Close()
+
+	server := NewMqttServer(100, url, false, 0)
+	if err := server.Run(); err != nil {
+		t.Fatalf("failed to run server: %v", err)
+	}
+	conn, err := transport.Dial(url)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	connect := packet.NewConnect()
+	connect.ClientID = "test"
+	if err := conn.Send(connect, false); err != nil {
+		t.Fatalf("failed to send connect: %v", err)
+	}
+	if pkt, err := conn.Receive(); err != nil || pkt.Type() != packet.CONNACK {
+		t.Fatalf("expected connack, got %v: %v", pkt, err)
+	}
+
+	server.Close()
+	// the connected clients are closed with the server
+	if _, err := conn.Receive(); err == nil {
+		t.Errorf("expected the connection to be closed")
+	}
+	// the url is released once the server is closed, so that eventbus can be started again
+	server = NewMqttServer(100, url, false, 0)
+	if err := server.Run(); err != nil {
+		t.Fatalf("failed to run the server again: %v", err)
+	}
+	server.Close()
+}
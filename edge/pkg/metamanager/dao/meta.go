@@ -26,14 +26,28 @@ type Meta struct {
 	Value string `orm:"column(value); null; type(text)"`
 }
 
+// metaStore persists the metas, it is implemented for the sqlite database and for the KV drivers
+type metaStore interface {
+	Save(meta *Meta) error
+	DeleteByKey(key string) error
+	DeleteByKeyAndPodUID(key, podUID string) (int64, error)
+	Update(meta *Meta) error
+	InsertOrUpdate(meta *Meta) error
+	UpdateFields(key string, cols map[string]interface{}) error
+	Query(key string, condition string) (*[]Meta, error)
+}
+
+// getMetaStore returns the store of the configured database driver
+func getMetaStore() metaStore {
+	if dbm.KVAccess != nil {
+		return kvMetaStore{kv: dbm.KVAccess}
+	}
+	return sqliteMetaStore{}
+}
+
 // SaveMeta save meta to db
 func SaveMeta(meta *Meta) error {
-	num, err := dbm.DBAccess.Insert(meta)
-	klog.V(4).Infof("Insert affected Num: %d, %v", num, err)
-	if err == nil || IsNonUniqueNameError(err) {
-		return nil
-	}
-	return err
+	return getMetaStore().Save(meta)
 }
 
 // IsNonUniqueNameError tests if the error returned by sqlite is unique.
@@ -48,54 +62,37 @@ func IsNonUniqueNameError(err error) bool {
 
 // DeleteMetaByKey delete meta by key
 func DeleteMetaByKey(key string) error {
-	num, err := dbm.DBAccess.QueryTable(MetaTableName).Filter("key", key).Delete()
-	klog.V(4).Infof("Delete affected Num: %d, %v", num, err)
-	return err
+	return getMetaStore().DeleteByKey(key)
 }
 
 // DeleteMetaByKeyAndPodUID delete meta by key and podUID
 func DeleteMetaByKeyAndPodUID(key, podUID string) (int64, error) {
-	sqlStr := fmt.Sprintf("DELETE FROM meta WHERE key = '%s' and value LIKE '%%%s%%'", key, podUID)
-	res, err := dbm.DBAccess.Raw(sqlStr).Exec()
-	if err != nil {
-		klog.Errorf("delete pod by key %s and podUID %s failed, err: %v", key, podUID, err)
-		return 0, err
-	}
-	return res.RowsAffected()
+	return getMetaStore().DeleteByKeyAndPodUID(key, podUID)
 }
 
 // UpdateMeta update meta
 func UpdateMeta(meta *Meta) error {
-	num, err := dbm.DBAccess.Update(meta) // will update all field
-	klog.V(4).Infof("Update affected Num: %d, %v", num, err)
-	return err
+	return getMetaStore().Update(meta)
 }
 
 // InsertOrUpdate insert or update meta
 func InsertOrUpdate(meta *Meta) error {
-	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO meta (key, type, value) VALUES (?,?,?)", meta.Key, meta.Type, meta.Value).Exec() // will update all field
-	klog.V(4).Infof("Update result %v", err)
-	return err
+	return getMetaStore().InsertOrUpdate(meta)
 }
 
 // UpdateMetaField update special field
 func UpdateMetaField(key string, col string, value interface{}) error {
-	num, err := dbm.DBAccess.QueryTable(MetaTableName).Filter("key", key).Update(map[string]interface{}{col: value})
-	klog.V(4).Infof("Update affected Num: %d, %v", num, err)
-	return err
+	return getMetaStore().UpdateFields(key, map[string]interface{}{col: value})
 }
 
 // UpdateMetaFields update special fields
 func UpdateMetaFields(key string, cols map[string]interface{}) error {
-	num, err := dbm.DBAccess.QueryTable(MetaTableName).Filter("key", key).Update(cols)
-	klog.V(4).Infof("Update affected Num: %d, %v", num, err)
-	return err
+	return getMetaStore().UpdateFields(key, cols)
 }
 
 // QueryMeta return only meta's value, if no error, Meta not null
 func QueryMeta(key string, condition string) (*[]string, error) {
-	meta := new([]Meta)
-	_, err := dbm.DBAccess.QueryTable(MetaTableName).Filter(key, condition).All(meta)
+	meta, err := getMetaStore().Query(key, condition)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +106,61 @@ func QueryMeta(key string, condition string) (*[]string, error) {
 
 // QueryAllMeta return all meta, if no error, Meta not null
 func QueryAllMeta(key string, condition string) (*[]Meta, error) {
+	return getMetaStore().Query(key, condition)
+}
+
+// sqliteMetaStore is the metaStore of the sqlite database
+type sqliteMetaStore struct{}
+
+func (sqliteMetaStore) Save(meta *Meta) error {
+	num, err := dbm.DBAccess.Insert(meta)
+	klog.V(4).Infof("Insert affected Num: %d, %v", num, err)
+	if err == nil || IsNonUniqueNameError(err) {
+		return nil
+	}
+	return err
+}
+
+func (sqliteMetaStore) DeleteByKey(key string) error {
+	num, err := dbm.DBAccess.QueryTable(MetaTableName).Filter("key", key).Delete()
+	klog.V(4).Infof("Delete affected Num: %d, %v", num, err)
+	return err
+}
+
+func (sqliteMetaStore) DeleteByKeyAndPodUID(key, podUID string) (int64, error) {
+	sqlStr := fmt.Sprintf("DELETE FROM meta WHERE key = '%s' and value LIKE '%%%s%%'", key, podUID)
+	res, err := dbm.DBAccess.Raw(sqlStr).Exec()
+	if err != nil {
+		klog.Errorf("delete pod by key %s and podUID %s failed, err: %v", key, podUID, err)
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (sqliteMetaStore) Update(meta *Meta) error {
+	num, err := dbm.DBAccess.Update(meta) // will update all field
+	klog.V(4).Infof("Update affected Num: %d, %v", num, err)
+	return err
+}
+
+func (sqliteMetaStore) InsertOrUpdate(meta *Meta) error {
+	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO meta (key, type, value) VALUES (?,?,?)", meta.Key, meta.Type, meta.Value).Exec() // will update all field
+	klog.V(4).Infof("Update result %v", err)
+	return err
+}
+
+func (sqliteMetaStore) UpdateFields(key string, cols map[string]interface{}) error {
+	num, err := dbm.DBAccess.QueryTable(MetaTableName).Filter("key", key).Update(cols)
+	klog.V(4).Infof("Update affected Num: %d, %v", num, err)
+	return err
+}
+
+func (sqliteMetaStore) Query(key string, condition string) (*[]Meta, error) {
 	meta := new([]Meta)
 	_, err := dbm.DBAccess.QueryTable(MetaTableName).Filter(key, condition).All(meta)
 	if err != nil {
 		return nil, err
 	}
-
 	return meta, nil
 }
 
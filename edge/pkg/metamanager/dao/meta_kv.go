@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+// kvMetaStore is the metaStore of the KV drivers, the metas are stored by key in the meta bucket
+type kvMetaStore struct {
+	kv dbm.KV
+}
+
+func (s kvMetaStore) Save(meta *Meta) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		if tx.Get(MetaTableName, meta.Key) != nil {
+			// like the sqlite store, saving an existing meta is not an error
+			return nil
+		}
+		return dbm.PutJSON(tx, MetaTableName, meta.Key, meta)
+	})
+}
+
+func (s kvMetaStore) DeleteByKey(key string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return tx.Delete(MetaTableName, key)
+	})
+}
+
+func (s kvMetaStore) DeleteByKeyAndPodUID(key, podUID string) (int64, error) {
+	var num int64
+	err := s.kv.Update(func(tx dbm.Tx) error {
+		var meta Meta
+		ok, err := dbm.GetJSON(tx, MetaTableName, key, &meta)
+		if err != nil || !ok || !strings.Contains(meta.Value, podUID) {
+			return err
+		}
+		num = 1
+		return tx.Delete(MetaTableName, key)
+	})
+	return num, err
+}
+
+func (s kvMetaStore) Update(meta *Meta) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		if tx.Get(MetaTableName, meta.Key) == nil {
+			return nil
+		}
+		return dbm.PutJSON(tx, MetaTableName, meta.Key, meta)
+	})
+}
+
+func (s kvMetaStore) InsertOrUpdate(meta *Meta) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return dbm.PutJSON(tx, MetaTableName, meta.Key, meta)
+	})
+}
+
+func (s kvMetaStore) UpdateFields(key string, cols map[string]interface{}) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		var meta Meta
+		ok, err := dbm.GetJSON(tx, MetaTableName, key, &meta)
+		if err != nil || !ok {
+			return err
+		}
+		if err := dbm.SetColumns(&meta, cols); err != nil {
+			return err
+		}
+		if meta.Key != key {
+			if err := tx.Delete(MetaTableName, key); err != nil {
+				return err
+			}
+		}
+		return dbm.PutJSON(tx, MetaTableName, meta.Key, &meta)
+	})
+}
+
+func (s kvMetaStore) Query(key string, condition string) (*[]Meta, error) {
+	metas := new([]Meta)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		if strings.EqualFold(key, "key") {
+			var meta Meta
+			ok, err := dbm.GetJSON(tx, MetaTableName, condition, &meta)
+			if ok && err == nil {
+				*metas = append(*metas, meta)
+			}
+			return err
+		}
+		return tx.ForEach(MetaTableName, "", func(_ string, value []byte) error {
+			var meta Meta
+			if err := json.Unmarshal(value, &meta); err != nil {
+				return err
+			}
+			match, err := dbm.MatchColumn(&meta, key, condition)
+			if match {
+				*metas = append(*metas, meta)
+			}
+			return err
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metas, nil
+}
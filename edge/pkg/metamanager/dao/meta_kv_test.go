@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+func useMemoryKV(t *testing.T) {
+	dbm.KVAccess = dbm.NewMemoryKV()
+	t.Cleanup(func() { dbm.KVAccess = nil })
+}
+
+func TestKVMetaStore(t *testing.T) {
+	useMemoryKV(t)
+
+	pod := &Meta{Key: "default/pod/nginx", Type: "pod", Value: `{"metadata":{"uid":"uid-1"}}`}
+	for _, m := range []*Meta{pod, {Key: "default/configmap/cm", Type: "configmap", Value: "cm"}} {
+		if err := SaveMeta(m); err != nil {
+			t.Fatalf("SaveMeta() failed: %v", err)
+		}
+	}
+	// saving an existing meta is ignored like a unique constraint error of sqlite
+	if err := SaveMeta(&Meta{Key: pod.Key, Type: "pod", Value: "ignored"}); err != nil {
+		t.Fatalf("SaveMeta() of an existing key failed: %v", err)
+	}
+
+	values, err := QueryMeta("key", pod.Key)
+	if err != nil || len(*values) != 1 || (*values)[0] != pod.Value {
+		t.Fatalf("QueryMeta(key) = %v, %v, want [%s]", values, err, pod.Value)
+	}
+	metas, err := QueryAllMeta("type", "configmap")
+	if err != nil || len(*metas) != 1 || (*metas)[0].Key != "default/configmap/cm" {
+		t.Fatalf("QueryAllMeta(type) = %v, %v", metas, err)
+	}
+
+	if err := UpdateMetaField(pod.Key, "value", "updated"); err != nil {
+		t.Fatalf("UpdateMetaField() failed: %v", err)
+	}
+	if values, _ := QueryMeta("key", pod.Key); len(*values) != 1 || (*values)[0] != "updated" {
+		t.Errorf("QueryMeta() after UpdateMetaField() = %v", values)
+	}
+	if err := InsertOrUpdate(&Meta{Key: pod.Key, Type: "pod", Value: `{"metadata":{"uid":"uid-2"}}`}); err != nil {
+		t.Fatalf("InsertOrUpdate() failed: %v", err)
+	}
+
+	if num, err := DeleteMetaByKeyAndPodUID(pod.Key, "uid-1"); err != nil || num != 0 {
+		t.Errorf("DeleteMetaByKeyAndPodUID() of another uid = %d, %v, want 0", num, err)
+	}
+	if num, err := DeleteMetaByKeyAndPodUID(pod.Key, "uid-2"); err != nil || num != 1 {
+		t.Errorf("DeleteMetaByKeyAndPodUID() = %d, %v, want 1", num, err)
+	}
+	if err := DeleteMetaByKey("default/configmap/cm"); err != nil {
+		t.Fatalf("DeleteMetaByKey() failed: %v", err)
+	}
+	if metas, _ := QueryAllMeta("type", "configmap"); len(*metas) != 0 {
+		t.Errorf("QueryAllMeta() after DeleteMetaByKey() = %v, want none", metas)
+	}
+}
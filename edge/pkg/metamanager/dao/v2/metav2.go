@@ -46,8 +46,52 @@ type MetaV2 struct {
 	Value string `orm:"column(value); null; type(text)"`
 }
 
+// metaV2Store persists the api objects, it is implemented for the sqlite database and for the KV drivers
+type metaV2Store interface {
+	RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]MetaV2, error)
+	QueryByKey(key string) (*[]MetaV2, error)
+	InsertOrUpdate(m *MetaV2) error
+	DeleteByKey(key string) error
+	LatestResourceVersion() (uint64, error)
+}
+
+// getMetaV2Store returns the store of the configured database driver
+func getMetaV2Store() metaV2Store {
+	if dbm.KVAccess != nil {
+		return kvMetaV2Store{kv: dbm.KVAccess}
+	}
+	return sqliteMetaV2Store{}
+}
+
 // List a slice of raw data by Group Version Resource Namespace Name
 func RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]MetaV2, error) {
+	return getMetaV2Store().RawMetaByGVRNN(gvr, namespace, name)
+}
+
+// QueryMetaByKey returns the records of key, there is at most one
+func QueryMetaByKey(key string) (*[]MetaV2, error) {
+	return getMetaV2Store().QueryByKey(key)
+}
+
+// InsertOrUpdate inserts the record or replaces the record of the same key
+func InsertOrUpdate(m *MetaV2) error {
+	return getMetaV2Store().InsertOrUpdate(m)
+}
+
+// DeleteMetaByKey deletes the record of key
+func DeleteMetaByKey(key string) error {
+	return getMetaV2Store().DeleteByKey(key)
+}
+
+// LatestResourceVersion returns the highest resource version of the records, 0 if there are none
+func LatestResourceVersion() (uint64, error) {
+	return getMetaV2Store().LatestResourceVersion()
+}
+
+// sqliteMetaV2Store is the metaV2Store of the sqlite database
+type sqliteMetaV2Store struct{}
+
+func (sqliteMetaV2Store) RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]MetaV2, error) {
 	objs := new([]MetaV2)
 	var err error
 	// TODO: use getCondition
@@ -80,6 +124,31 @@ func RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name stri
 	return objs, nil
 }
 
+func (sqliteMetaV2Store) QueryByKey(key string) (*[]MetaV2, error) {
+	objs := new([]MetaV2)
+	if _, err := dbm.DBAccess.QueryTable(NewMetaTableName).Filter(KEY, key).All(objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (sqliteMetaV2Store) InsertOrUpdate(m *MetaV2) error {
+	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO meta_v2 (key, groupversionresource, namespace,name,resourceversion,value) VALUES (?,?,?,?,?,?)", m.Key, m.GroupVersionResource, m.Namespace, m.Name, m.ResourceVersion, m.Value).Exec()
+	return err
+}
+
+func (sqliteMetaV2Store) DeleteByKey(key string) error {
+	_, err := dbm.DBAccess.Delete(&MetaV2{Key: key})
+	return err
+}
+
+func (sqliteMetaV2Store) LatestResourceVersion() (uint64, error) {
+	m := new(MetaV2)
+	// get the most recent record
+	_, err := dbm.DBAccess.QueryTable(NewMetaTableName).OrderBy("-" + RV).Limit(1).All(m)
+	return m.ResourceVersion, err
+}
+
 func getCondition(gvr schema.GroupVersionResource, namespace string, name string) *orm.Condition {
 	cond := orm.NewCondition()
 	cond.And(GVR, gvr.String())
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+// kvMetaV2Store is the metaV2Store of the KV drivers, the records are stored by key in the meta_v2 bucket
+type kvMetaV2Store struct {
+	kv dbm.KV
+}
+
+func (s kvMetaV2Store) RawMetaByGVRNN(gvr schema.GroupVersionResource, namespace string, name string) (*[]MetaV2, error) {
+	objs := new([]MetaV2)
+	err := s.forEach(func(m MetaV2) {
+		if !gvr.Empty() {
+			if m.GroupVersionResource != gvr.String() {
+				return
+			}
+			if namespace != NullNamespace && namespace != "" && m.Namespace != namespace {
+				return
+			}
+			if name != NullName && name != "" && m.Name != name {
+				return
+			}
+		}
+		*objs = append(*objs, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (s kvMetaV2Store) QueryByKey(key string) (*[]MetaV2, error) {
+	objs := new([]MetaV2)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		var m MetaV2
+		ok, err := dbm.GetJSON(tx, NewMetaTableName, key, &m)
+		if ok && err == nil {
+			*objs = append(*objs, m)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+func (s kvMetaV2Store) InsertOrUpdate(m *MetaV2) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return dbm.PutJSON(tx, NewMetaTableName, m.Key, m)
+	})
+}
+
+func (s kvMetaV2Store) DeleteByKey(key string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return tx.Delete(NewMetaTableName, key)
+	})
+}
+
+func (s kvMetaV2Store) LatestResourceVersion() (uint64, error) {
+	var rv uint64
+	err := s.forEach(func(m MetaV2) {
+		if m.ResourceVersion > rv {
+			rv = m.ResourceVersion
+		}
+	})
+	return rv, err
+}
+
+func (s kvMetaV2Store) forEach(fn func(m MetaV2)) error {
+	return s.kv.View(func(tx dbm.Tx) error {
+		return tx.ForEach(NewMetaTableName, "", func(_ string, value []byte) error {
+			var m MetaV2
+			if err := json.Unmarshal(value, &m); err != nil {
+				return err
+			}
+			fn(m)
+			return nil
+		})
+	})
+}
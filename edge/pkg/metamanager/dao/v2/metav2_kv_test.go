@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+)
+
+func TestKVMetaV2Store(t *testing.T) {
+	dbm.KVAccess = dbm.NewMemoryKV()
+	t.Cleanup(func() { dbm.KVAccess = nil })
+
+	pods := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	for _, m := range []MetaV2{
+		{Key: "/core/v1/pods/default/a", GroupVersionResource: pods.String(), Namespace: "default", Name: "a", ResourceVersion: 3},
+		{Key: "/core/v1/pods/kube-system/b", GroupVersionResource: pods.String(), Namespace: "kube-system", Name: "b", ResourceVersion: 7},
+		{Key: "/core/v1/services/default/a", GroupVersionResource: "/v1, Resource=services", Namespace: "default", Name: "a", ResourceVersion: 5},
+	} {
+		m := m
+		if err := InsertOrUpdate(&m); err != nil {
+			t.Fatalf("InsertOrUpdate() failed: %v", err)
+		}
+	}
+
+	cases := []struct {
+		namespace, name string
+		want            int
+	}{
+		{NullNamespace, NullName, 2},
+		{"default", NullName, 1},
+		{"", "b", 1},
+		{"default", "b", 0},
+	}
+	for _, c := range cases {
+		objs, err := RawMetaByGVRNN(pods, c.namespace, c.name)
+		if err != nil || len(*objs) != c.want {
+			t.Errorf("RawMetaByGVRNN(%s, %s) = %v, %v, want %d objects", c.namespace, c.name, objs, err, c.want)
+		}
+	}
+	if objs, _ := RawMetaByGVRNN(schema.GroupVersionResource{}, "", ""); len(*objs) != 3 {
+		t.Errorf("RawMetaByGVRNN() of an empty gvr = %v, want all objects", objs)
+	}
+
+	if rv, err := LatestResourceVersion(); err != nil || rv != 7 {
+		t.Errorf("LatestResourceVersion() = %d, %v, want 7", rv, err)
+	}
+	if err := DeleteMetaByKey("/core/v1/pods/kube-system/b"); err != nil {
+		t.Fatalf("DeleteMetaByKey() failed: %v", err)
+	}
+	if objs, _ := QueryMetaByKey("/core/v1/pods/kube-system/b"); len(*objs) != 0 {
+		t.Errorf("QueryMetaByKey() after DeleteMetaByKey() = %v, want none", objs)
+	}
+	if rv, _ := LatestResourceVersion(); rv != 5 {
+		t.Errorf("LatestResourceVersion() after DeleteMetaByKey() = %d, want 5", rv)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gc purges stale objects from the edge database, so that the database
+// of a node which is offline for a long time does not fill up its storage.
+package gc
+
+import (
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+// Collector periodically purges the events and the terminated pods which exceeded
+// their TTL, and all of them once the database exceeds its max size.
+type Collector struct {
+	config     v1alpha2.MetaManagerGC
+	store      store
+	now        func() time.Time
+	lastVacuum time.Time
+}
+
+// NewCollector returns a collector of the edge database.
+func NewCollector(config v1alpha2.MetaManagerGC) *Collector {
+	registerMetrics()
+	return &Collector{
+		config:     config,
+		store:      dbStore{},
+		now:        time.Now,
+		lastVacuum: time.Now(),
+	}
+}
+
+// Run runs the garbage collection every interval until stop is closed.
+func (c *Collector) Run(stop <-chan struct{}) {
+	klog.Infof("Start the garbage collection of the edge database every %ds", c.config.Interval)
+	wait.Until(c.RunOnce, time.Duration(c.config.Interval)*time.Second, stop)
+}
+
+// RunOnce runs the garbage collection once.
+func (c *Collector) RunOnce() {
+	now := c.now()
+	c.purgeEvents(now, time.Duration(c.config.EventTTL)*time.Second, reasonTTL)
+	c.purgePods(now, time.Duration(c.config.TerminatedPodTTL)*time.Second, reasonTTL)
+
+	vacuum := c.config.VacuumInterval > 0 && now.Sub(c.lastVacuum) >= time.Duration(c.config.VacuumInterval)*time.Second
+	size, err := c.store.size()
+	if err != nil {
+		klog.Errorf("failed to get the size of the edge database: %v", err)
+	} else {
+		dbSizeBytes.Set(float64(size))
+		if maxSize := int64(c.config.MaxDBSize) * 1024 * 1024; maxSize > 0 && size > maxSize {
+			klog.Warningf("edge database size %d bytes exceeds the limit of %dMiB, purging all events and terminated pods",
+				size, c.config.MaxDBSize)
+			c.purgeEvents(now, 0, reasonSizeLimit)
+			c.purgePods(now, 0, reasonSizeLimit)
+			vacuum = true
+		}
+	}
+	if !vacuum {
+		return
+	}
+
+	if err := c.store.vacuum(); err != nil {
+		klog.Errorf("failed to vacuum the edge database: %v", err)
+		return
+	}
+	c.lastVacuum = now
+	vacuums.Inc()
+	if size, err := c.store.size(); err == nil {
+		dbSizeBytes.Set(float64(size))
+		if maxSize := int64(c.config.MaxDBSize) * 1024 * 1024; maxSize > 0 && size > maxSize {
+			klog.Warningf("edge database size %d bytes still exceeds the limit of %dMiB after the garbage collection",
+				size, c.config.MaxDBSize)
+		}
+	}
+}
+
+// purgeEvents deletes the events which last occurred before now-ttl, all of them if ttl is zero.
+func (c *Collector) purgeEvents(now time.Time, ttl time.Duration, reason string) {
+	events, err := c.store.listEvents()
+	if err != nil {
+		klog.Errorf("failed to list the events of the edge database: %v", err)
+		return
+	}
+	purged := 0
+	for _, event := range events {
+		if ttl > 0 {
+			last, err := eventLastTime(event.Value)
+			if err != nil {
+				klog.Warningf("failed to parse event %s: %v", event.Key, err)
+				continue
+			}
+			if now.Sub(last) < ttl {
+				continue
+			}
+		}
+		if err := c.store.deleteEvent(event.Key); err != nil {
+			klog.Errorf("failed to delete event %s: %v", event.Key, err)
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		klog.V(2).Infof("purged %d events from the edge database", purged)
+		purgedObjects.WithLabelValues(resourceEvent, reason).Add(float64(purged))
+	}
+}
+
+// purgePods deletes the pods which terminated before now-ttl, all terminated pods if ttl is zero.
+func (c *Collector) purgePods(now time.Time, ttl time.Duration, reason string) {
+	pods, err := c.store.listPods()
+	if err != nil {
+		klog.Errorf("failed to list the pods of the edge database: %v", err)
+		return
+	}
+	purged := 0
+	for _, meta := range pods {
+		var pod corev1.Pod
+		if err := json.Unmarshal([]byte(meta.Value), &pod); err != nil {
+			klog.Warningf("failed to parse pod %s: %v", meta.Key, err)
+			continue
+		}
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if ttl > 0 && now.Sub(podTerminatedTime(&pod)) < ttl {
+			continue
+		}
+		if err := c.store.deletePod(meta, pod.Namespace, pod.Name); err != nil {
+			klog.Errorf("failed to delete pod %s: %v", meta.Key, err)
+			continue
+		}
+		purged++
+	}
+	if purged > 0 {
+		klog.V(2).Infof("purged %d terminated pods from the edge database", purged)
+		purgedObjects.WithLabelValues(resourcePod, reason).Add(float64(purged))
+	}
+}
+
+// eventTimes holds the timestamps of both core/v1 and events.k8s.io/v1 events.
+type eventTimes struct {
+	Metadata struct {
+		CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	} `json:"metadata"`
+	EventTime               metav1.MicroTime `json:"eventTime"`
+	LastTimestamp           metav1.Time      `json:"lastTimestamp"`
+	DeprecatedLastTimestamp metav1.Time      `json:"deprecatedLastTimestamp"`
+	Series                  *struct {
+		LastObservedTime metav1.MicroTime `json:"lastObservedTime"`
+	} `json:"series"`
+}
+
+// eventLastTime returns when the event encoded in value last occurred.
+func eventLastTime(value string) (time.Time, error) {
+	var e eventTimes
+	if err := json.Unmarshal([]byte(value), &e); err != nil {
+		return time.Time{}, err
+	}
+	last := latest(e.Metadata.CreationTimestamp.Time, e.EventTime.Time, e.LastTimestamp.Time, e.DeprecatedLastTimestamp.Time)
+	if e.Series != nil {
+		last = latest(last, e.Series.LastObservedTime.Time)
+	}
+	return last, nil
+}
+
+// podTerminatedTime returns when the last container of the pod terminated, falling
+// back to the last transition of its conditions and its creation.
+func podTerminatedTime(pod *corev1.Pod) time.Time {
+	var finished time.Time
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range statuses {
+			if status.State.Terminated != nil {
+				finished = latest(finished, status.State.Terminated.FinishedAt.Time)
+			}
+		}
+	}
+	if !finished.IsZero() {
+		return finished
+	}
+	for _, condition := range pod.Status.Conditions {
+		finished = latest(finished, condition.LastTransitionTime.Time)
+	}
+	if !finished.IsZero() {
+		return finished
+	}
+	return pod.CreationTimestamp.Time
+}
+
+func latest(times ...time.Time) time.Time {
+	var res time.Time
+	for _, t := range times {
+		if t.After(res) {
+			res = t
+		}
+	}
+	return res
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
+	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+var now = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type fakeStore struct {
+	events   map[string]string
+	pods     map[string]string
+	dbSize   int64
+	vacuumed int
+}
+
+func (s *fakeStore) listEvents() ([]v2.MetaV2, error) {
+	var res []v2.MetaV2
+	for k, v := range s.events {
+		res = append(res, v2.MetaV2{Key: k, Value: v})
+	}
+	return res, nil
+}
+
+func (s *fakeStore) deleteEvent(key string) error {
+	delete(s.events, key)
+	return nil
+}
+
+func (s *fakeStore) listPods() ([]dao.Meta, error) {
+	var res []dao.Meta
+	for k, v := range s.pods {
+		res = append(res, dao.Meta{Key: k, Type: "pod", Value: v})
+	}
+	return res, nil
+}
+
+func (s *fakeStore) deletePod(pod dao.Meta, namespace, name string) error {
+	delete(s.pods, pod.Key)
+	return nil
+}
+
+func (s *fakeStore) size() (int64, error) {
+	return s.dbSize, nil
+}
+
+func (s *fakeStore) vacuum() error {
+	s.vacuumed++
+	return nil
+}
+
+func keys(m map[string]string) []string {
+	res := []string{}
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func mustJSON(t *testing.T, obj interface{}) string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func event(t *testing.T, last time.Time) string {
+	return mustJSON(t, &corev1.Event{
+		ObjectMeta:    metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(last.Add(-time.Hour))},
+		LastTimestamp: metav1.NewTime(last),
+	})
+}
+
+func pod(t *testing.T, phase corev1.PodPhase, finished time.Time) string {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "nginx"},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+	if !finished.IsZero() {
+		p.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{FinishedAt: metav1.NewTime(finished)}},
+		}}
+	}
+	return mustJSON(t, p)
+}
+
+func newTestCollector(s *fakeStore, config v1alpha2.MetaManagerGC) *Collector {
+	return &Collector{
+		config:     config,
+		store:      s,
+		now:        func() time.Time { return now },
+		lastVacuum: now,
+	}
+}
+
+func TestRunOncePurgesExpiredObjects(t *testing.T) {
+	s := &fakeStore{
+		events: map[string]string{
+			"/core/v1/events/default/old":    event(t, now.Add(-2*time.Hour)),
+			"/core/v1/events/default/recent": event(t, now.Add(-time.Minute)),
+		},
+		pods: map[string]string{
+			"default/pod/succeeded-old":    pod(t, corev1.PodSucceeded, now.Add(-48*time.Hour)),
+			"default/pod/failed-recent":    pod(t, corev1.PodFailed, now.Add(-time.Hour)),
+			"default/pod/running":          pod(t, corev1.PodRunning, time.Time{}),
+			"default/pod/succeeded-no-end": pod(t, corev1.PodSucceeded, time.Time{}),
+		},
+	}
+	c := newTestCollector(s, v1alpha2.MetaManagerGC{
+		EventTTL:         3600,
+		TerminatedPodTTL: 86400,
+		VacuumInterval:   86400,
+	})
+	c.RunOnce()
+
+	if got, want := keys(s.events), []string{"/core/v1/events/default/recent"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected events %v, got %v", want, got)
+	}
+	// the pod without terminated containers falls back to its creation, which is the zero time
+	if got, want := keys(s.pods), []string{"default/pod/failed-recent", "default/pod/running"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected pods %v, got %v", want, got)
+	}
+	if s.vacuumed != 0 {
+		t.Errorf("expected no vacuum before the vacuum interval, got %d", s.vacuumed)
+	}
+}
+
+func TestRunOnceSizeLimit(t *testing.T) {
+	s := &fakeStore{
+		events: map[string]string{
+			"/core/v1/events/default/recent": event(t, now.Add(-time.Minute)),
+		},
+		pods: map[string]string{
+			"default/pod/failed-recent": pod(t, corev1.PodFailed, now.Add(-time.Hour)),
+			"default/pod/running":       pod(t, corev1.PodRunning, time.Time{}),
+		},
+		dbSize: 200 * 1024 * 1024,
+	}
+	c := newTestCollector(s, v1alpha2.MetaManagerGC{
+		EventTTL:         3600,
+		TerminatedPodTTL: 86400,
+		MaxDBSize:        100,
+	})
+	c.RunOnce()
+
+	if len(s.events) != 0 {
+		t.Errorf("expected all events to be purged, got %v", keys(s.events))
+	}
+	if got, want := keys(s.pods), []string{"default/pod/running"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected pods %v, got %v", want, got)
+	}
+	if s.vacuumed != 1 {
+		t.Errorf("expected a vacuum after exceeding the size limit, got %d", s.vacuumed)
+	}
+}
+
+func TestRunOnceVacuumInterval(t *testing.T) {
+	s := &fakeStore{}
+	c := newTestCollector(s, v1alpha2.MetaManagerGC{
+		EventTTL:         3600,
+		TerminatedPodTTL: 86400,
+		VacuumInterval:   3600,
+	})
+	c.lastVacuum = now.Add(-2 * time.Hour)
+	c.RunOnce()
+	c.RunOnce()
+	if s.vacuumed != 1 {
+		t.Errorf("expected a single vacuum, got %d", s.vacuumed)
+	}
+}
+
+func TestEventLastTime(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{
+			name:  "core event",
+			value: event(t, now),
+			want:  now,
+		},
+		{
+			name:  "events.k8s.io event series",
+			value: `{"metadata":{"creationTimestamp":"2024-03-01T10:00:00Z"},"eventTime":"2024-03-01T10:00:00.000000Z","series":{"count":3,"lastObservedTime":"2024-03-01T12:00:00.000000Z"}}`,
+			want:  now,
+		},
+		{
+			name:  "only created",
+			value: `{"metadata":{"creationTimestamp":"2024-03-01T12:00:00Z"}}`,
+			want:  now,
+		},
+	}
+	for _, c := range cases {
+		got, err := eventLastTime(c.value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("%s: expected %s, got %s", c.name, c.want, got)
+		}
+	}
+	if _, err := eventLastTime("not json"); err == nil {
+		t.Error("expected an error parsing an invalid event")
+	}
+}
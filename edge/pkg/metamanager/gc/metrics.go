@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	metricNamespace = "kubeedge"
+	metricSubsystem = "metamanager"
+
+	resourceEvent = "event"
+	resourcePod   = "pod"
+
+	reasonTTL       = "ttl"
+	reasonSizeLimit = "size_limit"
+)
+
+var (
+	// dbSizeBytes is the size of the edge database, it is exposed at the metrics endpoint of edged
+	dbSizeBytes = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespace,
+			Subsystem:      metricSubsystem,
+			Name:           "db_size_bytes",
+			Help:           "Size of the edge database in bytes",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	purgedObjects = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespace,
+			Subsystem:      metricSubsystem,
+			Name:           "gc_purged_objects_total",
+			Help:           "Number of objects purged from the edge database by resource and reason",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource", "reason"},
+	)
+
+	vacuums = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespace,
+			Subsystem:      metricSubsystem,
+			Name:           "gc_vacuums_total",
+			Help:           "Number of VACUUMs of the edge database",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+)
+
+var registerOnce sync.Once
+
+// registerMetrics register all metrics.
+func registerMetrics() {
+	registerOnce.Do(func() {
+		legacyregistry.MustRegister(
+			dbSizeBytes,
+			purgedObjects,
+			vacuums,
+		)
+	})
+}
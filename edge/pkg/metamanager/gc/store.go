@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gc
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
+	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
+)
+
+// store is the access of the collector to the edge database.
+type store interface {
+	// listEvents returns the events stored by metaserver
+	listEvents() ([]v2.MetaV2, error)
+	// deleteEvent deletes the event of key
+	deleteEvent(key string) error
+	// listPods returns the pods stored by metamanager
+	listPods() ([]dao.Meta, error)
+	// deletePod deletes the pod, its patch and the copy of metaserver
+	deletePod(pod dao.Meta, namespace, name string) error
+	// size returns the size of the database in bytes
+	size() (int64, error)
+	// vacuum rebuilds the database to return the free pages to the file system
+	vacuum() error
+}
+
+var (
+	eventGVRs = []schema.GroupVersionResource{
+		corev1.SchemeGroupVersion.WithResource("events"),
+		eventsv1.SchemeGroupVersion.WithResource("events"),
+	}
+	podGVR = corev1.SchemeGroupVersion.WithResource("pods")
+)
+
+// dbStore is the store of the edge database of edgecore, whichever driver it uses.
+type dbStore struct{}
+
+func (dbStore) listEvents() ([]v2.MetaV2, error) {
+	var events []v2.MetaV2
+	for _, gvr := range eventGVRs {
+		objs, err := v2.RawMetaByGVRNN(gvr, v2.NullNamespace, v2.NullName)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *objs...)
+	}
+	return events, nil
+}
+
+func (dbStore) deleteEvent(key string) error {
+	return v2.DeleteMetaByKey(key)
+}
+
+func (dbStore) listPods() ([]dao.Meta, error) {
+	pods, err := dao.QueryAllMeta("type", model.ResourceTypePod)
+	if err != nil {
+		return nil, err
+	}
+	return *pods, nil
+}
+
+func (dbStore) deletePod(pod dao.Meta, namespace, name string) error {
+	if err := dao.DeleteMetaByKey(pod.Key); err != nil {
+		return err
+	}
+	podPatchKey := strings.Replace(pod.Key,
+		constants.ResourceSep+model.ResourceTypePod+constants.ResourceSep,
+		constants.ResourceSep+model.ResourceTypePodPatch+constants.ResourceSep, 1)
+	if err := dao.DeleteMetaByKey(podPatchKey); err != nil {
+		return err
+	}
+	objs, err := v2.RawMetaByGVRNN(podGVR, namespace, name)
+	if err != nil {
+		return err
+	}
+	for _, obj := range *objs {
+		if err := v2.DeleteMetaByKey(obj.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dbStore) size() (int64, error) {
+	if dbm.KVAccess != nil {
+		return dbm.KVAccess.Size()
+	}
+	var pageCount, pageSize int64
+	if err := dbm.DBAccess.Raw("PRAGMA page_count").QueryRow(&pageCount); err != nil {
+		return 0, err
+	}
+	if err := dbm.DBAccess.Raw("PRAGMA page_size").QueryRow(&pageSize); err != nil {
+		return 0, err
+	}
+	return pageCount * pageSize, nil
+}
+
+func (dbStore) vacuum() error {
+	if dbm.KVAccess != nil {
+		// the KV drivers reuse their free pages, there is nothing to rebuild
+		return nil
+	}
+	_, err := dbm.DBAccess.Raw("VACUUM").Exec()
+	return err
+}
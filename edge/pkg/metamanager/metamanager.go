@@ -10,6 +10,7 @@ import (
 	metamanagerconfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
 	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/gc"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver"
 	metaserverconfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
@@ -65,5 +66,9 @@ func (m *metaManager) Start() {
 		go metaserver.NewMetaServer().Start(beehiveContext.Done())
 	}
 
+	if gcConfig := metamanagerconfig.Config.GC; gcConfig != nil && gcConfig.Enable {
+		go gc.NewCollector(*gcConfig).Run(beehiveContext.Done())
+	}
+
 	m.runMetaManager()
 }
@@ -11,7 +11,6 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 
 	"github.com/kubeedge/beehive/pkg/core/model"
-	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
 )
 
@@ -56,9 +55,8 @@ func newV2Client() Client {
 
 // StorageInit must be called before using imitator storage (run metaserver or metamanager)
 func StorageInit() {
-	m := new(v2.MetaV2)
 	// get the most recent record as the init resource version
-	_, err := dbm.DBAccess.QueryTable(v2.NewMetaTableName).OrderBy("-" + v2.RV).Limit(1).All(m)
+	rv, err := v2.LatestResourceVersion()
 	utilruntime.Must(err)
-	DefaultV2Client.SetRevision(m.ResourceVersion)
+	DefaultV2Client.SetRevision(rv)
 }
@@ -17,7 +17,6 @@ import (
 
 	"github.com/kubeedge/beehive/pkg/core/model"
 	"github.com/kubeedge/kubeedge/common/constants"
-	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
 	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
 	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator/watchhook"
@@ -89,14 +88,14 @@ func (s *imitator) InsertOrUpdateObj(ctx context.Context, obj runtime.Object) er
 func (s *imitator) insertOrReplaceMetaV2(m v2.MetaV2, objRv uint64) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO meta_v2 (key, groupversionresource, namespace,name,resourceversion,value) VALUES (?,?,?,?,?,?)", m.Key, m.GroupVersionResource, m.Namespace, m.Name, m.ResourceVersion, m.Value).Exec()
+	err := v2.InsertOrUpdate(&m)
 	var maxRetryTimes = 3
 	for i := 1; err != nil; i++ {
 		klog.Errorf("failed to access database:%v", err)
 		if i == maxRetryTimes {
 			return fmt.Errorf("failed to access database after %v times try", i)
 		}
-		_, err = dbm.DBAccess.Raw("INSERT OR REPLACE INTO meta_v2 (key, groupversionresource, namespace,name,resourceversion,value) VALUES (?,?,?,?,?,?)", m.Key, m.GroupVersionResource, m.Namespace, m.Name, m.ResourceVersion, m.Value).Exec()
+		err = v2.InsertOrUpdate(&m)
 	}
 	if objRv > s.GetRevision() {
 		s.SetRevision(objRv)
@@ -116,8 +115,7 @@ func (s *imitator) InsertOrUpdatePassThroughObj(ctx context.Context, obj []byte,
 func (s *imitator) GetPassThroughObj(ctx context.Context, key string) ([]byte, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	results := new([]v2.MetaV2)
-	_, err := dbm.DBAccess.QueryTable(v2.NewMetaTableName).Filter(v2.KEY, key).All(results)
+	results, err := v2.QueryMetaByKey(key)
 	if err != nil {
 		return nil, err
 	}
@@ -132,11 +130,8 @@ func (s *imitator) GetPassThroughObj(ctx context.Context, key string) ([]byte, e
 }
 
 func (s *imitator) Delete(ctx context.Context, key string) error {
-	m := v2.MetaV2{
-		Key: key,
-	}
 	s.lock.Lock()
-	_, err := dbm.DBAccess.Delete(&m)
+	err := v2.DeleteMetaByKey(key)
 	if err != nil {
 		klog.Errorf("[imitator] delete error: %v", err)
 	}
@@ -19,9 +19,12 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/klog/v2"
 
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/agent"
+	metaserverconfig "github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/config"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite"
 	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/localwrite"
 	"github.com/kubeedge/kubeedge/pkg/metaserver"
 	"github.com/kubeedge/kubeedge/pkg/metaserver/util"
 )
@@ -30,6 +33,8 @@ import (
 type REST struct {
 	*genericregistry.Store
 	*agent.Agent
+	// localWriter writes the objects locally if they cannot be written through the cloud
+	localWriter *localwrite.Writer
 }
 
 // NewREST returns a RESTStorage object that will work against all resources
@@ -61,7 +66,10 @@ func NewREST() (*REST, error) {
 	store.Storage.Storage = sqlite.New()
 	store.Storage.Codec = unstructured.UnstructuredJSONScheme
 
-	return &REST{store, agent.DefaultAgent}, nil
+	localWriter := localwrite.NewWriter(metaserverconfig.Config.LocalWrite, agent.DefaultAgent)
+	go localWriter.Run(beehiveContext.Done())
+
+	return &REST{store, agent.DefaultAgent, localWriter}, nil
 }
 
 // decorateList set list's gvk if it's gvk is empty
@@ -222,7 +230,7 @@ func (r *REST) Watch(ctx context.Context, options *metainternalversion.ListOptio
 }
 
 func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
-	obj, err := func() (runtime.Object, error) {
+	retObj, err := func() (runtime.Object, error) {
 		app, err := r.Agent.Generate(ctx, metaserver.Create, *options, obj)
 		if err != nil {
 			klog.Errorf("[metaserver/reststorage] failed to generate application: %v", err)
@@ -243,12 +251,15 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation
 	}()
 
 	if err != nil {
+		if r.localWriter.Handles(ctx, err) {
+			return r.localWriter.Create(ctx, obj)
+		}
 		klog.Errorf("[metaserver/reststorage] failed to create (%v)", metaserver.KeyFunc(obj))
 		return nil, err
 	}
 
-	klog.Infof("[metaserver/reststorage] successfully create (%v)", metaserver.KeyFunc(obj))
-	return obj, nil
+	klog.Infof("[metaserver/reststorage] successfully create (%v)", metaserver.KeyFunc(retObj))
+	return retObj, nil
 }
 
 func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
@@ -283,11 +294,11 @@ func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObje
 	}
 	if err != nil {
 		klog.Errorf("[metaserver/reststorage] failed to generate application: %v", err)
-		return nil, false, err
+		return r.updateLocally(ctx, obj, err)
 	}
 	defer app.Close()
 	if err := r.Agent.Apply(app); err != nil {
-		return nil, false, err
+		return r.updateLocally(ctx, obj, err)
 	}
 	retObj := new(unstructured.Unstructured)
 	if err := json.Unmarshal(app.RespBody, retObj); err != nil {
@@ -296,6 +307,15 @@ func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObje
 	return retObj, false, nil
 }
 
+// updateLocally updates obj in the local storage if it failed to be updated through the cloud with err
+func (r *REST) updateLocally(ctx context.Context, obj runtime.Object, err error) (runtime.Object, bool, error) {
+	if !r.localWriter.Handles(ctx, err) {
+		return nil, false, err
+	}
+	retObj, err := r.localWriter.Update(ctx, obj)
+	return retObj, false, err
+}
+
 func (r *REST) Patch(ctx context.Context, pi metaserver.PatchInfo) (runtime.Object, error) {
 	app, err := r.Agent.Generate(ctx, metaserver.Patch, pi, nil)
 	if err != nil {
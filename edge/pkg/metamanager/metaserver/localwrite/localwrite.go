@@ -0,0 +1,250 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localwrite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/watch"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/agent"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/metaserver/kubernetes/storage/sqlite/imitator/watchhook"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/metaserver"
+)
+
+// Writer creates and updates the objects of the configured resources in the local storage
+// while the cloud cannot be reached, and reconciles them with the cloud on reconnect.
+type Writer struct {
+	enable    bool
+	policy    v1alpha2.ConflictPolicy
+	resources map[schema.GroupResource]v1alpha2.ConflictPolicy
+	interval  time.Duration
+	agent     *agent.Agent
+	// lock serializes the local writes and the reconciling of them
+	lock sync.Mutex
+}
+
+// NewWriter returns the Writer of the local write config, the Writer is disabled if config is nil
+func NewWriter(config *v1alpha2.MetaServerLocalWrite, a *agent.Agent) *Writer {
+	w := &Writer{
+		resources: make(map[schema.GroupResource]v1alpha2.ConflictPolicy),
+		agent:     a,
+	}
+	if config == nil || !config.Enable {
+		return w
+	}
+	w.enable = true
+	w.policy = config.ConflictPolicy
+	if w.policy == "" {
+		w.policy = v1alpha2.ConflictPolicyCloudWins
+	}
+	w.interval = time.Duration(config.ReconcileInterval) * time.Second
+	for _, resource := range config.Resources {
+		w.resources[schema.GroupResource{Group: resource.Group, Resource: resource.Resource}] = resource.ConflictPolicy
+	}
+	return w
+}
+
+// Enabled reports whether resources can be written locally
+func (w *Writer) Enabled() bool {
+	return w != nil && w.enable
+}
+
+// Handles reports whether the write request failed with err can be written locally instead,
+// which is when the cloud cannot be reached and the resource can be written locally.
+// Errors returned by the cloud API server, like conflicts or validation errors, are not handled.
+func (w *Writer) Handles(ctx context.Context, err error) bool {
+	if !w.Enabled() || err == nil {
+		return false
+	}
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		return false
+	}
+	info, ok := apirequest.RequestInfoFrom(ctx)
+	if !ok || !info.IsResourceRequest {
+		return false
+	}
+	if info.Subresource != "" && info.Subresource != "status" {
+		return false
+	}
+	_, ok = w.resources[schema.GroupResource{Group: info.APIGroup, Resource: info.Resource}]
+	return ok
+}
+
+// conflictPolicy returns the conflict policy of the resource
+func (w *Writer) conflictPolicy(gr schema.GroupResource) v1alpha2.ConflictPolicy {
+	if policy := w.resources[gr]; policy != "" {
+		return policy
+	}
+	return w.policy
+}
+
+// Create creates obj in the local storage and records it to be created in the cloud
+func (w *Writer) Create(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
+	info, _ := apirequest.RequestInfoFrom(ctx)
+	gr := schema.GroupResource{Group: info.APIGroup, Resource: info.Resource}
+	unstr, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, apierrors.NewBadRequest("obj is not unstructured type")
+	}
+	unstr = unstr.DeepCopy()
+	if unstr.GetNamespace() == "" {
+		unstr.SetNamespace(info.Namespace)
+	}
+	if unstr.GetName() == "" {
+		if unstr.GetGenerateName() == "" {
+			return nil, apierrors.NewBadRequest("name or generateName is required")
+		}
+		unstr.SetName(names.SimpleNameGenerator.GenerateName(unstr.GetGenerateName()))
+	}
+	key, err := metaserver.KeyFuncObj(unstr)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if _, err := getLocalObject(ctx, key); err == nil {
+		return nil, apierrors.NewAlreadyExists(gr, unstr.GetName())
+	}
+	unstr.SetUID(uuid.NewUUID())
+	unstr.SetCreationTimestamp(metav1.Now())
+	unstr.SetResourceVersion(nextResourceVersion())
+
+	pending := newPendingWrite(verbCreate, info, unstr.GetName())
+	pending.Object = unstr
+	if err := savePendingWrite(key, pending); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if err := saveLocalObject(watch.Added, unstr); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	klog.Infof("[metaserver/localwrite] create (%v) locally, it will be created in the cloud on reconnect", key)
+	return unstr, nil
+}
+
+// Update updates obj in the local storage and records it to be updated in the cloud,
+// the local updates of an object are coalesced until they are reconciled.
+func (w *Writer) Update(ctx context.Context, obj runtime.Object) (runtime.Object, error) {
+	info, _ := apirequest.RequestInfoFrom(ctx)
+	gr := schema.GroupResource{Group: info.APIGroup, Resource: info.Resource}
+	unstr, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, apierrors.NewBadRequest("obj is not unstructured type")
+	}
+	key, err := metaserver.KeyFuncReq(ctx, "")
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	current, err := getLocalObject(ctx, key)
+	if err != nil {
+		return nil, apierrors.NewNotFound(gr, info.Name)
+	}
+	if rv := unstr.GetResourceVersion(); rv != "" && rv != current.GetResourceVersion() {
+		return nil, apierrors.NewConflict(gr, info.Name,
+			fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+
+	var updated *unstructured.Unstructured
+	if info.Subresource == "status" {
+		// like the API server, only the status is updated through the status subresource
+		updated = current.DeepCopy()
+		if status, ok := unstr.Object["status"]; ok {
+			updated.Object["status"] = runtime.DeepCopyJSONValue(status)
+		} else {
+			delete(updated.Object, "status")
+		}
+	} else {
+		updated = unstr.DeepCopy()
+		updated.SetUID(current.GetUID())
+		updated.SetCreationTimestamp(current.GetCreationTimestamp())
+	}
+	updated.SetResourceVersion(nextResourceVersion())
+
+	pending, err := getPendingWrite(key)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if pending == nil {
+		pending = newPendingWrite(verbUpdate, info, info.Name)
+		pending.Original = current
+	}
+	pending.Status = pending.Status || info.Subresource == "status"
+	pending.Object = updated
+	if err := savePendingWrite(key, pending); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	if err := saveLocalObject(watch.Modified, updated); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	klog.Infof("[metaserver/localwrite] update (%v) locally, it will be updated in the cloud on reconnect", key)
+	return updated, nil
+}
+
+// nextResourceVersion returns the resource version of a local write, greater than all the stored objects
+// so that the watchers of the local storage receive the event
+func nextResourceVersion() string {
+	return strconv.FormatUint(imitator.DefaultV2Client.GetRevision()+1, 10)
+}
+
+func getLocalObject(ctx context.Context, key string) (*unstructured.Unstructured, error) {
+	resp, err := imitator.DefaultV2Client.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	obj := new(unstructured.Unstructured)
+	if err := json.Unmarshal([]byte((*resp.Kvs)[0].Value), obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// saveLocalObject saves obj to the local storage and serves the watchers of the local storage
+func saveLocalObject(eventType watch.EventType, obj *unstructured.Unstructured) error {
+	var err error
+	if eventType == watch.Deleted {
+		err = imitator.DefaultV2Client.DeleteObj(context.TODO(), obj)
+	} else {
+		err = imitator.DefaultV2Client.InsertOrUpdateObj(context.TODO(), obj)
+	}
+	if err != nil {
+		return err
+	}
+	watchhook.Trigger(watch.Event{Type: eventType, Object: obj})
+	return nil
+}
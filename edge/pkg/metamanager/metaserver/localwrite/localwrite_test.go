@@ -0,0 +1,170 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localwrite
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	connect "github.com/kubeedge/kubeedge/edge/pkg/common/cloudconnection"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+func newTestWriter() *Writer {
+	return NewWriter(&v1alpha2.MetaServerLocalWrite{
+		Enable:            true,
+		ConflictPolicy:    v1alpha2.ConflictPolicyMerge,
+		ReconcileInterval: 10,
+		Resources: []v1alpha2.LocalWriteResource{
+			{Resource: "configmaps"},
+			{Group: "coordination.k8s.io", Resource: "leases", ConflictPolicy: v1alpha2.ConflictPolicyEdgeWins},
+		},
+	}, nil)
+}
+
+func TestWriterHandles(t *testing.T) {
+	configMaps := &apirequest.RequestInfo{IsResourceRequest: true, APIPrefix: "api", APIVersion: "v1", Resource: "configmaps"}
+	tests := []struct {
+		name   string
+		writer *Writer
+		info   *apirequest.RequestInfo
+		err    error
+		want   bool
+	}{
+		{name: "connection lost", writer: newTestWriter(), info: configMaps, err: connect.ErrConnectionLost, want: true},
+		{name: "failed to access cloud", writer: newTestWriter(), info: configMaps, err: errors.New("timeout"), want: true},
+		{
+			name:   "status subresource",
+			writer: newTestWriter(),
+			info: &apirequest.RequestInfo{IsResourceRequest: true, APIPrefix: "apis", APIGroup: "coordination.k8s.io",
+				APIVersion: "v1", Resource: "leases", Subresource: "status"},
+			err:  connect.ErrConnectionLost,
+			want: true,
+		},
+		{
+			name:   "rejected by cloud",
+			writer: newTestWriter(),
+			info:   configMaps,
+			err:    apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "demo", errors.New("conflict")),
+		},
+		{
+			name:   "resource not allowed",
+			writer: newTestWriter(),
+			info:   &apirequest.RequestInfo{IsResourceRequest: true, APIPrefix: "api", APIVersion: "v1", Resource: "secrets"},
+			err:    connect.ErrConnectionLost,
+		},
+		{name: "disabled", writer: NewWriter(nil, nil), info: configMaps, err: connect.ErrConnectionLost},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := apirequest.WithRequestInfo(context.TODO(), tt.info)
+			if got := tt.writer.Handles(ctx, tt.err); got != tt.want {
+				t.Errorf("Handles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	w := newTestWriter()
+	if policy := w.conflictPolicy(schema.GroupResource{Resource: "configmaps"}); policy != v1alpha2.ConflictPolicyMerge {
+		t.Errorf("expected the default conflict policy, but got %s", policy)
+	}
+	if policy := w.conflictPolicy(schema.GroupResource{Group: "coordination.k8s.io", Resource: "leases"}); policy != v1alpha2.ConflictPolicyEdgeWins {
+		t.Errorf("expected the conflict policy of the resource, but got %s", policy)
+	}
+}
+
+func newConfigMap(rv string, data map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "demo",
+			"namespace":       "default",
+			"uid":             "cloud-uid",
+			"resourceVersion": rv,
+		},
+		"data": data,
+	}}
+}
+
+func TestResolveConflict(t *testing.T) {
+	original := newConfigMap("100", map[string]interface{}{"mode": "release", "level": "info", "region": "north"})
+	local := newConfigMap("101", map[string]interface{}{"mode": "debug", "level": "info"})
+	local.SetUID("local-uid")
+	current := newConfigMap("200", map[string]interface{}{"mode": "release", "level": "warn", "region": "north", "zone": "a"})
+
+	tests := []struct {
+		name     string
+		policy   v1alpha2.ConflictPolicy
+		original *unstructured.Unstructured
+		current  *unstructured.Unstructured
+		want     *unstructured.Unstructured
+	}{
+		{
+			name:     "no conflict",
+			policy:   v1alpha2.ConflictPolicyCloudWins,
+			original: original,
+			current:  newConfigMap("100", map[string]interface{}{"mode": "release", "level": "info", "region": "north"}),
+			want:     newConfigMap("100", map[string]interface{}{"mode": "debug", "level": "info"}),
+		},
+		{
+			name:     "cloud wins",
+			policy:   v1alpha2.ConflictPolicyCloudWins,
+			original: original,
+			current:  current,
+			want:     current,
+		},
+		{
+			name:     "edge wins",
+			policy:   v1alpha2.ConflictPolicyEdgeWins,
+			original: original,
+			current:  current,
+			want:     newConfigMap("200", map[string]interface{}{"mode": "debug", "level": "info"}),
+		},
+		{
+			name:     "merge",
+			policy:   v1alpha2.ConflictPolicyMerge,
+			original: original,
+			current:  current,
+			want:     newConfigMap("200", map[string]interface{}{"mode": "debug", "level": "warn", "zone": "a"}),
+		},
+		{
+			name:    "merge created locally",
+			policy:  v1alpha2.ConflictPolicyMerge,
+			current: current,
+			want:    newConfigMap("200", map[string]interface{}{"mode": "debug", "level": "info", "region": "north", "zone": "a"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveConflict(tt.policy, tt.original, local, tt.current)
+			if err != nil {
+				t.Fatalf("resolveConflict() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Object, tt.want.Object) {
+				t.Errorf("resolveConflict() = %v, want %v", got.Object, tt.want.Object)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localwrite
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog/v2"
+
+	connect "github.com/kubeedge/kubeedge/edge/pkg/common/cloudconnection"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/metaserver"
+)
+
+// Run reconciles the local writes with the cloud every ReconcileInterval until stop is closed
+func (w *Writer) Run(stop <-chan struct{}) {
+	if !w.Enabled() {
+		return
+	}
+	klog.Infof("[metaserver/localwrite] start reconciling local writes every %v", w.interval)
+	wait.Until(w.reconcile, w.interval, stop)
+}
+
+func (w *Writer) reconcile() {
+	if !connect.IsConnected() {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	pendings, err := listPendingWrites()
+	if err != nil {
+		klog.Errorf("[metaserver/localwrite] failed to list pending writes: %v", err)
+		return
+	}
+	keys := make([]string, 0, len(pendings))
+	for key := range pendings {
+		keys = append(keys, key)
+	}
+	// reconcile the writes in the order they were made
+	sort.Slice(keys, func(i, j int) bool {
+		return pendings[keys[i]].Timestamp.Before(&pendings[keys[j]].Timestamp)
+	})
+	for _, key := range keys {
+		if err := w.reconcileWrite(pendings[key]); err != nil {
+			klog.Errorf("[metaserver/localwrite] failed to reconcile (%v), retry later: %v", key, err)
+			continue
+		}
+		if err := deletePendingWrite(key); err != nil {
+			klog.Errorf("[metaserver/localwrite] failed to delete pending write of (%v): %v", key, err)
+			continue
+		}
+		klog.Infof("[metaserver/localwrite] successfully reconcile (%v) with the cloud", key)
+	}
+}
+
+// reconcileWrite sends the pending write to the cloud, and saves the object returned by the cloud locally
+func (w *Writer) reconcileWrite(pending *pendingWrite) error {
+	var result *unstructured.Unstructured
+	var err error
+	switch pending.Verb {
+	case verbCreate:
+		result, err = w.create(pending, withServerFields(pending.Object, nil))
+		if apierrors.IsAlreadyExists(err) {
+			result, err = w.resolve(pending)
+		}
+	case verbUpdate:
+		result, err = w.resolve(pending)
+	default:
+		return fmt.Errorf("unknown verb %s of pending write", pending.Verb)
+	}
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		// the object was deleted in the cloud and the local writes are discarded
+		return saveLocalObject(watch.Deleted, pending.Object)
+	}
+	return saveLocalObject(watch.Modified, result)
+}
+
+// resolve updates the object in the cloud with the local writes, if the object was also changed
+// in the cloud since it was last seen, the conflict is resolved by the conflict policy of the resource
+func (w *Writer) resolve(pending *pendingWrite) (*unstructured.Unstructured, error) {
+	policy := w.conflictPolicy(pending.groupResource())
+	current, err := w.send(pending, metaserver.Get, pending.Name, "", metav1.GetOptions{}, nil)
+	if apierrors.IsNotFound(err) {
+		if policy == v1alpha2.ConflictPolicyCloudWins {
+			klog.Warningf("[metaserver/localwrite] %s %s/%s is deleted in the cloud, discard the local writes",
+				pending.Resource, pending.Namespace, pending.Name)
+			return nil, nil
+		}
+		return w.create(pending, withServerFields(pending.Object, nil))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := resolveConflict(policy, pending.Original, pending.Object, current)
+	if err != nil {
+		return nil, err
+	}
+	if desired == current {
+		return current, nil
+	}
+	result, err := w.send(pending, metaserver.Update, pending.Name, "", &metav1.UpdateOptions{}, desired)
+	if err != nil {
+		return nil, err
+	}
+	return w.updateStatus(pending, result, desired)
+}
+
+// resolveConflict returns the object to update in the cloud, or current itself if the cloud wins.
+// There is no conflict if the object in the cloud was not changed since original was seen.
+func resolveConflict(policy v1alpha2.ConflictPolicy, original, local, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if original != nil && original.GetResourceVersion() == current.GetResourceVersion() {
+		return withServerFields(local, current), nil
+	}
+	klog.Warningf("[metaserver/localwrite] %s %s/%s is changed both locally and in the cloud, resolve by policy %s",
+		current.GetKind(), current.GetNamespace(), current.GetName(), policy)
+	switch policy {
+	case v1alpha2.ConflictPolicyEdgeWins:
+		return withServerFields(local, current), nil
+	case v1alpha2.ConflictPolicyMerge:
+		return mergeObject(original, local, current)
+	default:
+		return current, nil
+	}
+}
+
+// mergeObject applies the changes made locally since original to current,
+// the local value is kept if a field was changed both locally and in the cloud.
+// All the fields of local are changes if original is nil.
+func mergeObject(original, local, current *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	originalJSON := []byte("{}")
+	if original != nil {
+		data, err := original.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		originalJSON = data
+	}
+	localJSON, err := withServerFields(local, original).MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	currentJSON, err := current.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreateMergePatch(originalJSON, localJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge patch, err: %v", err)
+	}
+	merged, err := jsonpatch.MergePatch(currentJSON, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply merge patch, err: %v", err)
+	}
+	obj := new(unstructured.Unstructured)
+	if err := obj.UnmarshalJSON(merged); err != nil {
+		return nil, err
+	}
+	obj.SetResourceVersion(current.GetResourceVersion())
+	return obj, nil
+}
+
+// withServerFields returns a copy of obj with the fields set by the API server copied from server,
+// or removed if server is nil, so that the fields set by the local writes are not sent to the cloud
+func withServerFields(obj, server *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	if server == nil {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "uid")
+		unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+		unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+		return obj
+	}
+	obj.SetUID(server.GetUID())
+	obj.SetResourceVersion(server.GetResourceVersion())
+	obj.SetCreationTimestamp(server.GetCreationTimestamp())
+	return obj
+}
+
+func (w *Writer) create(pending *pendingWrite, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	result, err := w.send(pending, metaserver.Create, "", "", metav1.CreateOptions{}, obj)
+	if err != nil {
+		return nil, err
+	}
+	return w.updateStatus(pending, result, obj)
+}
+
+// updateStatus updates the status of the object in the cloud to the status of desired,
+// if the status was updated locally
+func (w *Writer) updateStatus(pending *pendingWrite, result, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	status, ok := desired.Object["status"]
+	if !pending.Status || !ok {
+		return result, nil
+	}
+	obj := result.DeepCopy()
+	obj.Object["status"] = runtime.DeepCopyJSONValue(status)
+	return w.send(pending, metaserver.UpdateStatus, pending.Name, "status", &metav1.UpdateOptions{}, obj)
+}
+
+// send sends the request of verb to the cloud through the metaserver agent
+func (w *Writer) send(pending *pendingWrite, verb metaserver.ApplicationVerb, name, subresource string,
+	option interface{}, obj runtime.Object) (*unstructured.Unstructured, error) {
+	app, err := w.agent.Generate(pending.context(name, subresource), verb, option, obj)
+	if err != nil {
+		return nil, err
+	}
+	defer app.Close()
+	if err := w.agent.Apply(app); err != nil {
+		return nil, err
+	}
+	retObj := new(unstructured.Unstructured)
+	if err := json.Unmarshal(app.RespBody, retObj); err != nil {
+		return nil, err
+	}
+	return retObj, nil
+}
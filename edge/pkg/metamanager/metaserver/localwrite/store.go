@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localwrite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+
+	v2 "github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao/v2"
+)
+
+const (
+	verbCreate = "create"
+	verbUpdate = "update"
+)
+
+// pendingWriteGVR is the resource the pending writes are recorded as in table meta_v2,
+// it is never served by the metaserver
+var pendingWriteGVR = schema.GroupVersionResource{Group: "edge.kubeedge.io", Version: "v1alpha1", Resource: "localwrites"}
+
+// pendingWrite is an object written locally while offline, which is not reconciled with the cloud yet
+type pendingWrite struct {
+	// Verb is create if the object was created locally, otherwise update
+	Verb string `json:"verb"`
+	// the request info to send the write to the cloud
+	APIPrefix  string `json:"apiPrefix"`
+	APIGroup   string `json:"apiGroup,omitempty"`
+	APIVersion string `json:"apiVersion"`
+	Resource   string `json:"resource"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	// Status is true if the status of the object was updated locally
+	Status bool `json:"status,omitempty"`
+	// Original is the object last seen from the cloud before the local writes,
+	// it is nil if the object was created locally
+	Original *unstructured.Unstructured `json:"original,omitempty"`
+	// Object is the object in the local storage
+	Object    *unstructured.Unstructured `json:"object"`
+	Timestamp metav1.Time                `json:"timestamp"`
+}
+
+func newPendingWrite(verb string, info *apirequest.RequestInfo, name string) *pendingWrite {
+	return &pendingWrite{
+		Verb:       verb,
+		APIPrefix:  info.APIPrefix,
+		APIGroup:   info.APIGroup,
+		APIVersion: info.APIVersion,
+		Resource:   info.Resource,
+		Namespace:  info.Namespace,
+		Name:       name,
+		Timestamp:  metav1.Now(),
+	}
+}
+
+// context returns the context of the request sending the write to the cloud
+func (p *pendingWrite) context(name, subresource string) context.Context {
+	info := &apirequest.RequestInfo{
+		IsResourceRequest: true,
+		APIPrefix:         p.APIPrefix,
+		APIGroup:          p.APIGroup,
+		APIVersion:        p.APIVersion,
+		Namespace:         p.Namespace,
+		Resource:          p.Resource,
+		Subresource:       subresource,
+		Name:              name,
+	}
+	ctx := apirequest.WithRequestInfo(context.Background(), info)
+	return apirequest.WithNamespace(ctx, p.Namespace)
+}
+
+func (p *pendingWrite) groupResource() schema.GroupResource {
+	return schema.GroupResource{Group: p.APIGroup, Resource: p.Resource}
+}
+
+// pendingWriteKey returns the key of the pending write of the object with objKey
+func pendingWriteKey(objKey string) string {
+	return fmt.Sprintf("/%s/%s/%s/%s/%s", pendingWriteGVR.Group, pendingWriteGVR.Version,
+		pendingWriteGVR.Resource, v2.NullNamespace, url.PathEscape(objKey))
+}
+
+// getPendingWrite returns the pending write of the object with objKey, nil if there is none
+func getPendingWrite(objKey string) (*pendingWrite, error) {
+	results, err := v2.QueryMetaByKey(pendingWriteKey(objKey))
+	if err != nil {
+		return nil, err
+	}
+	if len(*results) == 0 {
+		return nil, nil
+	}
+	pending := new(pendingWrite)
+	if err := json.Unmarshal([]byte((*results)[0].Value), pending); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pending write of %s, err: %v", objKey, err)
+	}
+	return pending, nil
+}
+
+func savePendingWrite(objKey string, pending *pendingWrite) error {
+	value, err := json.Marshal(pending)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending write of %s, err: %v", objKey, err)
+	}
+	return v2.InsertOrUpdate(&v2.MetaV2{
+		Key:                  pendingWriteKey(objKey),
+		GroupVersionResource: pendingWriteGVR.String(),
+		Namespace:            v2.NullNamespace,
+		Name:                 objKey,
+		Value:                string(value),
+	})
+}
+
+func deletePendingWrite(objKey string) error {
+	return v2.DeleteMetaByKey(pendingWriteKey(objKey))
+}
+
+// listPendingWrites returns the pending writes by the keys of the objects
+func listPendingWrites() (map[string]*pendingWrite, error) {
+	results, err := v2.RawMetaByGVRNN(pendingWriteGVR, v2.NullNamespace, v2.NullName)
+	if err != nil {
+		return nil, err
+	}
+	pendings := make(map[string]*pendingWrite, len(*results))
+	for _, result := range *results {
+		pending := new(pendingWrite)
+		if err := json.Unmarshal([]byte(result.Value), pending); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal pending write of %s, err: %v", result.Name, err)
+		}
+		pendings[result.Name] = pending
+	}
+	return pendings, nil
+}
@@ -16,6 +16,9 @@ limitations under the License.
 package dao
 
 import (
+	"errors"
+
+	"github.com/beego/beego/orm"
 	"k8s.io/klog/v2"
 
 	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
@@ -25,25 +28,63 @@ const (
 	TargetUrlsName = "target_urls"
 )
 
+// errStopIteration ends a ForEach early
+var errStopIteration = errors.New("stop iteration")
+
 type TargetUrls struct {
 	URL string `orm:"column(url);type(text);pk"`
 }
 
+// urlsStore persists the target urls, it is implemented for the sqlite database and for the KV drivers
+type urlsStore interface {
+	Insert(url string) error
+	Delete(url string) error
+	IsEmpty() bool
+	Get(url string) (*TargetUrls, error)
+}
+
+// getUrlsStore returns the store of the configured database driver
+func getUrlsStore() urlsStore {
+	if dbm.KVAccess != nil {
+		return kvUrlsStore{kv: dbm.KVAccess}
+	}
+	return sqliteUrlsStore{}
+}
+
 // InsertUrls insert target_urls
 func InsertUrls(url string) error {
+	return getUrlsStore().Insert(url)
+}
+
+// DeleteUrlsByKey delete target_urls by key
+func DeleteUrlsByKey(key string) error {
+	return getUrlsStore().Delete(key)
+}
+
+func IsTableEmpty() bool {
+	return getUrlsStore().IsEmpty()
+}
+
+func GetUrlsByKey(key string) (result *TargetUrls, err error) {
+	return getUrlsStore().Get(key)
+}
+
+// sqliteUrlsStore is the urlsStore of the sqlite database
+type sqliteUrlsStore struct{}
+
+func (sqliteUrlsStore) Insert(url string) error {
 	_, err := dbm.DBAccess.Raw("INSERT OR REPLACE INTO target_urls (url) VALUES (?)", url).Exec()
 	klog.V(4).Infof("INSERT result %v", err)
 	return err
 }
 
-// DeleteUrlsByKey delete target_urls by key
-func DeleteUrlsByKey(key string) error {
+func (sqliteUrlsStore) Delete(key string) error {
 	num, err := dbm.DBAccess.QueryTable(TargetUrlsName).Filter("url", key).Delete()
 	klog.V(4).Infof("Delete affected Num: %d, %v", num, err)
 	return err
 }
 
-func IsTableEmpty() bool {
+func (sqliteUrlsStore) IsEmpty() bool {
 	var count int64
 	if count, _ = dbm.DBAccess.QueryTable(TargetUrlsName).Count(); count > 0 {
 		return false
@@ -51,10 +92,53 @@ func IsTableEmpty() bool {
 	return true
 }
 
-func GetUrlsByKey(key string) (result *TargetUrls, err error) {
+func (sqliteUrlsStore) Get(key string) (*TargetUrls, error) {
 	targetUrls := new(TargetUrls)
 	if err := dbm.DBAccess.QueryTable(TargetUrlsName).Filter("url", key).One(targetUrls); err != nil {
 		return nil, err
 	}
 	return targetUrls, nil
 }
+
+// kvUrlsStore is the urlsStore of the KV drivers, the urls are the keys of the target_urls bucket
+type kvUrlsStore struct {
+	kv dbm.KV
+}
+
+func (s kvUrlsStore) Insert(url string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return dbm.PutJSON(tx, TargetUrlsName, url, &TargetUrls{URL: url})
+	})
+}
+
+func (s kvUrlsStore) Delete(key string) error {
+	return s.kv.Update(func(tx dbm.Tx) error {
+		return tx.Delete(TargetUrlsName, key)
+	})
+}
+
+func (s kvUrlsStore) IsEmpty() bool {
+	empty := true
+	_ = s.kv.View(func(tx dbm.Tx) error {
+		return tx.ForEach(TargetUrlsName, "", func(string, []byte) error {
+			empty = false
+			return errStopIteration
+		})
+	})
+	return empty
+}
+
+func (s kvUrlsStore) Get(key string) (*TargetUrls, error) {
+	targetUrls := new(TargetUrls)
+	err := s.kv.View(func(tx dbm.Tx) error {
+		ok, err := dbm.GetJSON(tx, TargetUrlsName, key, targetUrls)
+		if err == nil && !ok {
+			return orm.ErrNoRows
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return targetUrls, nil
+}
@@ -227,3 +227,28 @@ func TestGetUrlsByKey(t *testing.T) {
 		})
 	}
 }
+
+// TestKVUrlsStore tests the target urls with a KV driver
+func TestKVUrlsStore(t *testing.T) {
+	dbm.KVAccess = dbm.NewMemoryKV()
+	defer func() { dbm.KVAccess = nil }()
+
+	if !IsTableEmpty() {
+		t.Errorf("IsTableEmpty() of a new store = false, want true")
+	}
+	if err := InsertUrls("http://127.0.0.1:9090"); err != nil {
+		t.Fatalf("InsertUrls() failed: %v", err)
+	}
+	if IsTableEmpty() {
+		t.Errorf("IsTableEmpty() after InsertUrls() = true, want false")
+	}
+	if urls, err := GetUrlsByKey("http://127.0.0.1:9090"); err != nil || urls.URL != "http://127.0.0.1:9090" {
+		t.Errorf("GetUrlsByKey() = %v, %v", urls, err)
+	}
+	if err := DeleteUrlsByKey("http://127.0.0.1:9090"); err != nil {
+		t.Fatalf("DeleteUrlsByKey() failed: %v", err)
+	}
+	if _, err := GetUrlsByKey("http://127.0.0.1:9090"); err != orm.ErrNoRows {
+		t.Errorf("GetUrlsByKey() of a deleted url returned %v, want %v", err, orm.ErrNoRows)
+	}
+}
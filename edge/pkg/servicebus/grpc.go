@@ -0,0 +1,202 @@
+package servicebus
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	beehiveModel "github.com/kubeedge/beehive/pkg/core/model"
+	commonType "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+	servicebusConfig "github.com/kubeedge/kubeedge/edge/pkg/servicebus/config"
+)
+
+const (
+	// grpcChunkSize is the max size of the response body carried by a frame
+	grpcChunkSize = 32 * 1024
+	// grpcFrameBuffer is the number of the request frames buffered for a call, the call fails
+	// if the service falls behind by more frames
+	grpcFrameBuffer = 64
+	// grpcOverflowError is the error of the call whose service does not read the request in time
+	grpcOverflowError = "request frames of the call overflow, the service is too slow"
+)
+
+// grpcStreams are the gRPC calls being proxied to the edge services, by stream ID
+var grpcStreams sync.Map
+
+// h2cClient calls the gRPC services over HTTP/2 without TLS
+var h2cClient = &http.Client{
+	Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	},
+}
+
+// grpcStream is a gRPC call proxied from cloud to an edge service
+type grpcStream struct {
+	id     string
+	frames chan *commonType.GRPCFrame
+	ctx    context.Context
+	cancel context.CancelFunc
+	// overflowOnce fails the call once when its frames overflow
+	overflowOnce sync.Once
+}
+
+// processGRPCMessage dispatches a frame of gRPC call to its stream, the stream is started by the first frame.
+// It must be called in the order the messages are received, so that the request body is kept in order.
+func processGRPCMessage(msg *beehiveModel.Message) {
+	content, err := msg.GetContentData()
+	if err != nil {
+		klog.Errorf("marshall message content failed %v", err)
+		return
+	}
+	frame := new(commonType.GRPCFrame)
+	if err := json.Unmarshal(content, frame); err != nil {
+		klog.Errorf("error to parse gRPC frame, %v", err)
+		return
+	}
+	if v, ok := grpcStreams.Load(frame.StreamID); ok {
+		v.(*grpcStream).deliver(frame)
+		return
+	}
+	if frame.Header == nil {
+		// the call is finished already
+		klog.V(4).Infof("ignore the frame of gRPC call %s", frame.StreamID)
+		return
+	}
+
+	timeout := frame.Timeout
+	if timeout <= 0 {
+		timeout = time.Duration(servicebusConfig.Config.Timeout) * time.Second
+	}
+	s := newGRPCStream(frame.StreamID, timeout)
+	resource := msg.GetResource()
+	r := strings.Split(resource, ":")
+	if len(r) != 2 {
+		m := "the format of resource " + resource + " is incorrect"
+		klog.Warningf(m)
+		s.send(&commonType.GRPCFrame{Error: m})
+		s.cancel()
+		return
+	}
+	grpcStreams.Store(s.id, s)
+	go s.run("http://127.0.0.1:"+r[0]+r[1], frame.Header)
+}
+
+func newGRPCStream(id string, timeout time.Duration) *grpcStream {
+	ctx, cancel := context.WithTimeout(beehiveContext.GetContext(), timeout)
+	return &grpcStream{
+		id:     id,
+		frames: make(chan *commonType.GRPCFrame, grpcFrameBuffer),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// deliver hands the frame over to the stream, it is called by the receive loop of servicebus,
+// so that it must not block on the service
+func (s *grpcStream) deliver(frame *commonType.GRPCFrame) {
+	select {
+	case s.frames <- frame:
+	case <-s.ctx.Done():
+	default:
+		s.overflowOnce.Do(func() {
+			klog.Errorf("gRPC call %s failed: %s", s.id, grpcOverflowError)
+			s.send(&commonType.GRPCFrame{Error: grpcOverflowError})
+			s.cancel()
+		})
+	}
+}
+
+// run calls the service with the request body received from cloud, and sends the response back to cloud
+func (s *grpcStream) run(targetURL string, header http.Header) {
+	defer grpcStreams.Delete(s.id)
+	defer s.cancel()
+
+	body, pw := io.Pipe()
+	go s.writeBody(pw)
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, targetURL, body)
+	if err != nil {
+		s.send(&commonType.GRPCFrame{Error: fmt.Sprintf("error to build request, %v", err)})
+		return
+	}
+	req.Header = header
+	resp, err := h2cClient.Do(req)
+	if err != nil {
+		klog.Errorf("error to call gRPC service %s, %v", targetURL, err)
+		s.send(&commonType.GRPCFrame{Error: fmt.Sprintf("error to call service, %v", err)})
+		return
+	}
+	defer resp.Body.Close()
+	s.send(&commonType.GRPCFrame{Header: resp.Header, StatusCode: resp.StatusCode})
+
+	buf := make([]byte, grpcChunkSize)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			s.send(&commonType.GRPCFrame{Data: data})
+		}
+		if err == io.EOF {
+			// the trailers are read after the body
+			s.send(&commonType.GRPCFrame{Trailer: resp.Trailer, End: true})
+			return
+		}
+		if err != nil {
+			if s.ctx.Err() == nil {
+				s.send(&commonType.GRPCFrame{Error: fmt.Sprintf("error to receive response, %v", err)})
+			}
+			return
+		}
+	}
+}
+
+// writeBody writes the request body received from cloud, it cancels the call if the caller in cloud cancels it
+func (s *grpcStream) writeBody(pw *io.PipeWriter) {
+	for {
+		select {
+		case <-s.ctx.Done():
+			pw.CloseWithError(s.ctx.Err())
+			return
+		case frame := <-s.frames:
+			if frame.Error != "" {
+				klog.V(4).Infof("gRPC call %s is canceled: %s", s.id, frame.Error)
+				pw.CloseWithError(errors.New(frame.Error))
+				s.cancel()
+				return
+			}
+			if len(frame.Data) != 0 {
+				if _, err := pw.Write(frame.Data); err != nil {
+					klog.V(4).Infof("failed to write the request body of gRPC call %s: %v", s.id, err)
+				}
+			}
+			if frame.End {
+				pw.Close()
+			}
+		}
+	}
+}
+
+// send sends a frame of the response to cloud
+func (s *grpcStream) send(frame *commonType.GRPCFrame) {
+	frame.StreamID = s.id
+	msg := beehiveModel.NewMessage(s.id).SetRoute(modules.ServiceBusModuleName, modules.UserGroup).
+		SetResourceOperation("", beehiveModel.UploadOperation).FillBody(frame)
+	beehiveContext.SendToGroup(modules.HubGroup, *msg)
+}
@@ -0,0 +1,49 @@
+package servicebus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/beehive/pkg/common"
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+	commonType "github.com/kubeedge/kubeedge/common/types"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/modules"
+)
+
+func TestGRPCStreamDeliverOverflow(t *testing.T) {
+	beehiveContext.InitContext([]string{common.MsgCtxTypeChannel})
+	beehiveContext.AddModule(&common.ModuleInfo{ModuleName: modules.EdgeHubModuleName, ModuleType: common.MsgCtxTypeChannel})
+	beehiveContext.AddModuleGroup(modules.EdgeHubModuleName, modules.HubGroup)
+
+	s := newGRPCStream("stream", time.Minute)
+	delivered := make(chan struct{})
+	go func() {
+		// the service does not read the request, the frames beyond the buffer must not block
+		for i := 0; i < 2*grpcFrameBuffer; i++ {
+			s.deliver(&commonType.GRPCFrame{StreamID: s.id, Data: []byte("data")})
+		}
+		close(delivered)
+	}()
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the frames to be delivered without blocking")
+	}
+	if s.ctx.Err() == nil {
+		t.Errorf("expected the call to be canceled once its frames overflow")
+	}
+
+	msg, err := beehiveContext.Receive(modules.EdgeHubModuleName)
+	if err != nil {
+		t.Fatalf("failed to receive the frame sent to cloud: %v", err)
+	}
+	content, err := msg.GetContentData()
+	if err != nil {
+		t.Fatalf("failed to get the content of the frame: %v", err)
+	}
+	frame := new(commonType.GRPCFrame)
+	if err := json.Unmarshal(content, frame); err != nil || frame.StreamID != s.id || frame.Error != grpcOverflowError {
+		t.Errorf("expected the call to be failed with overflow, got %+v: %v", frame, err)
+	}
+}
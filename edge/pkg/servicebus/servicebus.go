@@ -113,6 +113,11 @@ func (sb *servicebus) Start() {
 
 		// build new message with required field & send message to servicebus
 		klog.V(4).Info("servicebus receive msg")
+		if msg.GetSource() == sourceType && msg.GetOperation() == commonType.OperationGRPC {
+			// the frames of a gRPC call are dispatched in order
+			processGRPCMessage(&msg)
+			continue
+		}
 		go processMessage(&msg)
 	}
 }
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core"
+	metaclient "github.com/kubeedge/kubeedge/edge/pkg/metamanager/client"
+)
+
+const (
+	conditionCheckPeriod = 10 * time.Second
+	// conditionResyncPeriod is the period the unchanged conditions are reported again,
+	// so that they are refreshed in the cloud after the node was disconnected
+	conditionResyncPeriod = 5 * time.Minute
+)
+
+// ReportNodeConditions reports the health of each module as a node condition until stopCh
+// is closed, e.g. the condition EdgedModuleHealthy is True if edged is running. The node
+// status is patched through metamanager when the conditions change.
+func ReportNodeConditions(nodeName string, stopCh <-chan struct{}) {
+	r := &conditionReporter{
+		statuses: core.GetModuleStatuses,
+		patch: func(data []byte) error {
+			_, err := metaclient.New().Nodes(metav1.NamespaceDefault).Patch(nodeName, data)
+			return err
+		},
+	}
+	wait.Until(r.report, conditionCheckPeriod, stopCh)
+}
+
+type conditionReporter struct {
+	statuses func() []core.ModuleStatus
+	patch    func(data []byte) error
+
+	// reported is the conditions reported last time
+	reported     []v1.NodeCondition
+	reportedTime time.Time
+}
+
+func (r *conditionReporter) report() {
+	conditions := moduleConditions(r.statuses())
+	if len(conditions) == 0 {
+		return
+	}
+	if equalConditions(conditions, r.reported) && time.Since(r.reportedTime) < conditionResyncPeriod {
+		return
+	}
+
+	now := metav1.Now()
+	for i := range conditions {
+		conditions[i].LastHeartbeatTime = now
+	}
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": conditions,
+		},
+	})
+	if err != nil {
+		klog.Errorf("failed to marshal module conditions, err: %v", err)
+		return
+	}
+	if err := r.patch(data); err != nil {
+		klog.Warningf("failed to report module conditions, err: %v", err)
+		return
+	}
+	r.reported, r.reportedTime = conditions, time.Now()
+}
+
+// conditionType returns the node condition type of the module, e.g. EdgedModuleHealthy
+func conditionType(module string) v1.NodeConditionType {
+	if module == "" {
+		return ""
+	}
+	return v1.NodeConditionType(strings.ToUpper(module[:1]) + module[1:] + "ModuleHealthy")
+}
+
+func moduleConditions(statuses []core.ModuleStatus) []v1.NodeCondition {
+	conditions := make([]v1.NodeCondition, 0, len(statuses))
+	for _, s := range statuses {
+		condition := v1.NodeCondition{
+			Type:               conditionType(s.Name),
+			Status:             v1.ConditionTrue,
+			Reason:             "Module" + string(s.State),
+			Message:            fmt.Sprintf("module %s is %s", s.Name, strings.ToLower(string(s.State))),
+			LastTransitionTime: metav1.NewTime(s.LastTransitionTime),
+		}
+		if !s.Healthy() {
+			condition.Status = v1.ConditionFalse
+		}
+		if s.Restarts > 0 {
+			condition.Message += fmt.Sprintf(", restarted %d times", s.Restarts)
+		}
+		if s.Reason != "" {
+			condition.Message += ", last failure: " + s.Reason
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// equalConditions returns whether the conditions are equal regardless of the heartbeat time
+func equalConditions(a, b []v1.NodeCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type || a[i].Status != b[i].Status || a[i].Reason != b[i].Reason ||
+			a[i].Message != b[i].Message || !a[i].LastTransitionTime.Equal(&b[i].LastTransitionTime) {
+			return false
+		}
+	}
+	return true
+}
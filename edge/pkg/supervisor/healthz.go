@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/beehive/pkg/core"
+)
+
+const healthzPath = "/healthz"
+
+// healthzResponse is the response of the healthz endpoint
+type healthzResponse struct {
+	Healthy bool                `json:"healthy"`
+	Modules []core.ModuleStatus `json:"modules"`
+}
+
+// ServeHealthz serves the health of the modules on addr until stopCh is closed.
+// GET /healthz returns the statuses of all modules, and GET /healthz/{module} returns
+// the status of the module. The status code is 503 if any returned module is unhealthy.
+func ServeHealthz(addr string, stopCh <-chan struct{}) {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           newHealthzHandler(core.GetModuleStatuses),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-stopCh
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			klog.Errorf("failed to shutdown healthz server, err: %v", err)
+		}
+	}()
+
+	klog.Infof("serving module healthz on %s", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		klog.Errorf("failed to serve module healthz on %s, err: %v", addr, err)
+	}
+}
+
+func newHealthzHandler(statuses func() []core.ModuleStatus) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthzPath, func(w http.ResponseWriter, r *http.Request) {
+		writeHealthz(w, statuses())
+	})
+	mux.HandleFunc(healthzPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, healthzPath+"/")
+		for _, status := range statuses() {
+			if status.Name == name {
+				writeHealthz(w, []core.ModuleStatus{status})
+				return
+			}
+		}
+		http.Error(w, "module "+name+" not found", http.StatusNotFound)
+	})
+	return mux
+}
+
+func writeHealthz(w http.ResponseWriter, statuses []core.ModuleStatus) {
+	resp := healthzResponse{Healthy: true, Modules: statuses}
+	for _, status := range statuses {
+		if !status.Healthy() {
+			resp.Healthy = false
+		}
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := w.Write(data); err != nil {
+		klog.Errorf("failed to write healthz response, err: %v", err)
+	}
+}
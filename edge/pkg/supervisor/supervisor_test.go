@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supervisor
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeedge/beehive/pkg/core"
+)
+
+func testStatuses() []core.ModuleStatus {
+	return []core.ModuleStatus{
+		{Name: "edged", State: core.ModuleRunning, LastTransitionTime: time.Unix(100, 0)},
+		{Name: "eventbus", State: core.ModuleStuck, Restarts: 2, Reason: "stuck, 3 messages pending", LastTransitionTime: time.Unix(200, 0)},
+	}
+}
+
+func TestHealthzHandler(t *testing.T) {
+	handler := newHealthzHandler(testStatuses)
+	cases := []struct {
+		path        string
+		wantCode    int
+		wantHealthy bool
+		wantModules int
+	}{
+		{path: "/healthz", wantCode: http.StatusServiceUnavailable, wantHealthy: false, wantModules: 2},
+		{path: "/healthz/edged", wantCode: http.StatusOK, wantHealthy: true, wantModules: 1},
+		{path: "/healthz/eventbus", wantCode: http.StatusServiceUnavailable, wantHealthy: false, wantModules: 1},
+		{path: "/healthz/unknown", wantCode: http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+		if rec.Code != tc.wantCode {
+			t.Errorf("%s: expected status code %d, but got %d", tc.path, tc.wantCode, rec.Code)
+			continue
+		}
+		if tc.wantCode == http.StatusNotFound {
+			continue
+		}
+		var resp healthzResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s: failed to unmarshal response: %v", tc.path, err)
+		}
+		if resp.Healthy != tc.wantHealthy || len(resp.Modules) != tc.wantModules {
+			t.Errorf("%s: expected healthy %v with %d modules, but got %+v", tc.path, tc.wantHealthy, tc.wantModules, resp)
+		}
+	}
+}
+
+func TestConditionReporter(t *testing.T) {
+	var patches []map[string]map[string][]v1.NodeCondition
+	patchErr := errors.New("disconnected")
+	r := &conditionReporter{
+		statuses: testStatuses,
+		patch: func(data []byte) error {
+			if patchErr != nil {
+				return patchErr
+			}
+			var patch map[string]map[string][]v1.NodeCondition
+			if err := json.Unmarshal(data, &patch); err != nil {
+				t.Fatalf("failed to unmarshal patch: %v", err)
+			}
+			patches = append(patches, patch)
+			return nil
+		},
+	}
+
+	// the conditions are reported again after a failure
+	r.report()
+	patchErr = nil
+	r.report()
+	// the unchanged conditions are not reported
+	r.report()
+	if len(patches) != 1 {
+		t.Fatalf("expected the conditions to be reported once, but got %d patches", len(patches))
+	}
+
+	conditions := patches[0]["status"]["conditions"]
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 conditions, but got %+v", conditions)
+	}
+	edged, eventbus := conditions[0], conditions[1]
+	if edged.Type != "EdgedModuleHealthy" || edged.Status != v1.ConditionTrue || edged.Reason != "ModuleRunning" {
+		t.Errorf("unexpected condition of edged: %+v", edged)
+	}
+	if eventbus.Type != "EventbusModuleHealthy" || eventbus.Status != v1.ConditionFalse || eventbus.Reason != "ModuleStuck" ||
+		eventbus.Message != "module eventbus is stuck, restarted 2 times, last failure: stuck, 3 messages pending" {
+		t.Errorf("unexpected condition of eventbus: %+v", eventbus)
+	}
+	if !eventbus.LastTransitionTime.Time.Equal(time.Unix(200, 0)) || eventbus.LastHeartbeatTime.IsZero() {
+		t.Errorf("unexpected times of the condition of eventbus: %+v", eventbus)
+	}
+}
@@ -166,12 +166,14 @@ function create_rule_crd {
   echo "creating the rule crd..."
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/router/router_v1_rule.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/router/router_v1_ruleEndpoint.yaml
+  kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/router/router_v1_ruleDeadLetter.yaml
 }
 
 function create_operation_crd {
   echo "creating the operation crd..."
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha1_nodeupgradejob.yaml
   kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha1_imageprepulljob.yaml
+  kubectl apply -f ${KUBEEDGE_ROOT}/build/crds/operations/operations_v1alpha1_deviceupgradejob.yaml
 }
 
 function create_serviceaccountaccess_crd {
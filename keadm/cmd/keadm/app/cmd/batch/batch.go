@@ -0,0 +1,182 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+const (
+	flagNameInventory     = "inventory"
+	flagNameParallelism   = "parallelism"
+	flagNameRetries       = "retries"
+	flagNameRetryInterval = "retry-interval"
+	flagNameTimeout       = "timeout"
+	flagNameToVersion     = "to-version"
+)
+
+var batchExample = `
+keadm batch join --inventory=inventory.yaml --parallelism=20
+
+  - inventory.yaml lists the edge nodes, for example:
+
+apiVersion: keadm.kubeedge.io/v1alpha1
+kind: Inventory
+defaults:
+  ssh:
+    user: root
+    privateKeyFile: /root/.ssh/id_ed25519
+    insecureIgnoreHostKey: true
+  join:
+    cloudCoreIPPort: 10.20.30.40:10000
+    token: <token>
+hosts:
+- name: edge-01
+  address: 10.20.30.51
+- name: edge-02
+  address: 10.20.30.52:2222
+  join:
+    labels:
+      zone: b
+
+keadm batch upgrade --inventory=inventory.yaml --to-version=v` + common.DefaultKubeEdgeVersion + `
+`
+
+// options are the flags shared by the keadm batch commands.
+type options struct {
+	Inventory string
+	RunOptions
+}
+
+// NewBatch returns the "keadm batch" command
+func NewBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "batch",
+		Short:   "Join, reset or upgrade many edge nodes over SSH",
+		Long:    "Use this command to run keadm join, reset or upgrade on the edge nodes listed in an inventory file over SSH, in parallel",
+		Example: batchExample,
+	}
+	cmd.AddCommand(newCmdBatchJoin())
+	cmd.AddCommand(newCmdBatchReset())
+	cmd.AddCommand(newCmdBatchUpgrade())
+	return cmd
+}
+
+func newCmdBatchJoin() *cobra.Command {
+	opts := newOptions()
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "Join the edge nodes of the inventory",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd.Context(), func(ctx context.Context, h *Host) error {
+				config, err := h.JoinConfiguration()
+				if err != nil {
+					return err
+				}
+				// The configuration holds the token, so it is removed as soon as keadm exits
+				script := fmt.Sprintf(`f=$(mktemp) && cat > "$f" && %s; rc=$?; rm -f "$f"; exit $rc`,
+					h.keadmCommand(`join --config "$f"`))
+				return runRemote(ctx, h, script, config)
+			})
+		},
+		Args: cobra.NoArgs,
+	}
+	addFlags(cmd, opts)
+	return cmd
+}
+
+func newCmdBatchReset() *cobra.Command {
+	opts := newOptions()
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Reset the edge nodes of the inventory",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd.Context(), func(ctx context.Context, h *Host) error {
+				return runRemote(ctx, h, h.keadmCommand("reset --force"), nil)
+			})
+		},
+		Args: cobra.NoArgs,
+	}
+	addFlags(cmd, opts)
+	return cmd
+}
+
+func newCmdBatchUpgrade() *cobra.Command {
+	opts := newOptions()
+	toVersion := "v" + common.DefaultKubeEdgeVersion
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the edge nodes of the inventory",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd.Context(), func(ctx context.Context, h *Host) error {
+				return runRemote(ctx, h, h.keadmCommand("upgrade edge --toVersion "+toVersion), nil)
+			})
+		},
+		Args: cobra.NoArgs,
+	}
+	addFlags(cmd, opts)
+	cmd.Flags().StringVar(&toVersion, flagNameToVersion, toVersion,
+		"The KubeEdge version the edge nodes are upgraded to")
+	return cmd
+}
+
+func newOptions() *options {
+	return &options{
+		RunOptions: RunOptions{
+			Parallelism:   10,
+			Retries:       2,
+			RetryInterval: 10 * time.Second,
+			Timeout:       30 * time.Minute,
+		},
+	}
+}
+
+func addFlags(cmd *cobra.Command, opts *options) {
+	cmd.Flags().StringVar(&opts.Inventory, flagNameInventory, opts.Inventory,
+		"Path of the inventory file listing the edge nodes")
+	if err := cmd.MarkFlagRequired(flagNameInventory); err != nil {
+		fmt.Printf("mark flag required failed with error: %v\n", err)
+	}
+	cmd.Flags().IntVar(&opts.Parallelism, flagNameParallelism, opts.Parallelism,
+		"The maximum number of edge nodes operated on at the same time")
+	cmd.Flags().IntVar(&opts.Retries, flagNameRetries, opts.Retries,
+		"The number of times a failed edge node is retried")
+	cmd.Flags().DurationVar(&opts.RetryInterval, flagNameRetryInterval, opts.RetryInterval,
+		"The time waited before retrying a failed edge node")
+	cmd.Flags().DurationVar(&opts.Timeout, flagNameTimeout, opts.Timeout,
+		"The time limit of a single attempt on an edge node, 0 disables it")
+}
+
+// run runs action on the hosts of the inventory and prints a summary of the results.
+func (opts *options) run(ctx context.Context, action hostAction) error {
+	inv, err := LoadInventory(opts.Inventory)
+	if err != nil {
+		return err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	results := runAll(ctx, inv.Hosts, opts.RunOptions, action)
+	return printSummary(os.Stdout, results)
+}
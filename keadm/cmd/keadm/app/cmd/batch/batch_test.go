@@ -0,0 +1,186 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+func writeInventory(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "inventory.yaml")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadInventory(t *testing.T) {
+	path := writeInventory(t, `apiVersion: keadm.kubeedge.io/v1alpha1
+kind: Inventory
+defaults:
+  ssh:
+    user: root
+    privateKeyFile: /root/.ssh/id_ed25519
+    insecureIgnoreHostKey: true
+  join:
+    cloudCoreIPPort: 10.20.30.40:10000
+    labels:
+      zone: a
+hosts:
+- name: edge-01
+  address: 10.20.30.51
+- name: edge-02
+  address: 10.20.30.52
+  ssh:
+    user: admin
+    port: 2222
+    sudo: true
+  join:
+    labels:
+      arch: arm64
+`)
+	inv, err := LoadInventory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inv.Hosts) != 2 {
+		t.Fatalf("expected 2 hosts, got %d", len(inv.Hosts))
+	}
+
+	h := inv.Hosts[1]
+	if h.SSH.User != "admin" || h.SSH.Port != 2222 || h.SSH.PrivateKeyFile != "/root/.ssh/id_ed25519" {
+		t.Errorf("unexpected ssh settings %+v", h.SSH)
+	}
+	if got := h.keadmCommand("reset --force"); got != "sudo -n keadm reset --force" {
+		t.Errorf("unexpected command %s", got)
+	}
+	if inv.Hosts[0].SSH.Port != defaultSSHPort {
+		t.Errorf("expected the default port, got %d", inv.Hosts[0].SSH.Port)
+	}
+
+	data, err := h.JoinConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := &common.JoinConfiguration{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.EdgeNodeName != "edge-02" || cfg.CloudCoreIPPort != "10.20.30.40:10000" ||
+		cfg.Labels["zone"] != "a" || cfg.Labels["arch"] != "arm64" {
+		t.Errorf("unexpected join configuration %+v", cfg)
+	}
+}
+
+func TestLoadInventoryInvalid(t *testing.T) {
+	cases := map[string]string{
+		"wrong kind": "apiVersion: keadm.kubeedge.io/v1alpha1\nkind: JoinConfiguration\n",
+		"duplicate host": `apiVersion: keadm.kubeedge.io/v1alpha1
+kind: Inventory
+defaults:
+  ssh: {user: root, password: secret, insecureIgnoreHostKey: true}
+hosts:
+- {name: edge-01, address: 10.20.30.51}
+- {name: edge-01, address: 10.20.30.52}
+`,
+		"no host key check": `apiVersion: keadm.kubeedge.io/v1alpha1
+kind: Inventory
+hosts:
+- {name: edge-01, address: 10.20.30.51, ssh: {user: root, password: secret}}
+`,
+		"unknown join field": `apiVersion: keadm.kubeedge.io/v1alpha1
+kind: Inventory
+hosts:
+- name: edge-01
+  address: 10.20.30.51
+  ssh: {user: root, password: secret, insecureIgnoreHostKey: true}
+  join: {cloudcore: 10.20.30.40:10000}
+`,
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadInventory(writeInventory(t, data)); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestRunAll(t *testing.T) {
+	hosts := []Host{{Name: "edge-01"}, {Name: "edge-02"}, {Name: "edge-03"}, {Name: "edge-04"}}
+	var running, maxRunning int32
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	action := func(ctx context.Context, h *Host) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[h.Name]++
+		switch {
+		case h.Name == "edge-02" && attempts[h.Name] == 1:
+			return errors.New("temporary failure")
+		case h.Name == "edge-04":
+			return errors.New("permanent failure")
+		}
+		return nil
+	}
+
+	results := runAll(context.Background(), hosts, RunOptions{Parallelism: 2, Retries: 1}, action)
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 hosts at the same time, got %d", maxRunning)
+	}
+	wantAttempts := []int{1, 2, 1, 2}
+	for i, r := range results {
+		if r.Host != hosts[i].Name || r.Attempts != wantAttempts[i] {
+			t.Errorf("unexpected result %+v for host %s", r, hosts[i].Name)
+		}
+		if (r.Err != nil) != (r.Host == "edge-04") {
+			t.Errorf("unexpected error of host %s: %v", r.Host, r.Err)
+		}
+	}
+
+	var out bytes.Buffer
+	err := printSummary(&out, results)
+	if err == nil || err.Error() != "1 of 4 hosts failed" {
+		t.Errorf("unexpected summary error %v", err)
+	}
+	if !strings.Contains(out.String(), "permanent failure") {
+		t.Errorf("expected the summary to hold the error, got\n%s", out.String())
+	}
+}
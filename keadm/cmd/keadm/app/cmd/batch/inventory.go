@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+const (
+	// InventoryKind is the kind of the keadm batch inventory file
+	InventoryKind = "Inventory"
+
+	defaultSSHPort   = 22
+	defaultKeadmPath = "keadm"
+)
+
+// Inventory lists the edge nodes keadm batch operates on.
+type Inventory struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Defaults apply to every host, the settings of a host take precedence
+	Defaults HostConfig `json:"defaults"`
+	Hosts    []Host     `json:"hosts"`
+}
+
+// Host is an edge node of the inventory.
+type Host struct {
+	// Name is the edge node name, used unless the join settings set edgeNodeName
+	Name string `json:"name"`
+	// Address is the SSH address of the host, the port defaults to the one of the ssh settings
+	Address string `json:"address"`
+
+	HostConfig `json:",inline"`
+}
+
+// HostConfig holds the SSH credentials and the keadm join settings of a host.
+type HostConfig struct {
+	SSH SSHConfig `json:"ssh"`
+	// Join holds fields of a JoinConfiguration, see 'keadm config print-default join'
+	Join map[string]interface{} `json:"join,omitempty"`
+}
+
+// SSHConfig holds how keadm connects to a host.
+type SSHConfig struct {
+	User string `json:"user,omitempty"`
+	Port int    `json:"port,omitempty"`
+	// Password authenticates the user, PrivateKeyFile is preferred
+	Password       string `json:"password,omitempty"`
+	PrivateKeyFile string `json:"privateKeyFile,omitempty"`
+	// HostKeyFingerprint is the SHA256 fingerprint of the host key, e.g. SHA256:nThbg6kXUpJWGl7E1IGOCspRomTxdCARLviKw6E5SY8
+	HostKeyFingerprint string `json:"hostKeyFingerprint,omitempty"`
+	// InsecureIgnoreHostKey accepts any host key, it is only meant for test environments
+	InsecureIgnoreHostKey *bool `json:"insecureIgnoreHostKey,omitempty"`
+	// Sudo runs keadm with sudo, which must not prompt for a password
+	Sudo *bool `json:"sudo,omitempty"`
+	// KeadmPath is the path of keadm on the host, defaults to keadm
+	KeadmPath string `json:"keadmPath,omitempty"`
+}
+
+// LoadInventory reads the inventory in path and applies the defaults to its hosts.
+func LoadInventory(path string) (*Inventory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read inventory %s, err: %v", path, err)
+	}
+	inv := &Inventory{}
+	if err := yaml.UnmarshalStrict(data, inv); err != nil {
+		return nil, fmt.Errorf("failed to parse inventory %s, err: %v", path, err)
+	}
+	if inv.APIVersion != common.JoinConfigurationAPIVersion || inv.Kind != InventoryKind {
+		return nil, fmt.Errorf("inventory %s has apiVersion %q and kind %q, expected %s %s",
+			path, inv.APIVersion, inv.Kind, common.JoinConfigurationAPIVersion, InventoryKind)
+	}
+
+	names := map[string]bool{}
+	for i := range inv.Hosts {
+		h := &inv.Hosts[i]
+		if h.Name == "" || h.Address == "" {
+			return nil, fmt.Errorf("host %d of inventory %s needs both a name and an address", i, path)
+		}
+		if names[h.Name] {
+			return nil, fmt.Errorf("host %s is listed more than once in inventory %s", h.Name, path)
+		}
+		names[h.Name] = true
+
+		h.SSH = mergeSSHConfig(inv.Defaults.SSH, h.SSH)
+		h.Join = mergeMaps(inv.Defaults.Join, h.Join)
+		if err := h.validate(); err != nil {
+			return nil, fmt.Errorf("invalid host %s in inventory %s, err: %v", h.Name, path, err)
+		}
+	}
+	return inv, nil
+}
+
+func (h *Host) validate() error {
+	if h.SSH.User == "" {
+		return fmt.Errorf("ssh.user is not set")
+	}
+	if h.SSH.Password == "" && h.SSH.PrivateKeyFile == "" {
+		return fmt.Errorf("neither ssh.password nor ssh.privateKeyFile is set")
+	}
+	if h.SSH.HostKeyFingerprint == "" && !isTrue(h.SSH.InsecureIgnoreHostKey) {
+		return fmt.Errorf("neither ssh.hostKeyFingerprint nor ssh.insecureIgnoreHostKey is set")
+	}
+	if _, err := h.JoinConfiguration(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// JoinConfiguration returns the JoinConfiguration of the host, the edge node name
+// defaults to the name of the host.
+func (h *Host) JoinConfiguration() ([]byte, error) {
+	join := mergeMaps(map[string]interface{}{
+		"apiVersion":   common.JoinConfigurationAPIVersion,
+		"kind":         common.JoinConfigurationKind,
+		"edgeNodeName": h.Name,
+	}, h.Join)
+	data, err := yaml.Marshal(join)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the join settings, err: %v", err)
+	}
+	// Reject typos before connecting to the host
+	if err := yaml.UnmarshalStrict(data, &common.JoinConfiguration{}); err != nil {
+		return nil, fmt.Errorf("invalid join settings, err: %v", err)
+	}
+	return data, nil
+}
+
+// mergeSSHConfig returns the defaults overridden by the settings of the host.
+func mergeSSHConfig(defaults, host SSHConfig) SSHConfig {
+	res := defaults
+	setString := func(dst *string, val string) {
+		if val != "" {
+			*dst = val
+		}
+	}
+	setString(&res.User, host.User)
+	setString(&res.Password, host.Password)
+	setString(&res.PrivateKeyFile, host.PrivateKeyFile)
+	setString(&res.HostKeyFingerprint, host.HostKeyFingerprint)
+	setString(&res.KeadmPath, host.KeadmPath)
+	if host.Port != 0 {
+		res.Port = host.Port
+	}
+	if host.InsecureIgnoreHostKey != nil {
+		res.InsecureIgnoreHostKey = host.InsecureIgnoreHostKey
+	}
+	if host.Sudo != nil {
+		res.Sudo = host.Sudo
+	}
+	if res.Port == 0 {
+		res.Port = defaultSSHPort
+	}
+	if res.KeadmPath == "" {
+		res.KeadmPath = defaultKeadmPath
+	}
+	return res
+}
+
+// mergeMaps returns a copy of dst with src merged into it, nested maps are merged
+// and any other value of src replaces the one of dst.
+func mergeMaps(dst, src map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(dst)+len(src))
+	for k, v := range dst {
+		res[k] = v
+	}
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]interface{}); ok {
+			if dstMap, ok := res[k].(map[string]interface{}); ok {
+				res[k] = mergeMaps(dstMap, srcMap)
+				continue
+			}
+		}
+		res[k] = v
+	}
+	return res
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
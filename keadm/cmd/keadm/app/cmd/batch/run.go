@@ -0,0 +1,241 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// outputTailLines is the number of the last output lines of a failed command kept in its error
+const outputTailLines = 10
+
+// RunOptions controls how an operation is run on the hosts of the inventory.
+type RunOptions struct {
+	// Parallelism is the maximum number of hosts operated on at the same time
+	Parallelism int
+	// Retries is the number of times a failed host is retried
+	Retries int
+	// RetryInterval is the time waited before retrying a failed host
+	RetryInterval time.Duration
+	// Timeout limits the time of a single attempt on a host, zero disables it
+	Timeout time.Duration
+}
+
+// Result is the outcome of an operation on a host.
+type Result struct {
+	Host     string
+	Attempts int
+	Duration time.Duration
+	Err      error
+}
+
+// hostAction runs an operation on a host.
+type hostAction func(ctx context.Context, h *Host) error
+
+// runAll runs action on all hosts with at most opts.Parallelism of them at the same
+// time, retrying failed hosts. The results are in the order of the hosts.
+func runAll(ctx context.Context, hosts []Host, opts RunOptions, action hostAction) []Result {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	results := make([]Result, len(hosts))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range hosts {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i] = Result{Host: hosts[i].Name, Err: ctx.Err()}
+				return
+			}
+			defer func() { <-sem }()
+			results[i] = runWithRetries(ctx, &hosts[i], opts, action)
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func runWithRetries(ctx context.Context, h *Host, opts RunOptions, action hostAction) Result {
+	res := Result{Host: h.Name}
+	start := time.Now()
+	for res.Attempts <= opts.Retries {
+		if res.Attempts > 0 {
+			fmt.Printf("[%s] attempt %d failed: %v, retrying in %s\n", h.Name, res.Attempts, res.Err, opts.RetryInterval)
+			select {
+			case <-time.After(opts.RetryInterval):
+			case <-ctx.Done():
+				res.Err = ctx.Err()
+				res.Duration = time.Since(start)
+				return res
+			}
+		}
+		res.Attempts++
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if opts.Timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		}
+		res.Err = action(attemptCtx, h)
+		cancel()
+		if res.Err == nil {
+			break
+		}
+	}
+	res.Duration = time.Since(start)
+	return res
+}
+
+// printSummary writes a table of the results to w and returns an error if a host failed.
+func printSummary(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "HOST\tRESULT\tATTEMPTS\tDURATION\tERROR")
+	failed := 0
+	for _, r := range results {
+		result, errMsg := "ok", ""
+		if r.Err != nil {
+			failed++
+			result = "failed"
+			errMsg = strings.SplitN(r.Err.Error(), "\n", 2)[0]
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\n", r.Host, result, r.Attempts, r.Duration.Round(time.Second), errMsg)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d hosts failed", failed, len(results))
+	}
+	return nil
+}
+
+// runRemote runs cmd on the host over SSH, feeding it stdin.
+func runRemote(ctx context.Context, h *Host, cmd string, stdin []byte) error {
+	config, err := h.clientConfig()
+	if err != nil {
+		return err
+	}
+	addr := h.Address
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, strconv.Itoa(h.SSH.Port))
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s, err: %v", addr, err)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("ssh handshake with %s failed, err: %v", addr, err)
+	}
+	client := ssh.NewClient(sshConn, chans, reqs)
+	defer client.Close()
+	// Closing the client aborts the command once ctx is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			client.Close()
+		case <-done:
+		}
+	}()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed to open a ssh session on %s, err: %v", addr, err)
+	}
+	defer session.Close()
+	session.Stdin = bytes.NewReader(stdin)
+	out, err := session.CombinedOutput(cmd)
+	if ctx.Err() != nil {
+		return fmt.Errorf("%s on %s was aborted, err: %v", cmd, addr, ctx.Err())
+	}
+	if err != nil {
+		return fmt.Errorf("%s on %s failed, err: %v\n%s", cmd, addr, err, outputTail(out))
+	}
+	return nil
+}
+
+func (h *Host) clientConfig() (*ssh.ClientConfig, error) {
+	var auth []ssh.AuthMethod
+	if h.SSH.PrivateKeyFile != "" {
+		key, err := os.ReadFile(h.SSH.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read private key %s, err: %v", h.SSH.PrivateKeyFile, err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key %s, err: %v", h.SSH.PrivateKeyFile, err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if h.SSH.Password != "" {
+		auth = append(auth, ssh.Password(h.SSH.Password))
+	}
+
+	hostKeyCallback := ssh.InsecureIgnoreHostKey()
+	if h.SSH.HostKeyFingerprint != "" {
+		want := h.SSH.HostKeyFingerprint
+		hostKeyCallback = func(hostname string, _ net.Addr, key ssh.PublicKey) error {
+			if got := ssh.FingerprintSHA256(key); got != want {
+				return fmt.Errorf("host key of %s has fingerprint %s, expected %s", hostname, got, want)
+			}
+			return nil
+		}
+	}
+	return &ssh.ClientConfig{
+		User:            h.SSH.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, nil
+}
+
+// keadmCommand returns the shell command running keadm with args on the host.
+func (h *Host) keadmCommand(args string) string {
+	cmd := h.SSH.KeadmPath + " " + args
+	if isTrue(h.SSH.Sudo) {
+		cmd = "sudo -n " + cmd
+	}
+	return cmd
+}
+
+func outputTail(out []byte) string {
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if len(lines) > outputTailLines {
+		lines = lines[len(lines)-outputTailLines:]
+	}
+	return strings.Join(lines, "\n")
+}
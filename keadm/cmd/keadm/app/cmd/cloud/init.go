@@ -41,6 +41,9 @@ keadm init
 keadm init --advertise-address=127.0.0.1 --profile version=v%s --kube-config=/root/.kube/config
   - kube-config is the absolute path of kubeconfig which used to secure connectivity between cloudcore and kube-apiserver
 	- a list of helm style set flags like "--set key=value" can be implemented, ref: https://github.com/kubeedge/kubeedge/tree/master/manifests/charts/cloudcore/README.md
+
+keadm init --replicas=3 --leader-elect --service-type=LoadBalancer --load-balancer-ip=10.10.102.100
+  - deploys 3 cloudcore replicas in high availability, only the elected leader serves the edge nodes at the shared address
 `
 )
 
@@ -64,6 +67,7 @@ func NewCloudInit() *cobra.Command {
 	}
 
 	addInitOtherFlags(cmd, opts)
+	addInitHAFlags(cmd, &opts.HAOptions)
 	addHelmValueOptionsFlags(cmd, opts)
 	addForceOptionsFlags(cmd, opts)
 	return cmd
@@ -94,18 +98,58 @@ func addInitOtherFlags(cmd *cobra.Command, initOpts *types.InitOptions) {
 		"Allow appending file directories of k8s resources to keadm, separated by commas")
 
 	cmd.Flags().BoolVarP(&initOpts.DryRun, types.FlagNameDryRun, "d", initOpts.DryRun,
-		"Print the generated k8s resources on the stdout, not actual execute. Always use in debug mode")
+		"Print the generated k8s resources on the stdout, not actual execute. Always use in debug mode. "+
+			"It is a client-side dry-run, the resources are not validated by the Kubernetes API server")
 
 	cmd.Flags().StringVar(&initOpts.ExternalHelmRoot, types.FlagNameExternalHelmRoot, initOpts.ExternalHelmRoot,
 		"Add external helm root path to keadm.")
 
 	cmd.Flags().StringVar(&initOpts.ImageRepository, types.FlagNameImageRepository, initOpts.ImageRepository,
 		"Choose a container image repository to pull the image of the kubedge component.")
+
+	addChartSourceFlags(cmd, &initOpts.CloudInitUpdateBase)
+}
+
+func addInitHAFlags(cmd *cobra.Command, opts *types.HAOptions) {
+	cmd.Flags().Int32Var(&opts.Replicas, types.FlagNameReplicas, opts.Replicas,
+		"The number of the cloudcore replicas, the chart default if zero")
+
+	cmd.Flags().BoolVar(&opts.LeaderElect, types.FlagNameLeaderElect, opts.LeaderElect,
+		"Elect the leader of the cloudcore replicas, only the leader is ready and serves the edge nodes")
+
+	cmd.Flags().DurationVar(&opts.LeaderElectLeaseDuration, types.FlagNameLeaderElectLeaseDuration, opts.LeaderElectLeaseDuration,
+		"The duration the standby replicas wait after the last renewal of the leader before taking over, eg: 15s")
+
+	cmd.Flags().DurationVar(&opts.LeaderElectRenewDeadline, types.FlagNameLeaderElectRenewDeadline, opts.LeaderElectRenewDeadline,
+		"The duration the leader retries to renew the leadership before giving it up, eg: 10s")
+
+	cmd.Flags().DurationVar(&opts.LeaderElectRetryPeriod, types.FlagNameLeaderElectRetryPeriod, opts.LeaderElectRetryPeriod,
+		"The duration between the attempts to acquire and renew the leadership, eg: 2s")
+
+	cmd.Flags().StringVar(&opts.ServiceType, types.FlagNameServiceType, opts.ServiceType,
+		"The type of the cloudcore service: NodePort, ClusterIP or LoadBalancer")
+
+	cmd.Flags().StringVar(&opts.LoadBalancerIP, types.FlagNameLoadBalancerIP, opts.LoadBalancerIP,
+		"The shared address of the cloudcore LoadBalancer service, it is advertised to the edge nodes if --advertise-address is not set")
+}
+
+func addChartSourceFlags(cmd *cobra.Command, opts *types.CloudInitUpdateBase) {
+	cmd.Flags().StringVar(&opts.ChartRepo, types.FlagNameChartRepo, opts.ChartRepo,
+		"Pull the cloudcore chart from this chart repository URL or oci:// reference instead of using the built-in chart")
+
+	cmd.Flags().StringVar(&opts.ChartVersion, types.FlagNameChartVersion, opts.ChartVersion,
+		"The version of the cloudcore chart to pull from --chart-repo, the latest if empty")
+
+	cmd.Flags().StringVar(&opts.ChartDigest, types.FlagNameChartDigest, opts.ChartDigest,
+		"The expected sha256 digest of the chart pulled from --chart-repo, eg: sha256:<hex>. Pinned charts are cached locally")
 }
 
 func addHelmValueOptionsFlags(cmd *cobra.Command, initOpts *types.InitOptions) {
 	cmd.Flags().StringArrayVar(&initOpts.Sets, types.FlagNameSet, []string{}, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
-	cmd.Flags().StringVar(&initOpts.Profile, types.FlagNameProfile, initOpts.Profile, fmt.Sprintf("Set profile on the command line (iptablesMgrMode=external or version=v%s)", types.DefaultKubeEdgeVersion))
+	cmd.Flags().StringArrayVar(&initOpts.Profiles, types.FlagNameProfile, []string{}, fmt.Sprintf("Set profile on the command line (iptablesMgrMode=external or version=v%s), can specify multiple which are merged in order", types.DefaultKubeEdgeVersion))
+	cmd.Flags().StringVar(&initOpts.ValuesSchema, types.FlagNameValuesSchema, initOpts.ValuesSchema, "Validate the merged values against this JSON schema instead of the values.schema.json of the chart")
+	cmd.Flags().BoolVar(&initOpts.TrimEmptyValues, types.FlagNameValuesTrim, false, "Remove empty maps and lists from the merged values")
+	cmd.Flags().BoolVar(&initOpts.ShowDefaults, types.FlagNameShowDefaults, false, "Print the chart default values which are not overridden by the profile or --set")
 }
 
 func addForceOptionsFlags(cmd *cobra.Command, initOpts *types.InitOptions) {
@@ -126,7 +170,7 @@ func AddInit2ToolsList(toolList map[string]types.ToolsInstaller, initOpts *types
 		Namespace:        constants.SystemNamespace,
 		DryRun:           initOpts.DryRun,
 		Sets:             initOpts.Sets,
-		Profile:          initOpts.Profile,
+		Profiles:         initOpts.Profiles,
 		ExternalHelmRoot: initOpts.ExternalHelmRoot,
 		Force:            initOpts.Force,
 		Action:           types.HelmInstallAction,
@@ -92,7 +92,7 @@ func AddManifestsGenerate2ToolsList(toolList map[string]types.ToolsInstaller, fl
 		Namespace:        constants.SystemNamespace,
 		DryRun:           initOpts.DryRun,
 		Sets:             initOpts.Sets,
-		Profile:          initOpts.Profile,
+		Profiles:         initOpts.Profiles,
 		SkipCRDs:         initOpts.SkipCRDs,
 		Action:           types.HelmManifestAction,
 	}
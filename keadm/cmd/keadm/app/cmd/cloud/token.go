@@ -0,0 +1,246 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+)
+
+var (
+	tokenLongDescription = `
+"keadm token" manages the bootstrap tokens of edge nodes. Unlike the shared token printed by
+"keadm gettoken", a bootstrap token can only be used by the edge node it was issued for,
+expires after its ttl and can be revoked, e.g. when the device is lost.
+`
+	tokenExample = `
+keadm token create --node-name=edge-01 --ttl=1h
+keadm token list
+keadm token revoke <token id>
+`
+)
+
+// NewToken returns the "keadm token" command
+func NewToken() *cobra.Command {
+	opts := newTokenOptions()
+	cmd := &cobra.Command{
+		Use:     "token",
+		Short:   "Manage the bootstrap tokens of edge nodes",
+		Long:    tokenLongDescription,
+		Example: tokenExample,
+	}
+	cmd.PersistentFlags().StringVar(&opts.Kubeconfig, common.FlagNameKubeConfig, opts.Kubeconfig,
+		"Use this key to set kube-config path, eg: $HOME/.kube/config")
+
+	cmd.AddCommand(newCmdTokenCreate(opts))
+	cmd.AddCommand(newCmdTokenList(opts))
+	cmd.AddCommand(newCmdTokenRevoke(opts))
+	return cmd
+}
+
+func newTokenOptions() *common.TokenOptions {
+	return &common.TokenOptions{
+		Kubeconfig: common.DefaultKubeConfig,
+		TTL:        24 * time.Hour,
+	}
+}
+
+func newCmdTokenCreate(opts *common.TokenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a bootstrap token for an edge node",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := util.KubeClient(opts.Kubeconfig)
+			if err != nil {
+				return err
+			}
+			token, err := createBootstrapToken(cmd.Context(), client, opts.NodeName, opts.TTL, time.Now())
+			if err != nil {
+				return err
+			}
+			return showToken([]byte(token))
+		},
+		Args: cobra.NoArgs,
+	}
+	cmd.Flags().StringVar(&opts.NodeName, common.FlagNameNodeName, opts.NodeName,
+		"The name of the edge node the token is issued for")
+	if err := cmd.MarkFlagRequired(common.FlagNameNodeName); err != nil {
+		fmt.Printf("mark flag required failed with error: %v\n", err)
+	}
+	cmd.Flags().DurationVar(&opts.TTL, common.FlagNameTTL, opts.TTL,
+		"The duration the token can be used for")
+	return cmd
+}
+
+func newCmdTokenList(opts *common.TokenOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the bootstrap tokens",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := util.KubeClient(opts.Kubeconfig)
+			if err != nil {
+				return err
+			}
+			secrets, err := listBootstrapTokens(cmd.Context(), client)
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tNODE\tEXPIRATION\tSTATUS")
+			now := time.Now()
+			for _, s := range secrets {
+				status := "valid"
+				if expiration, err := time.Parse(time.RFC3339, string(s.Data[constants.BootstrapTokenExpirationKey])); err != nil || !now.Before(expiration) {
+					status = "expired"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Data[constants.BootstrapTokenIDKey],
+					s.Data[constants.BootstrapTokenNodeNameKey], s.Data[constants.BootstrapTokenExpirationKey], status)
+			}
+			return w.Flush()
+		},
+		Args: cobra.NoArgs,
+	}
+}
+
+func newCmdTokenRevoke(opts *common.TokenOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <token id>...",
+		Short: "Revoke bootstrap tokens, cloudcore then rejects them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := util.KubeClient(opts.Kubeconfig)
+			if err != nil {
+				return err
+			}
+			for _, id := range args {
+				if err := revokeBootstrapToken(cmd.Context(), client, id); err != nil {
+					return err
+				}
+				fmt.Printf("bootstrap token %s revoked\n", id)
+			}
+			return nil
+		},
+		Args: cobra.MinimumNArgs(1),
+	}
+}
+
+// createBootstrapToken issues a token for nodeName valid for ttl and records it,
+// the token has the format of the shared token, the hash of the CA followed by a jwt
+// signed with the CA key, so edgecore needs no change to use it.
+func createBootstrapToken(ctx context.Context, client kubernetes.Interface, nodeName string, ttl time.Duration, now time.Time) (string, error) {
+	if nodeName == "" {
+		return "", fmt.Errorf("the node name of the bootstrap token is empty")
+	}
+	if ttl <= 0 {
+		return "", fmt.Errorf("the ttl of the bootstrap token must be positive, got %s", ttl)
+	}
+	caSecret, err := client.CoreV1().Secrets(constants.SystemNamespace).Get(ctx, common.CaSecretName, metaV1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the CA of cloudcore, err: %v", err)
+	}
+
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", fmt.Errorf("failed to generate the bootstrap token id, err: %v", err)
+	}
+	id := hex.EncodeToString(idBytes)
+	expiration := now.Add(ttl)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
+		Id:        id,
+		Subject:   nodeName,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiration.Unix(),
+	})
+	tokenString, err := token.SignedString(caSecret.Data[common.CaKeyDataName])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the bootstrap token, err: %v", err)
+	}
+
+	record := &corev1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      constants.BootstrapTokenSecretPrefix + id,
+			Namespace: constants.SystemNamespace,
+		},
+		Type: constants.BootstrapTokenSecretType,
+		Data: map[string][]byte{
+			constants.BootstrapTokenIDKey:         []byte(id),
+			constants.BootstrapTokenNodeNameKey:   []byte(nodeName),
+			constants.BootstrapTokenExpirationKey: []byte(expiration.UTC().Format(time.RFC3339)),
+		},
+	}
+	if _, err := client.CoreV1().Secrets(constants.SystemNamespace).Create(ctx, record, metaV1.CreateOptions{}); err != nil {
+		return "", fmt.Errorf("failed to record the bootstrap token, err: %v", err)
+	}
+
+	caHash := sha256.Sum256(caSecret.Data[common.CaDataName])
+	return strings.Join([]string{hex.EncodeToString(caHash[:]), tokenString}, "."), nil
+}
+
+// listBootstrapTokens returns the secrets recording the bootstrap tokens.
+func listBootstrapTokens(ctx context.Context, client kubernetes.Interface) ([]corev1.Secret, error) {
+	list, err := client.CoreV1().Secrets(constants.SystemNamespace).List(ctx, metaV1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(constants.BootstrapTokenSecretType)).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the bootstrap tokens, err: %v", err)
+	}
+	var tokens []corev1.Secret
+	for _, s := range list.Items {
+		if s.Type == constants.BootstrapTokenSecretType {
+			tokens = append(tokens, s)
+		}
+	}
+	return tokens, nil
+}
+
+// revokeBootstrapToken deletes the record of the bootstrap token id.
+func revokeBootstrapToken(ctx context.Context, client kubernetes.Interface, id string) error {
+	name := constants.BootstrapTokenSecretPrefix + id
+	secret, err := client.CoreV1().Secrets(constants.SystemNamespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("bootstrap token %s does not exist", id)
+		}
+		return fmt.Errorf("failed to get bootstrap token %s, err: %v", id, err)
+	}
+	if secret.Type != constants.BootstrapTokenSecretType {
+		return fmt.Errorf("secret %s is not a bootstrap token", name)
+	}
+	if err := client.CoreV1().Secrets(constants.SystemNamespace).Delete(ctx, name, metaV1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("failed to revoke bootstrap token %s, err: %v", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	corev1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+func TestBootstrapTokens(t *testing.T) {
+	caKey := []byte("ca-key")
+	client := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: common.CaSecretName, Namespace: constants.SystemNamespace},
+		Data:       map[string][]byte{common.CaDataName: []byte("ca"), common.CaKeyDataName: caKey},
+	})
+	ctx := context.Background()
+
+	token, err := createBootstrapToken(ctx, client, "edge-01", time.Hour, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		t.Fatalf("expected the token to consist of the CA hash and a jwt, got %s", token)
+	}
+	claims := &jwt.StandardClaims{}
+	if _, err := jwt.ParseWithClaims(strings.Join(parts[1:], "."), claims, func(*jwt.Token) (interface{}, error) {
+		return caKey, nil
+	}); err != nil {
+		t.Fatalf("failed to verify the token: %v", err)
+	}
+	if claims.Subject != "edge-01" || claims.Id == "" {
+		t.Fatalf("unexpected claims %+v", claims)
+	}
+
+	tokens, err := listBootstrapTokens(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 1 || string(tokens[0].Data[constants.BootstrapTokenIDKey]) != claims.Id ||
+		string(tokens[0].Data[constants.BootstrapTokenNodeNameKey]) != "edge-01" {
+		t.Fatalf("unexpected bootstrap tokens %+v", tokens)
+	}
+
+	if err := revokeBootstrapToken(ctx, client, claims.Id); err != nil {
+		t.Fatal(err)
+	}
+	if err := revokeBootstrapToken(ctx, client, claims.Id); err == nil {
+		t.Fatal("expected revoking a revoked token to fail")
+	}
+	if err := revokeBootstrapToken(ctx, client, "../"+common.CaSecretName); err == nil {
+		t.Fatal("expected revoking a secret which is not a bootstrap token to fail")
+	}
+	if _, err := createBootstrapToken(ctx, client, "", time.Hour, time.Now()); err == nil {
+		t.Fatal("expected an error for an empty node name")
+	}
+}
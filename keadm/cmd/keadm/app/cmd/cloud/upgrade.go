@@ -16,6 +16,8 @@ limitations under the License.
 package cloud
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	types "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
@@ -30,6 +32,12 @@ func NewCloudUpgrade() *cobra.Command {
 		Short: "Upgrade the cloud components",
 		Long: "Upgrade the cloud components to the desired version, " +
 			"it uses helm to upgrade the installed release of cloudcore chart, which includes all the cloud components",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Diff && !opts.DryRun {
+				return fmt.Errorf("--%s can only be used with --%s", types.FlagNameDiff, types.FlagNameDryRun)
+			}
+			return nil
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			tool := helm.NewCloudCoreHelmTool(opts.KubeConfig, opts.KubeEdgeVersion)
 			return tool.Upgrade(opts)
@@ -60,7 +68,8 @@ func addUpgradeOptionFlags(cmd *cobra.Command, opts *types.CloudUpgradeOptions)
 		"Use this key to update kube-config path, eg: $HOME/.kube/config")
 
 	fs.BoolVarP(&opts.DryRun, types.FlagNameDryRun, "d", opts.DryRun,
-		"Print the generated k8s resources on the stdout, not actual execute. Always use in debug mode")
+		"Print the generated k8s resources on the stdout, not actual execute. Always use in debug mode. "+
+			"It is a client-side dry-run, the resources are not validated by the Kubernetes API server")
 
 	fs.StringArrayVar(&opts.Sets, types.FlagNameSet, []string{},
 		"Sets values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
@@ -68,11 +77,17 @@ func addUpgradeOptionFlags(cmd *cobra.Command, opts *types.CloudUpgradeOptions)
 	fs.StringArrayVar(&opts.ValueFiles, types.FlagNameValueFiles, []string{},
 		"specify values in a YAML file (can specify multiple)")
 
+	fs.BoolVar(&opts.TrimEmptyValues, types.FlagNameValuesTrim, false,
+		"Remove empty maps and lists from the merged values")
+
 	fs.BoolVar(&opts.Force, types.FlagNameForce, opts.Force,
 		"Forced upgrading the cloud components without waiting")
 
-	fs.StringVar(&opts.Profile, types.FlagNameProfile, opts.Profile,
-		"Sets profile on the command line. If '--values' is specified, this is ignored")
+	fs.StringArrayVar(&opts.Profiles, types.FlagNameProfile, []string{},
+		"Sets profile on the command line, can specify multiple which are merged in order. If '--values' is specified, this is ignored")
+
+	fs.StringVar(&opts.ValuesSchema, types.FlagNameValuesSchema, opts.ValuesSchema,
+		"Validate the merged values against this JSON schema instead of the values.schema.json of the chart")
 
 	fs.StringVar(&opts.ExternalHelmRoot, types.FlagNameExternalHelmRoot, opts.ExternalHelmRoot,
 		"Add external helm root path to keadm")
@@ -80,9 +95,20 @@ func addUpgradeOptionFlags(cmd *cobra.Command, opts *types.CloudUpgradeOptions)
 	fs.BoolVar(&opts.ReuseValues, types.FlagNameReuseValues, false,
 		"reuse the last release's values and merge in any overrides from the command line via --set and -f.")
 
+	fs.BoolVar(&opts.Diff, types.FlagNameDiff, false,
+		"Print the changes of the rendered manifests to the deployed release instead of the full manifests, it requires --dry-run")
+
+	fs.BoolVar(&opts.ValuesDiffOnly, types.FlagNameValuesDiffOnly, false,
+		"Only send the values changed since the last release, as a JSON merge patch, to reduce churn on running components")
+
 	fs.BoolVar(&opts.PrintFinalValues, types.FlagNamePrintFinalValues, false,
 		"Print the final values configuration for debuging")
 
+	fs.BoolVar(&opts.ShowDefaults, types.FlagNameShowDefaults, false,
+		"Print the chart default values which are not overridden by the profile, --values or --set")
+
 	fs.StringVar(&opts.ImageRepository, types.FlagNameImageRepository, opts.ImageRepository,
 		"Choose a container image repository to pull the image of the kubedge component.")
+
+	addChartSourceFlags(cmd, &opts.CloudInitUpdateBase)
 }
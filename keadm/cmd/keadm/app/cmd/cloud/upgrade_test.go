@@ -0,0 +1,45 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"testing"
+)
+
+func TestCloudUpgradeDiffRequiresDryRun(t *testing.T) {
+	cases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{name: "diff without dry-run", args: []string{"--diff"}, expectErr: true},
+		{name: "diff with dry-run", args: []string{"--diff", "--dry-run"}},
+		{name: "dry-run only", args: []string{"--dry-run"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cmd := NewCloudUpgrade()
+			if err := cmd.ParseFlags(c.args); err != nil {
+				t.Fatalf("parse flags failed: %v", err)
+			}
+			err := cmd.PreRunE(cmd, nil)
+			if (err != nil) != c.expectErr {
+				t.Errorf("expected error %v, but got %v", c.expectErr, err)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ package cmd
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/batch"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/beta"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/cloud"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/ctl"
@@ -77,6 +78,7 @@ func NewKubeedgeCommand() *cobra.Command {
 
 	cmds.AddCommand(NewCmdVersion())
 	cmds.AddCommand(cloud.NewGettoken())
+	cmds.AddCommand(cloud.NewToken())
 	cmds.AddCommand(debug.NewEdgeDebug())
 
 	// recommended cmds
@@ -85,6 +87,7 @@ func NewKubeedgeCommand() *cobra.Command {
 	cmds.AddCommand(cloud.NewManifestGenerate())
 	cmds.AddCommand(newCmdConfig())
 	cmds.AddCommand(NewKubeEdgeReset())
+	cmds.AddCommand(batch.NewBatch())
 
 	// beta cmds
 	cmds.AddCommand(beta.NewBeta())
@@ -36,6 +36,9 @@ const (
 	// FlagNameProfile flag name for install and upgrade in cloud
 	FlagNameProfile = "profile"
 
+	// FlagNameValuesSchema is a JSON schema the merged values are validated against
+	FlagNameValuesSchema = "values-schema"
+
 	// FlagNameExternalHelmRoot external Helm Root
 	FlagNameExternalHelmRoot = "external-helm-root"
 
@@ -50,6 +53,24 @@ const (
 
 	// FlagNamePrintFinalValues ...
 	FlagNamePrintFinalValues = "print-final-values"
+
+	// FlagNameValuesTrim drops empty maps and lists from the merged values
+	FlagNameValuesTrim = "values-trim"
+
+	// FlagNameValuesDiffOnly upgrades with only the values changed since the last release
+	FlagNameValuesDiffOnly = "values-diff-only"
+
+	// FlagNameShowDefaults prints the chart defaults which are not overridden
+	FlagNameShowDefaults = "show-defaults"
+
+	// FlagNameChartRepo is the chart repository or oci:// reference of the cloudcore chart
+	FlagNameChartRepo = "chart-repo"
+
+	// FlagNameChartVersion is the version of the cloudcore chart in the chart repository
+	FlagNameChartVersion = "chart-version"
+
+	// FlagNameChartDigest is the expected sha256 digest of the cloudcore chart archive
+	FlagNameChartDigest = "chart-digest"
 )
 
 // Cloud init flag names
@@ -66,12 +87,36 @@ const (
 
 	// FlagNameFiles allow appending manifests paths of manifests to keadm, separated by commas, another supported flag
 	FlagNameFiles = "files"
+
+	// FlagNameReplicas sets the number of the cloudcore replicas
+	FlagNameReplicas = "replicas"
+
+	// FlagNameLeaderElect enables the leader election of the cloudcore replicas
+	FlagNameLeaderElect = "leader-elect"
+
+	// FlagNameLeaderElectLeaseDuration sets the lease duration of the leader election
+	FlagNameLeaderElectLeaseDuration = "leader-elect-lease-duration"
+
+	// FlagNameLeaderElectRenewDeadline sets the renew deadline of the leader election
+	FlagNameLeaderElectRenewDeadline = "leader-elect-renew-deadline"
+
+	// FlagNameLeaderElectRetryPeriod sets the retry period of the leader election
+	FlagNameLeaderElectRetryPeriod = "leader-elect-retry-period"
+
+	// FlagNameServiceType sets the type of the cloudcore service
+	FlagNameServiceType = "service-type"
+
+	// FlagNameLoadBalancerIP sets the shared address of the cloudcore LoadBalancer service
+	FlagNameLoadBalancerIP = "load-balancer-ip"
 )
 
 // Cloud upgrade flag names
 const (
 	// FlagNameReuseValues ...
 	FlagNameReuseValues = "reuse-values"
+
+	// FlagNameDiff prints the changes of a dry-run upgrade to the deployed manifests
+	FlagNameDiff = "diff"
 )
 
 // Edge join flag names
@@ -113,6 +158,15 @@ const (
 	// FlagNameTarballPath sets the temp directory path for KubeEdge tarball, if not exist, download it
 	// eg.  "/tmp/kubeedge" or "/etc/kubeedge" by default
 	FlagNameTarballPath = "tarballpath"
+
+	// FlagNameTTL sets the lifetime of a bootstrap token
+	FlagNameTTL = "ttl"
+
+	// FlagNameNodeName sets the edge node a bootstrap token is issued for
+	FlagNameNodeName = "node-name"
+
+	// FlagNameConfig sets the path of the keadm join configuration file
+	FlagNameConfig = "config"
 )
 
 const (
@@ -130,6 +184,11 @@ const (
 	TokenSecretName = "tokensecret"
 	TokenDataName   = "tokendata"
 
+	// CA secret
+	CaSecretName  = "casecret"
+	CaDataName    = "cadata"
+	CaKeyDataName = "cakeydata"
+
 	StrCheck    = "check"
 	StrDiagnose = "diagnose"
 
@@ -168,6 +227,7 @@ const (
 	/*edgecore info*/
 	PathEdgecoreService = "/lib/systemd/system/edgecore.service"
 	CmdEdgecoreVersion  = "edgecore  --version > %s/version"
+	CmdEdgecoreJournal  = "journalctl -u edgecore --no-pager > %s/edgecore.log"
 
 	/*runtime info*/
 	CmdDockerVersion    = "docker version > %s/version"
@@ -177,6 +237,17 @@ const (
 	CmdDockerImageInfo  = "docker images > %s/images"
 	PathDockerService   = "/lib/systemd/system/docker.service"
 
+	/*cri runtime info, formatted with the runtime endpoint and the output directory*/
+	CmdCrictlVersion = "crictl --runtime-endpoint %s version > %s/version"
+	CmdCrictlInfo    = "crictl --runtime-endpoint %s info > %s/info"
+	CmdCrictlPods    = "crictl --runtime-endpoint %s pods > %s/pods"
+	CmdCrictlPs      = "crictl --runtime-endpoint %s ps -a > %s/containerInfo"
+	CmdCrictlImages  = "crictl --runtime-endpoint %s images > %s/images"
+	CmdRuntimeLog    = "journalctl -u %s --no-pager > %s/log"
+
+	/*mqtt broker info*/
+	CmdMosquittoStatus = "systemctl status mosquitto --no-pager > %s/mosquitto"
+
 	DescAll     = "Check all item"
 	DescArch    = "Check whether the architecture can work"
 	DescCPU     = "Check node CPU requirements"
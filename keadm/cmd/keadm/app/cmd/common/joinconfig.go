@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/pflag"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/viaduct/pkg/api"
+)
+
+const (
+	// JoinConfigurationAPIVersion is the apiVersion of the keadm join configuration file
+	JoinConfigurationAPIVersion = "keadm.kubeedge.io/v1alpha1"
+	// JoinConfigurationKind is the kind of the keadm join configuration file
+	JoinConfigurationKind = "JoinConfiguration"
+)
+
+// JoinConfiguration is the configuration file of "keadm join --config",
+// holding the same settings as the join flags.
+type JoinConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	KubeEdgeVersion       string            `json:"kubeEdgeVersion"`
+	CloudCoreIPPort       string            `json:"cloudCoreIPPort"`
+	CertPort              string            `json:"certPort"`
+	CertPath              string            `json:"certPath"`
+	Token                 string            `json:"token"`
+	EdgeNodeName          string            `json:"edgeNodeName"`
+	CGroupDriver          string            `json:"cgroupDriver"`
+	RemoteRuntimeEndpoint string            `json:"remoteRuntimeEndpoint"`
+	HubProtocol           string            `json:"hubProtocol"`
+	ImageRepository       string            `json:"imageRepository"`
+	TarballPath           string            `json:"tarballPath"`
+	Labels                map[string]string `json:"labels"`
+	Taints                []v1.Taint        `json:"taints"`
+	// Modules overrides the modules of the generated edgecore configuration,
+	// using the field names of edgecore.yaml, e.g. edged.maxPods
+	Modules map[string]interface{} `json:"modules"`
+}
+
+// NewDefaultJoinConfiguration returns a JoinConfiguration with the defaults of keadm join.
+func NewDefaultJoinConfiguration() *JoinConfiguration {
+	return &JoinConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: JoinConfigurationAPIVersion,
+			Kind:       JoinConfigurationKind,
+		},
+		CertPath:              DefaultCertPath,
+		CGroupDriver:          v1alpha2.CGroupDriverCGroupFS,
+		RemoteRuntimeEndpoint: constants.DefaultRemoteRuntimeEndpoint,
+		HubProtocol:           api.ProtocolTypeWS,
+		Labels:                map[string]string{},
+		Taints:                []v1.Taint{},
+		Modules:               map[string]interface{}{},
+	}
+}
+
+// LoadJoinConfiguration reads the JoinConfiguration file in path, unknown fields are rejected.
+func LoadJoinConfiguration(path string) (*JoinConfiguration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read join configuration %s, err: %v", path, err)
+	}
+	cfg := &JoinConfiguration{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse join configuration %s, err: %v", path, err)
+	}
+	if cfg.APIVersion != JoinConfigurationAPIVersion || cfg.Kind != JoinConfigurationKind {
+		return nil, fmt.Errorf("join configuration %s has apiVersion %q and kind %q, expected %s %s",
+			path, cfg.APIVersion, cfg.Kind, JoinConfigurationAPIVersion, JoinConfigurationKind)
+	}
+	return cfg, nil
+}
+
+// ApplyTo sets the options of opt given by the configuration.
+// Flags set explicitly on the command line take precedence over the file.
+func (cfg *JoinConfiguration) ApplyTo(opt *JoinOptions, flags *pflag.FlagSet) {
+	setString := func(flag string, dst *string, val string) {
+		if val != "" && !flags.Changed(flag) {
+			*dst = val
+		}
+	}
+	setString(FlagNameKubeEdgeVersion, &opt.KubeEdgeVersion, cfg.KubeEdgeVersion)
+	setString(FlagNameCloudCoreIPPort, &opt.CloudCoreIPPort, cfg.CloudCoreIPPort)
+	setString(FlagNameCertPort, &opt.CertPort, cfg.CertPort)
+	setString(FlagNameCertPath, &opt.CertPath, cfg.CertPath)
+	setString(FlagNameToken, &opt.Token, cfg.Token)
+	setString(FlagNameEdgeNodeName, &opt.EdgeNodeName, cfg.EdgeNodeName)
+	setString(FlagNameCGroupDriver, &opt.CGroupDriver, cfg.CGroupDriver)
+	setString(FlagNameRemoteRuntimeEndpoint, &opt.RemoteRuntimeEndpoint, cfg.RemoteRuntimeEndpoint)
+	setString(HubProtocol, &opt.HubProtocol, cfg.HubProtocol)
+	setString(FlagNameImageRepository, &opt.ImageRepository, cfg.ImageRepository)
+	setString(FlagNameTarballPath, &opt.TarballPath, cfg.TarballPath)
+
+	if len(cfg.Labels) > 0 && !flags.Changed(FlagNameLabels) {
+		keys := make([]string, 0, len(cfg.Labels))
+		for k := range cfg.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		opt.Labels = make([]string, 0, len(keys))
+		for _, k := range keys {
+			opt.Labels = append(opt.Labels, k+"="+cfg.Labels[k])
+		}
+	}
+	opt.Taints = cfg.Taints
+	opt.EdgeCoreModules = cfg.Modules
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestLoadJoinConfiguration(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: "apiVersion: keadm.kubeedge.io/v1alpha1\nkind: JoinConfiguration\ncloudCoreIPPort: 10.20.30.40:10000\n" +
+				"labels:\n  zone: a\ntaints:\n- key: dedicated\n  effect: NoSchedule\nmodules:\n  edged:\n    maxPods: 50\n",
+		},
+		{name: "wrong kind", data: "apiVersion: keadm.kubeedge.io/v1alpha1\nkind: InitConfiguration\n", wantErr: true},
+		{name: "missing apiVersion", data: "kind: JoinConfiguration\n", wantErr: true},
+		{name: "unknown field", data: "apiVersion: keadm.kubeedge.io/v1alpha1\nkind: JoinConfiguration\ncloudcore: a:1\n", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "join.yaml")
+			if err := os.WriteFile(path, []byte(c.data), 0600); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := LoadJoinConfiguration(path)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("expected error %t, got %v", c.wantErr, err)
+			}
+			if err == nil && (cfg.CloudCoreIPPort != "10.20.30.40:10000" || len(cfg.Taints) != 1) {
+				t.Fatalf("unexpected configuration %+v", cfg)
+			}
+		})
+	}
+}
+
+func TestJoinConfigurationApplyTo(t *testing.T) {
+	var opt JoinOptions
+	flags := pflag.NewFlagSet("join", pflag.ContinueOnError)
+	flags.StringVar(&opt.Token, FlagNameToken, "", "")
+	flags.StringVar(&opt.CloudCoreIPPort, FlagNameCloudCoreIPPort, "", "")
+	if err := flags.Parse([]string{"--" + FlagNameToken + "=from-flag"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &JoinConfiguration{
+		CloudCoreIPPort: "10.20.30.40:10000",
+		Token:           "from-file",
+		Labels:          map[string]string{"zone": "a", "arch": "arm64"},
+	}
+	cfg.ApplyTo(&opt, flags)
+
+	if opt.Token != "from-flag" {
+		t.Errorf("expected the token flag to take precedence, got %s", opt.Token)
+	}
+	if opt.CloudCoreIPPort != "10.20.30.40:10000" {
+		t.Errorf("expected the cloudcore address of the file, got %s", opt.CloudCoreIPPort)
+	}
+	if want := []string{"arch=arm64", "zone=a"}; !reflect.DeepEqual(opt.Labels, want) {
+		t.Errorf("expected labels %v, got %v", want, opt.Labels)
+	}
+}
@@ -19,8 +19,10 @@ package common
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
+	v1 "k8s.io/api/core/v1"
 )
 
 // CloudInitUpdateBase defines common flags for init and upgrade in the cloud.
@@ -28,7 +30,8 @@ type CloudInitUpdateBase struct {
 	KubeConfig       string
 	KubeEdgeVersion  string
 	AdvertiseAddress string
-	Profile          string
+	Profiles         []string
+	ValuesSchema     string
 	ExternalHelmRoot string
 	Sets             []string
 	ValueFiles       []string
@@ -36,6 +39,11 @@ type CloudInitUpdateBase struct {
 	DryRun           bool
 	PrintFinalValues bool
 	ImageRepository  string
+	TrimEmptyValues  bool
+	ShowDefaults     bool
+	ChartRepo        string
+	ChartVersion     string
+	ChartDigest      string
 }
 
 const requiredSetSplitLen = 2
@@ -74,11 +82,26 @@ type InitOptions struct {
 	Manifests string
 	SkipCRDs  bool
 	CloudInitUpdateBase
+	HAOptions
+}
+
+// HAOptions defines the cloud init flags to deploy cloudcore in high availability,
+// the zero values leave the chart values unchanged
+type HAOptions struct {
+	Replicas                 int32
+	LeaderElect              bool
+	LeaderElectLeaseDuration time.Duration
+	LeaderElectRenewDeadline time.Duration
+	LeaderElectRetryPeriod   time.Duration
+	ServiceType              string
+	LoadBalancerIP           string
 }
 
 // CloudUpgradeOptions defines cloud upgrade flags
 type CloudUpgradeOptions struct {
-	ReuseValues bool
+	ReuseValues    bool
+	ValuesDiffOnly bool
+	Diff           bool
 	CloudInitUpdateBase
 }
 
@@ -102,6 +125,13 @@ type JoinOptions struct {
 	ImageRepository string
 	HubProtocol     string
 	TarballPath     string
+
+	// Config is the path of a JoinConfiguration file
+	Config string
+	// Taints are registered with the node, only set by the join configuration
+	Taints []v1.Taint
+	// EdgeCoreModules overrides the modules of edgecore.yaml, only set by the join configuration
+	EdgeCoreModules map[string]interface{}
 }
 
 type CheckOptions struct {
@@ -132,12 +162,28 @@ type ResetOptions struct {
 	Kubeconfig string
 	Force      bool
 	Endpoint   string
+	// DryRun prints the steps of the reset and the objects to clean up without changing anything
+	DryRun bool
+	// DrainTimeout is how long to wait for the pods of the edge node to be evicted
+	DrainTimeout time.Duration
+	// KubeAPIServer, Token and KubeCAFile are used to clean up the objects of the edge node
+	// in the cloud when no kubeconfig is given
+	KubeAPIServer string
+	Token         string
+	KubeCAFile    string
 }
 
 type GettokenOptions struct {
 	Kubeconfig string
 }
 
+// TokenOptions are the options of the keadm token commands.
+type TokenOptions struct {
+	Kubeconfig string
+	NodeName   string
+	TTL        time.Duration
+}
+
 type DiagnoseOptions struct {
 	Pod          string
 	Namespace    string
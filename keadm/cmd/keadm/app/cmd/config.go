@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	cmdcommon "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
@@ -59,6 +60,36 @@ func newCmdConfig() *cobra.Command {
 	}
 
 	cmd.AddCommand(newCmdConfigImages())
+	cmd.AddCommand(newCmdConfigPrintDefault())
+	return cmd
+}
+
+// newCmdConfigPrintDefault returns the "keadm config print-default" command
+func newCmdConfigPrintDefault() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "print-default",
+		Short: "Print the default configuration files of keadm",
+		Long:  "Use this command to print the default configuration files, which can be used as templates",
+	}
+	cmd.AddCommand(newCmdConfigPrintDefaultJoin())
+	return cmd
+}
+
+// newCmdConfigPrintDefaultJoin returns the "keadm config print-default join" command
+func newCmdConfigPrintDefaultJoin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "Print the default JoinConfiguration used by 'keadm join --config'",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			data, err := yaml.Marshal(cmdcommon.NewDefaultJoinConfiguration())
+			if err != nil {
+				return err
+			}
+			fmt.Print(string(data))
+			return nil
+		},
+		Args: cobra.NoArgs,
+	}
 	return cmd
 }
 
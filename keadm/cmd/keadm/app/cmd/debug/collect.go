@@ -1,21 +1,33 @@
 package debug
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/yaml"
 
-	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/kubeedge/edge/pkg/common/dbm"
+	"github.com/kubeedge/kubeedge/edge/pkg/metamanager/dao"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	"github.com/kubeedge/kubeedge/pkg/version"
 )
 
 var (
-	edgecollectLongDescription = `Collect all the data of the current node, and then Operations Engineer can use them to debug.
+	edgecollectLongDescription = `Collect all the data of the current node into a tar.gz bundle, and then Operations Engineer can use them to debug.
+The bundle contains the edgecore logs and config with secrets redacted, a dump of the edgecore meta database,
+the container runtime state, the connectivity to cloudcore, the MQTT broker status and a manifest.json
+recording every collected item.
 `
 	edgecollectExample = `
 # Collect all items and specified the output directory path
@@ -23,8 +35,62 @@ keadm debug collect --output-path .
 `
 )
 
+const (
+	// redactedValue replaces the secrets in the collected data
+	redactedValue = "<redacted>"
+	// dialTimeout is the timeout of checking the connectivity to an endpoint
+	dialTimeout = 5 * time.Second
+)
+
 var printDeatilFlag = false
 
+// collectManifest describes the content of a debug bundle
+type collectManifest struct {
+	NodeName     string        `json:"nodeName,omitempty"`
+	KeadmVersion string        `json:"keadmVersion"`
+	CollectedAt  time.Time     `json:"collectedAt"`
+	Items        []collectItem `json:"items"`
+}
+
+// collectItem is one item of a debug bundle, Error is set if it is not completely collected
+type collectItem struct {
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	Error string `json:"error,omitempty"`
+}
+
+// collector collects the items of a debug bundle into a directory
+type collector struct {
+	root     string
+	manifest collectManifest
+}
+
+// collect runs fn with the directory dir of the bundle and records the result in the manifest,
+// a failed item does not stop collecting the others
+func (c *collector) collect(name, dir string, fn func(tmpPath string) error) {
+	item := collectItem{Name: name, Path: dir}
+	tmpPath := filepath.Join(c.root, dir)
+	err := os.MkdirAll(tmpPath, os.ModePerm)
+	if err == nil {
+		err = fn(tmpPath)
+	}
+	if err != nil {
+		item.Error = err.Error()
+		fmt.Printf("collect %s data failed: %v\n", name, err)
+	} else {
+		printDetail(fmt.Sprintf("collect %s data finish", name))
+	}
+	c.manifest.Items = append(c.manifest.Items, item)
+}
+
+func (c *collector) writeManifest() error {
+	data, err := json.MarshalIndent(c.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(c.root, "manifest.json"), data, 0600)
+}
+
 // NewCollect returns KubeEdge collect command.
 func NewCollect() *cobra.Command {
 	collectOptions := newCollectOptions()
@@ -86,35 +152,41 @@ func ExecuteCollect(collectOptions *common.CollectOptions) error {
 	}
 	printDetail(fmt.Sprintf("create tmp file: %s", tmpName))
 
-	err = collectSystemData(fmt.Sprintf("%s/system", tmpName))
-	if err != nil {
-		fmt.Printf("collect System data failed")
-	}
-	printDetail("collect systemd data finish")
-
 	edgeconfig, err := util.ParseEdgecoreConfig(collectOptions.Config)
-
-	if err != nil {
-		fmt.Printf("fail to load edgecore config: %s", err.Error())
-	}
-	err = collectEdgecoreData(fmt.Sprintf("%s/edgecore", tmpName), edgeconfig, collectOptions)
 	if err != nil {
-		fmt.Printf("collect edgecore data failed")
+		fmt.Printf("fail to load edgecore config, use the default config: %s\n", err.Error())
+		edgeconfig = v1alpha2.NewDefaultEdgeCoreConfig()
 	}
-	printDetail("collect edgecore data finish")
 
-	if edgeconfig.Modules.Edged.ContainerRuntime == constants.DefaultRuntimeType ||
-		edgeconfig.Modules.Edged.ContainerRuntime == "" {
-		err = collectRuntimeData(fmt.Sprintf("%s/runtime", tmpName))
-		if err != nil {
-			fmt.Printf("collect runtime data failed")
-			return err
-		}
-		printDetail("collect runtime data finish")
-	} else {
-		fmt.Printf("now runtime only support: docker")
-		// TODO
-		// other runtime
+	c := &collector{
+		root: tmpName,
+		manifest: collectManifest{
+			NodeName:     edgeconfig.Modules.Edged.HostnameOverride,
+			KeadmVersion: version.Get().String(),
+			CollectedAt:  time.Now(),
+		},
+	}
+	c.collect("system", "system", collectSystemData)
+	c.collect("edgecore", "edgecore", func(tmpPath string) error {
+		return collectEdgecoreData(tmpPath, edgeconfig, collectOptions)
+	})
+	c.collect("edgecore config", "edgecore", func(tmpPath string) error {
+		return collectEdgecoreConfig(tmpPath, collectOptions.Config)
+	})
+	c.collect("meta", "meta", func(tmpPath string) error {
+		return collectMetaData(tmpPath, edgeconfig)
+	})
+	c.collect("runtime", "runtime", func(tmpPath string) error {
+		return collectRuntimeData(tmpPath, edgeconfig)
+	})
+	c.collect("network", "network", func(tmpPath string) error {
+		return collectNetworkData(tmpPath, edgeconfig)
+	})
+	c.collect("mqtt", "mqtt", func(tmpPath string) error {
+		return collectMQTTData(tmpPath, edgeconfig)
+	})
+	if err = c.writeManifest(); err != nil {
+		return err
 	}
 
 	OutputPath := collectOptions.OutputPath
@@ -166,120 +238,176 @@ func makeDirTmp() (string, string, error) {
 
 // collect system data
 func collectSystemData(tmpPath string) error {
-	printDetail(fmt.Sprintf("create tmp file: %s", tmpPath))
-	err := os.Mkdir(tmpPath, os.ModePerm)
-	if err != nil {
-		return err
+	var errs []error
+	// arch, disk, process, date, uptime, history and network info
+	for _, cmd := range []string{common.CmdArchInfo, common.CmdDiskInfo, common.CmdProcessInfo,
+		common.CmdDateInfo, common.CmdUptimeInfo, common.CmdHistorynfo, common.CmdNetworkInfo} {
+		if err := ExecuteShell(cmd, tmpPath); err != nil {
+			errs = append(errs, err)
+		}
 	}
+	// cpu, memory, hosts and resolv info
+	for _, path := range []string{common.PathCpuinfo, common.PathMemory, common.PathHosts, common.PathDNSResolv} {
+		if err := CopyFile(path, tmpPath); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
 
-	// arch info
-	if err = ExecuteShell(common.CmdArchInfo, tmpPath); err != nil {
-		return err
+// collect edgecore data, the private keys are not collected
+func collectEdgecoreData(tmpPath string, config *v1alpha2.EdgeCoreConfig, ops *common.CollectOptions) error {
+	var errs []error
+	logPath := ops.LogPath
+	if logPath == "" {
+		logPath = util.KubeEdgeLogPath
+	}
+	if util.FileExists(logPath) {
+		if err := CopyFile(logPath, tmpPath); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	// cpu info
-	if err = CopyFile(common.PathCpuinfo, tmpPath); err != nil {
-		return err
+	if err := ExecuteShell(common.CmdEdgecoreJournal, tmpPath); err != nil {
+		errs = append(errs, err)
 	}
-	// memory info
-	if err = CopyFile(common.PathMemory, tmpPath); err != nil {
-		return err
+
+	if err := CopyFile(common.PathEdgecoreService, tmpPath); err != nil {
+		errs = append(errs, err)
 	}
-	// diskinfo info
-	if err = ExecuteShell(common.CmdDiskInfo, tmpPath); err != nil {
-		return err
+
+	for _, path := range []string{config.Modules.EdgeHub.TLSCAFile, config.Modules.EdgeHub.TLSCertFile} {
+		if path == "" {
+			continue
+		}
+		if err := CopyFile(path, tmpPath); err != nil {
+			errs = append(errs, err)
+		}
 	}
-	// hosts info
-	if err = CopyFile(common.PathHosts, tmpPath); err != nil {
-		return err
+
+	if err := ExecuteShell(common.CmdEdgecoreVersion, tmpPath); err != nil {
+		errs = append(errs, err)
 	}
-	// resolv info
-	if err = CopyFile(common.PathDNSResolv, tmpPath); err != nil {
+	return utilerrors.NewAggregate(errs)
+}
+
+// collectEdgecoreConfig collects the edgecore config with the secrets redacted
+func collectEdgecoreConfig(tmpPath, configPath string) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
 		return err
 	}
-	// process info
-	if err = ExecuteShell(common.CmdProcessInfo, tmpPath); err != nil {
-		return err
+	data, err = redactConfig(data)
+	if err != nil {
+		return fmt.Errorf("failed to redact edgecore config: %v", err)
 	}
-	// date info
-	if err = ExecuteShell(common.CmdDateInfo, tmpPath); err != nil {
-		return err
+	return os.WriteFile(filepath.Join(tmpPath, filepath.Base(configPath)), data, 0600)
+}
+
+// redactConfig replaces the values of the secret fields of the edgecore config
+func redactConfig(data []byte) ([]byte, error) {
+	var obj interface{}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
 	}
-	// uptime info
-	if err = ExecuteShell(common.CmdUptimeInfo, tmpPath); err != nil {
-		return err
+	redactValue(obj)
+	return yaml.Marshal(obj)
+}
+
+func redactValue(obj interface{}) {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if s, ok := value.(string); ok && s != "" && isSecretConfigKey(key) {
+				v[key] = redactedValue
+				continue
+			}
+			redactValue(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redactValue(value)
+		}
 	}
-	// history info
-	if err = ExecuteShell(common.CmdHistorynfo, tmpPath); err != nil {
-		return err
+}
+
+// isSecretConfigKey reports whether the config field key holds a secret,
+// the fields holding the path of a secret file are kept
+func isSecretConfigKey(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "file") {
+		return false
 	}
-	// network info
-	return ExecuteShell(common.CmdNetworkInfo, tmpPath)
+	return strings.HasSuffix(key, "token") || strings.HasSuffix(key, "password") || strings.HasSuffix(key, "secret")
 }
 
-// collect edgecore data
-func collectEdgecoreData(tmpPath string, config *v1alpha2.EdgeCoreConfig, ops *common.CollectOptions) error {
-	printDetail(fmt.Sprintf("create tmp file: %s", tmpPath))
-	err := os.Mkdir(tmpPath, os.ModePerm)
-	if err != nil {
+// collectMetaData dumps the meta of edgecore, the values of secrets and service account tokens are redacted
+func collectMetaData(tmpPath string, config *v1alpha2.EdgeCoreConfig) error {
+	if dbm.IsKVDriver(config.DataBase.DriverName) {
+		return fmt.Errorf("dumping the meta of database driver %s is not supported", config.DataBase.DriverName)
+	}
+	dataSource := config.DataBase.DataSource
+	if dataSource == "" {
+		dataSource = v1alpha2.DataBaseDataSource
+	}
+	if !util.FileExists(dataSource) {
+		return fmt.Errorf("edgecore database file %s does not exist", dataSource)
+	}
+	if err := InitDB(config.DataBase.DriverName, config.DataBase.AliasName, dataSource); err != nil {
 		return err
 	}
 
-	if config.DataBase.DataSource != "" {
-		if err = CopyFile(config.DataBase.DataSource, tmpPath); err != nil {
-			return err
-		}
-	} else {
-		if err = CopyFile(v1alpha2.DataBaseDataSource, tmpPath); err != nil {
-			return err
-		}
+	var metas []dao.Meta
+	if _, err := dbm.DBAccess.QueryTable(dao.MetaTableName).Limit(-1).All(&metas); err != nil {
+		return fmt.Errorf("failed to query meta: %v", err)
 	}
-	if ops.LogPath != "" {
-		if err = CopyFile(ops.LogPath, tmpPath); err != nil {
-			return err
-		}
-	} else {
-		if err = CopyFile(util.KubeEdgeLogPath, fmt.Sprintf("%s/log", tmpPath)); err != nil {
-			return err
+	for i := range metas {
+		if metas[i].Type == model.ResourceTypeSecret || metas[i].Type == model.ResourceTypeServiceAccountToken {
+			metas[i].Value = redactedValue
 		}
 	}
-
-	if err = CopyFile(common.PathEdgecoreService, tmpPath); err != nil {
+	data, err := json.MarshalIndent(metas, "", "  ")
+	if err != nil {
 		return err
 	}
-	if err = CopyFile(constants.DefaultConfigDir, tmpPath); err != nil {
+	return os.WriteFile(filepath.Join(tmpPath, "meta.json"), data, 0600)
+}
+
+// collect runtime data of the cri runtime, and of docker if it is used through cri-dockerd
+func collectRuntimeData(tmpPath string, config *v1alpha2.EdgeCoreConfig) error {
+	endpoint := config.Modules.Edged.RemoteRuntimeEndpoint
+	if kubeletConfig := config.Modules.Edged.TailoredKubeletConfig; kubeletConfig != nil && kubeletConfig.ContainerRuntimeEndpoint != "" {
+		endpoint = kubeletConfig.ContainerRuntimeEndpoint
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, "endpoint"), []byte(endpoint+"\n"), 0600); err != nil {
 		return err
 	}
 
-	if config.Modules.EdgeHub.TLSCertFile != "" && config.Modules.EdgeHub.TLSPrivateKeyFile != "" {
-		if err = CopyFile(config.Modules.EdgeHub.TLSCertFile, tmpPath); err != nil {
-			return err
-		}
-		if err = CopyFile(config.Modules.EdgeHub.TLSPrivateKeyFile, tmpPath); err != nil {
-			return err
-		}
-	} else {
-		printDetail(fmt.Sprintf("not found cert config, use default path: %s", tmpPath))
-		if err = CopyFile(common.DefaultCertPath+"/", tmpPath); err != nil {
-			return err
+	var errs []error
+	for _, cmd := range []string{common.CmdCrictlVersion, common.CmdCrictlInfo, common.CmdCrictlPods,
+		common.CmdCrictlPs, common.CmdCrictlImages} {
+		if err := util.NewCommand(fmt.Sprintf(cmd, endpoint, tmpPath)).Exec(); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	if config.Modules.EdgeHub.TLSCAFile != "" {
-		if err = CopyFile(config.Modules.EdgeHub.TLSCAFile, tmpPath); err != nil {
-			return err
-		}
-	} else {
-		printDetail(fmt.Sprintf("not found ca config, use default path: %s", tmpPath))
-		if err = CopyFile(constants.DefaultCAKeyFile, tmpPath); err != nil {
-			return err
+	service := "containerd"
+	switch {
+	case strings.Contains(endpoint, "crio"):
+		service = "crio"
+	case strings.Contains(endpoint, "docker"):
+		service = "cri-docker"
+		if err := collectDockerData(filepath.Join(tmpPath, "docker")); err != nil {
+			errs = append(errs, err)
 		}
 	}
-
-	return ExecuteShell(common.CmdEdgecoreVersion, tmpPath)
+	if err := util.NewCommand(fmt.Sprintf(common.CmdRuntimeLog, service, tmpPath)).Exec(); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
 }
 
-// collect runtime/docker data
-func collectRuntimeData(tmpPath string) error {
+// collect docker data
+func collectDockerData(tmpPath string) error {
 	printDetail(fmt.Sprintf("create tmp file: %s", tmpPath))
 	err := os.Mkdir(tmpPath, os.ModePerm)
 	if err != nil {
@@ -300,6 +428,74 @@ func collectRuntimeData(tmpPath string) error {
 	return nil
 }
 
+// collectNetworkData checks the connectivity to the cloudcore endpoints in the edgecore config
+func collectNetworkData(tmpPath string, config *v1alpha2.EdgeCoreConfig) error {
+	var lines []string
+	edgeHub := config.Modules.EdgeHub
+	if edgeHub.WebSocket != nil && edgeHub.WebSocket.Enable {
+		lines = append(lines, checkEndpoint("cloudhub websocket", edgeHub.WebSocket.Server))
+	}
+	if edgeHub.Quic != nil && edgeHub.Quic.Enable {
+		lines = append(lines, fmt.Sprintf("cloudhub quic %s: skipped, udp endpoints are not checked", edgeHub.Quic.Server))
+	}
+	if edgeHub.HTTPServer != "" {
+		lines = append(lines, checkEndpoint("cloudhub https", urlHost(edgeHub.HTTPServer)))
+	}
+	if stream := config.Modules.EdgeStream; stream != nil && stream.Enable {
+		lines = append(lines, checkEndpoint("cloudstream tunnel", stream.TunnelServer))
+	}
+	return os.WriteFile(filepath.Join(tmpPath, "connectivity"), []byte(strings.Join(lines, "\n")+"\n"), 0600)
+}
+
+// collectMQTTData checks the connectivity to the MQTT brokers used by eventbus
+func collectMQTTData(tmpPath string, config *v1alpha2.EdgeCoreConfig) error {
+	eventBus := config.Modules.EventBus
+	if eventBus == nil || !eventBus.Enable {
+		return os.WriteFile(filepath.Join(tmpPath, "brokers"), []byte("eventbus is disabled\n"), 0600)
+	}
+
+	var lines []string
+	if eventBus.MqttMode <= v1alpha2.MqttModeBoth {
+		lines = append(lines, checkEndpoint("internal broker", urlHost(eventBus.MqttServerInternal)))
+	}
+	if eventBus.MqttMode >= v1alpha2.MqttModeBoth {
+		lines = append(lines, checkEndpoint("external broker", urlHost(eventBus.MqttServerExternal)))
+		if eventBus.MqttExternalBroker != nil {
+			for _, server := range eventBus.MqttExternalBroker.Servers {
+				lines = append(lines, checkEndpoint("external broker", urlHost(server)))
+			}
+		}
+	}
+	if err := os.WriteFile(filepath.Join(tmpPath, "brokers"), []byte(strings.Join(lines, "\n")+"\n"), 0600); err != nil {
+		return err
+	}
+	// the broker may also run in a container, so the status of the service is best effort
+	if err := ExecuteShell(common.CmdMosquittoStatus, tmpPath); err != nil {
+		printDetail(fmt.Sprintf("get mosquitto service status failed: %v", err))
+	}
+	return nil
+}
+
+// urlHost returns the host:port of rawURL, rawURL is returned if it is not a url
+func urlHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	return u.Host
+}
+
+// checkEndpoint dials the tcp address and returns a line describing the result
+func checkEndpoint(name, address string) string {
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", address, dialTimeout)
+	if err != nil {
+		return fmt.Sprintf("%s %s: unreachable, %v", name, address, err)
+	}
+	conn.Close()
+	return fmt.Sprintf("%s %s: reachable in %v", name, address, time.Since(start).Round(time.Millisecond))
+}
+
 func CopyFile(pathSrc, tmpPath string) error {
 	cmd := util.NewCommand(fmt.Sprintf(common.CmdCopyFile, pathSrc, tmpPath))
 	return cmd.Exec()
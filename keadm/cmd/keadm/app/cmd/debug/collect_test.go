@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactConfig(t *testing.T) {
+	config := `modules:
+  edgeHub:
+    token: abc.def
+    tlsPrivateKeyFile: /etc/kubeedge/certs/server.key
+    websocket:
+      server: 127.0.0.1:10000
+  eventBus:
+    mqttPassword: secret-password
+    mqttExternalBroker:
+      servers:
+      - tcp://127.0.0.1:1883
+      clientSecret: ""
+`
+	data, err := redactConfig([]byte(config))
+	if err != nil {
+		t.Fatalf("failed to redact config: %v", err)
+	}
+	redacted := string(data)
+	for _, secret := range []string{"abc.def", "secret-password"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("expected %s redacted, but got:\n%s", secret, redacted)
+		}
+	}
+	for _, kept := range []string{"/etc/kubeedge/certs/server.key", "127.0.0.1:10000", "tcp://127.0.0.1:1883", `clientSecret: ""`} {
+		if !strings.Contains(redacted, kept) {
+			t.Errorf("expected %s kept, but got:\n%s", kept, redacted)
+		}
+	}
+}
+
+func TestURLHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "tcp://127.0.0.1:1883", want: "127.0.0.1:1883"},
+		{url: "https://cloudcore:10002", want: "cloudcore:10002"},
+		{url: "127.0.0.1:10000", want: "127.0.0.1:10000"},
+	}
+	for _, tt := range tests {
+		if got := urlHost(tt.url); got != tt.want {
+			t.Errorf("urlHost(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package edge
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -40,10 +42,14 @@ devices to cloud
 	edgeJoinExample = `
 keadm join --cloudcore-ipport=<ip:port address> --edgenode-name=<unique string as edge identifier>
 
-  - For this command --cloudcore-ipport flag is a required option
+  - For this command --cloudcore-ipport flag is a required option, unless it is set by the --config file
   - This command will download and install the default version of pre-requisites and KubeEdge
 
 keadm join --cloudcore-ipport=10.20.30.40:10000 --edgenode-name=testing123 --kubeedge-version=v` + common.DefaultKubeEdgeVersion + `
+
+keadm join --config=join.yaml
+
+  - The settings are read from a JoinConfiguration file, see 'keadm config print-default join'
 `
 )
 
@@ -85,6 +91,17 @@ func NewEdgeJoin() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if joinOptions.Config != "" {
+				cfg, err := common.LoadJoinConfiguration(joinOptions.Config)
+				if err != nil {
+					return fmt.Errorf("edge node join failed: %v", err)
+				}
+				cfg.ApplyTo(joinOptions, cmd.Flags())
+			}
+			if joinOptions.CloudCoreIPPort == "" {
+				return fmt.Errorf("required flag \"%s\" not set", common.FlagNameCloudCoreIPPort)
+			}
+
 			ver, err := util.GetCurrentVersion(joinOptions.KubeEdgeVersion)
 			if err != nil {
 				return fmt.Errorf("edge node join failed: %v", err)
@@ -161,3 +178,21 @@ func createBootstrapFile(opt *common.JoinOptions) error {
 	token := []byte(opt.Token)
 	return os.WriteFile(bootstrapFile, token, 0640)
 }
+
+// overrideEdgeCoreModules merges the module overrides of the join configuration
+// into the edgecore configuration, rejecting fields edgecore does not know.
+func overrideEdgeCoreModules(config *v1alpha2.EdgeCoreConfig, modules map[string]interface{}) error {
+	if len(modules) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(modules)
+	if err != nil {
+		return fmt.Errorf("marshal edgecore module overrides failed: %v", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(config.Modules); err != nil {
+		return fmt.Errorf("apply edgecore module overrides failed: %v", err)
+	}
+	return nil
+}
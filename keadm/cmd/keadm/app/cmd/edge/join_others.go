@@ -38,6 +38,7 @@ import (
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2/validation"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
 	pkgutil "github.com/kubeedge/kubeedge/pkg/util"
 	"github.com/kubeedge/viaduct/pkg/api"
 )
@@ -54,11 +55,10 @@ func AddJoinOtherFlags(cmd *cobra.Command, joinOptions *common.JoinOptions) {
 		fmt.Sprintf("The certPath used by edgecore, the default value is %s", common.DefaultCertPath))
 
 	cmd.Flags().StringVarP(&joinOptions.CloudCoreIPPort, common.FlagNameCloudCoreIPPort, "e", joinOptions.CloudCoreIPPort,
-		"IP:Port address of KubeEdge CloudCore")
+		"IP:Port address of KubeEdge CloudCore, required unless set by the join configuration file")
 
-	if err := cmd.MarkFlagRequired(common.FlagNameCloudCoreIPPort); err != nil {
-		fmt.Printf("mark flag required failed with error: %v\n", err)
-	}
+	cmd.Flags().StringVar(&joinOptions.Config, common.FlagNameConfig, joinOptions.Config,
+		fmt.Sprintf("Path of a %s file holding the join settings, flags set on the command line override it. Print a template with 'keadm config print-default join'", common.JoinConfigurationKind))
 
 	cmd.Flags().StringVarP(&joinOptions.EdgeNodeName, common.FlagNameEdgeNodeName, "i", joinOptions.EdgeNodeName,
 		"KubeEdge Node unique identification string, if flag not used then the command will generate a unique id on its own")
@@ -91,7 +91,7 @@ func AddJoinOtherFlags(cmd *cobra.Command, joinOptions *common.JoinOptions) {
 	)
 
 	cmd.Flags().StringVar(&joinOptions.HubProtocol, common.HubProtocol, joinOptions.HubProtocol,
-		`Use this key to decide which communication protocol the edge node adopts.`)
+		`Use this key to decide which communication protocol the edge node adopts, one of websocket, quic and grpc.`)
 
 	cmd.Flags().StringVar(&joinOptions.Sets, common.FlagNameSet, joinOptions.Sets,
 		`Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)`)
@@ -155,11 +155,27 @@ func createEdgeConfigFiles(opt *common.JoinOptions) error {
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = opt.CloudCoreIPPort
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultWebSocketPort))
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
 	case api.ProtocolTypeWS:
 		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = true
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultQuicPort))
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = opt.CloudCoreIPPort
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
+	case grpchub.ProtocolTypeGRPC:
+		if edgeCoreConfig.Modules.EdgeHub.GRPC == nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC = v1alpha2.NewDefaultEdgeCoreConfig().Modules.EdgeHub.GRPC
+		}
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = true
+		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Server = opt.CloudCoreIPPort
+		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultQuicPort))
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultWebSocketPort))
 	default:
 		return fmt.Errorf("unsupported hub of protocol: %s", opt.HubProtocol)
 	}
@@ -168,6 +184,12 @@ func createEdgeConfigFiles(opt *common.JoinOptions) error {
 	if len(opt.Labels) > 0 {
 		edgeCoreConfig.Modules.Edged.NodeLabels = setEdgedNodeLabels(opt)
 	}
+	if len(opt.Taints) > 0 {
+		edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.RegisterWithTaints = opt.Taints
+	}
+	if err := overrideEdgeCoreModules(edgeCoreConfig, opt.EdgeCoreModules); err != nil {
+		return err
+	}
 	if len(opt.Sets) > 0 {
 		if err := util.ParseSet(edgeCoreConfig, opt.Sets); err != nil {
 			return err
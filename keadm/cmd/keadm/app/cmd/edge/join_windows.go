@@ -19,14 +19,12 @@ limitations under the License.
 package edge
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/blang/semver"
@@ -40,6 +38,7 @@ import (
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2/validation"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
 	pkgutil "github.com/kubeedge/kubeedge/pkg/util"
 	"github.com/kubeedge/viaduct/pkg/api"
 )
@@ -53,11 +52,10 @@ func AddJoinOtherFlags(cmd *cobra.Command, joinOptions *common.JoinOptions) {
 		fmt.Sprintf("The certPath used by edgecore, the default value is %s", common.DefaultCertPath))
 
 	cmd.Flags().StringVarP(&joinOptions.CloudCoreIPPort, common.FlagNameCloudCoreIPPort, "e", joinOptions.CloudCoreIPPort,
-		"IP:Port address of KubeEdge CloudCore")
+		"IP:Port address of KubeEdge CloudCore, required unless set by the join configuration file")
 
-	if err := cmd.MarkFlagRequired(common.FlagNameCloudCoreIPPort); err != nil {
-		fmt.Printf("mark flag required failed with error: %v\n", err)
-	}
+	cmd.Flags().StringVar(&joinOptions.Config, common.FlagNameConfig, joinOptions.Config,
+		fmt.Sprintf("Path of a %s file holding the join settings, flags set on the command line override it. Print a template with 'keadm config print-default join'", common.JoinConfigurationKind))
 
 	cmd.Flags().StringVarP(&joinOptions.EdgeNodeName, common.FlagNameEdgeNodeName, "i", joinOptions.EdgeNodeName,
 		"KubeEdge Node unique identification string, if flag not used then the command will generate a unique id on its own")
@@ -79,7 +77,7 @@ func AddJoinOtherFlags(cmd *cobra.Command, joinOptions *common.JoinOptions) {
 	)
 
 	cmd.Flags().StringVar(&joinOptions.HubProtocol, common.HubProtocol, joinOptions.HubProtocol,
-		`Use this key to decide which communication protocol the edge node adopts.`)
+		`Use this key to decide which communication protocol the edge node adopts, one of websocket, quic and grpc.`)
 
 	cmd.Flags().StringVar(&joinOptions.Sets, common.FlagNameSet, joinOptions.Sets,
 		`Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)`)
@@ -142,11 +140,27 @@ func createEdgeConfigFiles(opt *common.JoinOptions) error {
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = opt.CloudCoreIPPort
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultWebSocketPort))
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
 	case api.ProtocolTypeWS:
 		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = true
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultQuicPort))
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = opt.CloudCoreIPPort
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
+	case grpchub.ProtocolTypeGRPC:
+		if edgeCoreConfig.Modules.EdgeHub.GRPC == nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC = v1alpha2.NewDefaultEdgeCoreConfig().Modules.EdgeHub.GRPC
+		}
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = true
+		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Server = opt.CloudCoreIPPort
+		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultQuicPort))
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(host, strconv.Itoa(constants.DefaultWebSocketPort))
 	default:
 		return fmt.Errorf("unsupported hub of protocol: %s", opt.HubProtocol)
 	}
@@ -155,6 +169,12 @@ func createEdgeConfigFiles(opt *common.JoinOptions) error {
 	if len(opt.Labels) > 0 {
 		edgeCoreConfig.Modules.Edged.NodeLabels = setEdgedNodeLabels(opt)
 	}
+	if len(opt.Taints) > 0 {
+		edgeCoreConfig.Modules.Edged.TailoredKubeletConfig.RegisterWithTaints = opt.Taints
+	}
+	if err := overrideEdgeCoreModules(edgeCoreConfig, opt.EdgeCoreModules); err != nil {
+		return err
+	}
 
 	if len(opt.Sets) > 0 {
 		if err := util.ParseSet(edgeCoreConfig, opt.Sets); err != nil {
@@ -174,8 +194,9 @@ func join(opt *common.JoinOptions, step *common.Step) error {
 		return err
 	}
 
-	if err := prepareWindowsNssm(step); err != nil {
-		return fmt.Errorf("prepare windows nssm service fail: %v", err)
+	step.Printf("Check container runtime")
+	if err := util.CheckContainerRuntime(opt.RemoteRuntimeEndpoint); err != nil {
+		return fmt.Errorf("%v, please install containerd and start its windows service", err)
 	}
 
 	step.Printf("Check edge bin exist")
@@ -192,15 +213,14 @@ func join(opt *common.JoinOptions, step *common.Step) error {
 	}
 
 	step.Printf("Register edgecore as windows service")
-	if err := util.InstallNSSMService(util.KubeEdgeBinaryName, filepath.Join(util.KubeEdgeUsrBinPath, util.KubeEdgeBinaryName+".exe"), "--config", filepath.Join(util.KubeEdgePath, "config/edgecore.yaml")); err != nil {
-		return fmt.Errorf("install edgecore useing nssm fail: %v", err)
+	var dependencies []string
+	if util.IsWindowsServiceExist(util.ContainerdServiceName) {
+		dependencies = append(dependencies, util.ContainerdServiceName)
 	}
-
-	if err := util.SetNSSMServiceStdout(util.KubeEdgeBinaryName, filepath.Join(util.KubeEdgeLogPath, "out.log")); err != nil {
-		return fmt.Errorf("setting edgecore stdout log using nssm fail: %v", err)
-	}
-	if err := util.SetNSSMServiceStderr(util.KubeEdgeBinaryName, filepath.Join(util.KubeEdgeLogPath, "err.log")); err != nil {
-		return fmt.Errorf("setting edgecore stderr log using nssm fail: %v", err)
+	if err := util.InstallWindowsService(util.KubeEdgeBinaryName, "KubeEdge edge node agent",
+		filepath.Join(util.KubeEdgeUsrBinPath, util.KubeEdgeBinaryName+".exe"), dependencies,
+		"--config", filepath.Join(util.KubeEdgePath, "config/edgecore.yaml")); err != nil {
+		return fmt.Errorf("register edgecore as windows service failed: %v", err)
 	}
 
 	// write token to bootstrap configure file
@@ -241,25 +261,5 @@ func join(opt *common.JoinOptions, step *common.Step) error {
 }
 
 func runEdgeCore(_ bool) error {
-	return util.StartNSSMService(util.KubeEdgeBinaryName)
-}
-
-func prepareWindowsNssm(step *common.Step) error {
-	step.Printf("Check if nssm installed")
-	if util.IsNSSMInstalled() {
-		return nil
-	}
-
-	fmt.Print("[join] Nssm not found, auto install now? [y/N]: ")
-	s := bufio.NewScanner(os.Stdin)
-	s.Scan()
-	if err := s.Err(); err != nil {
-		return err
-	}
-	if strings.ToLower(s.Text()) != "y" {
-		return fmt.Errorf("aborted join operation, please install nssm manually and retry")
-	}
-
-	step.Printf("Nssm not found, start install under $env:ProgramFiles\\nssm")
-	return util.InstallNSSM()
+	return util.StartWindowsService(util.KubeEdgeBinaryName, util.DefaultServiceTimeout)
 }
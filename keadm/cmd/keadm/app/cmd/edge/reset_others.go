@@ -24,21 +24,32 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	phases "k8s.io/kubernetes/cmd/kubeadm/app/cmd/phases/reset"
 	utilsexec "k8s.io/utils/exec"
 
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+	crdclientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
 )
 
 var (
 	resetLongDescription = `
 keadm reset edge command can be executed edge node
-In edge node it shuts down the edge processes of KubeEdge
+In edge node it cordons the node, waits for its pods to be evicted and shuts down the edge processes of KubeEdge.
+With --kubeconfig, or --kube-apiserver and --token, it also deletes the node, its stale pods, objectsyncs and devices in the cloud.
 `
 	resetExample = `
 For edge node edge:
 keadm reset edge
+
+Preview the reset and the objects to delete in the cloud:
+keadm reset edge --dry-run --kubeconfig=/root/.kube/config
+
+Delete the node in the cloud, stopping the pods which are not evicted in 5 minutes:
+keadm reset edge --force --drain-timeout=5m --kube-apiserver=https://192.168.0.1:6443 --token=<token>
 `
 )
 
@@ -64,7 +75,7 @@ func NewOtherEdgeReset() *cobra.Command {
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !reset.Force {
+			if !reset.Force && !reset.DryRun {
 				fmt.Println("[reset] WARNING: Changes made to this host by 'keadm init' or 'keadm join' will be reverted.")
 				fmt.Print("[reset] Are you sure you want to proceed? [y/N]: ")
 				s := bufio.NewScanner(os.Stdin)
@@ -77,6 +88,11 @@ func NewOtherEdgeReset() *cobra.Command {
 				}
 			}
 
+			kubeClient, crdClient, err := util.CloudClients(reset)
+			if err != nil {
+				return err
+			}
+
 			staticPodPath := ""
 			config, err := util.ParseEdgecoreConfig(common.EdgecoreConfigPath)
 			if err != nil {
@@ -87,6 +103,11 @@ func NewOtherEdgeReset() *cobra.Command {
 				}
 				staticPodPath = config.Modules.Edged.TailoredKubeletConfig.StaticPodPath
 			}
+
+			if reset.DryRun {
+				return dryRunReset(reset, config, staticPodPath, isEdgeNode, kubeClient, crdClient)
+			}
+
 			// first cleanup edge node static pod directory to stop static and mirror pod
 			if staticPodPath != "" {
 				if err := phases.CleanDir(staticPodPath); err != nil {
@@ -97,24 +118,38 @@ func NewOtherEdgeReset() *cobra.Command {
 				}
 			}
 
-			// 1. kill edgecore process.
-			// For edgecore, don't delete node from K8S
+			// 1. cordon the node and wait for its pods to be evicted while edgecore is still running
+			if config != nil {
+				if err := drainEdgeNode(reset, config, kubeClient); err != nil {
+					return err
+				}
+			}
+
+			// 2. kill edgecore process.
 			if err := TearDownEdgeCore(); err != nil {
 				return err
 			}
 
-			// 2. Remove containers managed by KubeEdge. Only for edge node.
+			// 3. Stop and remove containers managed by KubeEdge. Only for edge node.
+			if err := util.StopContainers(reset.Endpoint, util.DefaultStopGracePeriod); err != nil {
+				fmt.Printf("Failed to stop containers: %v\n", err)
+			}
 			if err := util.RemoveContainers(reset.Endpoint, utilsexec.New()); err != nil {
 				fmt.Printf("Failed to remove containers: %v\n", err)
 			}
 
-			// 3. Clean stateful directories
+			// 4. Delete the node and the objects bound to it in the cloud
+			if kubeClient != nil && config != nil {
+				if err := util.CleanupCloudNode(kubeClient, crdClient, config.Modules.Edged.HostnameOverride, false); err != nil {
+					fmt.Printf("Failed to clean up node in the cloud: %v\n", err)
+				}
+			}
+
+			// 5. Clean stateful directories
 			if err := util.CleanDirectories(isEdgeNode); err != nil {
 				return err
 			}
 
-			//4. TODO: clean status information
-
 			return nil
 		},
 	}
@@ -133,7 +168,90 @@ func TearDownEdgeCore() error {
 	return nil
 }
 
+// drainEdgeNode deregisters the edge node from cloudcore, which cordons the node and evicts its
+// pods, and waits for the pods to be stopped by edgecore
+func drainEdgeNode(reset *common.ResetOptions, config *v1alpha2.EdgeCoreConfig, kubeClient kubernetes.Interface) error {
+	nodeName := config.Modules.Edged.HostnameOverride
+	if err := util.DeregisterNode(config); err != nil {
+		fmt.Printf("[reset] Failed to deregister node %s from cloudcore: %v\n", nodeName, err)
+		if kubeClient == nil {
+			fmt.Println("[reset] WARNING: the pods are not evicted, they will be stopped with edgecore")
+			return nil
+		}
+		if err := util.DeregisterNodeWithKubeClient(kubeClient, nodeName); err != nil {
+			fmt.Printf("[reset] WARNING: failed to cordon node %s, the pods will be stopped with edgecore: %v\n", nodeName, err)
+			return nil
+		}
+	}
+
+	fmt.Printf("[reset] Node %s is cordoned, waiting up to %v for its pods to be evicted\n", nodeName, reset.DrainTimeout)
+	pods, err := util.WaitForPodsEvicted(reset.Endpoint, reset.DrainTimeout)
+	if err != nil {
+		fmt.Printf("[reset] WARNING: failed to wait for the pods to be evicted: %v\n", err)
+		return nil
+	}
+	if len(pods) == 0 {
+		fmt.Println("[reset] All pods are evicted")
+		return nil
+	}
+	names := podNames(pods)
+	if !reset.Force {
+		return fmt.Errorf("timed out waiting for pods %s to be evicted, the node stays cordoned until it joins again, "+
+			"use --force to stop the pods anyway", names)
+	}
+	fmt.Printf("[reset] WARNING: timed out waiting for pods %s to be evicted, they will be stopped with edgecore\n", names)
+	return nil
+}
+
+// dryRunReset prints the steps of the reset without changing anything
+func dryRunReset(reset *common.ResetOptions, config *v1alpha2.EdgeCoreConfig, staticPodPath string, isEdgeNode bool,
+	kubeClient kubernetes.Interface, crdClient crdclientset.Interface) error {
+	if staticPodPath != "" {
+		fmt.Printf("[reset] [dry-run] Would delete static pod directory %s\n", staticPodPath)
+	}
+	if config != nil {
+		fmt.Printf("[reset] [dry-run] Would deregister node %s from cloudcore %s and wait up to %v for its pods to be evicted\n",
+			config.Modules.Edged.HostnameOverride, config.Modules.EdgeHub.HTTPServer, reset.DrainTimeout)
+	}
+	if pods, err := util.ListKubePods(reset.Endpoint); err != nil {
+		fmt.Printf("[reset] [dry-run] Failed to list pods: %v\n", err)
+	} else if len(pods) > 0 {
+		fmt.Printf("[reset] [dry-run] Would evict pods %s\n", podNames(pods))
+	}
+	fmt.Println("[reset] [dry-run] Would stop edgecore")
+	fmt.Println("[reset] [dry-run] Would stop and remove the containers managed by KubeEdge")
+
+	if kubeClient == nil || config == nil {
+		fmt.Println("[reset] [dry-run] Would keep the node in the cloud, --kubeconfig or --kube-apiserver and --token are not given")
+	} else if err := util.CleanupCloudNode(kubeClient, crdClient, config.Modules.Edged.HostnameOverride, true); err != nil {
+		return err
+	}
+
+	fmt.Printf("[reset] [dry-run] Would delete directories %s\n", strings.Join(util.ResetDirectories(isEdgeNode), ", "))
+	return nil
+}
+
+func podNames(pods []*runtimeapi.PodSandbox) string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Metadata.Namespace+"/"+pod.Metadata.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
 func addResetFlags(cmd *cobra.Command, resetOpts *common.ResetOptions) {
 	cmd.Flags().BoolVar(&resetOpts.Force, "force", resetOpts.Force,
-		"Reset the node without prompting for confirmation")
+		"Reset the node without prompting for confirmation, and stop the pods which are not evicted before the drain timeout")
+	cmd.Flags().BoolVar(&resetOpts.DryRun, "dry-run", resetOpts.DryRun,
+		"Print the steps of the reset and the objects to clean up without changing anything")
+	cmd.Flags().DurationVar(&resetOpts.DrainTimeout, "drain-timeout", resetOpts.DrainTimeout,
+		"The time to wait for the pods of the node to be evicted")
+	cmd.Flags().StringVar(&resetOpts.Kubeconfig, common.FlagNameKubeConfig, resetOpts.Kubeconfig,
+		"Use this key to delete the node and the objects bound to it in the cloud")
+	cmd.Flags().StringVar(&resetOpts.KubeAPIServer, "kube-apiserver", resetOpts.KubeAPIServer,
+		"The address of the apiserver to delete the node and the objects bound to it in the cloud, used with --token")
+	cmd.Flags().StringVar(&resetOpts.Token, "token", resetOpts.Token,
+		"The bearer token to access the apiserver given by --kube-apiserver")
+	cmd.Flags().StringVar(&resetOpts.KubeCAFile, "kube-ca-file", resetOpts.KubeCAFile,
+		"The CA file to verify the apiserver given by --kube-apiserver, the system roots are used if it is not given")
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultIPPaths are the values holding an IP address, or a list of them, checked
+// together with Options.IPPaths when Options.ValidateAddresses is set.
+var DefaultIPPaths = []string{
+	"cloudCore.modules.cloudHub.advertiseAddress",
+	"modules.edged.nodeIP",
+}
+
+// DefaultCIDRPaths are the values holding a CIDR, or a list of them, checked
+// together with Options.CIDRPaths when Options.ValidateAddresses is set.
+var DefaultCIDRPaths = []string{
+	"modules.edged.tailoredKubeletConfig.podCIDR",
+}
+
+// checkAddresses verifies that the values at the IP and CIDR paths, IPv4 or IPv6,
+// are well-formed. Paths which are not set and empty strings are skipped.
+func (opts *Options) checkAddresses(vals map[string]interface{}) error {
+	ipPaths := append(append([]string{}, DefaultIPPaths...), opts.IPPaths...)
+	for _, path := range ipPaths {
+		if err := checkAddressPath(vals, path, "IP address", func(s string) error {
+			_, err := netip.ParseAddr(s)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	cidrPaths := append(append([]string{}, DefaultCIDRPaths...), opts.CIDRPaths...)
+	for _, path := range cidrPaths {
+		if err := checkAddressPath(vals, path, "CIDR", func(s string) error {
+			_, err := netip.ParsePrefix(s)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkAddressPath checks the string, or list of strings, at path with parse.
+func checkAddressPath(vals map[string]interface{}, path, kind string, parse func(string) error) error {
+	v, ok := lookupPath(vals, path)
+	if !ok {
+		return nil
+	}
+	check := func(path string, v interface{}) error {
+		s, ok := v.(string)
+		if !ok {
+			return newValuesError(ErrValidation, "", path, errors.Errorf("path %s expects a string, but got %#v", path, v))
+		}
+		if s == "" {
+			return nil
+		}
+		if err := parse(s); err != nil {
+			return newValuesError(ErrValidation, "", path, errors.Errorf("path %s value %q is not a valid %s", path, s, kind))
+		}
+		return nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		return check(path, v)
+	}
+	for i, item := range list {
+		if err := check(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
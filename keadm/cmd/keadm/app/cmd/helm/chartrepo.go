@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/registry"
+	"k8s.io/klog/v2"
+)
+
+// dirChartCache is the directory in the helm repository cache holding the charts
+// downloaded by keadm, named by their sha256 digest.
+const dirChartCache = "keadm-charts"
+
+// ChartSource locates a chart outside of the charts built into keadm.
+type ChartSource struct {
+	// Repo is the URL of a classic chart repository, or an oci:// reference of the chart
+	Repo string
+	// Version is the chart version, the latest one if empty
+	Version string
+	// Digest is the expected sha256 digest of the chart archive, "sha256:<hex>" or
+	// only the hex. Charts with a digest are cached and used without downloading
+	// them again, so a pinned chart keeps working without network access.
+	Digest string
+}
+
+// LoadChart returns the chart called name from the source, downloading it if needed.
+func (s ChartSource) LoadChart(name string) (*chart.Chart, error) {
+	digest := strings.TrimPrefix(s.Digest, "sha256:")
+	cached := filepath.Join(helmSettings.RepositoryCache, dirChartCache, digest+".tgz")
+	if digest != "" {
+		if sum, err := fileSHA256(cached); err == nil && sum == digest {
+			klog.V(2).Infof("using the cached chart %s", cached)
+			return loader.Load(cached)
+		}
+	}
+
+	path, err := s.locate(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %s from %s, err: %v", name, s.Repo, err)
+	}
+	sum, err := fileSHA256(path)
+	if err != nil {
+		return nil, err
+	}
+	if digest != "" {
+		if sum != digest {
+			return nil, fmt.Errorf("chart %s from %s has the digest sha256:%s, expected sha256:%s", name, s.Repo, sum, digest)
+		}
+		if err := cacheChart(path, cached); err != nil {
+			klog.Warningf("failed to cache chart %s, err: %v", path, err)
+		}
+	}
+	return loader.Load(path)
+}
+
+// locate downloads the chart into the helm repository cache, returning its path.
+func (s ChartSource) locate(name string) (string, error) {
+	client, err := registry.NewClient(registry.ClientOptCredentialsFile(helmSettings.RegistryConfig))
+	if err != nil {
+		return "", err
+	}
+	install := action.NewInstall(&action.Configuration{RegistryClient: client})
+	install.Version = s.Version
+	if registry.IsOCI(s.Repo) {
+		return install.LocateChart(s.Repo, helmSettings)
+	}
+	install.RepoURL = s.Repo
+	return install.LocateChart(name, helmSettings)
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func cacheChart(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0644)
+}
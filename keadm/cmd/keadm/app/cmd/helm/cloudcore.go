@@ -17,13 +17,17 @@ package helm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/fs"
+	"os"
 	"path"
 	"strings"
 	"time"
 
 	"github.com/blang/semver"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
 	helmcli "helm.sh/helm/v3/pkg/cli"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -53,6 +57,14 @@ REVISION: %d
 `
 	messageFormatFinalValues = "FINAL VALUES:\n%s"
 
+	messageFormatDefaultValues = "DEFAULT VALUES:\n%s"
+
+	messageFormatValuesPatch = "VALUES PATCH:\n%s\n"
+
+	messageFormatManifests = "MANIFESTS:\n%s\n"
+
+	messageFormatManifestsDiff = "MANIFESTS DIFF:\n%s"
+
 	messageFormatUpgradationPrintConfig = `This is cloudcore configuration of the previous version.
 If you want to revert configuration items, please manually modify the configmap 'cloudcore' 
 and restart the cloudcore:
@@ -103,38 +115,35 @@ func (c *CloudCoreHelmTool) Install(opts *types.InitOptions) error {
 
 	fmt.Println("Kubernetes version verification passed, KubeEdge installation will start...")
 
-	appendDefaultSets(opts.KubeEdgeVersion, opts.AdvertiseAddress, &opts.CloudInitUpdateBase)
-	// Load profile values, and merges the sets flag
-	var vals map[string]interface{}
-	var err error
-	if opts.Profile != "" {
-		// Load profile values, and merges the sets flag
-		vals, err = MergeProfileValues(getValuesFile(opts.Profile), opts.GetValidSets())
-		if err != nil {
-			return err
-		}
-	} else {
-		valueOpts := &Options{
-			Values: opts.GetValidSets(),
-		}
-		vals, err = valueOpts.MergeValues()
-		if err != nil {
-			return err
-		}
+	version := opts.KubeEdgeVersion
+	if v := profileVersion(opts.Profiles); v != "" {
+		version = v
 	}
+	if err := appendHASets(opts.HAOptions, &opts.CloudInitUpdateBase); err != nil {
+		return err
+	}
+	appendDefaultSets(version, opts.AdvertiseAddress, &opts.CloudInitUpdateBase)
 
 	// TODO: think about how to support addons, and should we support addons?
 	subDir := path.Join(dirCharts, cloudCoreHelmComponent)
 	componentName := cloudCoreHelmComponent
 
 	// Build a new renderer instance
-	renderer := NewGenericRenderer(kecharts.BuiltinOrDir(opts.ExternalHelmRoot),
-		subDir, componentName, constants.SystemNamespace, vals, opts.SkipCRDs)
+	files := kecharts.BuiltinOrDir(opts.ExternalHelmRoot)
+	renderer := NewGenericRenderer(files,
+		subDir, componentName, constants.SystemNamespace, nil, opts.SkipCRDs)
 	// Load the charts to this renderer
-	if err := renderer.LoadChart(); err != nil {
+	if err := loadCloudCoreChart(renderer, opts.CloudInitUpdateBase); err != nil {
 		return fmt.Errorf("cannot load the given charts %s, error: %s", renderer.componentName, err.Error())
 	}
 
+	// Load profile values, and merges the sets flag
+	vals, err := mergeCloudCoreValues(&opts.CloudInitUpdateBase, opts.Profiles, nil, files, renderer.chart)
+	if err != nil {
+		return err
+	}
+	renderer.profileValsMap = vals
+
 	helper, err := NewHelper(opts.KubeConfig, constants.SystemNamespace)
 	if err != nil {
 		return err
@@ -175,6 +184,9 @@ func (c *CloudCoreHelmTool) Install(opts *types.InitOptions) error {
 		rel.Namespace,
 		rel.Info.Status.String(),
 		rel.Version)
+	if opts.DryRun {
+		fmt.Printf(messageFormatManifests, rel.Manifest)
+	}
 	if opts.PrintFinalValues {
 		cfgyml, err := yaml.Marshal(rel.Config)
 		if err != nil {
@@ -182,6 +194,9 @@ func (c *CloudCoreHelmTool) Install(opts *types.InitOptions) error {
 		}
 		fmt.Printf(messageFormatFinalValues, string(cfgyml))
 	}
+	if opts.ShowDefaults {
+		printDefaultsReport(renderer.chart.Values, vals)
+	}
 	return nil
 }
 
@@ -206,50 +221,65 @@ func (c *CloudCoreHelmTool) Upgrade(opts *types.CloudUpgradeOptions) error {
 		return err
 	}
 	// Determine whether the cloudcore release has been installed
-	if rel, err := helper.GetRelease(componentName); err != nil {
+	deployed, err := helper.GetRelease(componentName)
+	if err != nil {
 		return err
-	} else if rel == nil {
+	} else if deployed == nil {
 		return fmt.Errorf("the cloudcore release not found, and you can init the cloudcore using the `keadm init`")
 	}
 
-	appendDefaultSets(opts.KubeEdgeVersion, opts.AdvertiseAddress, &opts.CloudInitUpdateBase)
-
-	var vals map[string]interface{}
-	if len(opts.ValueFiles) == 0 && opts.Profile != "" {
-		// Load profile values, and merges the sets flag
-		vals, err = MergeProfileValues(getValuesFile(opts.Profile), opts.GetValidSets())
-		if err != nil {
-			return err
-		}
-	} else {
-		valueOpts := &Options{
-			ValueFiles: opts.ValueFiles,
-			Values:     opts.GetValidSets(),
-		}
-		vals, err = valueOpts.MergeValues()
-		if err != nil {
-			return err
-		}
+	// The profiles are ignored when value files are given
+	var profiles []string
+	if len(opts.ValueFiles) == 0 {
+		profiles = opts.Profiles
+	}
+	version := opts.KubeEdgeVersion
+	if v := profileVersion(profiles); v != "" {
+		version = v
 	}
+	appendDefaultSets(version, opts.AdvertiseAddress, &opts.CloudInitUpdateBase)
 
 	// Build a new renderer instance
-	renderer := NewGenericRenderer(kecharts.BuiltinOrDir(""),
-		subDir, componentName, constants.SystemNamespace, vals, false)
+	files := kecharts.BuiltinOrDir("")
+	renderer := NewGenericRenderer(files,
+		subDir, componentName, constants.SystemNamespace, nil, false)
 	// Load the charts to this renderer
-	if err := renderer.LoadChart(); err != nil {
+	if err := loadCloudCoreChart(renderer, opts.CloudInitUpdateBase); err != nil {
 		return fmt.Errorf("cannot load the given charts %s, err: %s", renderer.componentName, err.Error())
 	}
 
+	// Load profile values, and merges the value files and the sets flag
+	vals, err := mergeCloudCoreValues(&opts.CloudInitUpdateBase, profiles, opts.ValueFiles, files, renderer.chart)
+	if err != nil {
+		return err
+	}
+	renderer.profileValsMap = vals
+
 	// Upgrade the helm release cloudcore
 	client := action.NewUpgrade(helper.GetConfig())
 	client.ReuseValues = opts.ReuseValues
+	upgradeVals := vals
+	if opts.ValuesDiffOnly {
+		live, err := helper.GetValues(componentName)
+		if err != nil {
+			return err
+		}
+		// Reusing the last values, the nulls of the patch remove the values dropped since then
+		upgradeVals = DiffValues(live, vals)
+		client.ReuseValues = true
+		patch, err := json.Marshal(upgradeVals)
+		if err != nil {
+			return fmt.Errorf("failed to marshal values patch, err: %v", err)
+		}
+		fmt.Printf(messageFormatValuesPatch, patch)
+	}
 	client.DryRun = opts.DryRun
 	// If the flag force is true, don't wait for the command result of helm upgrade
 	if !opts.Force {
 		client.Wait = defaultHelmWait
 		client.Timeout = DefaultHelmTimeout
 	}
-	rel, err := client.Run(renderer.componentName, renderer.chart, vals)
+	rel, err := client.Run(renderer.componentName, renderer.chart, upgradeVals)
 	if err != nil {
 		return fmt.Errorf("failed to upgrade release %s, err: %v", renderer.componentName, err)
 	}
@@ -267,6 +297,13 @@ func (c *CloudCoreHelmTool) Upgrade(opts *types.CloudUpgradeOptions) error {
 		rel.Info.Status.String(),
 		rel.Version)
 
+	if opts.DryRun {
+		if opts.Diff {
+			fmt.Printf(messageFormatManifestsDiff, DiffManifests(deployed.Manifest, rel.Manifest))
+		} else {
+			fmt.Printf(messageFormatManifests, rel.Manifest)
+		}
+	}
 	if opts.PrintFinalValues {
 		cfgyml, err := yaml.Marshal(rel.Config)
 		if err != nil {
@@ -274,6 +311,9 @@ func (c *CloudCoreHelmTool) Upgrade(opts *types.CloudUpgradeOptions) error {
 		}
 		fmt.Printf(messageFormatFinalValues, string(cfgyml))
 	}
+	if opts.ShowDefaults {
+		printDefaultsReport(renderer.chart.Values, vals)
+	}
 
 	fmt.Printf(messageFormatUpgradationPrintConfig, cloudcoreConfig)
 	return nil
@@ -324,6 +364,46 @@ func appendDefaultSets(version, advertiseAddress string, opts *types.CloudInitUp
 	}
 }
 
+// loadCloudCoreChart loads the chart of the renderer, from the chart repository
+// given by --chart-repo if set.
+func loadCloudCoreChart(renderer *Renderer, opts types.CloudInitUpdateBase) error {
+	if opts.ChartRepo == "" {
+		return renderer.LoadChart()
+	}
+	source := ChartSource{Repo: opts.ChartRepo, Version: opts.ChartVersion, Digest: opts.ChartDigest}
+	ch, err := source.LoadChart(renderer.componentName)
+	if err != nil {
+		return err
+	}
+	renderer.chart = ch
+	return nil
+}
+
+// mergeCloudCoreValues merges the profiles, in order, the value files and the sets
+// flag, validating the result against the --values-schema or the schema of the chart.
+func mergeCloudCoreValues(opts *types.CloudInitUpdateBase, profiles, valueFiles []string,
+	files fs.FS, ch *chart.Chart) (map[string]interface{}, error) {
+	schema := ch.Schema
+	if opts.ValuesSchema != "" {
+		data, err := os.ReadFile(opts.ValuesSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values schema, err: %v", err)
+		}
+		schema = data
+	}
+	valueOpts := &Options{
+		ValueFiles: append(profileValueFiles(profiles), valueFiles...),
+		Values:     opts.GetValidSets(),
+		TrimEmpty:  opts.TrimEmptyValues,
+		Fetchers:   []Fetcher{profileFetcher{files: files}},
+	}
+	if len(schema) > 0 {
+		valueOpts.ValuesSchema = schema
+		valueOpts.ValidateAgainstSchema = true
+	}
+	return valueOpts.MergeValues()
+}
+
 // getCloudcoreHistoryConfig ...
 func getCloudcoreHistoryConfig(kubeconfig, namespace string) (string, error) {
 	kcli, err := util.KubeClient(kubeconfig)
@@ -338,6 +418,15 @@ func getCloudcoreHistoryConfig(kubeconfig, namespace string) (string, error) {
 	return cm.Data["cloudcore.yaml"], nil
 }
 
+// printDefaultsReport prints the chart defaults which are not set in vals.
+func printDefaultsReport(defaults, vals map[string]interface{}) {
+	var report strings.Builder
+	for _, d := range DefaultsReport(defaults, vals) {
+		fmt.Fprintf(&report, "%s: %v\n", d.Path, d.Value)
+	}
+	fmt.Printf(messageFormatDefaultValues, report.String())
+}
+
 // getValuesFile ...
 func getValuesFile(profileKey string) string {
 	pf := profileKey
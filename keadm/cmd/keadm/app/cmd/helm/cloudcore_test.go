@@ -16,10 +16,21 @@ limitations under the License.
 package helm
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
 
 	types "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	kecharts "github.com/kubeedge/kubeedge/manifests"
 )
 
 func TestAppendDefaultSets(t *testing.T) {
@@ -64,3 +75,140 @@ func TestGetValuesFile(t *testing.T) {
 		})
 	}
 }
+
+func TestChartSourceCachedDigest(t *testing.T) {
+	cache := t.TempDir()
+	oldCache := helmSettings.RepositoryCache
+	helmSettings.RepositoryCache = cache
+	defer func() { helmSettings.RepositoryCache = oldCache }()
+
+	ch := &chart.Chart{Metadata: &chart.Metadata{APIVersion: chart.APIVersionV2, Name: "cloudcore", Version: "1.17.0"}}
+	archive, err := chartutil.Save(ch, t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to save chart, err: %v", err)
+	}
+	digest, err := fileSHA256(archive)
+	if err != nil {
+		t.Fatalf("failed to hash chart, err: %v", err)
+	}
+	if err := cacheChart(archive, filepath.Join(cache, dirChartCache, digest+".tgz")); err != nil {
+		t.Fatalf("failed to cache chart, err: %v", err)
+	}
+
+	// The repository does not exist, the pinned chart must come from the cache
+	source := ChartSource{Repo: "https://charts.invalid", Version: "1.17.0", Digest: "sha256:" + digest}
+	loaded, err := source.LoadChart("cloudcore")
+	if err != nil {
+		t.Fatalf("failed to load cached chart, err: %v", err)
+	}
+	if loaded.Metadata.Version != "1.17.0" {
+		t.Fatalf("expected chart version 1.17.0, actual: %s", loaded.Metadata.Version)
+	}
+
+	if err := os.WriteFile(filepath.Join(cache, dirChartCache, digest+".tgz"), []byte("tampered"), 0644); err != nil {
+		t.Fatalf("failed to write chart, err: %v", err)
+	}
+	if _, err := source.LoadChart("cloudcore"); err == nil {
+		t.Fatalf("expected a tampered cached chart not to be used")
+	}
+}
+
+func TestDiffManifests(t *testing.T) {
+	deployment := func(replicas, image string) string {
+		return "# Source: cloudcore/templates/deployment.yaml\napiVersion: apps/v1\nkind: Deployment\nmetadata:\n" +
+			"  name: cloudcore\n  namespace: kubeedge\nspec:\n  replicas: " + replicas + "\n  template:\n    spec:\n" +
+			"      containers:\n      - name: cloudcore\n        image: " + image + "\n"
+	}
+	service := "# Source: cloudcore/templates/service.yaml\napiVersion: v1\nkind: Service\nmetadata:\n  name: cloudcore\n"
+	configMap := "# Source: cloudcore/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cloudcore\n"
+
+	deployed := "---\n" + deployment("1", "kubeedge/cloudcore:v1.16.0") + YAMLSeparator + service
+	rendered := "---\n" + deployment("1", "kubeedge/cloudcore:v1.17.0") + YAMLSeparator + configMap
+
+	diff := DiffManifests(deployed, rendered)
+	for _, want := range []string{
+		"ConfigMap cloudcore has been added:\n+# Source: cloudcore/templates/configmap.yaml\n",
+		"Deployment kubeedge/cloudcore has changed:\n...\n",
+		"-        image: kubeedge/cloudcore:v1.16.0\n+        image: kubeedge/cloudcore:v1.17.0\n",
+		"Service cloudcore has been removed:\n-# Source: cloudcore/templates/service.yaml\n",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Fatalf("expected diff to contain %q, actual:\n%s", want, diff)
+		}
+	}
+	if strings.Contains(diff, "replicas") {
+		t.Fatalf("expected unchanged lines far from the changes to be omitted, actual:\n%s", diff)
+	}
+	if diff := DiffManifests(deployed, deployed); diff != "" {
+		t.Fatalf("expected no diff for identical manifests, actual:\n%s", diff)
+	}
+}
+
+func TestMergeCloudCoreValuesProfiles(t *testing.T) {
+	files := fstest.MapFS{
+		"profiles/version.yaml": {Data: []byte("cloudCore:\n  replicaCount: 1\n  image:\n    tag: v1.15.1\n")},
+		"profiles/ha.yaml":      {Data: []byte("cloudCore:\n  replicaCount: 3\n")},
+	}
+	schema := []byte(`{"type": "object", "additionalProperties": false, "properties": {"cloudCore": {"type": "object",
+		"additionalProperties": false, "properties": {"replicaCount": {"type": "integer"}, "image": {"type": "object"}}}}}`)
+	ch := &chart.Chart{Metadata: &chart.Metadata{Name: "cloudcore"}, Schema: schema}
+	opts := &types.CloudInitUpdateBase{Sets: []string{"cloudCore.image.tag=v1.17.0"}}
+
+	vals, err := mergeCloudCoreValues(opts, []string{"version=v1.17.0", "ha"}, nil, files, ch)
+	if err != nil {
+		t.Fatalf("failed to merge profiles, err: %v", err)
+	}
+	want := map[string]interface{}{
+		"cloudCore": map[string]interface{}{
+			"replicaCount": float64(3),
+			"image":        map[string]interface{}{"tag": "v1.17.0"},
+		},
+	}
+	if !reflect.DeepEqual(vals, want) {
+		t.Fatalf("expected: %v, actual: %v", want, vals)
+	}
+	if v := profileVersion([]string{"version=1.17.0", "ha"}); v != "v1.17.0" {
+		t.Fatalf("expected profile version v1.17.0, actual: %s", v)
+	}
+
+	opts.Sets = []string{"cloudCore.replicaCont=3"}
+	if _, err := mergeCloudCoreValues(opts, []string{"version"}, nil, files, ch); !errors.Is(err, ErrSchemaValidation) {
+		t.Fatalf("expected a typo in --set to be rejected by the schema, actual: %v", err)
+	}
+	opts.Sets = []string{"cloudCore.replicaCount=three"}
+	if _, err := mergeCloudCoreValues(opts, []string{"version"}, nil, files, ch); !errors.Is(err, ErrSchemaValidation) {
+		t.Fatalf("expected a type mismatch to be rejected by the schema, actual: %v", err)
+	}
+	if _, err := mergeCloudCoreValues(opts, []string{"missing"}, nil, files, ch); !errors.Is(err, ErrFetch) {
+		t.Fatalf("expected an unknown profile to fail, actual: %v", err)
+	}
+}
+
+func TestMergeCloudCoreValuesBuiltinSchema(t *testing.T) {
+	renderer := NewGenericRenderer(kecharts.FS, path.Join(dirCharts, cloudCoreHelmComponent), cloudCoreHelmComponent, "kubeedge", nil, false)
+	if err := renderer.LoadChart(); err != nil {
+		t.Fatalf("failed to load chart, err: %v", err)
+	}
+	if len(renderer.chart.Schema) == 0 {
+		t.Fatalf("expected the cloudcore chart to have a values schema")
+	}
+
+	opts := &types.CloudInitUpdateBase{Sets: []string{"cloudCore.modules.cloudHub.advertiseAddress[0]=10.0.0.1",
+		"cloudCore.featureGates.requireAuthorization=true", "cloudCore.service.cloudhubNodePort=30010"}}
+	vals, err := mergeCloudCoreValues(opts, []string{"version=v1.17.0", "ha"}, nil, kecharts.FS, renderer.chart)
+	if err != nil {
+		t.Fatalf("failed to merge the builtin profiles, err: %v", err)
+	}
+	// the chart defaults and the merged values must pass the validation of helm as well
+	renderer.profileValsMap = vals
+	if _, err := renderer.RenderManifest(); err != nil {
+		t.Fatalf("failed to render the chart, err: %v", err)
+	}
+
+	for _, set := range []string{"cloudCore.replicaCont=3", "cloudcore.replicaCount=3", "cloudCore.modules.cloudHub.websocket.prot=10000"} {
+		opts.Sets = []string{set}
+		if _, err := mergeCloudCoreValues(opts, []string{"version"}, nil, kecharts.FS, renderer.chart); !errors.Is(err, ErrSchemaValidation) {
+			t.Errorf("expected the mistyped key of %s to be rejected, actual: %v", set, err)
+		}
+	}
+}
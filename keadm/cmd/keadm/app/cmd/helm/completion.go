@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValuePathsForCompletion merges the value sources of opts and returns every
+// path present in the result, in the --set syntax and sorted, for completing
+// value paths where the chart has no schema.
+func ValuePathsForCompletion(opts *Options) ([]string, error) {
+	vals, err := opts.MergeValues()
+	if err != nil {
+		return nil, err
+	}
+	return flattenPaths(vals), nil
+}
+
+// flattenPaths returns the paths of all values in vals, maps and lists included,
+// in depth-first order of the sorted keys. Dots in keys are escaped like --set expects.
+func flattenPaths(vals map[string]interface{}) []string {
+	var paths []string
+	var walk func(path string, v interface{})
+	walk = func(path string, v interface{}) {
+		if path != "" {
+			paths = append(paths, path)
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for _, k := range sortedKeys(v) {
+				walk(joinPath(path, strings.ReplaceAll(k, ".", `\.`)), v[k])
+			}
+		case []interface{}:
+			for i, item := range v {
+				walk(fmt.Sprintf("%s[%d]", path, i), item)
+			}
+		}
+	}
+	walk("", vals)
+	return paths
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	versionutil "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
+)
+
+// ConfigVersionKey is the top-level key by which a value file declares the
+// generation of the configuration it belongs to, such as "v2.1".
+const ConfigVersionKey = "configVersion"
+
+// configVersions tracks the config versions declared by the value files of a merge.
+type configVersions struct {
+	enforce bool
+	// highest is the highest version declared so far, raw its value as written
+	highest *versionutil.Version
+	raw     interface{}
+	source  string
+}
+
+// check records the version declared by layer, if any. Versions with a different
+// major version than a previous one are an error if enforce is set, otherwise
+// they are logged.
+func (c *configVersions) check(source string, layer map[string]interface{}) error {
+	raw, ok := layer[ConfigVersionKey]
+	if !ok || raw == nil {
+		return nil
+	}
+	v, err := parseConfigVersion(raw)
+	if err != nil {
+		if c.enforce {
+			return newValuesError(ErrParse, source, ConfigVersionKey, errors.Wrapf(err, "invalid %s in %s", ConfigVersionKey, source))
+		}
+		klog.Warningf("ignoring invalid %s %v in %s: %v", ConfigVersionKey, raw, source, err)
+		return nil
+	}
+	if c.highest != nil && v.Major() != c.highest.Major() {
+		msg := fmt.Sprintf("%s %v of %s is incompatible with %s %v of %s", ConfigVersionKey, raw, source, ConfigVersionKey, c.raw, c.source)
+		if c.enforce {
+			return newValuesError(ErrConfigVersion, source, ConfigVersionKey, errors.New(msg))
+		}
+		klog.Warning(msg)
+	}
+	if c.highest == nil || c.highest.LessThan(v) {
+		c.highest, c.raw, c.source = v, raw, source
+	}
+	return nil
+}
+
+// apply sets the highest declared version in the merged values.
+func (c *configVersions) apply(vals map[string]interface{}) {
+	if c.highest != nil {
+		vals[ConfigVersionKey] = c.raw
+	}
+}
+
+// parseConfigVersion parses versions like v2, 2.1 or 2.1.0.
+func parseConfigVersion(raw interface{}) (*versionutil.Version, error) {
+	s := fmt.Sprint(raw)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return versionutil.ParseGeneric(s)
+}
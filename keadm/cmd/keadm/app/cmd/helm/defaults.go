@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+// AppliedDefault is a default value which takes effect because no value source sets its path.
+type AppliedDefault struct {
+	Path  string
+	Value interface{}
+}
+
+// DefaultsReport lists the values of defaults, such as the values.yaml of a chart,
+// which are not set in vals and thus silently end up in the effective values.
+// The result is sorted by path. Empty maps in defaults are reported as a whole.
+func DefaultsReport(defaults, vals map[string]interface{}) []AppliedDefault {
+	var report []AppliedDefault
+	collectDefaults(defaults, vals, "", &report)
+	return report
+}
+
+func collectDefaults(defaults, vals map[string]interface{}, path string, report *[]AppliedDefault) {
+	for _, k := range sortedKeys(defaults) {
+		v, set := vals[k]
+		if dm, ok := defaults[k].(map[string]interface{}); ok && len(dm) > 0 {
+			vm, isMap := v.(map[string]interface{})
+			// A value of another type replaces the whole map of defaults
+			if !set || isMap {
+				collectDefaults(dm, vm, joinPath(path, k), report)
+			}
+			continue
+		}
+		if !set {
+			*report = append(*report, AppliedDefault{Path: joinPath(path, k), Value: defaults[k]})
+		}
+	}
+}
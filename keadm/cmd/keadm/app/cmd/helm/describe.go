@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"strings"
+)
+
+// redactedValue replaces secret values in DescribeInvocation.
+const redactedValue = "<redacted>"
+
+// secretKeyParts are substrings of keys, in lower case, which usually hold secrets.
+var secretKeyParts = []string{"password", "passwd", "secret", "token", "privatekey", "apikey", "credential"}
+
+// DescribeInvocation reconstructs the --values and --set* arguments equivalent to
+// opts, shell-quoted in the order MergeValues applies them, for logs and bug reports.
+// Values of keys that look like secrets, or are listed in RequiredNonEmpty, are
+// redacted. Options without a command line flag are not included.
+func DescribeInvocation(opts Options) string {
+	var args []string
+	for _, f := range opts.ValueFiles {
+		args = append(args, "--values", shellQuote(f))
+	}
+	for _, v := range opts.JSONValues {
+		args = append(args, flagSetJSON, shellQuote(opts.redactFlagValue(flagSetJSON, v)))
+	}
+	for _, v := range opts.Values {
+		args = append(args, flagSet, shellQuote(opts.redactFlagValue(flagSet, v)))
+	}
+	for _, v := range opts.StringValues {
+		args = append(args, flagSetString, shellQuote(opts.redactFlagValue(flagSetString, v)))
+	}
+	// --set-file assigns file names, the contents are not part of the command line
+	for _, v := range opts.FileValues {
+		args = append(args, flagSetFile, shellQuote(v))
+	}
+	for _, v := range opts.LiteralValues {
+		args = append(args, flagSetLiteral, shellQuote(opts.redactFlagValue(flagSetLiteral, v)))
+	}
+	return strings.Join(args, " ")
+}
+
+// redactFlagValue redacts the secrets assigned by a single flag value.
+func (opts *Options) redactFlagValue(flag, value string) string {
+	switch flag {
+	case flagSet, flagSetString:
+		// Redact the pairs one by one, values may hold escaped commas
+		pairs := splitUnescaped(value, ',')
+		for i, pair := range pairs {
+			if key, _, ok := strings.Cut(pair, "="); ok && opts.isSecretPath(key) {
+				pairs[i] = key + "=" + redactedValue
+			}
+		}
+		return strings.Join(pairs, ",")
+	}
+	// JSON and literal values cannot be split reliably, redact all of it
+	layer, err := parseFlagLayer(flag, value)
+	if err != nil || opts.hasSecretPath(layer, "") {
+		key, _, _ := strings.Cut(value, "=")
+		return key + "=" + redactedValue
+	}
+	return value
+}
+
+// isSecretPath reports whether the value at the dotted path is likely a secret.
+func (opts *Options) isSecretPath(path string) bool {
+	for _, p := range opts.RequiredNonEmpty {
+		if p == path {
+			return true
+		}
+	}
+	i := strings.LastIndex(path, ".")
+	key := strings.ToLower(path[i+1:])
+	for _, part := range secretKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSecretPath reports whether vals, found at path, hold a likely secret.
+func (opts *Options) hasSecretPath(vals map[string]interface{}, path string) bool {
+	for k, v := range vals {
+		p := joinPath(path, k)
+		if opts.isSecretPath(p) {
+			return true
+		}
+		if m, ok := v.(map[string]interface{}); ok && opts.hasSecretPath(m, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitUnescaped splits s at every sep which is not escaped by a backslash.
+func splitUnescaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// shellQuote quotes s for POSIX shells if it contains special characters.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_./:=,@%+-") == "" {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"reflect"
+)
+
+// DiffValues returns the minimal changes turning live into desired as a JSON
+// Merge Patch (RFC 7386): changed and added values are set, removed values are
+// null, and maps are compared recursively. Lists are replaced as a whole, as
+// merge patches cannot address list items. The result is empty if both are equal.
+func DiffValues(live, desired map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for _, k := range sortedKeys(live) {
+		if _, ok := desired[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for _, k := range sortedKeys(desired) {
+		dv := desired[k]
+		lv, ok := live[k]
+		if ok && reflect.DeepEqual(lv, dv) {
+			continue
+		}
+		lm, liveIsMap := lv.(map[string]interface{})
+		dm, desiredIsMap := dv.(map[string]interface{})
+		if ok && liveIsMap && desiredIsMap {
+			if sub := DiffValues(lm, dm); len(sub) > 0 {
+				patch[k] = sub
+			}
+			continue
+		}
+		patch[k] = dv
+	}
+	return patch
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// Target is a destination of merged values, such as a node of a fleet rollout.
+type Target struct {
+	// Name identifies the target in errors
+	Name string
+	// Overrides are merged over the shared values for this target only
+	Overrides map[string]interface{}
+	// Write persists or renders the values of the target
+	Write func(vals map[string]interface{}) error
+}
+
+// FileTarget returns a target writing its values as YAML to path. The file is
+// only readable by its owner, as values often include tokens.
+func FileTarget(path string, overrides map[string]interface{}) Target {
+	return Target{
+		Name:      path,
+		Overrides: overrides,
+		Write: func(vals map[string]interface{}) error {
+			data, err := yaml.Marshal(vals)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(path, data, 0600)
+		},
+	}
+}
+
+// MergeAndDistribute merges the values of opts once and writes them, with the
+// overrides of each target, to all targets. A failing target does not stop the
+// others, the failures of all targets are returned together.
+func MergeAndDistribute(opts *Options, targets []Target) error {
+	base, err := opts.MergeValues()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, t := range targets {
+		// mergeMaps copies the maps it changes, base stays the same for every target
+		vals := mergeMaps(base, t.Overrides)
+		if err := t.Write(vals); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to write values to target %s", t.Name))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
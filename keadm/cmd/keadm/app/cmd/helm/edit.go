@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// SetValueInFile sets the dotted path key to value in the YAML value file at
+// filePath and rewrites it in place, keeping comments. The value is typed the way
+// --set does. Only the bytes of the value change when the key already holds a
+// single line scalar, otherwise the file is re-encoded with two space indentation.
+func SetValueInFile(filePath, key, value string) error {
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	out, err := SetValueInDocument(data, key, typedFlagValue(value))
+	if err != nil {
+		return errors.Wrapf(err, "failed to set %s in %s", key, filePath)
+	}
+	return os.WriteFile(filePath, out, fi.Mode().Perm())
+}
+
+// SetValueInDocument returns the YAML document data with the dotted path key set
+// to value, keeping comments, see SetValueInFile.
+func SetValueInDocument(data []byte, key string, value interface{}) ([]byte, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	var valueNode yamlv3.Node
+	if err := valueNode.Encode(value); err != nil {
+		return nil, err
+	}
+	path := strings.Split(key, ".")
+
+	if node := lookupNode(&doc, path); node != nil {
+		if out, ok := spliceScalar(data, node, &valueNode); ok {
+			return out, nil
+		}
+	}
+
+	if doc.Kind == 0 {
+		doc = yamlv3.Node{Kind: yamlv3.DocumentNode, Content: []*yamlv3.Node{{Kind: yamlv3.MappingNode, Tag: "!!map"}}}
+	}
+	if err := setNode(doc.Content[0], path, &valueNode); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// lookupNode returns the value node at path in a YAML document, or nil.
+func lookupNode(doc *yamlv3.Node, path []string) *yamlv3.Node {
+	if len(doc.Content) != 1 {
+		return nil
+	}
+	node := doc.Content[0]
+	for _, key := range path {
+		if node.Kind != yamlv3.MappingNode {
+			return nil
+		}
+		var next *yamlv3.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
+
+// spliceScalar replaces the text of the single line scalar node in data by the
+// text of value, leaving all other bytes untouched.
+func spliceScalar(data []byte, node, value *yamlv3.Node) ([]byte, bool) {
+	if node.Kind != yamlv3.ScalarNode || value.Kind != yamlv3.ScalarNode || node.Anchor != "" ||
+		node.Style&(yamlv3.LiteralStyle|yamlv3.FoldedStyle) != 0 || strings.Contains(node.Value, "\n") {
+		return nil, false
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if node.Line < 1 || node.Line > len(lines) {
+		return nil, false
+	}
+	line := string(lines[node.Line-1])
+	if node.Column < 1 || node.Column > len(line) {
+		return nil, false
+	}
+	rest := strings.TrimRight(line[node.Column-1:], "\r\n")
+	if node.LineComment != "" {
+		if i := strings.LastIndex(rest, node.LineComment); i >= 0 {
+			rest = rest[:i]
+		}
+	}
+	rest = strings.TrimRight(rest, " \t")
+
+	// Make sure rest is exactly the scalar by parsing it on its own
+	var parsed yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(rest), &parsed); err != nil || len(parsed.Content) != 1 ||
+		parsed.Content[0].Kind != yamlv3.ScalarNode || parsed.Content[0].Value != node.Value {
+		return nil, false
+	}
+
+	text, err := yamlv3.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	replacement := strings.TrimSuffix(string(text), "\n")
+	if strings.Contains(replacement, "\n") {
+		return nil, false
+	}
+	start := 0
+	for _, l := range lines[:node.Line-1] {
+		start += len(l)
+	}
+	start += node.Column - 1
+	out := make([]byte, 0, len(data)+len(replacement))
+	out = append(out, data[:start]...)
+	out = append(out, replacement...)
+	out = append(out, data[start+len(rest):]...)
+	return out, true
+}
+
+// setNode sets the value at path under the mapping node, creating missing mappings.
+func setNode(node *yamlv3.Node, path []string, value *yamlv3.Node) error {
+	for i, key := range path {
+		if node.Kind != yamlv3.MappingNode {
+			return errors.Errorf("%s is not a map", strings.Join(path[:i], "."))
+		}
+		var next *yamlv3.Node
+		for j := 0; j+1 < len(node.Content); j += 2 {
+			if node.Content[j].Value == key {
+				next = node.Content[j+1]
+			}
+		}
+		if i == len(path)-1 {
+			if next != nil {
+				// Keep the comments of the replaced value
+				value.HeadComment, value.LineComment, value.FootComment = next.HeadComment, next.LineComment, next.FootComment
+				*next = *value
+				return nil
+			}
+			node.Content = append(node.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, value)
+			return nil
+		}
+		if next == nil {
+			next = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, next)
+		}
+		node = next
+	}
+	return nil
+}
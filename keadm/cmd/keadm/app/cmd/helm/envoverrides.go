@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// envPathSeparator separates the keys of the path in the name of an override variable.
+const envPathSeparator = "__"
+
+// envOverrideLayers returns a layer for every environment variable starting with
+// opts.EnvOverridePrefix, sorted by name. Variables whose names do not encode a
+// valid path, such as PREFIX_a____b, are skipped with a warning.
+func (opts *Options) envOverrideLayers() []Layer {
+	if opts.EnvOverridePrefix == "" {
+		return nil
+	}
+	var names []string
+	values := map[string]string{}
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		if strings.HasPrefix(name, opts.EnvOverridePrefix) {
+			names = append(names, name)
+			values[name] = value
+		}
+	}
+	sort.Strings(names)
+
+	var layers []Layer
+	for _, name := range names {
+		path := strings.Split(strings.TrimPrefix(name, opts.EnvOverridePrefix), envPathSeparator)
+		if !validEnvPath(path) {
+			klog.Warningf("ignoring environment variable %s, it does not encode a values path like %smodules%sedged%snodeIP",
+				name, opts.EnvOverridePrefix, envPathSeparator, envPathSeparator)
+			continue
+		}
+		var value interface{} = values[name]
+		if !opts.EnvOverridesAsStrings {
+			value = typedFlagValue(values[name])
+		}
+		layer := map[string]interface{}{path[len(path)-1]: value}
+		for i := len(path) - 2; i >= 0; i-- {
+			layer = map[string]interface{}{path[i]: layer}
+		}
+		layers = append(layers, Layer{Source: "environment variable " + name, Kind: LayerEnv, Values: layer})
+	}
+	return layers
+}
+
+func validEnvPath(path []string) bool {
+	for _, key := range path {
+		if key == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrorCode is a stable category of the errors returned while merging values.
+// Every code is also an error, so callers can test for a category with
+// errors.Is(err, helm.ErrFetch); errors.As with a *ValuesError gives the details.
+type ErrorCode string
+
+func (c ErrorCode) Error() string {
+	return string(c)
+}
+
+const (
+	// ErrInvalidOptions means the Options themselves are inconsistent.
+	ErrInvalidOptions ErrorCode = "InvalidOptions"
+	// ErrFetch means a value source could not be read.
+	ErrFetch ErrorCode = "FetchError"
+	// ErrParse means a value source or flag value is malformed.
+	ErrParse ErrorCode = "ParseError"
+	// ErrSchemaValidation means a value does not match the values schema.
+	ErrSchemaValidation ErrorCode = "SchemaValidationError"
+	// ErrLockedPath means a value source overrides one of the LockedPaths.
+	ErrLockedPath ErrorCode = "LockedPath"
+	// ErrMergeConflict means the OnConflict callback aborted the merge.
+	ErrMergeConflict ErrorCode = "MergeConflict"
+	// ErrMaxDepth means a value source is nested deeper than Options.MaxDepth.
+	ErrMaxDepth ErrorCode = "MaxDepthExceeded"
+	// ErrMaxSources means there are more value files than Options.MaxSources.
+	ErrMaxSources ErrorCode = "MaxSourcesExceeded"
+	// ErrConfigVersion means value files declare incompatible config versions.
+	ErrConfigVersion ErrorCode = "ConfigVersionMismatch"
+	// ErrValidation means the merged values fail RequiredNonEmpty or the module rules.
+	ErrValidation ErrorCode = "ValidationError"
+)
+
+// ValuesError is an error with its category, the value source and the dotted
+// values path it concerns. Source and Path are empty when they do not apply.
+// The message is the one of the wrapped error.
+type ValuesError struct {
+	Code   ErrorCode
+	Source string
+	Path   string
+	Err    error
+}
+
+func newValuesError(code ErrorCode, source, path string, err error) error {
+	return &ValuesError{Code: code, Source: source, Path: path, Err: err}
+}
+
+func (e *ValuesError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValuesError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the code of e.
+func (e *ValuesError) Is(target error) bool {
+	return target == e.Code
+}
+
+// withSource sets the source of the ValuesError wrapped in err, if it has none yet.
+func withSource(err error, source string) error {
+	var verr *ValuesError
+	if errors.As(err, &verr) && verr.Source == "" {
+		verr.Source = source
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// ExplainValues marshals vals to YAML, with the description of every key in the
+// JSON schema written as a comment above it. Keys without a description are not
+// commented. This documents the effective configuration for operators.
+func ExplainValues(vals map[string]interface{}, schema []byte) ([]byte, error) {
+	s, err := parseValuesSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	var doc yamlv3.Node
+	if err := doc.Encode(vals); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal values")
+	}
+	annotateNode(&doc, s)
+
+	var buf bytes.Buffer
+	enc := yamlv3.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal values")
+	}
+	if err := enc.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal values")
+	}
+	return buf.Bytes(), nil
+}
+
+// annotateNode adds the descriptions of schema to the keys of node and its children.
+func annotateNode(node *yamlv3.Node, schema valuesSchema) {
+	if schema == nil {
+		return
+	}
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, val := node.Content[i], node.Content[i+1]
+			prop := schema.property(key.Value)
+			if d := prop.description(); d != "" {
+				key.HeadComment = d
+			}
+			annotateNode(val, prop)
+		}
+	case yamlv3.SequenceNode:
+		items := schema.items()
+		for _, item := range node.Content {
+			annotateNode(item, items)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// DefaultFeatureGatesPath is the dotted path of the cloudcore feature gates in the values.
+const DefaultFeatureGatesPath = "cloudCore.featureGates"
+
+// FeatureGateRule forbids the gate Conflicts to be in the state ConflictEnabled
+// while Gate is in the state Enabled. Gates missing from the values are disabled,
+// which is the default of all KubeEdge feature gates. Warn only logs the conflict
+// instead of failing the merge.
+type FeatureGateRule struct {
+	Gate            string
+	Enabled         bool
+	Conflicts       string
+	ConflictEnabled bool
+	// Reason explains the conflict to the user
+	Reason string
+	Warn   bool
+}
+
+// DefaultFeatureGateRules are the known incompatible combinations of KubeEdge
+// feature gates, checked together with Options.FeatureGateRules. The current
+// gates, requireAuthorization and moduleRestart, are independent of each other.
+var DefaultFeatureGateRules []FeatureGateRule
+
+// checkFeatureGates verifies the feature gates at opts.FeatureGatesPath against
+// DefaultFeatureGateRules and opts.FeatureGateRules, reporting all conflicts at once.
+func (opts *Options) checkFeatureGates(vals map[string]interface{}) error {
+	rules := append(append([]FeatureGateRule{}, DefaultFeatureGateRules...), opts.FeatureGateRules...)
+	if len(rules) == 0 {
+		return nil
+	}
+	path := opts.FeatureGatesPath
+	if path == "" {
+		path = DefaultFeatureGatesPath
+	}
+	gates, _ := lookupPath(vals, path)
+	gateMap, _ := gates.(map[string]interface{})
+
+	var conflicts []string
+	for _, r := range rules {
+		if gateEnabled(gateMap, r.Gate) != r.Enabled || gateEnabled(gateMap, r.Conflicts) != r.ConflictEnabled {
+			continue
+		}
+		msg := fmt.Sprintf("%s=%t requires %s=%t", r.Gate, r.Enabled, r.Conflicts, !r.ConflictEnabled)
+		if r.Reason != "" {
+			msg += ": " + r.Reason
+		}
+		if r.Warn {
+			klog.Warningf("feature gates at %s conflict, %s", path, msg)
+			continue
+		}
+		conflicts = append(conflicts, msg)
+	}
+	if len(conflicts) > 0 {
+		return newValuesError(ErrValidation, "", path,
+			errors.Errorf("feature gates at %s conflict: %s", path, strings.Join(conflicts, "; ")))
+	}
+	return nil
+}
+
+// gateEnabled reports whether the feature gate is enabled, either by a boolean
+// or by a string such as "true" given via --set-string.
+func gateEnabled(gates map[string]interface{}, gate string) bool {
+	switch v := gates[gate].(type) {
+	case bool:
+		return v
+	case string:
+		enabled, _ := strconv.ParseBool(v)
+		return enabled
+	}
+	return false
+}
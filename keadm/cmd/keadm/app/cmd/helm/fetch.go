@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Fetcher reads value files whose URI has a given scheme, such as "wss" for
+// wss://host/values.yaml. Register custom fetchers via Options.Fetchers.
+type Fetcher interface {
+	// Scheme returns the URI scheme handled by the fetcher, without "://".
+	Scheme() string
+	// Fetch returns the contents of the value file at uri.
+	Fetch(ctx context.Context, uri string) ([]byte, error)
+}
+
+// fileScheme is the scheme of the fetcher reading local files and stdin, which
+// also reads the value files given by a plain path.
+const fileScheme = "file"
+
+type fileFetcher struct{}
+
+func (fileFetcher) Scheme() string {
+	return fileScheme
+}
+
+func (fileFetcher) Fetch(_ context.Context, uri string) ([]byte, error) {
+	return readFile(strings.TrimPrefix(uri, fileScheme+"://"))
+}
+
+type envFetcher struct{}
+
+func (envFetcher) Scheme() string {
+	return strings.TrimSuffix(envScheme, "://")
+}
+
+func (envFetcher) Fetch(_ context.Context, uri string) ([]byte, error) {
+	return readFile(uri)
+}
+
+type webSocketFetcher struct {
+	opts   *Options
+	scheme string
+}
+
+func (f webSocketFetcher) Scheme() string {
+	return f.scheme
+}
+
+func (f webSocketFetcher) Fetch(ctx context.Context, uri string) ([]byte, error) {
+	return f.opts.readWebSocket(ctx, uri)
+}
+
+// fetcher returns the fetcher for uri, preferring Options.Fetchers over the built-in ones.
+func (opts *Options) fetcher(uri string) (Fetcher, bool) {
+	scheme, _, ok := strings.Cut(uri, "://")
+	if !ok {
+		scheme = fileScheme
+	}
+	builtin := []Fetcher{
+		fileFetcher{},
+		envFetcher{},
+		webSocketFetcher{opts: opts, scheme: "ws"},
+		webSocketFetcher{opts: opts, scheme: "wss"},
+	}
+	for _, f := range append(opts.Fetchers, builtin...) {
+		if f.Scheme() == scheme {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// fetch reads the value file at uri with the fetcher of its scheme, within the FetchTimeout.
+// Failures are fetch errors, unless the fetcher reports a more specific ValuesError.
+func (opts *Options) fetch(uri string) ([]byte, error) {
+	f, ok := opts.fetcher(uri)
+	if !ok {
+		return nil, newValuesError(ErrFetch, uri, "", errors.Errorf("no fetcher is registered for the scheme of %s", uri))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), opts.fetchTimeout())
+	defer cancel()
+	data, err := f.Fetch(ctx, uri)
+	if err != nil {
+		var valuesErr *ValuesError
+		if errors.As(err, &valuesErr) {
+			return nil, err
+		}
+		return nil, newValuesError(ErrFetch, uri, "", err)
+	}
+	return data, nil
+}
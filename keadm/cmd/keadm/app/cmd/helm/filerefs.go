@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// checkFileRefs verifies that the values at paths, each a string or a list of
+// strings, name readable local files. All missing or unreadable files are
+// reported at once, paths which are not set and empty strings are skipped.
+func checkFileRefs(vals map[string]interface{}, paths []string) error {
+	var invalid, invalidPaths []string
+	check := func(path string, v interface{}) {
+		file, ok := v.(string)
+		if !ok {
+			invalid = append(invalid, fmt.Sprintf("%s: expects a file path, but got %#v", path, v))
+			invalidPaths = append(invalidPaths, path)
+			return
+		}
+		if file == "" {
+			return
+		}
+		if err := checkReadableFile(file); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", path, err))
+			invalidPaths = append(invalidPaths, path)
+		}
+	}
+	for _, path := range paths {
+		v, ok := lookupPath(vals, path)
+		if !ok {
+			continue
+		}
+		list, ok := v.([]interface{})
+		if !ok {
+			check(path, v)
+			continue
+		}
+		for i, item := range list {
+			check(fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	}
+	if len(invalid) > 0 {
+		return newValuesError(ErrValidation, "", invalidPaths[0],
+			errors.Errorf("invalid file references: %s", strings.Join(invalid, "; ")))
+	}
+	return nil
+}
+
+// checkReadableFile returns an error if file is not a regular file which can be opened for reading.
+func checkReadableFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		return errors.Errorf("%s is a directory", file)
+	}
+	return nil
+}
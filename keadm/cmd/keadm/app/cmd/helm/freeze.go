@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// DeepCopyValues returns a copy of vals sharing no maps or lists with it.
+func DeepCopyValues(vals map[string]interface{}) map[string]interface{} {
+	if vals == nil {
+		return nil
+	}
+	return deepCopyValue(vals).(map[string]interface{})
+}
+
+func deepCopyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = deepCopyValue(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = deepCopyValue(item)
+		}
+		return out
+	}
+	return v
+}
+
+// FrozenValues holds merged values which cannot be modified by callers: every
+// call to Values returns a new copy. The checksum lets callers verify that a map
+// they handed on still holds the frozen values.
+type FrozenValues struct {
+	vals     map[string]interface{}
+	checksum string
+}
+
+// Freeze returns a frozen copy of vals.
+func Freeze(vals map[string]interface{}) (*FrozenValues, error) {
+	sum, err := ValuesChecksum(vals)
+	if err != nil {
+		return nil, err
+	}
+	return &FrozenValues{vals: DeepCopyValues(vals), checksum: sum}, nil
+}
+
+// Values returns a copy of the frozen values.
+func (f *FrozenValues) Values() map[string]interface{} {
+	return DeepCopyValues(f.vals)
+}
+
+// Checksum returns the ValuesChecksum of the frozen values.
+func (f *FrozenValues) Checksum() string {
+	return f.checksum
+}
+
+// Verify returns an error if vals differ from the frozen values, for example
+// because a consumer modified the map returned by Values.
+func (f *FrozenValues) Verify(vals map[string]interface{}) error {
+	sum, err := ValuesChecksum(vals)
+	if err != nil {
+		return err
+	}
+	if sum != f.checksum {
+		return errors.Errorf("values were modified after they were frozen, checksum %s differs from %s", sum, f.checksum)
+	}
+	return nil
+}
+
+// ValuesChecksum returns the hex encoded SHA-256 of the JSON encoding of vals,
+// which does not depend on the order of the keys.
+func ValuesChecksum(vals map[string]interface{}) (string, error) {
+	data, err := json.Marshal(vals)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal values")
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
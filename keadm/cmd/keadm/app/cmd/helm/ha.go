@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	types "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+)
+
+// appendHASets validates the high availability flags and sets the chart values of them via --sets,
+// the values given by --set take precedence. The LoadBalancer IP is advertised to the edge nodes
+// unless --advertise-address is set, so that it is in the SubAltNames of the cloudcore certificate.
+func appendHASets(ha types.HAOptions, opts *types.CloudInitUpdateBase) error {
+	if err := validateHAOptions(ha); err != nil {
+		return err
+	}
+
+	sets := map[string]interface{}{}
+	if ha.Replicas > 0 {
+		sets["cloudCore.replicaCount"] = ha.Replicas
+	}
+	if ha.LeaderElect {
+		sets["cloudCore.leaderElection.enable"] = true
+		for key, d := range map[string]time.Duration{
+			"cloudCore.leaderElection.leaseDuration": ha.LeaderElectLeaseDuration,
+			"cloudCore.leaderElection.renewDeadline": ha.LeaderElectRenewDeadline,
+			"cloudCore.leaderElection.retryPeriod":   ha.LeaderElectRetryPeriod,
+		} {
+			if d > 0 {
+				sets[key] = int64(d / time.Second)
+			}
+		}
+	}
+	if ha.ServiceType != "" {
+		sets["cloudCore.service.type"] = ha.ServiceType
+	}
+	if ha.LoadBalancerIP != "" {
+		sets["cloudCore.service.loadBalancerIP"] = ha.LoadBalancerIP
+		if opts.AdvertiseAddress == "" {
+			opts.AdvertiseAddress = ha.LoadBalancerIP
+		}
+	}
+
+	for _, key := range sortedKeys(sets) {
+		if !opts.HasSets(key) {
+			opts.Sets = append(opts.Sets, fmt.Sprintf("%s=%v", key, sets[key]))
+		}
+	}
+	return nil
+}
+
+func validateHAOptions(ha types.HAOptions) error {
+	if ha.Replicas < 0 {
+		return fmt.Errorf("--%s must not be negative", types.FlagNameReplicas)
+	}
+	if !ha.LeaderElect && (ha.LeaderElectLeaseDuration != 0 || ha.LeaderElectRenewDeadline != 0 || ha.LeaderElectRetryPeriod != 0) {
+		return fmt.Errorf("the leader election durations require --%s", types.FlagNameLeaderElect)
+	}
+	for name, d := range map[string]time.Duration{
+		types.FlagNameLeaderElectLeaseDuration: ha.LeaderElectLeaseDuration,
+		types.FlagNameLeaderElectRenewDeadline: ha.LeaderElectRenewDeadline,
+		types.FlagNameLeaderElectRetryPeriod:   ha.LeaderElectRetryPeriod,
+	} {
+		if d != 0 && (d < time.Second || d%time.Second != 0) {
+			return fmt.Errorf("--%s %v must be a whole number of seconds", name, d)
+		}
+	}
+	if ha.LeaderElectLeaseDuration != 0 && ha.LeaderElectRenewDeadline != 0 && ha.LeaderElectLeaseDuration <= ha.LeaderElectRenewDeadline {
+		return fmt.Errorf("--%s must be greater than --%s", types.FlagNameLeaderElectLeaseDuration, types.FlagNameLeaderElectRenewDeadline)
+	}
+
+	switch corev1.ServiceType(ha.ServiceType) {
+	case "", corev1.ServiceTypeNodePort, corev1.ServiceTypeClusterIP, corev1.ServiceTypeLoadBalancer:
+	default:
+		return fmt.Errorf("--%s %q is not supported, must be NodePort, ClusterIP or LoadBalancer", types.FlagNameServiceType, ha.ServiceType)
+	}
+	if ha.LoadBalancerIP != "" {
+		if corev1.ServiceType(ha.ServiceType) != corev1.ServiceTypeLoadBalancer {
+			return fmt.Errorf("--%s requires --%s=LoadBalancer", types.FlagNameLoadBalancerIP, types.FlagNameServiceType)
+		}
+		if _, err := netip.ParseAddr(ha.LoadBalancerIP); err != nil {
+			return fmt.Errorf("--%s %q is not a valid IP address", types.FlagNameLoadBalancerIP, ha.LoadBalancerIP)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"helm.sh/helm/v3/pkg/strvals"
+
+	types "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	kecharts "github.com/kubeedge/kubeedge/manifests"
+)
+
+func TestAppendHASets(t *testing.T) {
+	cases := []struct {
+		name              string
+		ha                types.HAOptions
+		sets              []string
+		advertiseAddress  string
+		wantSets          []string
+		wantAdvertiseAddr string
+		wantErr           bool
+	}{
+		{
+			name: "no flags",
+		},
+		{
+			name: "leader election behind a load balancer",
+			ha: types.HAOptions{Replicas: 3, LeaderElect: true, LeaderElectLeaseDuration: 20 * time.Second,
+				ServiceType: "LoadBalancer", LoadBalancerIP: "10.0.0.100"},
+			wantSets: []string{
+				"cloudCore.leaderElection.enable=true",
+				"cloudCore.leaderElection.leaseDuration=20",
+				"cloudCore.replicaCount=3",
+				"cloudCore.service.loadBalancerIP=10.0.0.100",
+				"cloudCore.service.type=LoadBalancer",
+			},
+			wantAdvertiseAddr: "10.0.0.100",
+		},
+		{
+			name:              "set flags and advertise address take precedence",
+			ha:                types.HAOptions{Replicas: 3, ServiceType: "LoadBalancer", LoadBalancerIP: "10.0.0.100"},
+			sets:              []string{"cloudCore.replicaCount=5"},
+			advertiseAddress:  "10.0.0.1",
+			wantSets:          []string{"cloudCore.replicaCount=5", "cloudCore.service.loadBalancerIP=10.0.0.100", "cloudCore.service.type=LoadBalancer"},
+			wantAdvertiseAddr: "10.0.0.1",
+		},
+		{
+			name:    "durations without leader election",
+			ha:      types.HAOptions{LeaderElectRenewDeadline: 10 * time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "lease duration less than renew deadline",
+			ha:      types.HAOptions{LeaderElect: true, LeaderElectLeaseDuration: 10 * time.Second, LeaderElectRenewDeadline: 15 * time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "fractional duration",
+			ha:      types.HAOptions{LeaderElect: true, LeaderElectRetryPeriod: 1500 * time.Millisecond},
+			wantErr: true,
+		},
+		{
+			name:    "load balancer ip without load balancer",
+			ha:      types.HAOptions{ServiceType: "NodePort", LoadBalancerIP: "10.0.0.100"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported service type",
+			ha:      types.HAOptions{ServiceType: "ExternalName"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := types.CloudInitUpdateBase{Sets: tc.sets, AdvertiseAddress: tc.advertiseAddress}
+			err := appendHASets(tc.ha, &opts)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("appendHASets() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(opts.Sets) != 0 || len(tc.wantSets) != 0 {
+				if !reflect.DeepEqual(opts.Sets, tc.wantSets) {
+					t.Errorf("expected sets %v, but got %v", tc.wantSets, opts.Sets)
+				}
+			}
+			if opts.AdvertiseAddress != tc.wantAdvertiseAddr {
+				t.Errorf("expected advertise address %q, but got %q", tc.wantAdvertiseAddr, opts.AdvertiseAddress)
+			}
+		})
+	}
+}
+
+func TestRenderCloudCoreHA(t *testing.T) {
+	opts := types.CloudInitUpdateBase{}
+	ha := types.HAOptions{Replicas: 3, LeaderElect: true, ServiceType: "LoadBalancer", LoadBalancerIP: "10.0.0.100"}
+	if err := appendHASets(ha, &opts); err != nil {
+		t.Fatalf("appendHASets() error = %v", err)
+	}
+	vals := map[string]interface{}{}
+	for _, set := range opts.Sets {
+		if err := strvals.ParseInto(set, vals); err != nil {
+			t.Fatalf("failed to parse set %s: %v", set, err)
+		}
+	}
+
+	renderer := NewGenericRenderer(kecharts.BuiltinOrDir(""), path.Join(dirCharts, cloudCoreHelmComponent),
+		cloudCoreHelmComponent, "kubeedge", vals, true)
+	if err := renderer.LoadChart(); err != nil {
+		t.Fatalf("failed to load chart: %v", err)
+	}
+	manifest, err := renderer.RenderManifest()
+	if err != nil {
+		t.Fatalf("failed to render chart: %v", err)
+	}
+	for _, want := range []string{
+		"replicas: 3",
+		"maxUnavailable: 2",
+		"leaderElection:\n        enable: true",
+		"readinessProbe:",
+		"type: LoadBalancer",
+		"loadBalancerIP: 10.0.0.100",
+	} {
+		if !strings.Contains(manifest, want) {
+			t.Errorf("expected the manifest to contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/pkg/errors"
+)
+
+// checkImageReferences verifies that the image references in vals can be parsed,
+// reporting all malformed ones at once. Image references are the values of image
+// keys, either strings or maps of a repository and a tag as used by the KubeEdge
+// charts, and the string values of images maps. Empty values are not checked.
+func checkImageReferences(vals map[string]interface{}) error {
+	var invalid, paths []string
+	walkImageReferences(vals, "", func(path, ref string) {
+		if _, err := reference.ParseNormalizedNamed(ref); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s (%q): %v", path, ref, err))
+			paths = append(paths, path)
+		}
+	})
+	if len(invalid) > 0 {
+		return newValuesError(ErrValidation, "", paths[0],
+			errors.Errorf("invalid image references: %s", strings.Join(invalid, "; ")))
+	}
+	return nil
+}
+
+// walkImageReferences calls fn with the path and value of every image reference in vals.
+func walkImageReferences(vals map[string]interface{}, path string, fn func(path, ref string)) {
+	for _, k := range sortedKeys(vals) {
+		p := joinPath(path, k)
+		switch v := vals[k].(type) {
+		case string:
+			if k == "image" && v != "" {
+				fn(p, v)
+			}
+		case map[string]interface{}:
+			switch {
+			case k == "image":
+				if ref := chartImageReference(v); ref != "" {
+					fn(p, ref)
+				}
+			case k == "images":
+				for _, name := range sortedKeys(v) {
+					if ref, ok := v[name].(string); ok && ref != "" {
+						fn(joinPath(p, name), ref)
+					}
+				}
+			}
+			walkImageReferences(v, p, fn)
+		}
+	}
+}
+
+// chartImageReference joins the repository and tag of a chart image, which may
+// also be a digest, to a reference.
+func chartImageReference(image map[string]interface{}) string {
+	repo, _ := image["repository"].(string)
+	if repo == "" {
+		return ""
+	}
+	if image["tag"] == nil {
+		return repo
+	}
+	// Tags like 1.6 are numbers unless quoted
+	tag := fmt.Sprint(image["tag"])
+	switch {
+	case tag == "":
+		return repo
+	case strings.Contains(tag, ":"):
+		return repo + "@" + tag
+	}
+	return repo + ":" + tag
+}
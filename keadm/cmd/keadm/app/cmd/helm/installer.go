@@ -53,7 +53,8 @@ type KubeCloudHelmInstTool struct {
 	Manifests        string
 	Namespace        string
 	Sets             []string
-	Profile          string
+	// Profiles are merged in order, the first one decides the chart to render
+	Profiles         []string
 	ProfileKey       string
 	ExternalHelmRoot string
 	Force            bool
@@ -160,35 +161,38 @@ func (cu *KubeCloudHelmInstTool) RunHelmManifest(baseHelmRoot string) error {
 	return cu.runHelmManifest(renderer, os.Stdout)
 }
 
-// beforeRenderer handles the value of the profile.
+// beforeRenderer handles the values of the profiles.
 func (cu *KubeCloudHelmInstTool) beforeRenderer(baseHelmRoot string) error {
-	if cu.Profile == "" {
-		cu.Profile = fmt.Sprintf("%s=v%s", VersionProfileKey, cu.Common.ToolVersion.String())
+	if len(cu.Profiles) == 0 {
+		cu.Profiles = []string{fmt.Sprintf("%s=v%s", VersionProfileKey, cu.Common.ToolVersion.String())}
 	}
-	// profile must be invalid
-	p := strings.Split(cu.Profile, "=")
-	cu.ProfileKey = p[0]
+	cu.ProfileKey, _, _ = strings.Cut(cu.Profiles[0], "=")
 
 	// check profile if the {baseHelmRoot}/profiles/{profileKey}.yaml exists
-	if err := cu.checkProfile(baseHelmRoot); err != nil {
-		if errors.Is(err, ErrListProfiles) {
-			cu.existsProfile = false
-			return nil
-		}
+	for _, profile := range cu.Profiles {
+		key, _, _ := strings.Cut(profile, "=")
+		if err := cu.checkProfile(baseHelmRoot, key); err != nil {
+			if errors.Is(err, ErrListProfiles) {
+				if len(cu.Profiles) > 1 {
+					return fmt.Errorf("stacking profiles requires the %s directory in %s", dirProfiles, baseHelmRoot)
+				}
+				cu.existsProfile = false
+				return nil
+			}
 
-		return fmt.Errorf("invalid profile key %s, err: %s", cu.ProfileKey, err.Error())
+			return fmt.Errorf("invalid profile key %s, err: %s", key, err.Error())
+		}
 	}
 
 	cu.existsProfile = true
 
 	// Only handle profiles when cu.ExternalHelmRoot is empty.
 	if cu.ExternalHelmRoot == "" {
-		var profileValue string
-		if len(p) >= 2 {
-			profileValue = p[1]
-		}
-		if err := cu.handleProfile(profileValue); err != nil {
-			return fmt.Errorf("can not handle profile %s", cu.Profile)
+		for _, profile := range cu.Profiles {
+			key, value, _ := strings.Cut(profile, "=")
+			if err := cu.handleProfile(key, value); err != nil {
+				return fmt.Errorf("can not handle profile %s", profile)
+			}
 		}
 
 		// combine the flag values
@@ -330,7 +334,7 @@ func (cu *KubeCloudHelmInstTool) TearDown() error {
 	return nil
 }
 
-func (cu *KubeCloudHelmInstTool) checkProfile(baseHelmRoot string) error {
+func (cu *KubeCloudHelmInstTool) checkProfile(baseHelmRoot, profileKey string) error {
 	// read external profiles
 	validProfiles, err := cu.readProfiles(baseHelmRoot, dirProfiles)
 	if err != nil {
@@ -340,22 +344,22 @@ func (cu *KubeCloudHelmInstTool) checkProfile(baseHelmRoot string) error {
 	// iptalesmgr is also an valid profile key.
 	validProfiles[IptablesMgrProfileKey] = true
 	validProfiles[ControllerManagerProfileKey] = true
-	if ok := validProfiles[cu.ProfileKey]; !ok {
+	if ok := validProfiles[profileKey]; !ok {
 		validKeys := make([]string, len(validProfiles))
 		for k := range validProfiles {
 			validKeys = append(validKeys, k)
 		}
-		return fmt.Errorf(fmt.Sprintf("profile %s not in %s", cu.ProfileKey, strings.Join(validKeys, ",")))
+		return fmt.Errorf(fmt.Sprintf("profile %s not in %s", profileKey, strings.Join(validKeys, ",")))
 	}
 
 	return nil
 }
 
 // handleProfile only handles inner profile
-func (cu *KubeCloudHelmInstTool) handleProfile(profileValue string) error {
+func (cu *KubeCloudHelmInstTool) handleProfile(profileKey, profileValue string) error {
 	// the current version
 	currentVersion := cu.Common.ToolVersion.String()
-	switch cu.ProfileKey {
+	switch profileKey {
 	case VersionProfileKey:
 		if profileValue == "" {
 			profileValue = currentVersion
@@ -382,15 +386,22 @@ func (cu *KubeCloudHelmInstTool) handleProfile(profileValue string) error {
 
 		if profileValue == InternalIptablesMgrMode || profileValue == ExternalIptablesMgrMode {
 			cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "iptablesManager.mode", profileValue))
-			cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "cloudCore.image.tag", currentVersion))
-			cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "iptablesManager.image.tag", currentVersion))
+			// keep the tags set by --set or a version profile before
+			if !SetsContainSubstring(cu.Sets, "cloudCore.image.tag") {
+				cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "cloudCore.image.tag", currentVersion))
+			}
+			if !SetsContainSubstring(cu.Sets, "iptablesManager.image.tag") {
+				cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "iptablesManager.image.tag", currentVersion))
+			}
 			return nil
 		}
 
 		return fmt.Errorf("the given mode of iptablesmgr %s is not supported, only support internal or external", profileValue)
 
 	case ControllerManagerProfileKey:
-		cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "controllerManager.image.tag", currentVersion))
+		if !SetsContainSubstring(cu.Sets, "controllerManager.image.tag") {
+			cu.Sets = append(cu.Sets, fmt.Sprintf("%s=%s", "controllerManager.image.tag", currentVersion))
+		}
 
 	default:
 	}
@@ -453,6 +464,23 @@ func (cu *KubeCloudHelmInstTool) combineProfVals() (map[string]interface{}, erro
 	if err := yaml.Unmarshal([]byte(profileValue), &profileValsMap); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal values: %v", err)
 	}
+	// The stacked profiles override the values of the profiles before them
+	for i, profile := range cu.Profiles {
+		key, _, _ := strings.Cut(profile, "=")
+		// the first profile is loaded above, iptablesmgr and controllermanager only set values
+		if i == 0 || key == IptablesMgrProfileKey || key == ControllerManagerProfileKey {
+			continue
+		}
+		value, err := loadValues(cu.ExternalHelmRoot, key, cu.existsProfile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load profile yaml:%s", err.Error())
+		}
+		vals := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(value), &vals); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal values: %v", err)
+		}
+		profileValsMap = mergeMaps(profileValsMap, vals)
+	}
 	// User specified a value via --set
 	for _, value := range cu.Sets {
 		if err := strvals.ParseInto(value, profileValsMap); err != nil {
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"testing"
+
+	"github.com/blang/semver"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/util"
+)
+
+func TestKubeCloudHelmInstToolStackedProfiles(t *testing.T) {
+	cu := &KubeCloudHelmInstTool{
+		Common:   util.Common{ToolVersion: semver.MustParse("1.17.0")},
+		Profiles: []string{"version=v1.16.0", "ha", "iptablesmgr=external"},
+		Sets:     []string{"cloudCore.leaderElection.leaseDuration=30"},
+	}
+	if err := cu.beforeRenderer(DefaultBaseHelmDir); err != nil {
+		t.Fatalf("failed to handle the profiles, err: %v", err)
+	}
+	renderer, err := cu.buildRenderer(DefaultBaseHelmDir)
+	if err != nil {
+		t.Fatalf("failed to build renderer, err: %v", err)
+	}
+	if renderer.componentName != cloudCoreHelmComponent {
+		t.Fatalf("expected the first profile to render the cloudcore chart, actual: %s", renderer.componentName)
+	}
+
+	cloudCore := renderer.profileValsMap["cloudCore"].(map[string]interface{})
+	leaderElection := cloudCore["leaderElection"].(map[string]interface{})
+	if cloudCore["replicaCount"] != float64(3) || leaderElection["enable"] != true {
+		t.Errorf("expected the ha profile to override the version profile, actual: %v", cloudCore)
+	}
+	if leaderElection["leaseDuration"] != int64(30) {
+		t.Errorf("expected --set to override the profiles, actual: %v", leaderElection["leaseDuration"])
+	}
+	if tag := cloudCore["image"].(map[string]interface{})["tag"]; tag != "v1.16.0" {
+		t.Errorf("expected the image tag of the version profile, actual: %v", tag)
+	}
+	if mode := renderer.profileValsMap["iptablesManager"].(map[string]interface{})["mode"]; mode != ExternalIptablesMgrMode {
+		t.Errorf("expected the iptablesmgr profile to set the mode, actual: %v", mode)
+	}
+	if _, err := renderer.RenderManifest(); err != nil {
+		t.Errorf("failed to render the stacked profiles, err: %v", err)
+	}
+
+	cu = &KubeCloudHelmInstTool{
+		Common:   util.Common{ToolVersion: semver.MustParse("1.17.0")},
+		Profiles: []string{"version", "missing"},
+	}
+	if err := cu.beforeRenderer(DefaultBaseHelmDir); err == nil {
+		t.Errorf("expected an unknown stacked profile to be rejected")
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// KeyTransform maps a key of a value document to the casing of the chart values.
+type KeyTransform func(key string) string
+
+var (
+	keyTransformsMu sync.RWMutex
+	keyTransforms   = map[string]KeyTransform{
+		"snake-to-camel": SnakeToCamelCase,
+		"kebab-to-camel": KebabToCamelCase,
+	}
+)
+
+// RegisterKeyTransform makes a key transform available by name, replacing any
+// transform already registered under that name.
+func RegisterKeyTransform(name string, t KeyTransform) {
+	keyTransformsMu.Lock()
+	defer keyTransformsMu.Unlock()
+	keyTransforms[name] = t
+}
+
+// LookupKeyTransform returns the key transform registered by name. The built-in
+// transforms are "snake-to-camel" and "kebab-to-camel".
+func LookupKeyTransform(name string) (KeyTransform, bool) {
+	keyTransformsMu.RLock()
+	defer keyTransformsMu.RUnlock()
+	t, ok := keyTransforms[name]
+	return t, ok
+}
+
+// SnakeToCamelCase converts snake_case keys, such as node_ip, to camelCase (nodeIp).
+func SnakeToCamelCase(key string) string {
+	return toCamelCase(key, '_')
+}
+
+// KebabToCamelCase converts kebab-case keys, such as node-ip, to camelCase (nodeIp).
+func KebabToCamelCase(key string) string {
+	return toCamelCase(key, '-')
+}
+
+func toCamelCase(key string, sep rune) string {
+	parts := strings.FieldsFunc(key, func(r rune) bool { return r == sep })
+	if len(parts) < 2 {
+		return key
+	}
+	var b strings.Builder
+	b.WriteString(parts[0])
+	for _, p := range parts[1:] {
+		b.WriteString(strings.ToUpper(p[:1]) + p[1:])
+	}
+	return b.String()
+}
+
+// transformKeys returns vals with all keys, including those nested in lists, mapped
+// by transform. When several keys map to the same one, the last in lexical order
+// wins and a warning naming source is logged.
+func transformKeys(source string, vals map[string]interface{}, transform KeyTransform) map[string]interface{} {
+	return transformKeysAt(source, vals, "", transform)
+}
+
+func transformKeysAt(source string, vals map[string]interface{}, path string, transform KeyTransform) map[string]interface{} {
+	out := make(map[string]interface{}, len(vals))
+	origin := make(map[string]string, len(vals))
+	for _, k := range sortedKeys(vals) {
+		key := transform(k)
+		if prev, ok := origin[key]; ok {
+			klog.Warningf("%s: keys %s and %s both become %s, using the value of %s",
+				source, joinPath(path, prev), joinPath(path, k), joinPath(path, key), joinPath(path, k))
+		}
+		origin[key] = k
+		out[key] = transformValueKeys(source, vals[k], joinPath(path, key), transform)
+	}
+	return out
+}
+
+func transformValueKeys(source string, v interface{}, path string, transform KeyTransform) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return transformKeysAt(source, val, path, transform)
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = transformValueKeys(source, item, path, transform)
+		}
+		return out
+	}
+	return v
+}
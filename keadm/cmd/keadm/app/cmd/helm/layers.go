@@ -0,0 +1,206 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"crypto/sha256"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/strvals"
+)
+
+// LayerKind tells where the values of a layer come from.
+type LayerKind string
+
+const (
+	// LayerFile is a value file, the chart values or a document read from stdin
+	LayerFile LayerKind = "file"
+	// LayerEnv is a value document read from an environment variable, or a
+	// single value given by a variable starting with Options.EnvOverridePrefix
+	LayerEnv LayerKind = "env"
+	// LayerRemote is a value document fetched over the network, such as from a ws:// URL
+	LayerRemote LayerKind = "remote"
+	// LayerFlag is a single --set-json, --set, --set-string, --set-file,
+	// --set-literal or PointerSetValues value
+	LayerFlag LayerKind = "flag"
+)
+
+// Layer is a value source parsed on its own, before merging.
+type Layer struct {
+	// Source names the source, the path of a file or the flag and its value
+	Source string
+	Kind   LayerKind
+	Values map[string]interface{}
+}
+
+// parsedValuesKey identifies a parsed value document by its content and format.
+type parsedValuesKey struct {
+	sum    [sha256.Size]byte
+	format string
+}
+
+// ParseLayers parses every value source of opts without merging them, in the
+// order MergeValues applies them, for callers implementing their own merge logic.
+// Layers of value documents with identical contents share their Values, which
+// must not be modified. Each flag value is parsed into a layer of its own, so
+// list indexes such as a[1] are not resolved against the previous layers.
+func (opts *Options) ParseLayers() ([]Layer, error) {
+	layers, err := opts.parseDocumentLayers(nil)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := opts.parseFlagLayers()
+	if err != nil {
+		return nil, err
+	}
+	layers = append(layers, flags...)
+	return append(layers, opts.envOverrideLayers()...), nil
+}
+
+// parseDocumentLayers reads and parses the chart values and the value files,
+// recording statistics into stats if it is not nil.
+func (opts *Options) parseDocumentLayers(stats *MergeStats) ([]Layer, error) {
+	var layers []Layer
+	// Files with identical contents (symlinks, copies) are parsed only once.
+	// Sharing the parsed maps is safe because mergeMaps never modifies its inputs.
+	parsed := map[parsedValuesKey]map[string]interface{}{}
+
+	// parseDocument parses a value document read from source and appends it to layers
+	parseDocument := func(source, format string, bytes []byte) error {
+		var err error
+		key := parsedValuesKey{sum: sha256.Sum256(bytes), format: format}
+		currentMap, ok := parsed[key]
+		if !ok {
+			currentMap, err = parseValueBytes(source, format, bytes, opts.StrictYAML)
+			if err != nil {
+				return newValuesError(ErrParse, source, "", err)
+			}
+			if err := opts.applyLiteralPaths(format, bytes, currentMap); err != nil {
+				return newValuesError(ErrParse, source, "", errors.Wrapf(err, "failed to parse %s", source))
+			}
+			if opts.KeyTransform != nil {
+				currentMap = transformKeys(source, currentMap, opts.KeyTransform)
+			}
+			if opts.ScanForSecrets {
+				opts.warnSecrets(source, currentMap)
+			}
+			parsed[key] = currentMap
+		}
+		layers = append(layers, Layer{Source: source, Kind: documentKind(source), Values: currentMap})
+		return nil
+	}
+
+	// The default values of the chart are the base of all other values
+	if opts.ChartValuesBase != "" {
+		source := filepath.Join(opts.ChartValuesBase, chartutil.ValuesfileName)
+		start := time.Now()
+		bytes, err := readChartValues(opts.ChartValuesBase)
+		if err != nil {
+			return nil, err
+		}
+		if bytes, err = opts.checkIndentation(source, valuesFormatYAML, bytes); err != nil {
+			return nil, err
+		}
+		stats.recordRead(source, len(bytes), start)
+		if err := parseDocument(source, valuesFormatYAML, bytes); err != nil {
+			return nil, err
+		}
+	}
+
+	valueFiles, err := opts.expandValueFiles()
+	if err != nil {
+		return nil, err
+	}
+	// User specified a values files via -f/--values
+	for _, filePath := range valueFiles {
+		format, err := opts.valueFileFormat(filePath)
+		if err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		bytes, err := opts.readValueFile(filePath, format)
+		if err != nil {
+			return nil, err
+		}
+		stats.recordRead(filePath, len(bytes), start)
+		if err := parseDocument(filePath, format, bytes); err != nil {
+			return nil, err
+		}
+	}
+	return layers, nil
+}
+
+// parseFlagLayers parses every flag value into a layer, in the order applyFlagValues applies them.
+func (opts *Options) parseFlagLayers() ([]Layer, error) {
+	var layers []Layer
+	add := func(flag string, values []string) error {
+		for _, value := range values {
+			var layer map[string]interface{}
+			var err error
+			if flag == flagSetFile {
+				layer, err = strvals.ParseFile(value, func(rs []rune) (interface{}, error) {
+					bytes, err := readFile(string(rs))
+					if err != nil {
+						return nil, err
+					}
+					return string(bytes), nil
+				})
+			} else {
+				layer, err = parseFlagLayer(flag, value)
+			}
+			if err == nil && flag == flagSet {
+				err = opts.applyLiteralFlag(layer, value)
+			}
+			source := flag + " " + value
+			if err != nil {
+				return newValuesError(ErrParse, source, "", errors.Wrapf(err, "failed parsing %s data", flag))
+			}
+			layers = append(layers, Layer{Source: source, Kind: LayerFlag, Values: layer})
+		}
+		return nil
+	}
+	for _, f := range []struct {
+		flag   string
+		values []string
+	}{
+		{flagSetJSON, opts.JSONValues},
+		{flagSet, opts.Values},
+		{flagSetString, opts.StringValues},
+		{flagSetFile, opts.FileValues},
+		{flagSetLiteral, opts.LiteralValues},
+		{flagSetPointer, opts.PointerSetValues},
+	} {
+		if err := add(f.flag, f.values); err != nil {
+			return nil, err
+		}
+	}
+	return layers, nil
+}
+
+// documentKind returns the kind of the value document read from source.
+func documentKind(source string) LayerKind {
+	if strings.HasPrefix(source, envScheme) {
+		return LayerEnv
+	}
+	if isURLSource(source) && !strings.HasPrefix(source, fileScheme+"://") {
+		return LayerRemote
+	}
+	return LayerFile
+}
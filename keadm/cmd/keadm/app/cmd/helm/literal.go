@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/strvals"
+)
+
+// applyLiteralPaths replaces the scalars at opts.LiteralPaths in vals, the document
+// parsed from data in the given format, by their text as written in data.
+func (opts *Options) applyLiteralPaths(format string, data []byte, vals map[string]interface{}) error {
+	if len(opts.LiteralPaths) == 0 {
+		return nil
+	}
+	var raw func(path string) (string, bool)
+	switch format {
+	case valuesFormatYAML:
+		var doc yamlv3.Node
+		if err := yamlv3.Unmarshal(data, &doc); err != nil {
+			return err
+		}
+		raw = func(path string) (string, bool) {
+			return yamlScalarText(&doc, strings.Split(path, "."))
+		}
+	case valuesFormatJSON:
+		// UseNumber keeps the numbers as written
+		doc := map[string]interface{}{}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&doc); err != nil {
+			return err
+		}
+		raw = func(path string) (string, bool) {
+			return scalarText(doc, path)
+		}
+	default:
+		// TOML is explicitly typed, there is no implicit typing to undo
+		raw = func(path string) (string, bool) {
+			return scalarText(vals, path)
+		}
+	}
+	for _, p := range opts.LiteralPaths {
+		if text, ok := raw(p); ok {
+			setPath(vals, strings.Split(p, "."), text)
+		}
+	}
+	return nil
+}
+
+// applyLiteralFlag replaces the values at opts.LiteralPaths assigned by a --set
+// value by the strings given on the command line.
+func (opts *Options) applyLiteralFlag(base map[string]interface{}, value string) error {
+	if len(opts.LiteralPaths) == 0 {
+		return nil
+	}
+	layer, err := strvals.ParseString(value)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing --set data")
+	}
+	for _, p := range opts.LiteralPaths {
+		if text, ok := scalarText(layer, p); ok {
+			setPath(base, strings.Split(p, "."), text)
+		}
+	}
+	return nil
+}
+
+// yamlScalarText returns the source text of the scalar at path in a YAML document.
+func yamlScalarText(node *yamlv3.Node, path []string) (string, bool) {
+	if node.Kind == yamlv3.DocumentNode && len(node.Content) == 1 {
+		node = node.Content[0]
+	}
+	for _, key := range path {
+		if node.Kind != yamlv3.MappingNode {
+			return "", false
+		}
+		var next *yamlv3.Node
+		// Later keys win, like they do when parsing the document
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+			}
+		}
+		if next == nil {
+			return "", false
+		}
+		node = next
+		for node.Kind == yamlv3.AliasNode {
+			node = node.Alias
+		}
+	}
+	if node.Kind != yamlv3.ScalarNode {
+		return "", false
+	}
+	return node.Value, true
+}
+
+// scalarText returns the scalar at path in vals as a string.
+func scalarText(vals map[string]interface{}, path string) (string, bool) {
+	v, ok := lookupPath(vals, path)
+	if !ok {
+		return "", false
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return "", false
+	case nil:
+		return "null", true
+	}
+	return fmt.Sprint(v), true
+}
+
+// setPath sets the value at path in vals if all its parents are maps.
+func setPath(vals map[string]interface{}, path []string, v interface{}) {
+	cur := vals
+	for _, key := range path[:len(path)-1] {
+		next, ok := cur[key].(map[string]interface{})
+		if !ok {
+			return
+		}
+		cur = next
+	}
+	cur[path[len(path)-1]] = v
+}
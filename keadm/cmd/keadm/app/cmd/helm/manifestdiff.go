@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// manifestDiffContext is the number of unchanged lines shown around a change.
+const manifestDiffContext = 3
+
+// DiffManifests compares two rendered manifests resource by resource, such as
+// the manifest of the deployed release and the one of a dry-run, and returns
+// the changes in a unified diff like format. It is empty if nothing changed.
+func DiffManifests(oldManifest, newManifest string) string {
+	oldDocs, newDocs := splitManifest(oldManifest), splitManifest(newManifest)
+	keys := make([]string, 0, len(oldDocs)+len(newDocs))
+	for k := range oldDocs {
+		keys = append(keys, k)
+	}
+	for k := range newDocs {
+		if _, ok := oldDocs[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, k := range keys {
+		oldDoc, inOld := oldDocs[k]
+		newDoc, inNew := newDocs[k]
+		switch {
+		case !inOld:
+			fmt.Fprintf(&sb, "%s has been added:\n", k)
+		case !inNew:
+			fmt.Fprintf(&sb, "%s has been removed:\n", k)
+		case oldDoc == newDoc:
+			continue
+		default:
+			fmt.Fprintf(&sb, "%s has changed:\n", k)
+		}
+		for _, line := range diffLines(splitLines(oldDoc), splitLines(newDoc)) {
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// splitManifest splits a multi-document manifest into its resources, keyed by
+// their kind, namespace and name.
+func splitManifest(manifest string) map[string]string {
+	docs := map[string]string{}
+	for i, doc := range strings.Split(manifest, YAMLSeparator) {
+		doc = strings.TrimSpace(strings.TrimPrefix(doc, "---\n"))
+		if doc == "" {
+			continue
+		}
+		var obj struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+		}
+		key := fmt.Sprintf("document %d", i)
+		if err := yaml.Unmarshal([]byte(doc), &obj); err == nil && obj.Kind != "" {
+			key = fmt.Sprintf("%s %s", obj.Kind, obj.Metadata.Name)
+			if obj.Metadata.Namespace != "" {
+				key = fmt.Sprintf("%s %s/%s", obj.Kind, obj.Metadata.Namespace, obj.Metadata.Name)
+			}
+		} else if err == nil && obj.Kind == "" && !hasContent(doc) {
+			// Only comments, such as the source of an empty template
+			continue
+		}
+		docs[key] = doc
+	}
+	return docs
+}
+
+// hasContent reports whether doc has lines other than comments.
+func hasContent(doc string) bool {
+	for _, line := range splitLines(doc) {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			return true
+		}
+	}
+	return false
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// diffLines returns the lines of a and b prefixed by "-" if removed, "+" if added
+// and " " if unchanged, keeping only manifestDiffContext unchanged lines around
+// the changes. It computes the longest common subsequence of the lines, which is
+// fast enough for the size of Kubernetes resources.
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, " "+a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, "-"+a[i])
+			i++
+		default:
+			lines = append(lines, "+"+b[j])
+			j++
+		}
+	}
+	return trimContext(lines)
+}
+
+// trimContext drops the unchanged lines further than manifestDiffContext lines
+// away from a change, marking the gaps with "...".
+func trimContext(lines []string) []string {
+	keep := make([]bool, len(lines))
+	for i, line := range lines {
+		if line[0] == ' ' {
+			continue
+		}
+		for k := i - manifestDiffContext; k <= i+manifestDiffContext; k++ {
+			if k >= 0 && k < len(lines) {
+				keep[k] = true
+			}
+		}
+	}
+	var res []string
+	skipped := false
+	for i, line := range lines {
+		if !keep[i] {
+			skipped = true
+			continue
+		}
+		if skipped {
+			res = append(res, "...")
+			skipped = false
+		}
+		res = append(res, line)
+	}
+	if skipped {
+		res = append(res, "...")
+	}
+	return res
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"math"
+	"reflect"
+	"strings"
+)
+
+// KeepEmptyMarker marks a map which must be kept, as an empty map, when empty
+// values are trimmed. For example `tolerations: {__keep__: true}`.
+const KeepEmptyMarker = "__keep__"
+
+// trimEmpty removes empty maps and lists from vals recursively, including those
+// which become empty after their own children were removed. Maps holding only
+// the KeepEmptyMarker are kept as empty maps.
+func trimEmpty(vals map[string]interface{}) {
+	for k, v := range vals {
+		trimmed, keep := trimEmptyValue(v)
+		if !keep {
+			delete(vals, k)
+			continue
+		}
+		vals[k] = trimmed
+	}
+}
+
+// trimEmptyValue returns v with its empty children removed, and whether v itself should be kept.
+func trimEmptyValue(v interface{}) (interface{}, bool) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if keep, ok := val[KeepEmptyMarker].(bool); ok && keep && len(val) == 1 {
+			return map[string]interface{}{}, true
+		}
+		trimEmpty(val)
+		return val, len(val) > 0
+	case []interface{}:
+		res := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			if trimmed, keep := trimEmptyValue(item); keep {
+				res = append(res, trimmed)
+			}
+		}
+		return res, len(res) > 0
+	}
+	return v, true
+}
+
+// NormalizeValues returns a canonical copy of vals, so that two semantically equal
+// values compare equal with reflect.DeepEqual. vals is not modified. The rules are:
+//   - all integral numbers become int64 and all other numbers float64, so 110
+//     read from a file equals 110 given via --set
+//   - the strings "true" and "false", in any case, become booleans
+//   - empty maps and lists are removed as done by Options.TrimEmpty
+//
+// Other strings, including numeric ones, are kept as is. Maps have no order,
+// marshaling the result with sigs.k8s.io/yaml always sorts the keys.
+func NormalizeValues(vals map[string]interface{}) map[string]interface{} {
+	out := normalizeValue(vals).(map[string]interface{})
+	trimEmpty(out)
+	return out
+}
+
+func normalizeValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, item := range val {
+			out[k] = normalizeValue(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, item := range val {
+			out[i] = normalizeValue(item)
+		}
+		return out
+	case string:
+		switch strings.ToLower(val) {
+		case "true":
+			return true
+		case "false":
+			return false
+		}
+		return val
+	case int:
+		return int64(val)
+	case int32:
+		return int64(val)
+	case float32:
+		return normalizeFloat(float64(val))
+	case float64:
+		return normalizeFloat(val)
+	}
+	return v
+}
+
+func normalizeFloat(f float64) interface{} {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f <= math.MaxInt64 {
+		return int64(f)
+	}
+	return f
+}
+
+// ValuesEqual reports whether a and b are equal after NormalizeValues, without
+// copying them.
+func ValuesEqual(a, b map[string]interface{}) bool {
+	return normalizedEqual(a, b)
+}
+
+// ShouldApply reports whether applying desired would change the live values, so
+// that reconcile loops can skip reconfigurations which would only restart edge
+// nodes. Values are compared like ValuesEqual does.
+func ShouldApply(desired, live map[string]interface{}) bool {
+	return !ValuesEqual(desired, live)
+}
+
+func normalizedEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		// Maps holding only the keep marker normalize to empty maps, any other
+		// map is either trimmed or holds values.
+		if aKeep, bKeep := isKeepMarker(av), isKeepMarker(bv); aKeep || bKeep {
+			return aKeep && bKeep
+		}
+		for k, v := range av {
+			if isTrimmed(v) {
+				continue
+			}
+			if w, ok := bv[k]; !ok || !normalizedEqual(v, w) {
+				return false
+			}
+		}
+		for k, w := range bv {
+			if v, ok := av[k]; (!ok || isTrimmed(v)) && !isTrimmed(w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			return false
+		}
+		// Compare the items which are not trimmed pairwise
+		i, j := 0, 0
+		for {
+			for i < len(av) && isTrimmed(av[i]) {
+				i++
+			}
+			for j < len(bv) && isTrimmed(bv[j]) {
+				j++
+			}
+			if i == len(av) || j == len(bv) {
+				return i == len(av) && j == len(bv)
+			}
+			if !normalizedEqual(av[i], bv[j]) {
+				return false
+			}
+			i, j = i+1, j+1
+		}
+	}
+	switch b.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return reflect.DeepEqual(normalizeValue(a), normalizeValue(b))
+}
+
+// isKeepMarker reports whether m holds only the KeepEmptyMarker.
+func isKeepMarker(m map[string]interface{}) bool {
+	keep, ok := m[KeepEmptyMarker].(bool)
+	return ok && keep && len(m) == 1
+}
+
+// isTrimmed reports whether trimEmpty would remove v.
+func isTrimmed(v interface{}) bool {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		if isKeepMarker(val) {
+			return false
+		}
+		for _, item := range val {
+			if !isTrimmed(item) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		for _, item := range val {
+			if !isTrimmed(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SetByPointer sets the value at the RFC 6901 JSON pointer in vals, such as
+// "/modules/edged/nodeIP" or "/labels/kubeedge.io~1role". Missing objects along
+// the pointer are created, like --set does. Existing lists can be indexed, the
+// index "-" or the length of the list appends to it.
+func SetByPointer(vals map[string]interface{}, pointer string, value interface{}) error {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return errors.Errorf("JSON pointer %q does not reference a value", pointer)
+	}
+	var cur interface{} = vals
+	// set replaces the value referenced by the previous token
+	set := func(v interface{}) {}
+	for i, token := range tokens {
+		last := i == len(tokens)-1
+		switch c := cur.(type) {
+		case map[string]interface{}:
+			if last {
+				c[token] = value
+				return nil
+			}
+			next, ok := c[token]
+			if _, isMap := next.(map[string]interface{}); !isMap {
+				if _, isList := next.([]interface{}); !ok || !isList {
+					next = map[string]interface{}{}
+					c[token] = next
+				}
+			}
+			m, key := c, token
+			set = func(v interface{}) { m[key] = v }
+			cur = next
+		case []interface{}:
+			idx := len(c)
+			if token != "-" {
+				if idx, err = strconv.Atoi(token); err != nil || idx < 0 || idx > len(c) {
+					return errors.Errorf("JSON pointer %q has an invalid index %q for a list of %d items", pointer, token, len(c))
+				}
+			}
+			if idx == len(c) {
+				c = append(c, nil)
+				set(c)
+			}
+			if last {
+				c[idx] = value
+				return nil
+			}
+			if _, isMap := c[idx].(map[string]interface{}); !isMap {
+				if _, isList := c[idx].([]interface{}); !isList {
+					c[idx] = map[string]interface{}{}
+				}
+			}
+			list, n := c, idx
+			set = func(v interface{}) { list[n] = v }
+			cur = c[idx]
+		}
+	}
+	return nil
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into its unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, errors.Errorf("JSON pointer %q must start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		if strings.Contains(strings.NewReplacer("~0", "", "~1", "").Replace(t), "~") {
+			return nil, errors.Errorf("JSON pointer %q has an invalid escape in %q", pointer, t)
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// parsePointerValue applies a "<pointer>=<value>" flag value to vals. The value is
+// typed like --set does, so true, false, null and integers are not strings.
+func parsePointerValue(value string, vals map[string]interface{}) error {
+	pointer, raw, ok := strings.Cut(value, "=")
+	if !ok {
+		return errors.Errorf("key %q has no value", value)
+	}
+	return SetByPointer(vals, pointer, typedFlagValue(raw))
+}
+
+// typedFlagValue converts a value the same way --set does.
+func typedFlagValue(val string) interface{} {
+	switch {
+	case strings.EqualFold(val, "true"):
+		return true
+	case strings.EqualFold(val, "false"):
+		return false
+	case strings.EqualFold(val, "null"):
+		return nil
+	case val == "0":
+		return int64(0)
+	}
+	// Values with a leading zero stay strings
+	if len(val) != 0 && val[0] != '0' {
+		if iv, err := strconv.ParseInt(val, 10, 64); err == nil {
+			return iv
+		}
+	}
+	return val
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/strvals"
+)
+
+// Flags that can assign values, in the order MergeValues applies them.
+const (
+	flagSetJSON    = "--set-json"
+	flagSet        = "--set"
+	flagSetString  = "--set-string"
+	flagSetFile    = "--set-file"
+	flagSetLiteral = "--set-literal"
+	// flagSetPointer names Options.PointerSetValues, which has no command line flag
+	flagSetPointer = "--set-pointer"
+)
+
+// layerChecks runs the configured checks against every value source (a layer)
+// as MergeValues applies it.
+type layerChecks struct {
+	schema valuesSchema
+	locked [][]string
+	// maxDepth is the nesting limit of a layer, zero disables the check
+	maxDepth int
+	// applied is the number of layers applied so far
+	applied int
+	// sealed are the values no later layer may change, nil until seal is called
+	sealed map[string]interface{}
+}
+
+func (opts *Options) newLayerChecks() (*layerChecks, error) {
+	checks := &layerChecks{maxDepth: opts.MaxDepth}
+	if checks.maxDepth == 0 {
+		checks.maxDepth = DefaultMaxDepth
+	} else if checks.maxDepth < 0 {
+		checks.maxDepth = 0
+	}
+	if opts.ValuesSchema != nil {
+		schema, err := parseValuesSchema(opts.ValuesSchema)
+		if err != nil {
+			return nil, err
+		}
+		checks.schema = schema
+	}
+	for _, p := range opts.LockedPaths {
+		checks.locked = append(checks.locked, strings.Split(p, "."))
+	}
+	return checks, nil
+}
+
+// checkLayer checks the values assigned by a layer, source names the layer in errors.
+func (c *layerChecks) checkLayer(source string, layer map[string]interface{}) error {
+	defer func() { c.applied++ }()
+	if c.maxDepth > 0 {
+		if path, ok := exceedsDepth(layer, c.maxDepth); ok {
+			return newValuesError(ErrMaxDepth, source, path,
+				errors.Errorf("%s is nested deeper than %d levels at %s", source, c.maxDepth, path))
+		}
+	}
+	// The first layer is the base which is allowed to set the locked paths.
+	if c.applied > 0 {
+		for _, path := range c.locked {
+			if assignsPath(layer, path) {
+				return newValuesError(ErrLockedPath, source, strings.Join(path, "."),
+					errors.Errorf("%s attempts to override the locked path %s", source, strings.Join(path, ".")))
+			}
+		}
+	}
+	if c.sealed != nil {
+		if path, ok := changesSealed(c.sealed, layer, ""); ok {
+			return newValuesError(ErrLockedPath, source, path,
+				errors.Errorf("%s attempts to change the sealed path %s", source, path))
+		}
+	}
+	return nil
+}
+
+// seal makes the values merged so far immutable for the following layers.
+func (c *layerChecks) seal(vals map[string]interface{}) {
+	c.sealed = DeepCopyValues(vals)
+}
+
+// changesSealed reports whether merging layer would change a value of sealed,
+// returning its path. Adding keys to sealed maps and assigning the sealed value
+// again, compared like ValuesEqual does, are allowed.
+func changesSealed(sealed, layer map[string]interface{}, path string) (string, bool) {
+	for _, k := range sortedKeys(layer) {
+		old, ok := sealed[k]
+		if !ok {
+			continue
+		}
+		oldMap, oldIsMap := old.(map[string]interface{})
+		newMap, newIsMap := layer[k].(map[string]interface{})
+		if oldIsMap && newIsMap {
+			if p, ok := changesSealed(oldMap, newMap, joinPath(path, k)); ok {
+				return p, true
+			}
+			continue
+		}
+		if !normalizedEqual(old, layer[k]) {
+			return joinPath(path, k), true
+		}
+	}
+	return "", false
+}
+
+// checkFlag checks the values assigned by a single flag value.
+func (c *layerChecks) checkFlag(flag, value string) error {
+	layer, err := parseFlagLayer(flag, value)
+	if err != nil {
+		return newValuesError(ErrParse, flag+" "+value, "", errors.Wrapf(err, "failed parsing %s data", flag))
+	}
+	source := flag + " " + value
+	if flag == flagSet || flag == flagSetString || flag == flagSetPointer {
+		if err := c.schema.checkTypes(layer, ""); err != nil {
+			return errors.Wrapf(withSource(err, source), "%s conflicts with the values schema", source)
+		}
+	}
+	return c.checkLayer(source, layer)
+}
+
+// parseFlagLayer parses a single flag value into a map holding only the values
+// it assigns. Files referenced by --set-file are not read, their contents are
+// replaced by empty strings.
+func parseFlagLayer(flag, value string) (map[string]interface{}, error) {
+	switch flag {
+	case flagSetJSON:
+		layer := map[string]interface{}{}
+		if err := strvals.ParseJSON(value, layer); err != nil {
+			return nil, err
+		}
+		return layer, nil
+	case flagSetString:
+		return strvals.ParseString(value)
+	case flagSetFile:
+		return strvals.ParseFile(value, func([]rune) (interface{}, error) {
+			return "", nil
+		})
+	case flagSetLiteral:
+		return strvals.ParseLiteral(value)
+	case flagSetPointer:
+		layer := map[string]interface{}{}
+		if err := parsePointerValue(value, layer); err != nil {
+			return nil, err
+		}
+		return layer, nil
+	}
+	return strvals.Parse(value)
+}
+
+// assignsPath reports whether merging layer would assign path or replace one of its parents.
+func assignsPath(layer map[string]interface{}, path []string) bool {
+	cur := layer
+	for i, key := range path {
+		v, ok := cur[key]
+		if !ok {
+			return false
+		}
+		if i == len(path)-1 {
+			return true
+		}
+		next, ok := v.(map[string]interface{})
+		if !ok {
+			// A non-map value replaces the whole subtree holding the path
+			return true
+		}
+		cur = next
+	}
+	return false
+}
+
+// exceedsDepth reports whether layer has values nested deeper than maxDepth levels,
+// a.b[0] being at level 3, returning the path of the first value beyond the limit.
+// It walks the values iteratively, so the check itself cannot exhaust the stack.
+func exceedsDepth(layer map[string]interface{}, maxDepth int) (string, bool) {
+	type entry struct {
+		val   interface{}
+		path  string
+		depth int
+	}
+	stack := []entry{{val: layer}}
+	for len(stack) > 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if e.depth > maxDepth {
+			return e.path, true
+		}
+		switch v := e.val.(type) {
+		case map[string]interface{}:
+			for _, k := range sortedKeys(v) {
+				stack = append(stack, entry{val: v[k], path: joinPath(e.path, k), depth: e.depth + 1})
+			}
+		case []interface{}:
+			for i, item := range v {
+				stack = append(stack, entry{val: item, path: fmt.Sprintf("%s[%d]", e.path, i), depth: e.depth + 1})
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// profileScheme is the scheme of the value files read from the profiles of the charts.
+const profileScheme = "profile"
+
+// profileFetcher reads the profiles of the charts, profile://version.yaml being
+// the file profiles/version.yaml.
+type profileFetcher struct {
+	files fs.FS
+}
+
+func (profileFetcher) Scheme() string {
+	return profileScheme
+}
+
+func (f profileFetcher) Fetch(_ context.Context, uri string) ([]byte, error) {
+	return fs.ReadFile(f.files, getValuesFile(strings.TrimPrefix(uri, profileScheme+"://")))
+}
+
+// profileValueFiles returns the value files of the profiles, given like --profile
+// ha or --profile version=v1.17.0, in order.
+func profileValueFiles(profiles []string) []string {
+	files := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		key, _, _ := strings.Cut(p, "=")
+		files = append(files, profileScheme+"://"+path.Base(getValuesFile(key)))
+	}
+	return files
+}
+
+// profileVersion returns the version given by the last --profile version=<version>,
+// or an empty string if there is none.
+func profileVersion(profiles []string) string {
+	var version string
+	for _, p := range profiles {
+		if key, value, ok := strings.Cut(p, "="); ok && key == DefaultProfileString && value != "" {
+			version = value
+			if !strings.HasPrefix(version, "v") {
+				version = "v" + version
+			}
+		}
+	}
+	return version
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// DefaultFetchTimeout bounds reading a remote value source when Options.FetchTimeout is zero.
+const DefaultFetchTimeout = 30 * time.Second
+
+// isURLSource reports whether the value source is given by a URL rather than a path.
+func isURLSource(filePath string) bool {
+	return strings.Contains(filePath, "://")
+}
+
+// fetchTimeout returns the timeout for reading a value source.
+func (opts *Options) fetchTimeout() time.Duration {
+	if opts.FetchTimeout > 0 {
+		return opts.FetchTimeout
+	}
+	return DefaultFetchTimeout
+}
+
+// readWebSocket reads a value document from the first message received on a
+// WebSocket connection to url before the deadline of ctx. Failures to connect or
+// receive are fetch errors, parsing the document is left to the caller.
+func (opts *Options) readWebSocket(ctx context.Context, url string) ([]byte, error) {
+	dialer := websocket.Dialer{
+		Proxy: http.ProxyFromEnvironment,
+	}
+	if opts.FetchInsecure && strings.HasPrefix(url, "wss://") {
+		if opts.StrictSecurity {
+			return nil, newValuesError(ErrInvalidOptions, url, "",
+				errors.Errorf("refusing to fetch %s without TLS verification, StrictSecurity is set", url))
+		}
+		klog.Warningf("WARNING: fetching %s without TLS verification, the values applied from it may have been tampered with", url)
+		dialer.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	conn, resp, err := dialer.DialContext(ctx, url, opts.FetchHeaders)
+	if err != nil {
+		if resp != nil {
+			err = errors.Wrapf(err, "handshake status %s", resp.Status)
+		}
+		return nil, newValuesError(ErrFetch, url, "", errors.Wrapf(err, "failed to connect to %s", url))
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(opts.fetchTimeout())
+	}
+	if err := conn.SetReadDeadline(deadline); err != nil {
+		return nil, newValuesError(ErrFetch, url, "", errors.Wrapf(err, "failed to read from %s", url))
+	}
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		return nil, newValuesError(ErrFetch, url, "", errors.Wrapf(err, "failed to read from %s", url))
+	}
+	// Only the first document is used, tell the server we are done
+	_ = conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	return data, nil
+}
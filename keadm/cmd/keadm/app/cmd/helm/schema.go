@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// valuesSchema is a parsed JSON schema of the values, such as a chart's values.schema.json.
+// Only the subset of keywords needed to describe the shape of the values is interpreted.
+type valuesSchema map[string]interface{}
+
+// parseValuesSchema parses a JSON schema document.
+func parseValuesSchema(data []byte) (valuesSchema, error) {
+	schema := valuesSchema{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, newValuesError(ErrParse, "values schema", "", errors.Wrap(err, "failed to parse values schema"))
+	}
+	return schema, nil
+}
+
+// property returns the schema of the object property key, or nil if it is not declared.
+func (s valuesSchema) property(key string) valuesSchema {
+	props, ok := s["properties"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	prop, ok := props[key].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return prop
+}
+
+// items returns the schema of the array items, or nil if it is not declared.
+func (s valuesSchema) items() valuesSchema {
+	items, ok := s["items"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return items
+}
+
+// allowsAdditionalProperties reports whether an object may have properties the
+// schema does not declare, which is only disallowed by "additionalProperties": false.
+func (s valuesSchema) allowsAdditionalProperties() bool {
+	allowed, ok := s["additionalProperties"].(bool)
+	return !ok || allowed
+}
+
+// description returns the description of the schema, which may be empty.
+func (s valuesSchema) description() string {
+	d, _ := s["description"].(string)
+	return d
+}
+
+// types returns the JSON types allowed by the schema, which may be empty.
+func (s valuesSchema) types() []string {
+	switch t := s["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		res := make([]string, 0, len(t))
+		for _, v := range t {
+			if str, ok := v.(string); ok {
+				res = append(res, str)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+// checkTypes verifies that val and its children have the types declared by the schema,
+// path is the dotted path of val used in error messages.
+func (s valuesSchema) checkTypes(val interface{}, path string) error {
+	if s == nil || val == nil {
+		return nil
+	}
+	if err := s.checkRange(val, path); err != nil {
+		return err
+	}
+	if types := s.types(); len(types) > 0 && !matchesSchemaType(val, types) {
+		return newValuesError(ErrSchemaValidation, "", path,
+			errors.Errorf("path %s expects type %s, but got %#v", path, typesString(types), val))
+	}
+	switch v := val.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			prop := s.property(k)
+			if prop == nil && !s.allowsAdditionalProperties() {
+				return newValuesError(ErrSchemaValidation, "", joinPath(path, k),
+					errors.Errorf("path %s is not declared by the values schema", joinPath(path, k)))
+			}
+			if err := prop.checkTypes(v[k], joinPath(path, k)); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		items := s.items()
+		for i, item := range v {
+			if err := items.checkTypes(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// matchesSchemaType reports whether val is of one of the given JSON schema types.
+func matchesSchemaType(val interface{}, types []string) bool {
+	for _, t := range types {
+		switch t {
+		case "null":
+			if val == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := val.(bool); ok {
+				return true
+			}
+		case "string":
+			if _, ok := val.(string); ok {
+				return true
+			}
+		case "object":
+			if _, ok := val.(map[string]interface{}); ok {
+				return true
+			}
+		case "array":
+			if _, ok := val.([]interface{}); ok {
+				return true
+			}
+		case "integer":
+			switch v := val.(type) {
+			case int, int64:
+				return true
+			case float64:
+				if v == math.Trunc(v) {
+					return true
+				}
+			}
+		case "number":
+			switch val.(type) {
+			case int, int64, float64:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typesString(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+	return fmt.Sprintf("%v", types)
+}
+
+// integerPattern matches the integers --set leaves as strings when they overflow int64.
+var integerPattern = regexp.MustCompile(`^[-+]?[0-9]+$`)
+
+// checkRange verifies that an integer or number val lies within the bounds of the
+// schema, given by minimum and maximum, and for integers within the int64 range.
+func (s valuesSchema) checkRange(val interface{}, path string) error {
+	types := s.types()
+	isInteger := containsString(types, "integer")
+	if !isInteger && !containsString(types, "number") {
+		return nil
+	}
+
+	var n *big.Float
+	switch v := val.(type) {
+	case int64:
+		n = new(big.Float).SetInt64(v)
+	case int:
+		n = new(big.Float).SetInt64(int64(v))
+	case float64:
+		n = big.NewFloat(v)
+	case string:
+		// An integer which did not fit into int64
+		if !isInteger || !integerPattern.MatchString(v) {
+			return nil
+		}
+		i, _ := new(big.Int).SetString(v, 10)
+		n = new(big.Float).SetInt(i)
+	default:
+		return nil
+	}
+
+	min, max := big.NewFloat(math.Inf(-1)), big.NewFloat(math.Inf(1))
+	if isInteger {
+		min, max = new(big.Float).SetInt64(math.MinInt64), new(big.Float).SetInt64(math.MaxInt64)
+	}
+	if m, ok := s["minimum"].(float64); ok && big.NewFloat(m).Cmp(min) > 0 {
+		min = big.NewFloat(m)
+	}
+	if m, ok := s["maximum"].(float64); ok && big.NewFloat(m).Cmp(max) < 0 {
+		max = big.NewFloat(m)
+	}
+	if n.Cmp(min) < 0 || n.Cmp(max) > 0 {
+		return newValuesError(ErrSchemaValidation, "", path, errors.Errorf("path %s value %v is out of range [%s, %s]",
+			path, val, min.Text('g', 20), max.Text('g', 20)))
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
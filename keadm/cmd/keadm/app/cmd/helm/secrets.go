@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+
+	"k8s.io/klog/v2"
+)
+
+// secretPatterns are formats of secrets which are very unlikely to match anything else.
+var secretPatterns = []struct {
+	category string
+	re       *regexp.Regexp
+}{
+	{"private key", regexp.MustCompile(`-----BEGIN ([A-Z]+ )?PRIVATE KEY-----`)},
+	{"JSON web token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{"AWS access key", regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36}\b`)},
+}
+
+const (
+	// minSecretLength and minSecretEntropy bound the random looking strings
+	// reported under keys named like secrets, see Options.isSecretPath.
+	minSecretLength  = 16
+	minSecretEntropy = 3.5
+)
+
+// secretFinding is a value that looks like a secret stored in plaintext.
+type secretFinding struct {
+	Path     string
+	Category string
+}
+
+// findSecrets returns the values of vals which look like secrets, sorted by path.
+func (opts *Options) findSecrets(vals map[string]interface{}) []secretFinding {
+	var findings []secretFinding
+	opts.collectSecrets(vals, "", &findings)
+	return findings
+}
+
+func (opts *Options) collectSecrets(v interface{}, path string, findings *[]secretFinding) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(val) {
+			opts.collectSecrets(val[k], joinPath(path, k), findings)
+		}
+	case []interface{}:
+		for i, item := range val {
+			opts.collectSecrets(item, fmt.Sprintf("%s[%d]", path, i), findings)
+		}
+	case string:
+		for _, p := range secretPatterns {
+			if p.re.MatchString(val) {
+				*findings = append(*findings, secretFinding{Path: path, Category: p.category})
+				return
+			}
+		}
+		if opts.isSecretPath(path) && len(val) >= minSecretLength && !isPlaceholder(val) && shannonEntropy(val) >= minSecretEntropy {
+			*findings = append(*findings, secretFinding{Path: path, Category: "high-entropy secret"})
+		}
+	}
+}
+
+// warnSecrets logs a warning for every secret found in the value file source.
+// The secrets themselves are never logged.
+func (opts *Options) warnSecrets(source string, vals map[string]interface{}) {
+	for _, f := range opts.findSecrets(vals) {
+		klog.Warningf("%s: %s looks like a %s stored in plaintext, consider passing it via --set or encrypting the file",
+			source, f.Path, f.Category)
+	}
+}
+
+// shannonEntropy returns the entropy of s in bits per byte.
+func shannonEntropy(s string) float64 {
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	entropy := 0.0
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / float64(len(s))
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy
+}
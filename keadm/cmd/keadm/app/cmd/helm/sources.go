@@ -0,0 +1,198 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+)
+
+// manifestPriorityPrefix marks the optional precedence of a source listed in a values manifest.
+const manifestPriorityPrefix = "priority="
+
+// weightedSource is a value source with its precedence weight.
+type weightedSource struct {
+	path   string
+	weight int
+}
+
+// expandValueFiles returns the value files to merge in order, that is the files of
+// the overlay directory, the sources listed in the values manifest and the files
+// given via -f/--values, stably sorted by ascending weight so that sources with a
+// higher weight win, and without duplicates.
+func (opts *Options) expandValueFiles() ([]string, error) {
+	if len(opts.ValueFileWeights) > 0 && len(opts.ValueFileWeights) != len(opts.ValueFiles) {
+		return nil, newValuesError(ErrInvalidOptions, "", "", errors.Errorf("got %d value file weights for %d value files",
+			len(opts.ValueFileWeights), len(opts.ValueFiles)))
+	}
+
+	var sources []weightedSource
+	if opts.OverlayDir != "" {
+		files, err := overlayFiles(opts.OverlayDir, opts.Environment)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			sources = append(sources, weightedSource{path: f})
+		}
+	}
+	if opts.ValuesManifest != "" {
+		listed, err := readValuesManifest(opts.ValuesManifest)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, listed...)
+	}
+	for i, f := range opts.ValueFiles {
+		s := weightedSource{path: f}
+		if len(opts.ValueFileWeights) > 0 {
+			s.weight = opts.ValueFileWeights[i]
+		}
+		sources = append(sources, s)
+	}
+
+	if n := opts.countSources(sources); opts.MaxSources > 0 && n > opts.MaxSources {
+		return nil, newValuesError(ErrMaxSources, "", "",
+			errors.Errorf("got %d value sources, more than the limit of %d", n, opts.MaxSources))
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return sources[i].weight < sources[j].weight
+	})
+	sources = dedupeSources(sources)
+
+	files := make([]string, 0, len(sources))
+	for _, s := range sources {
+		files = append(files, s.path)
+	}
+	return files, nil
+}
+
+// dedupeSources removes the sources listed more than once, such as a file both in
+// the overlay directory and given via -f/--values. Only the last occurrence is
+// kept, as it is the one deciding the precedence of the source.
+func dedupeSources(sources []weightedSource) []weightedSource {
+	last := map[string]int{}
+	for i, s := range sources {
+		last[sourceKey(s.path)] = i
+	}
+	res := make([]weightedSource, 0, len(last))
+	for i, s := range sources {
+		if last[sourceKey(s.path)] != i {
+			klog.Warningf("value file %s is listed more than once, only merging its last occurrence", s.path)
+			continue
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+// sourceKey identifies a value source, so that a.yaml and ./a.yaml are the same file.
+func sourceKey(path string) string {
+	if path == "-" || isURLSource(path) {
+		return path
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// countSources returns the number of value sources merged, the value files and the chart values.
+func (opts *Options) countSources(files []weightedSource) int {
+	if opts.ChartValuesBase != "" {
+		return len(files) + 1
+	}
+	return len(files)
+}
+
+// readValuesManifest reads the value sources listed in a manifest file, one per line.
+// Blank lines and lines starting with '#' are ignored. A source may be followed by
+// a "priority=<n>" marker which is used as its weight, see Options.ValueFileWeights.
+// Relative paths are resolved against the directory of the manifest.
+func readValuesManifest(manifest string) ([]weightedSource, error) {
+	data, err := readFile(manifest)
+	if err != nil {
+		return nil, newValuesError(ErrFetch, manifest, "", errors.Wrapf(err, "failed to read values manifest %s", manifest))
+	}
+
+	var sources []weightedSource
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		s := weightedSource{path: text}
+		if i := strings.LastIndexAny(text, " \t"); i > 0 && strings.HasPrefix(text[i+1:], manifestPriorityPrefix) {
+			p, err := strconv.Atoi(strings.TrimPrefix(text[i+1:], manifestPriorityPrefix))
+			if err != nil {
+				return nil, newValuesError(ErrParse, manifest, "", errors.Errorf("invalid priority in values manifest %s line %d: %s", manifest, line, text))
+			}
+			s.path, s.weight = strings.TrimSpace(text[:i]), p
+		}
+		if s.path != "-" && !filepath.IsAbs(s.path) && !isURLSource(s.path) && manifest != "-" {
+			s.path = filepath.Join(filepath.Dir(manifest), s.path)
+		}
+		sources = append(sources, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, newValuesError(ErrFetch, manifest, "", errors.Wrapf(err, "failed to read values manifest %s", manifest))
+	}
+	return sources, nil
+}
+
+// overlayFiles returns the base value files of dir followed by those of the
+// overlay for env, if env is not empty.
+func overlayFiles(dir, env string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "base", "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if env == "" {
+		return files, nil
+	}
+	envDir := filepath.Join(dir, "overlays", env)
+	if fi, err := os.Stat(envDir); err != nil || !fi.IsDir() {
+		return nil, newValuesError(ErrInvalidOptions, envDir, "", errors.Errorf(
+			"environment %q has no overlay in %s, available environments: %s", env, dir, strings.Join(overlayEnvironments(dir), ", ")))
+	}
+	overlays, err := filepath.Glob(filepath.Join(envDir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	return append(files, overlays...), nil
+}
+
+// overlayEnvironments lists the environments which have an overlay in dir.
+func overlayEnvironments(dir string) []string {
+	entries, _ := os.ReadDir(filepath.Join(dir, "overlays"))
+	var envs []string
+	for _, e := range entries {
+		if e.IsDir() {
+			envs = append(envs, e.Name())
+		}
+	}
+	return envs
+}
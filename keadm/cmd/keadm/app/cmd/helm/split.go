@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// splitCommonFile is the file of SplitValues holding the values outside modules.
+const splitCommonFile = "common.yaml"
+
+// SplitValues splits vals into one values document per module, keyed by file name:
+// the subtree modules.<name> goes to <name>.yaml and all other values to common.yaml.
+// Every document keeps the full path of its values, so merging the documents again,
+// for example via Options.ValueFiles, gives back vals.
+func SplitValues(vals map[string]interface{}) (map[string]map[string]interface{}, error) {
+	docs := map[string]map[string]interface{}{}
+	common := map[string]interface{}{}
+	for k, v := range vals {
+		if k != "modules" {
+			common[k] = v
+			continue
+		}
+		modules, ok := v.(map[string]interface{})
+		if !ok {
+			common[k] = v
+			continue
+		}
+		for name, module := range modules {
+			file := name + ".yaml"
+			if file == splitCommonFile || filepath.Base(file) != file {
+				return nil, errors.Errorf("module %q cannot be written to a file of its own", name)
+			}
+			docs[file] = map[string]interface{}{"modules": map[string]interface{}{name: module}}
+		}
+	}
+	if len(common) > 0 {
+		docs[splitCommonFile] = common
+	}
+	return docs, nil
+}
+
+// WriteSplitValues writes the documents of SplitValues to dir, which is created if
+// needed. The files are only readable by their owner, as values often include tokens.
+func WriteSplitValues(vals map[string]interface{}, dir string) error {
+	docs, err := SplitValues(vals)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create split output directory %s", dir)
+	}
+	files := make([]string, 0, len(docs))
+	for file := range docs {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		data, err := yaml.Marshal(docs[file])
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal values of %s", file)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0600); err != nil {
+			return errors.Wrapf(err, "failed to write split values")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"time"
+)
+
+// MergeStats describes the work done by a single merge of values.
+type MergeStats struct {
+	// FilesRead is the number of value files read
+	FilesRead int
+	// KeysSet is the number of leaf values assigned by all value sources
+	KeysSet int
+	// ConflictsResolved is the number of values overridden by a later value source
+	ConflictsResolved int
+	// BytesFetched is the total size of the value files read
+	BytesFetched int64
+	// FetchDurations is the time taken to read each value file, keyed by its path
+	FetchDurations map[string]time.Duration
+}
+
+// MergeValuesWithStats merges values like MergeValues, and also returns statistics
+// about the merge. Use MergeValues if the statistics are not needed, it does not
+// collect them.
+func (opts *Options) MergeValuesWithStats() (map[string]interface{}, *MergeStats, error) {
+	stats := &MergeStats{FetchDurations: map[string]time.Duration{}}
+	vals, err := opts.mergeValues(stats)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vals, stats, nil
+}
+
+// recordRead records a value file of size bytes whose read started at start.
+// All record methods do nothing on a nil MergeStats.
+func (s *MergeStats) recordRead(filePath string, size int, start time.Time) {
+	if s == nil {
+		return
+	}
+	s.FilesRead++
+	s.BytesFetched += int64(size)
+	s.FetchDurations[filePath] += time.Since(start)
+}
+
+// recordLayer records the values a layer assigns when merged into base.
+func (s *MergeStats) recordLayer(base, layer map[string]interface{}) {
+	if s == nil {
+		return
+	}
+	s.KeysSet += countLeaves(layer)
+	s.ConflictsResolved += countOverrides(base, layer)
+}
+
+// recordFlag records the values a flag assigns when applied to base.
+func (s *MergeStats) recordFlag(base map[string]interface{}, flag, value string) {
+	if s == nil {
+		return
+	}
+	// Invalid values are reported when the flag is applied
+	if layer, err := parseFlagLayer(flag, value); err == nil {
+		s.recordLayer(base, layer)
+	}
+}
+
+// countLeaves returns the number of non-map values in vals, nested empty maps count as a value.
+func countLeaves(vals map[string]interface{}) int {
+	n := 0
+	for _, v := range vals {
+		if m, ok := v.(map[string]interface{}); ok && len(m) > 0 {
+			n += countLeaves(m)
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// countOverrides returns the number of existing values in base replaced by merging layer.
+func countOverrides(base, layer map[string]interface{}) int {
+	n := 0
+	for k, v := range layer {
+		bv, ok := base[k]
+		if !ok {
+			continue
+		}
+		bm, bIsMap := bv.(map[string]interface{})
+		lm, lIsMap := v.(map[string]interface{})
+		if bIsMap && lIsMap {
+			n += countOverrides(bm, lm)
+			continue
+		}
+		n++
+	}
+	return n
+}
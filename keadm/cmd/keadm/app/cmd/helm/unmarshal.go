@@ -0,0 +1,158 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type unmarshalConfig struct {
+	disallowUnknownFields bool
+}
+
+// UnmarshalOption configures the behavior of UnmarshalValues.
+type UnmarshalOption func(*unmarshalConfig)
+
+// DisallowUnknownFields makes UnmarshalValues return an error when the values
+// contain a key that has no corresponding field in the target struct.
+func DisallowUnknownFields() UnmarshalOption {
+	return func(c *unmarshalConfig) {
+		c.disallowUnknownFields = true
+	}
+}
+
+// UnmarshalValues converts the merged values into the typed struct pointed to by out.
+// Fields are matched by their json tags, the same way sigs.k8s.io/yaml does.
+func UnmarshalValues(vals map[string]interface{}, out interface{}, opts ...UnmarshalOption) error {
+	cfg := &unmarshalConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errors.Errorf("unmarshal target must be a non-nil pointer, got %T", out)
+	}
+	if cfg.disallowUnknownFields {
+		if path := findUnknownField(vals, rv.Type().Elem(), ""); path != "" {
+			return errors.Errorf("unknown field %q", path)
+		}
+	}
+
+	data, err := json.Marshal(vals)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal values")
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal values into %T", out)
+	}
+	return nil
+}
+
+// findUnknownField returns the dotted path of the first value that has no
+// corresponding field in t, or an empty string if all values are known.
+func findUnknownField(val interface{}, t reflect.Type, path string) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for _, k := range sortedKeys(v) {
+				ft, ok := lookupJSONField(fields, k)
+				if !ok {
+					return joinPath(path, k)
+				}
+				if p := findUnknownField(v[k], ft, joinPath(path, k)); p != "" {
+					return p
+				}
+			}
+		case reflect.Map:
+			for _, k := range sortedKeys(v) {
+				if p := findUnknownField(v[k], t.Elem(), joinPath(path, k)); p != "" {
+					return p
+				}
+			}
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for i, item := range v {
+				if p := findUnknownField(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); p != "" {
+					return p
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// jsonFields returns the types of the fields of struct t keyed by their json names,
+// flattening embedded structs the same way encoding/json does.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookupJSONField finds the field for key, preferring an exact match but
+// falling back to a case-insensitive one like encoding/json.
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if ft, ok := fields[key]; ok {
+		return ft, true
+	}
+	for name, ft := range fields {
+		if strings.EqualFold(name, key) {
+			return ft, true
+		}
+	}
+	return nil, false
+}
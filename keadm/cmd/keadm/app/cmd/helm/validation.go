@@ -0,0 +1,149 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package helm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// placeholderValues are values commonly left in templates instead of real secrets.
+var placeholderValues = map[string]bool{
+	"changeme":    true,
+	"change-me":   true,
+	"change_me":   true,
+	"replaceme":   true,
+	"replace-me":  true,
+	"replace_me":  true,
+	"placeholder": true,
+	"todo":        true,
+	"xxx":         true,
+}
+
+// RequiredWhenRule requires the value at Required to be set when the value at
+// Path equals When.
+type RequiredWhenRule struct {
+	Path     string
+	When     interface{}
+	Required string
+}
+
+// DefaultModuleRules encode dependencies between KubeEdge module settings, they
+// are enforced when Options.EnforceModuleRules is set. Rules whose Path is not
+// set in the values do not apply.
+var DefaultModuleRules = []RequiredWhenRule{
+	{Path: "modules.edgeStream.enable", When: true, Required: "modules.edgeStream.tunnelServer"},
+	{Path: "cloudCore.modules.cloudHub.websocket.enable", When: true, Required: "cloudCore.modules.cloudHub.websocket.port"},
+	{Path: "cloudCore.modules.cloudHub.quic.enable", When: true, Required: "cloudCore.modules.cloudHub.quic.port"},
+}
+
+// validateValues runs the configured validations against the merged values.
+func (opts *Options) validateValues(vals map[string]interface{}) error {
+	if opts.ValidateAgainstSchema && opts.ValuesSchema != nil {
+		schema, err := parseValuesSchema(opts.ValuesSchema)
+		if err != nil {
+			return err
+		}
+		if err := schema.checkTypes(vals, ""); err != nil {
+			return errors.Wrap(err, "values conflict with the values schema")
+		}
+	}
+	if err := checkRequiredNonEmpty(vals, opts.RequiredNonEmpty); err != nil {
+		return err
+	}
+	if opts.EnforceModuleRules {
+		rules := append(append([]RequiredWhenRule{}, DefaultModuleRules...), opts.ModuleRules...)
+		if err := checkModuleRules(vals, rules); err != nil {
+			return err
+		}
+	}
+	if opts.ValidateImages {
+		if err := checkImageReferences(vals); err != nil {
+			return err
+		}
+	}
+	if err := checkFileRefs(vals, opts.ValidateFileRefs); err != nil {
+		return err
+	}
+	if opts.ValidateAddresses {
+		if err := opts.checkAddresses(vals); err != nil {
+			return err
+		}
+	}
+	return opts.checkFeatureGates(vals)
+}
+
+// checkModuleRules verifies vals against all rules, reporting every violation at once.
+func checkModuleRules(vals map[string]interface{}, rules []RequiredWhenRule) error {
+	var violations []string
+	for _, r := range rules {
+		v, ok := lookupPath(vals, r.Path)
+		// Compare the printed values, numbers read from files and flags have different types
+		if !ok || fmt.Sprint(v) != fmt.Sprint(r.When) {
+			continue
+		}
+		if req, ok := lookupPath(vals, r.Required); !ok || isEmptyValue(req) {
+			violations = append(violations, fmt.Sprintf("%s is required when %s is %v", r.Required, r.Path, r.When))
+		}
+	}
+	if len(violations) > 0 {
+		return newValuesError(ErrValidation, "", "", errors.Errorf("module rules are violated: %s", strings.Join(violations, "; ")))
+	}
+	return nil
+}
+
+// checkRequiredNonEmpty verifies that all paths have a non-empty value which is not
+// a placeholder. The values are never included in the error, as they may be secrets.
+func checkRequiredNonEmpty(vals map[string]interface{}, paths []string) error {
+	var invalid []string
+	for _, p := range paths {
+		v, ok := lookupPath(vals, p)
+		if !ok || isEmptyValue(v) {
+			invalid = append(invalid, fmt.Sprintf("%s is empty", p))
+		} else if s, ok := v.(string); ok && isPlaceholder(s) {
+			invalid = append(invalid, fmt.Sprintf("%s is a placeholder", p))
+		}
+	}
+	if len(invalid) > 0 {
+		return newValuesError(ErrValidation, "", "", errors.Errorf("required values are not set: %s", strings.Join(invalid, ", ")))
+	}
+	return nil
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(val) == ""
+	case map[string]interface{}:
+		return len(val) == 0
+	case []interface{}:
+		return len(val) == 0
+	}
+	return false
+}
+
+// isPlaceholder reports whether s looks like a placeholder, such as "changeme" or "<token>".
+func isPlaceholder(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if placeholderValues[s] {
+		return true
+	}
+	return len(s) > 2 && strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">")
+}
@@ -16,12 +16,22 @@ limitations under the License.
 package helm
 
 import (
+	"encoding/json"
 	"io"
+	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/BurntSushi/toml"
 	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
 )
 
@@ -35,47 +45,282 @@ type Options struct {
 	FileValues    []string // --set-file
 	JSONValues    []string // --set-json
 	LiteralValues []string // --set-literal
+
+	// PointerSetValues assign values like --set, but address them with RFC 6901
+	// JSON pointers, "/labels/kubeedge.io~1role=edge", which is unambiguous for
+	// keys containing dots. They are applied after all other flags.
+	PointerSetValues []string
+
+	// ValueFileFormats forces the parser used for a value file, keyed by the
+	// path given in ValueFiles. Valid formats are "yaml", "json" and "toml".
+	// Files not listed here are detected by their extension.
+	ValueFileFormats map[string]string
+
+	// ValuesSchema is a JSON schema of the values, such as a chart's values.schema.json.
+	// When set, values given via --set and --set-string must have the types declared
+	// by the schema, e.g. --set modules.edged.enable=enabled is rejected for a boolean.
+	ValuesSchema []byte
+	// ValidateAgainstSchema also checks the merged values against ValuesSchema,
+	// which rejects unknown keys of objects declaring "additionalProperties": false.
+	ValidateAgainstSchema bool
+
+	// ValuesManifest is a file listing value files, one per line, which are merged
+	// in order before the files given via -f/--values with the same weight.
+	ValuesManifest string
+
+	// ValueFileWeights are the precedence weights of ValueFiles, by index. Value
+	// files are merged by ascending weight, so a file with a higher weight wins
+	// regardless of its position, files with the same weight keep their order.
+	// Values given via flags such as --set are always applied after all files.
+	ValueFileWeights []int
+
+	// FixTabs converts tab indentation of YAML value files to spaces instead of
+	// failing, since YAML does not allow tabs for indentation.
+	FixTabs bool
+
+	// LockedPaths are dotted paths which only the first value source may set.
+	// Any later value file or flag assigning one of them, or replacing one of
+	// their parents, is rejected.
+	LockedPaths []string
+
+	// SealAfterBaseFiles seals the values merged from the ChartValuesBase and the
+	// first SealAfterBaseFiles value files: later value files and flags may only add
+	// new keys, changing any sealed value is rejected. Zero disables sealing.
+	SealAfterBaseFiles int
+
+	// LiteralPaths are dotted paths whose scalar values are kept as strings exactly
+	// as written in the value files and --set flags, bypassing implicit typing, so that
+	// a version like 1.20 does not become the number 1.2.
+	LiteralPaths []string
+
+	// TrimEmpty removes empty maps and lists from the merged values. A map holding
+	// only the KeepEmptyMarker key is kept as an empty map.
+	TrimEmpty bool
+
+	// OnConflict, if set, is called whenever a value file overrides a value set by
+	// a previous value file, and returns the value to keep. When nil the last value
+	// wins. Values set via flags always override.
+	OnConflict ConflictFunc
+
+	// ChartValuesBase is the path of a chart directory or packaged chart, whose
+	// values.yaml is used as the base of all other values, like helm does.
+	ChartValuesBase string
+
+	// RequiredNonEmpty are dotted paths, such as tokens and keys, which must have
+	// a non-empty value that is not a placeholder like "changeme" after merging.
+	RequiredNonEmpty []string
+
+	// EnforceModuleRules checks the merged values against DefaultModuleRules and
+	// ModuleRules, reporting all violations together.
+	EnforceModuleRules bool
+	ModuleRules        []RequiredWhenRule
+
+	// ScanForSecrets warns about values in the value files which look like
+	// secrets stored in plaintext, such as private keys and tokens. Only the path
+	// and the kind of secret are logged.
+	ScanForSecrets bool
+
+	// ValidateImages checks that the image references in the merged values, such
+	// as cloudCore.image, are well-formed, see checkImageReferences.
+	ValidateImages bool
+
+	// ValidateFileRefs are dotted paths of values naming local files, such as
+	// certificates or a kubeconfig, which must exist and be readable when merging.
+	ValidateFileRefs []string
+
+	// ValidateAddresses checks that the values at DefaultIPPaths and IPPaths are
+	// IP addresses, and those at DefaultCIDRPaths and CIDRPaths are CIDRs.
+	ValidateAddresses bool
+	IPPaths           []string
+	CIDRPaths         []string
+
+	// FeatureGateRules are checked, together with DefaultFeatureGateRules, against
+	// the feature gates map at FeatureGatesPath, DefaultFeatureGatesPath if empty.
+	FeatureGateRules []FeatureGateRule
+	FeatureGatesPath string
+
+	// OverlayDir is a directory of value files laid out by environment. All
+	// base/*.yaml files are merged first, then all overlays/<Environment>/*.yaml
+	// files, each in lexical order, followed by the other value files.
+	OverlayDir  string
+	Environment string
+
+	// KeyTransform, if not nil, maps the keys of every value document when it is
+	// parsed, such as SnakeToCamelCase, see also LookupKeyTransform. LiteralPaths
+	// refer to the keys as written in the documents. Flags are not transformed.
+	KeyTransform KeyTransform
+
+	// FetchTimeout bounds connecting to and reading a remote value file, such as
+	// ws:// and wss:// URLs, DefaultFetchTimeout if zero. FetchHeaders are sent
+	// with the request, for example to authenticate.
+	FetchTimeout time.Duration
+	FetchHeaders http.Header
+	// Fetchers read value files with custom URI schemes, they take precedence
+	// over the built-in fetchers of local files, env:// and ws:// or wss:// URLs.
+	Fetchers []Fetcher
+	// FetchInsecure skips the verification of the server certificate of wss://
+	// sources, which always logs a warning naming the URL.
+	FetchInsecure bool
+	// StrictSecurity refuses options undermining the integrity of the values,
+	// currently fetching with FetchInsecure.
+	StrictSecurity bool
+
+	// EnvOverridePrefix, if set, merges every environment variable with this prefix
+	// after all other sources, the rest of the name being the path with keys separated
+	// by "__": KEADM_VAL_modules__edged__nodeIP=1.2.3.4. The values are typed the way
+	// --set does, unless EnvOverridesAsStrings is set.
+	EnvOverridePrefix     string
+	EnvOverridesAsStrings bool
+
+	// StrictYAML rejects YAML and JSON value files with duplicate keys in a
+	// mapping, a common authoring mistake which otherwise silently keeps the last value.
+	StrictYAML bool
+
+	// EnforceConfigVersion makes value files declaring config versions, see
+	// ConfigVersionKey, with different major versions an error instead of a
+	// warning. The merged values carry the highest declared version.
+	EnforceConfigVersion bool
+
+	// MaxSources limits the number of value files, including those found in the
+	// OverlayDir and ValuesManifest and the ChartValuesBase. Zero means no limit.
+	MaxSources int
+
+	// MaxDepth is the maximum nesting of maps and lists a value source may have,
+	// protecting the recursive merge against pathological inputs. Zero means
+	// DefaultMaxDepth, a negative value disables the limit.
+	MaxDepth int
+
+	// SplitOutputDir, if set, makes MergeValues also write the merged values to
+	// this directory, one file per module and common.yaml, see WriteSplitValues.
+	SplitOutputDir string
 }
 
+// DefaultMaxDepth is the nesting limit used when Options.MaxDepth is zero.
+const DefaultMaxDepth = 100
+
+const (
+	valuesFormatYAML = "yaml"
+	valuesFormatJSON = "json"
+	valuesFormatTOML = "toml"
+)
+
 // MergeValues merges values from files specified via -f/--values and directly
 // via --set-json, --set, --set-string, or --set-file, marshaling them to YAML
 func (opts *Options) MergeValues() (map[string]interface{}, error) {
-	base := map[string]interface{}{}
+	return opts.mergeValues(nil)
+}
 
-	// User specified a values files via -f/--values
-	for _, filePath := range opts.ValueFiles {
-		currentMap := map[string]interface{}{}
+// mergeValues implements MergeValues, recording statistics into stats if it is not nil.
+func (opts *Options) mergeValues(stats *MergeStats) (map[string]interface{}, error) {
+	base := map[string]interface{}{}
+	checks, err := opts.newLayerChecks()
+	if err != nil {
+		return nil, err
+	}
+	layers, err := opts.parseDocumentLayers(stats)
+	if err != nil {
+		return nil, err
+	}
+	versions := &configVersions{enforce: opts.EnforceConfigVersion}
+	sealAt := opts.SealAfterBaseFiles
+	if sealAt > 0 && opts.ChartValuesBase != "" {
+		sealAt++
+	}
+	for i, layer := range layers {
+		if err := checks.checkLayer(layer.Source, layer.Values); err != nil {
+			return nil, err
+		}
+		if err := versions.check(layer.Source, layer.Values); err != nil {
+			return nil, err
+		}
+		stats.recordLayer(base, layer.Values)
+		// Merge with the previous map
+		if base, err = mergeMapsWithConflicts(base, layer.Values, "", opts.OnConflict); err != nil {
+			return nil, errors.Wrapf(withSource(err, layer.Source), "failed to merge %s", layer.Source)
+		}
+		if i+1 == sealAt || (i+1 == len(layers) && sealAt > len(layers)) {
+			checks.seal(base)
+		}
+	}
+	versions.apply(base)
 
-		bytes, err := readFile(filePath)
-		if err != nil {
+	if err := opts.applyFlagValues(base, checks, stats); err != nil {
+		return nil, err
+	}
+	for _, layer := range opts.envOverrideLayers() {
+		if err := checks.schema.checkTypes(layer.Values, ""); err != nil {
+			return nil, errors.Wrapf(withSource(err, layer.Source), "%s conflicts with the values schema", layer.Source)
+		}
+		if err := checks.checkLayer(layer.Source, layer.Values); err != nil {
 			return nil, err
 		}
+		stats.recordLayer(base, layer.Values)
+		if base, err = mergeMapsWithConflicts(base, layer.Values, "", opts.OnConflict); err != nil {
+			return nil, errors.Wrapf(withSource(err, layer.Source), "failed to merge %s", layer.Source)
+		}
+	}
 
-		if err := yaml.Unmarshal(bytes, &currentMap); err != nil {
-			return nil, errors.Wrapf(err, "failed to parse %s", filePath)
+	if opts.TrimEmpty {
+		trimEmpty(base)
+	}
+	if err := opts.validateValues(base); err != nil {
+		return nil, err
+	}
+	if opts.SplitOutputDir != "" {
+		if err := WriteSplitValues(base, opts.SplitOutputDir); err != nil {
+			return nil, err
 		}
-		// Merge with the previous map
-		base = mergeMaps(base, currentMap)
 	}
+	return base, nil
+}
+
+// FlagValuesFile returns a values file equivalent to the values given via
+// --set-json, --set, --set-string, --set-file, --set-literal and PointerSetValues,
+// so that an ad-hoc invocation can be captured and passed via -f/--values instead.
+func (opts *Options) FlagValuesFile() ([]byte, error) {
+	vals := map[string]interface{}{}
+	if err := opts.applyFlagValues(vals, &layerChecks{}, nil); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(vals)
+}
 
+// applyFlagValues applies the values given via --set-json, --set, --set-string,
+// --set-file, --set-literal and PointerSetValues to base, in that order.
+func (opts *Options) applyFlagValues(base map[string]interface{}, checks *layerChecks, stats *MergeStats) error {
 	// User specified a value via --set-json
 	for _, value := range opts.JSONValues {
+		stats.recordFlag(base, flagSetJSON, value)
 		if err := strvals.ParseJSON(value, base); err != nil {
-			return nil, errors.Errorf("failed parsing --set-json data %s", value)
+			return newValuesError(ErrParse, flagSetJSON+" "+value, "", errors.Errorf("failed parsing --set-json data %s", value))
+		}
+		if err := checks.checkFlag(flagSetJSON, value); err != nil {
+			return err
 		}
 	}
 
 	// User specified a value via --set
 	for _, value := range opts.Values {
+		stats.recordFlag(base, flagSet, value)
 		if err := strvals.ParseInto(value, base); err != nil {
-			return nil, errors.Wrap(err, "failed parsing --set data")
+			return newValuesError(ErrParse, flagSet+" "+value, "", errors.Wrap(err, "failed parsing --set data"))
+		}
+		if err := opts.applyLiteralFlag(base, value); err != nil {
+			return newValuesError(ErrParse, flagSet+" "+value, "", err)
+		}
+		if err := checks.checkFlag(flagSet, value); err != nil {
+			return err
 		}
 	}
 
 	// User specified a value via --set-string
 	for _, value := range opts.StringValues {
+		stats.recordFlag(base, flagSetString, value)
 		if err := strvals.ParseIntoString(value, base); err != nil {
-			return nil, errors.Wrap(err, "failed parsing --set-string data")
+			return newValuesError(ErrParse, flagSetString+" "+value, "", errors.Wrap(err, "failed parsing --set-string data"))
+		}
+		if err := checks.checkFlag(flagSetString, value); err != nil {
+			return err
 		}
 	}
 
@@ -88,44 +333,264 @@ func (opts *Options) MergeValues() (map[string]interface{}, error) {
 			}
 			return string(bytes), err
 		}
+		stats.recordFlag(base, flagSetFile, value)
 		if err := strvals.ParseIntoFile(value, base, reader); err != nil {
-			return nil, errors.Wrap(err, "failed parsing --set-file data")
+			return newValuesError(ErrParse, flagSetFile+" "+value, "", errors.Wrap(err, "failed parsing --set-file data"))
+		}
+		if err := checks.checkFlag(flagSetFile, value); err != nil {
+			return err
 		}
 	}
 
 	// User specified a value via --set-literal
 	for _, value := range opts.LiteralValues {
+		stats.recordFlag(base, flagSetLiteral, value)
 		if err := strvals.ParseLiteralInto(value, base); err != nil {
-			return nil, errors.Wrap(err, "failed parsing --set-literal data")
+			return newValuesError(ErrParse, flagSetLiteral+" "+value, "", errors.Wrap(err, "failed parsing --set-literal data"))
+		}
+		if err := checks.checkFlag(flagSetLiteral, value); err != nil {
+			return err
 		}
 	}
 
-	return base, nil
+	// User specified a value via a JSON pointer
+	for _, value := range opts.PointerSetValues {
+		stats.recordFlag(base, flagSetPointer, value)
+		if err := parsePointerValue(value, base); err != nil {
+			return newValuesError(ErrParse, flagSetPointer+" "+value, "", errors.Wrap(err, "failed parsing pointer set data"))
+		}
+		if err := checks.checkFlag(flagSetPointer, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// valueFileFormat returns the format of the value file, preferring the one
+// forced via ValueFileFormats over the one implied by the file extension.
+func (opts *Options) valueFileFormat(filePath string) (string, error) {
+	if format, ok := opts.ValueFileFormats[filePath]; ok {
+		switch format {
+		case valuesFormatYAML, valuesFormatJSON, valuesFormatTOML:
+			return format, nil
+		}
+		return "", newValuesError(ErrInvalidOptions, filePath, "", errors.Errorf("unsupported format %q for %s", format, filePath))
+	}
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return valuesFormatJSON, nil
+	case ".toml":
+		return valuesFormatTOML, nil
+	}
+	return valuesFormatYAML, nil
+}
+
+// parseValueBytes parses the contents of a values file read from filePath.
+// Every format goes through JSON, so that values have the same types regardless
+// of the format they were written in. YAML type tags such as !!str and !!int are
+// honored, so `id: !!str 123456` stays a string through merging and marshaling.
+// With strict set, duplicate keys are errors. TOML never allows them.
+func parseValueBytes(filePath, format string, bytes []byte, strict bool) (map[string]interface{}, error) {
+	currentMap := map[string]interface{}{}
+	switch format {
+	case valuesFormatJSON:
+		// encoding/json keeps the last of duplicate keys, JSON is YAML and the strict YAML decoder finds them
+		if strict {
+			if err := yaml.UnmarshalStrict(bytes, &map[string]interface{}{}); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse %s", filePath)
+			}
+		}
+		if err := json.Unmarshal(bytes, &currentMap); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", filePath)
+		}
+	case valuesFormatTOML:
+		tomlMap := map[string]interface{}{}
+		if err := toml.Unmarshal(bytes, &tomlMap); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", filePath)
+		}
+		data, err := json.Marshal(tomlMap)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to convert %s", filePath)
+		}
+		if err := json.Unmarshal(data, &currentMap); err != nil {
+			return nil, errors.Wrapf(err, "failed to convert %s", filePath)
+		}
+	default:
+		unmarshal := yaml.Unmarshal
+		if strict {
+			unmarshal = yaml.UnmarshalStrict
+		}
+		if err := unmarshal(bytes, &currentMap); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", filePath)
+		}
+	}
+	return currentMap, nil
 }
 
+// ConflictFunc decides the value of path when a value source overrides an existing
+// value with a different one. Returning an error aborts the merge.
+type ConflictFunc func(path string, existing, incoming interface{}) (interface{}, error)
+
 func mergeMaps(a, b map[string]interface{}) map[string]interface{} {
+	out, _ := mergeMapsWithConflicts(a, b, "", nil)
+	return out
+}
+
+// mergeMapsWithConflicts merges b into a copy of a like mergeMaps, calling onConflict,
+// if not nil, for every value of a which b would override. path is the dotted path of a.
+func mergeMapsWithConflicts(a, b map[string]interface{}, path string, onConflict ConflictFunc) (map[string]interface{}, error) {
 	out := make(map[string]interface{}, len(a))
 	for k, v := range a {
 		out[k] = v
 	}
-	for k, v := range b {
+	for _, k := range sortedKeys(b) {
+		v := b[k]
 		if v, ok := v.(map[string]interface{}); ok {
 			if bv, ok := out[k]; ok {
 				if bv, ok := bv.(map[string]interface{}); ok {
-					out[k] = mergeMaps(bv, v)
+					merged, err := mergeMapsWithConflicts(bv, v, joinPath(path, k), onConflict)
+					if err != nil {
+						return nil, err
+					}
+					out[k] = merged
 					continue
 				}
 			}
 		}
+		if existing, ok := out[k]; ok && onConflict != nil && !reflect.DeepEqual(existing, v) {
+			resolved, err := onConflict(joinPath(path, k), existing, v)
+			if err != nil {
+				return nil, newValuesError(ErrMergeConflict, "", joinPath(path, k), err)
+			}
+			v = resolved
+		}
 		out[k] = v
 	}
-	return out
+	return out, nil
+}
+
+// readValueFile reads a value file in the given format.
+func (opts *Options) readValueFile(filePath, format string) ([]byte, error) {
+	bytes, err := opts.fetch(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return opts.checkIndentation(filePath, format, bytes)
+}
+
+// checkIndentation checks YAML documents for tab indentation, converting
+// the tabs to spaces if FixTabs is set.
+func (opts *Options) checkIndentation(source, format string, bytes []byte) ([]byte, error) {
+	if format != valuesFormatYAML {
+		return bytes, nil
+	}
+	line := tabIndentedLine(bytes)
+	if line == 0 {
+		return bytes, nil
+	}
+	if !opts.FixTabs {
+		return nil, newValuesError(ErrParse, source, "", errors.Errorf("failed to parse %s: line %d is indented with tabs, YAML only allows spaces for indentation", source, line))
+	}
+	klog.Warningf("%s is indented with tabs from line %d, converting tabs to spaces", source, line)
+	return fixTabIndentation(bytes), nil
+}
+
+// readChartValues reads the default values file of a chart directory or packaged chart.
+func readChartValues(chartPath string) ([]byte, error) {
+	fi, err := os.Stat(chartPath)
+	if err != nil {
+		return nil, newValuesError(ErrFetch, chartPath, "", errors.Wrapf(err, "failed to load chart %s", chartPath))
+	}
+	if fi.IsDir() {
+		bytes, err := os.ReadFile(filepath.Join(chartPath, chartutil.ValuesfileName))
+		if err != nil {
+			return nil, newValuesError(ErrFetch, chartPath, "", err)
+		}
+		return bytes, nil
+	}
+	ch, err := loader.LoadFile(chartPath)
+	if err != nil {
+		return nil, newValuesError(ErrFetch, chartPath, "", errors.Wrapf(err, "failed to load chart %s", chartPath))
+	}
+	for _, f := range ch.Raw {
+		if f.Name == chartutil.ValuesfileName {
+			return f.Data, nil
+		}
+	}
+	return nil, newValuesError(ErrFetch, chartPath, "", errors.Errorf("chart %s has no %s", chartPath, chartutil.ValuesfileName))
+}
+
+// tabIndentedLine returns the number of the first line whose indentation contains
+// a tab, or 0 if there is none. Lines containing only whitespace are ignored.
+func tabIndentedLine(data []byte) int {
+	for i, line := range strings.Split(string(data), "\n") {
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if len(indent) < len(strings.TrimRight(line, "\r")) && strings.Contains(indent, "\t") {
+			return i + 1
+		}
+	}
+	return 0
 }
 
-// readFile load a file from stdin, the local directory, or a remote file with a url.
+// fixTabIndentation replaces every tab in the indentation of each line with two spaces.
+func fixTabIndentation(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		content := strings.TrimLeft(line, " \t")
+		indent := line[:len(line)-len(content)]
+		lines[i] = strings.ReplaceAll(indent, "\t", "  ") + content
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// envScheme is the prefix of a value source read from an environment variable.
+const envScheme = "env://"
+
+// readFile load a file from stdin, an environment variable holding the whole
+// document (env://NAME) or the local directory.
 func readFile(filePath string) ([]byte, error) {
 	if strings.TrimSpace(filePath) == "-" {
 		return io.ReadAll(os.Stdin)
 	}
+	if name, ok := strings.CutPrefix(filePath, envScheme); ok {
+		data, found := os.LookupEnv(name)
+		if !found {
+			return nil, errors.Errorf("environment variable %s is not set", name)
+		}
+		return []byte(data), nil
+	}
 	return os.ReadFile(filePath)
 }
+
+// joinPath appends key to the dotted values path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// lookupPath returns the value at the dotted path in vals.
+func lookupPath(vals map[string]interface{}, path string) (interface{}, bool) {
+	var cur interface{} = vals
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// sortedKeys returns the keys of m in sorted order, for deterministic traversal.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -58,13 +58,9 @@ func NewKubeEdgeReset() *cobra.Command {
 		Long:    resetLongDescription,
 		Example: resetExample,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			if !util.IsNSSMInstalled() {
-				fmt.Println("Seems like you haven't exec 'keadm join' in this host, because nssm not found in system path (auto installed by 'keadm join'), exit")
-				os.Exit(0)
-			}
 			whoRunning := util.RunningModuleV2(reset)
 			if whoRunning == common.NoneRunning && !reset.Force {
-				fmt.Println("Edgecore service installed by nssm not found in this host, exit. If you want to clean the related files, using flag --force")
+				fmt.Println("Edgecore windows service not found in this host, exit. If you want to clean the related files, using flag --force")
 				os.Exit(0)
 			}
 			return nil
@@ -85,7 +81,7 @@ func NewKubeEdgeReset() *cobra.Command {
 			// 1. kill edgecore process.
 			// For edgecore, don't delete node from K8S
 			if err := TearDownKubeEdge(reset.Kubeconfig); err != nil {
-				err = fmt.Errorf("err when stop and remove edgecore using nssm: %s", err.Error())
+				fmt.Printf("[reset] Failed to stop and remove edgecore windows service: %v\n", err)
 				fmt.Print("[reset] No edgecore running now, do you want to clean all the related directories? [y/N]: ")
 				s := bufio.NewScanner(os.Stdin)
 				s.Scan()
@@ -99,7 +95,7 @@ func NewKubeEdgeReset() *cobra.Command {
 			}
 
 			// 2. Remove containers managed by KubeEdge.
-			if err := RemoveContainers(utilsexec.New()); err != nil {
+			if err := RemoveContainers(reset.Endpoint, utilsexec.New()); err != nil {
 				fmt.Printf("Failed to remove containers: %v\n", err)
 			}
 
@@ -122,30 +118,31 @@ func NewKubeEdgeReset() *cobra.Command {
 // depending upon in which type of node it is executed
 func TearDownKubeEdge(_ string) error {
 	// 1.1 stop check if running now, stop it if running
-	if util.IsNSSMServiceRunning(util.KubeEdgeBinaryName) {
+	if util.IsWindowsServiceRunning(util.KubeEdgeBinaryName) {
 		fmt.Println("Egdecore service is running, stop...")
-		if _err := util.StopNSSMService(util.KubeEdgeBinaryName); _err != nil {
-			return _err
+		if err := util.StopWindowsService(util.KubeEdgeBinaryName, util.DefaultServiceTimeout); err != nil {
+			return err
 		}
 		fmt.Println("Egdecore service stop success.")
 	}
 
-	// 1.2 remove nssm service
-	fmt.Println("Start removing egdecore service using nssm")
-	_err := util.UninstallNSSMService(util.KubeEdgeBinaryName)
-	if _err != nil {
-		return _err
+	// 1.2 remove windows service
+	fmt.Println("Start removing egdecore windows service")
+	if err := util.UninstallWindowsService(util.KubeEdgeBinaryName); err != nil {
+		return err
 	}
 	fmt.Println("Egdecore service remove complete")
 	return nil
 }
 
 // RemoveContainers removes all Kubernetes-managed containers
-func RemoveContainers(execer utilsexec.Interface) error {
+func RemoveContainers(criSocketPath string, execer utilsexec.Interface) error {
 	fmt.Println("Start removing containers managed by KubeEdge")
-	criSocketPath, err := utilruntime.DetectCRISocket()
-	if err != nil {
-		return err
+	if criSocketPath == "" {
+		var err error
+		if criSocketPath, err = utilruntime.DetectCRISocket(); err != nil {
+			return err
+		}
 	}
 
 	containerRuntime, err := utilruntime.NewContainerRuntime(execer, criSocketPath)
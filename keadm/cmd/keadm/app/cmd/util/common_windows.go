@@ -19,7 +19,6 @@ limitations under the License.
 package util
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -66,7 +65,6 @@ func IsServiceExist(service string) bool {
 
 // IsKubeEdgeProcessRunning checks if the given process is running or not
 func IsKubeEdgeProcessRunning(proc string) (bool, error) {
-	// dont use nssm, maybe haven't installed yet
 	if IsServiceExist(proc) {
 		return true, nil
 	}
@@ -84,7 +82,7 @@ func CopyFile(src, dst string) error {
 // RunningModuleV2 identifies cloudcore/edgecore running or not.
 // only used for cloudcore container install and edgecore binary install
 func RunningModuleV2(opt *types.ResetOptions) types.ModuleRunning {
-	if IsNSSMServiceExist(KubeEdgeBinaryName) {
+	if IsWindowsServiceExist(KubeEdgeBinaryName) {
 		return types.KubeEdgeEdgeRunning
 	}
 	return types.NoneRunning
@@ -241,15 +239,16 @@ func retryDownload(filename, checksumFilename string, version semver.Version, ta
 }
 
 func runEdgeCore() error {
-	return errors.New("not implemented")
+	return StartWindowsService(KubeEdgeBinaryName, DefaultServiceTimeout)
 }
 
+// KillKubeEdgeBinary stops the windows service of the binary
 func KillKubeEdgeBinary(proc string) error {
-	return errors.New("not implemented")
+	return StopWindowsService(proc, DefaultServiceTimeout)
 }
 
 func EdgeCoreRunningModuleV2(opt *types.ResetOptions) types.ModuleRunning {
-	return types.NoneRunning
+	return RunningModuleV2(opt)
 }
 
 func CloudCoreRunningModuleV2(opt *types.ResetOptions) types.ModuleRunning {
@@ -28,6 +28,7 @@ import (
 	types "github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2/validation"
+	"github.com/kubeedge/kubeedge/pkg/grpchub"
 	"github.com/kubeedge/kubeedge/pkg/util"
 	"github.com/kubeedge/viaduct/pkg/api"
 )
@@ -127,11 +128,27 @@ func (ku *KubeEdgeInstTool) createEdgeConfigFiles() error {
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = ku.CloudCoreIP
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(cloudCoreIP, strconv.Itoa(constants.DefaultWebSocketPort))
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
 	case api.ProtocolTypeWS:
 		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = true
 		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(cloudCoreIP, strconv.Itoa(constants.DefaultQuicPort))
 		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = ku.CloudCoreIP
+		if edgeCoreConfig.Modules.EdgeHub.GRPC != nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = false
+		}
+	case grpchub.ProtocolTypeGRPC:
+		if edgeCoreConfig.Modules.EdgeHub.GRPC == nil {
+			edgeCoreConfig.Modules.EdgeHub.GRPC = v1alpha2.NewDefaultEdgeCoreConfig().Modules.EdgeHub.GRPC
+		}
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Enable = true
+		edgeCoreConfig.Modules.EdgeHub.Quic.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Enable = false
+		edgeCoreConfig.Modules.EdgeHub.GRPC.Server = ku.CloudCoreIP
+		edgeCoreConfig.Modules.EdgeHub.Quic.Server = net.JoinHostPort(cloudCoreIP, strconv.Itoa(constants.DefaultQuicPort))
+		edgeCoreConfig.Modules.EdgeHub.WebSocket.Server = net.JoinHostPort(cloudCoreIP, strconv.Itoa(constants.DefaultWebSocketPort))
 	default:
 		return fmt.Errorf("unsupported hub of protocol: %s", ku.HubProtocol)
 	}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/cri/remote"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
 
 	"github.com/kubeedge/kubeedge/common/constants"
 	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
@@ -44,6 +45,8 @@ type ContainerRuntime interface {
 	CopyResources(edgeImage string, files map[string]string) error
 	RunMQTT(mqttImage string) error
 	RemoveMQTT() error
+	ListKubePods() ([]*runtimeapi.PodSandbox, error)
+	StopKubeContainers(gracePeriod time.Duration) error
 }
 
 func NewContainerRuntime(endpoint, cgroupDriver string) (ContainerRuntime, error) {
@@ -67,6 +70,20 @@ func NewContainerRuntime(endpoint, cgroupDriver string) (ContainerRuntime, error
 	return runtime, nil
 }
 
+// CheckContainerRuntime checks if the container runtime serves the CRI on the endpoint
+func CheckContainerRuntime(endpoint string) error {
+	runtimeService, err := remote.NewRemoteRuntimeService(endpoint, time.Second*10, oteltrace.NewNoopTracerProvider())
+	if err != nil {
+		return err
+	}
+	version, err := runtimeService.Version(context.Background(), "")
+	if err != nil {
+		return fmt.Errorf("container runtime on %s is not ready: %v", endpoint, err)
+	}
+	klog.Infof("container runtime %s %s is ready on %s", version.RuntimeName, version.RuntimeVersion, endpoint)
+	return nil
+}
+
 type CRIRuntime struct {
 	endpoint            string
 	cgroupDriver        string
@@ -275,6 +292,49 @@ func (runtime *CRIRuntime) RemoveMQTT() error {
 	return nil
 }
 
+// ListKubePods lists the ready pod sandboxes of the pods managed by edged
+func (runtime *CRIRuntime) ListKubePods() ([]*runtimeapi.PodSandbox, error) {
+	sandboxes, err := runtime.RuntimeService.ListPodSandbox(runtime.ctx, &runtimeapi.PodSandboxFilter{
+		State: &runtimeapi.PodSandboxStateValue{State: runtimeapi.PodSandboxState_SANDBOX_READY},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []*runtimeapi.PodSandbox
+	for _, s := range sandboxes {
+		if _, ok := s.Labels[kubetypes.KubernetesPodNameLabel]; ok {
+			pods = append(pods, s)
+		}
+	}
+	return pods, nil
+}
+
+// StopKubeContainers stops the running containers of the pods managed by edged, the containers
+// are given the grace period to exit before they are killed
+func (runtime *CRIRuntime) StopKubeContainers(gracePeriod time.Duration) error {
+	containers, err := runtime.RuntimeService.ListContainers(runtime.ctx, &runtimeapi.ContainerFilter{
+		State: &runtimeapi.ContainerStateValue{State: runtimeapi.ContainerState_CONTAINER_RUNNING},
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, c := range containers {
+		if _, ok := c.Labels[kubetypes.KubernetesPodNameLabel]; !ok {
+			continue
+		}
+		if err := runtime.RuntimeService.StopContainer(runtime.ctx, c.Id, int64(gracePeriod.Seconds())); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to stop container %s: %v", c.Id, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 func copyResourcesCmd(files map[string]string) string {
 	var copyCmd string
 	first := true
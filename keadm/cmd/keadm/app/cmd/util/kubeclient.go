@@ -24,6 +24,18 @@ func kubeConfig(kubeconfigPath string) (conf *rest.Config, err error) {
 	return kubeConfig, nil
 }
 
+// kubeConfigWithToken builds the config of the apiserver authenticated with the bearer token,
+// the system roots are used to verify the apiserver if no CA file is given
+func kubeConfigWithToken(server, token, caFile string) *rest.Config {
+	return &rest.Config{
+		Host:            server,
+		BearerToken:     token,
+		TLSClientConfig: rest.TLSClientConfig{CAFile: caFile},
+		QPS:             float32(constants.DefaultKubeQPS),
+		Burst:           int(constants.DefaultKubeBurst),
+	}
+}
+
 // KubeClient from config
 func KubeClient(kubeConfigPath string) (*kubernetes.Clientset, error) {
 	kubeConfig, err := kubeConfig(kubeConfigPath)
@@ -17,20 +17,143 @@ limitations under the License.
 package util
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
 
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	phases "k8s.io/kubernetes/cmd/kubeadm/app/cmd/phases/reset"
 	utilruntime "k8s.io/kubernetes/cmd/kubeadm/app/util/runtime"
 	utilsexec "k8s.io/utils/exec"
 
+	"github.com/kubeedge/kubeedge/common/constants"
+	"github.com/kubeedge/kubeedge/common/types"
 	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	"github.com/kubeedge/kubeedge/pkg/apis/componentconfig/edgecore/v1alpha2"
+)
+
+const (
+	// DefaultDrainTimeout is the default time to wait for the pods of the edge node to be evicted
+	DefaultDrainTimeout = 2 * time.Minute
+	// DefaultStopGracePeriod is the grace period of the containers which are still running
+	// when edgecore is stopped
+	DefaultStopGracePeriod = 30 * time.Second
+
+	drainPollInterval = 2 * time.Second
 )
 
 func NewResetOptions() *common.ResetOptions {
-	opts := &common.ResetOptions{}
+	opts := &common.ResetOptions{
+		DrainTimeout: DefaultDrainTimeout,
+	}
 	return opts
 }
 
+// DeregisterNode asks cloudcore to cordon the edge node and evict its pods, the request is
+// authenticated with the certificate of the edge node
+func DeregisterNode(config *v1alpha2.EdgeCoreConfig) error {
+	edgeHub := config.Modules.EdgeHub
+	caCrt, err := os.ReadFile(edgeHub.TLSCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read ca: %v", err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AppendCertsFromPEM(caCrt)
+	cliCrt, err := tls.LoadX509KeyPair(edgeHub.TLSCertFile, edgeHub.TLSPrivateKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the certificate of the edge node: %v", err)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSClientConfig: &tls.Config{
+				RootCAs:      rootCAs,
+				Certificates: []tls.Certificate{cliCrt},
+				MinVersion:   tls.VersionTLS12,
+			},
+		},
+		Timeout: 30 * time.Second,
+	}
+	req, err := http.NewRequest(http.MethodPost, edgeHub.HTTPServer+constants.DefaultNodeDeregisterURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(types.NodeNameKey, config.Modules.Edged.HostnameOverride)
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("post http request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, constants.MaxRespBodyLength))
+		return fmt.Errorf("cloudcore responded %s: %s", resp.Status, body)
+	}
+	return nil
+}
+
+// WaitForPodsEvicted waits until the pods of the edge node are gone, it returns the pods which
+// are still running when the timeout expires
+func WaitForPodsEvicted(criSocketPath string, timeout time.Duration) ([]*runtimeapi.PodSandbox, error) {
+	runtime, err := newCRIRuntime(criSocketPath)
+	if err != nil {
+		return nil, err
+	}
+	return waitForPodsEvicted(runtime, timeout, drainPollInterval)
+}
+
+func waitForPodsEvicted(runtime ContainerRuntime, timeout, interval time.Duration) ([]*runtimeapi.PodSandbox, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		pods, err := runtime.ListKubePods()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods: %v", err)
+		}
+		if len(pods) == 0 || !time.Now().Before(deadline) {
+			return pods, nil
+		}
+		time.Sleep(interval)
+	}
+}
+
+// ListKubePods lists the pods of the edge node which are still running
+func ListKubePods(criSocketPath string) ([]*runtimeapi.PodSandbox, error) {
+	runtime, err := newCRIRuntime(criSocketPath)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.ListKubePods()
+}
+
+// StopContainers stops the containers of the edge node gracefully, so they can exit before
+// they are removed
+func StopContainers(criSocketPath string, gracePeriod time.Duration) error {
+	runtime, err := newCRIRuntime(criSocketPath)
+	if err != nil {
+		return err
+	}
+	return runtime.StopKubeContainers(gracePeriod)
+}
+
+func newCRIRuntime(criSocketPath string) (ContainerRuntime, error) {
+	if criSocketPath == "" {
+		var err error
+		criSocketPath, err = utilruntime.DetectCRISocket()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get crisocket with err:%v", err)
+		}
+	}
+	return NewContainerRuntime(criSocketPath, "")
+}
+
 func RemoveMqttContainer(endpoint, cgroupDriver string) error {
 	runtime, err := NewContainerRuntime(endpoint, cgroupDriver)
 	if err != nil {
@@ -63,7 +186,8 @@ func RemoveContainers(criSocketPath string, execer utilsexec.Interface) error {
 	return containerRuntime.RemoveContainers(containers)
 }
 
-func CleanDirectories(isEdgeNode bool) error {
+// ResetDirectories returns the stateful directories to clean
+func ResetDirectories(isEdgeNode bool) []string {
 	var dirToClean = []string{
 		KubeEdgePath,
 		KubeEdgeLogPath,
@@ -74,8 +198,11 @@ func CleanDirectories(isEdgeNode bool) error {
 	if isEdgeNode {
 		dirToClean = append(dirToClean, "/var/lib/dockershim", "/var/run/kubernetes", "/var/lib/cni")
 	}
+	return dirToClean
+}
 
-	for _, dir := range dirToClean {
+func CleanDirectories(isEdgeNode bool) error {
+	for _, dir := range ResetDirectories(isEdgeNode) {
 		if err := phases.CleanDir(dir); err != nil {
 			fmt.Printf("Failed to delete directory %s: %v\n", dir, err)
 		}
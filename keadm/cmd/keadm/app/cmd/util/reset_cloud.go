@@ -0,0 +1,159 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	crdclientset "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned"
+	nodeutil "github.com/kubeedge/kubeedge/pkg/util/node"
+)
+
+// CloudClients returns the clients to clean up the objects of the edge node in the cloud,
+// they are nil if neither a kubeconfig nor a token is given
+func CloudClients(opts *common.ResetOptions) (kubernetes.Interface, crdclientset.Interface, error) {
+	var config *rest.Config
+	switch {
+	case opts.Kubeconfig != "":
+		var err error
+		if config, err = kubeConfig(opts.Kubeconfig); err != nil {
+			return nil, nil, fmt.Errorf("get kube config failed with error: %s", err)
+		}
+	case opts.KubeAPIServer != "" && opts.Token != "":
+		config = kubeConfigWithToken(opts.KubeAPIServer, opts.Token, opts.KubeCAFile)
+	case opts.KubeAPIServer != "" || opts.Token != "":
+		return nil, nil, fmt.Errorf("both --kube-apiserver and --token are required to clean up the node in the cloud")
+	default:
+		return nil, nil, nil
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the custom resources can not be encoded with protobuf
+	crdConfig := rest.CopyConfig(config)
+	crdConfig.ContentType = runtime.ContentTypeJSON
+	crdClient, err := crdclientset.NewForConfig(crdConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kubeClient, crdClient, nil
+}
+
+// DeregisterNodeWithKubeClient cordons the edge node and evicts its pods through the apiserver,
+// it is used when the node can not deregister from cloudcore itself
+func DeregisterNodeWithKubeClient(kubeClient kubernetes.Interface, nodeName string) error {
+	return nodeutil.Deregister(context.Background(), kubeClient, nodeName)
+}
+
+// CleanupCloudNode deletes the stale pods, the objectsyncs and the devices of the edge node
+// and then the node itself, with dryRun it only prints the objects to delete
+func CleanupCloudNode(kubeClient kubernetes.Interface, crdClient crdclientset.Interface, nodeName string, dryRun bool) error {
+	ctx := context.Background()
+	var errs []string
+	deleteObject := func(kind, name string, del func() error) {
+		if dryRun {
+			fmt.Printf("[reset] [dry-run] Would delete %s %s\n", kind, name)
+			return
+		}
+		if err := del(); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Sprintf("failed to delete %s %s: %v", kind, name, err))
+			return
+		}
+		fmt.Printf("[reset] Deleted %s %s\n", kind, name)
+	}
+
+	// the pods can not be deleted gracefully since the edge node is gone
+	pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods of node %s: %v", nodeName, err)
+	}
+	for i := range pods.Items {
+		pod := pods.Items[i]
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		deleteObject("pod", pod.Namespace+"/"+pod.Name, func() error {
+			return kubeClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, *metav1.NewDeleteOptions(0))
+		})
+	}
+
+	// the objectsyncs of the node are named <node name>.<object uid>
+	prefix := nodeName + "."
+	objectSyncs, err := crdClient.ReliablesyncsV1alpha1().ObjectSyncs(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list objectsyncs: %v", err)
+	}
+	for i := range objectSyncs.Items {
+		sync := objectSyncs.Items[i]
+		if !strings.HasPrefix(sync.Name, prefix) {
+			continue
+		}
+		deleteObject("objectsync", sync.Namespace+"/"+sync.Name, func() error {
+			return crdClient.ReliablesyncsV1alpha1().ObjectSyncs(sync.Namespace).Delete(ctx, sync.Name, metav1.DeleteOptions{})
+		})
+	}
+	clusterObjectSyncs, err := crdClient.ReliablesyncsV1alpha1().ClusterObjectSyncs().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list clusterobjectsyncs: %v", err)
+	}
+	for i := range clusterObjectSyncs.Items {
+		sync := clusterObjectSyncs.Items[i]
+		if !strings.HasPrefix(sync.Name, prefix) {
+			continue
+		}
+		deleteObject("clusterobjectsync", sync.Name, func() error {
+			return crdClient.ReliablesyncsV1alpha1().ClusterObjectSyncs().Delete(ctx, sync.Name, metav1.DeleteOptions{})
+		})
+	}
+
+	devices, err := crdClient.DevicesV1beta1().Devices(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list devices: %v", err)
+	}
+	for i := range devices.Items {
+		device := devices.Items[i]
+		if device.Spec.NodeName != nodeName {
+			continue
+		}
+		deleteObject("device", device.Namespace+"/"+device.Name, func() error {
+			return crdClient.DevicesV1beta1().Devices(device.Namespace).Delete(ctx, device.Name, metav1.DeleteOptions{})
+		})
+	}
+
+	deleteObject("node", nodeName, func() error {
+		return kubeClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	})
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/kubeedge/kubeedge/keadm/cmd/keadm/app/cmd/common"
+	devicesv1beta1 "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+	reliablesyncsv1alpha1 "github.com/kubeedge/kubeedge/pkg/apis/reliablesyncs/v1alpha1"
+	crdfake "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned/fake"
+)
+
+// fakeRuntime returns the pods of the lists one by one on each call
+type fakeRuntime struct {
+	ContainerRuntime
+	pods [][]*runtimeapi.PodSandbox
+}
+
+func (r *fakeRuntime) ListKubePods() ([]*runtimeapi.PodSandbox, error) {
+	pods := r.pods[0]
+	if len(r.pods) > 1 {
+		r.pods = r.pods[1:]
+	}
+	return pods, nil
+}
+
+func TestWaitForPodsEvicted(t *testing.T) {
+	pod := &runtimeapi.PodSandbox{Metadata: &runtimeapi.PodSandboxMetadata{Name: "nginx", Namespace: "default"}}
+
+	runtime := &fakeRuntime{pods: [][]*runtimeapi.PodSandbox{{pod}, {pod}, {}}}
+	pods, err := waitForPodsEvicted(runtime, time.Second, time.Millisecond)
+	if err != nil || len(pods) != 0 {
+		t.Errorf("expected all pods to be evicted, got %v, err: %v", pods, err)
+	}
+
+	runtime = &fakeRuntime{pods: [][]*runtimeapi.PodSandbox{{pod}}}
+	pods, err = waitForPodsEvicted(runtime, 10*time.Millisecond, time.Millisecond)
+	if err != nil || len(pods) != 1 {
+		t.Errorf("expected the pod to be left after the timeout, got %v, err: %v", pods, err)
+	}
+}
+
+func TestCloudClients(t *testing.T) {
+	kubeClient, crdClient, err := CloudClients(&common.ResetOptions{})
+	if kubeClient != nil || crdClient != nil || err != nil {
+		t.Errorf("expected no clients without kubeconfig or token, err: %v", err)
+	}
+	if _, _, err := CloudClients(&common.ResetOptions{Token: "abc"}); err == nil {
+		t.Errorf("expected the token without the apiserver to be rejected")
+	}
+	kubeClient, crdClient, err = CloudClients(&common.ResetOptions{KubeAPIServer: "https://127.0.0.1:6443", Token: "abc"})
+	if kubeClient == nil || crdClient == nil || err != nil {
+		t.Errorf("expected the clients with the token, err: %v", err)
+	}
+}
+
+func TestCleanupCloudNode(t *testing.T) {
+	newObjects := func() ([]runtime.Object, []runtime.Object) {
+		kubeObjects := []runtime.Object{
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-01"}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "edge-02"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx-1", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: "edge-01"}},
+			&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx-2", Namespace: "default"}, Spec: corev1.PodSpec{NodeName: "edge-02"}},
+		}
+		crdObjects := []runtime.Object{
+			&reliablesyncsv1alpha1.ObjectSync{ObjectMeta: metav1.ObjectMeta{Name: "edge-01.uid-1", Namespace: "default"}},
+			&reliablesyncsv1alpha1.ObjectSync{ObjectMeta: metav1.ObjectMeta{Name: "edge-02.uid-2", Namespace: "default"}},
+			&reliablesyncsv1alpha1.ClusterObjectSync{ObjectMeta: metav1.ObjectMeta{Name: "edge-01.uid-3"}},
+			&devicesv1beta1.Device{ObjectMeta: metav1.ObjectMeta{Name: "sensor-1", Namespace: "default"},
+				Spec: devicesv1beta1.DeviceSpec{NodeName: "edge-01"}},
+			&devicesv1beta1.Device{ObjectMeta: metav1.ObjectMeta{Name: "sensor-2", Namespace: "default"},
+				Spec: devicesv1beta1.DeviceSpec{NodeName: "edge-02"}},
+		}
+		return kubeObjects, crdObjects
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		kubeObjects, crdObjects := newObjects()
+		kubeClient := fake.NewSimpleClientset(kubeObjects...)
+		crdClient := crdfake.NewSimpleClientset(crdObjects...)
+		if err := CleanupCloudNode(kubeClient, crdClient, "edge-01", dryRun); err != nil {
+			t.Fatalf("failed to clean up node, dry run %t: %v", dryRun, err)
+		}
+
+		ctx := context.Background()
+		nodes, _ := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		pods, _ := kubeClient.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+		objectSyncs, _ := crdClient.ReliablesyncsV1alpha1().ObjectSyncs("").List(ctx, metav1.ListOptions{})
+		clusterObjectSyncs, _ := crdClient.ReliablesyncsV1alpha1().ClusterObjectSyncs().List(ctx, metav1.ListOptions{})
+		devices, _ := crdClient.DevicesV1beta1().Devices("").List(ctx, metav1.ListOptions{})
+		got := []int{len(nodes.Items), len(pods.Items), len(objectSyncs.Items), len(clusterObjectSyncs.Items), len(devices.Items)}
+		want := []int{1, 1, 1, 0, 1}
+		if dryRun {
+			want = []int{2, 2, 2, 1, 2}
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("dry run %t: expected objects left %v, got %v", dryRun, want, got)
+				break
+			}
+		}
+		if !dryRun && (nodes.Items[0].Name != "edge-02" || pods.Items[0].Name != "nginx-2" || devices.Items[0].Name != "sensor-2") {
+			t.Errorf("expected the objects of the other node to be kept")
+		}
+	}
+}
@@ -0,0 +1,211 @@
+//go:build windows
+
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const (
+	// ContainerdServiceName is the windows service of containerd, edgecore depends on it
+	ContainerdServiceName = "containerd"
+	// DefaultServiceTimeout is how long to wait for a windows service to start or stop
+	DefaultServiceTimeout = time.Minute
+
+	// the failure count of the service is reset after one day without failures
+	serviceRecoveryResetPeriod = 24 * 60 * 60
+	serviceRestartDelay        = 10 * time.Second
+	servicePollInterval        = 500 * time.Millisecond
+)
+
+// InstallWindowsService registers the binary as an automatic windows service which is restarted
+// by the service control manager when it fails, the service is updated if it already exists
+func InstallWindowsService(name, description, binPath string, dependencies []string, args ...string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the service control manager: %v", err)
+	}
+	defer m.Disconnect()
+
+	config := mgr.Config{
+		DisplayName:      name,
+		Description:      description,
+		StartType:        mgr.StartAutomatic,
+		DelayedAutoStart: true,
+		Dependencies:     dependencies,
+	}
+	s, err := m.OpenService(name)
+	if err == nil {
+		current, err := s.Config()
+		if err != nil {
+			s.Close()
+			return fmt.Errorf("failed to get the config of service %s: %v", name, err)
+		}
+		config.ServiceType = current.ServiceType
+		config.ErrorControl = current.ErrorControl
+		if err := s.UpdateConfig(withBinaryPath(config, binPath, args...)); err != nil {
+			s.Close()
+			return fmt.Errorf("failed to update service %s: %v", name, err)
+		}
+	} else {
+		if s, err = m.CreateService(name, binPath, config, args...); err != nil {
+			return fmt.Errorf("failed to create service %s: %v", name, err)
+		}
+	}
+	defer s.Close()
+
+	actions := []mgr.RecoveryAction{
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+		{Type: mgr.ServiceRestart, Delay: serviceRestartDelay},
+	}
+	if err := s.SetRecoveryActions(actions, serviceRecoveryResetPeriod); err != nil {
+		return fmt.Errorf("failed to set the recovery actions of service %s: %v", name, err)
+	}
+	return nil
+}
+
+// withBinaryPath sets the command line of the service, the same as CreateService does
+func withBinaryPath(config mgr.Config, binPath string, args ...string) mgr.Config {
+	config.BinaryPathName = windows.EscapeArg(binPath)
+	for _, arg := range args {
+		config.BinaryPathName += " " + windows.EscapeArg(arg)
+	}
+	return config
+}
+
+// IsWindowsServiceExist checks if the windows service is registered
+func IsWindowsServiceExist(name string) bool {
+	m, err := mgr.Connect()
+	if err != nil {
+		return false
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return false
+	}
+	s.Close()
+	return true
+}
+
+// IsWindowsServiceRunning checks if the windows service is running
+func IsWindowsServiceRunning(name string) bool {
+	status, err := queryWindowsService(name)
+	return err == nil && status.State == svc.Running
+}
+
+// StartWindowsService starts the windows service and waits until it is running
+func StartWindowsService(name string, timeout time.Duration) error {
+	return controlWindowsService(name, timeout, svc.Running, func(s *mgr.Service) error {
+		return s.Start()
+	})
+}
+
+// StopWindowsService stops the windows service and waits until it is stopped, so the service
+// can shut down gracefully within the timeout
+func StopWindowsService(name string, timeout time.Duration) error {
+	return controlWindowsService(name, timeout, svc.Stopped, func(s *mgr.Service) error {
+		_, err := s.Control(svc.Stop)
+		return err
+	})
+}
+
+// UninstallWindowsService removes the windows service, it should be stopped first
+func UninstallWindowsService(name string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the service control manager: %v", err)
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("failed to open service %s: %v", name, err)
+	}
+	defer s.Close()
+	return s.Delete()
+}
+
+func queryWindowsService(name string) (svc.Status, error) {
+	m, err := mgr.Connect()
+	if err != nil {
+		return svc.Status{}, err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return svc.Status{}, err
+	}
+	defer s.Close()
+	return s.Query()
+}
+
+func controlWindowsService(name string, timeout time.Duration, want svc.State, control func(s *mgr.Service) error) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the service control manager: %v", err)
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("failed to open service %s: %v", name, err)
+	}
+	defer s.Close()
+
+	status, err := s.Query()
+	if err != nil {
+		return fmt.Errorf("failed to query service %s: %v", name, err)
+	}
+	if status.State == want {
+		return nil
+	}
+	if err := control(s); err != nil && !errors.Is(err, windows.ERROR_SERVICE_ALREADY_RUNNING) &&
+		!errors.Is(err, windows.ERROR_SERVICE_NOT_ACTIVE) {
+		return fmt.Errorf("failed to control service %s: %v", name, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for status.State != want {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for service %s to be %s", name, stateName(want))
+		}
+		time.Sleep(servicePollInterval)
+		if status, err = s.Query(); err != nil {
+			return fmt.Errorf("failed to query service %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func stateName(state svc.State) string {
+	if state == svc.Running {
+		return "running"
+	}
+	return "stopped"
+}
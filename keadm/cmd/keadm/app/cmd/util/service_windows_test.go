@@ -0,0 +1,20 @@
+//go:build windows
+
+package util
+
+import (
+	"testing"
+)
+
+func TestIsServiceExist(t *testing.T) {
+	t.Log(IsServiceExist("Power"))
+}
+
+func TestIsWindowsServiceExist(t *testing.T) {
+	if !IsWindowsServiceExist("Power") {
+		t.Errorf("expected the Power service to exist")
+	}
+	if IsWindowsServiceExist("kubeedge-not-exist") {
+		t.Errorf("expected the service not to exist")
+	}
+}
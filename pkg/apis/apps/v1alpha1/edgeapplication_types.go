@@ -75,6 +75,35 @@ type Overriders struct {
 	// ResourcesOverriders will override the resources field of the container
 	// +optional
 	ResourcesOverriders []ResourcesOverrider `json:"resourcesOverriders,omitempty"`
+	// ValuesOverrider renders the templates in the manifests, such as "{{ .Values.region }}",
+	// with the values of the node group. It is applied before the other overriders.
+	// +optional
+	ValuesOverrider *ValuesOverrider `json:"valuesOverrider,omitempty"`
+}
+
+// ValuesOverrider represents the Helm-style values used to render the manifests for a node group.
+//
+// Every string in a manifest containing "{{" is rendered as a Helm template, with the
+// merged values as .Values, only metadata.name and metadata.namespace are never rendered.
+// A string consisting of a single action, like "{{ toYaml .Values.env }}", is replaced by
+// the YAML its output is parsed to, so that whole blocks such as env or the data of a
+// ConfigMap can be templated, use the quote function to keep the output a string.
+// ConfigMaps and Secrets using templates are created per node group, suffixed with the name
+// of the node group like Deployments, the node group name is available as .Values.nodeGroup
+// to refer to them. Use {{ "{{" }} to keep a literal "{{" in a string.
+type ValuesOverrider struct {
+	// ValueFiles are the contents of YAML value files, merged in order like helm -f/--values,
+	// the values of a later file override those of an earlier one.
+	// +optional
+	ValueFiles []string `json:"valueFiles,omitempty"`
+
+	// Values are set on top of the ValueFiles like helm --set, such as "image.tag=v1.2.0".
+	// +optional
+	Values []string `json:"values,omitempty"`
+
+	// StringValues are set after the Values like helm --set-string, always as strings.
+	// +optional
+	StringValues []string `json:"stringValues,omitempty"`
 }
 
 // CommandArgsOverrider represents the rules dedicated to handling command/args overrides.
@@ -80,6 +80,9 @@ const (
 	FailedSelection SelectionStatus = "Failed"
 )
 
+// LabelBelongingTo is the label set on the member nodes of a NodeGroup with the name of it.
+const LabelBelongingTo = "apps.kubeedge.io/belonging-to"
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -410,6 +410,11 @@ func (in *Overriders) DeepCopyInto(out *Overriders) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ValuesOverrider != nil {
+		in, out := &in.ValuesOverrider, &out.ValuesOverrider
+		*out = new(ValuesOverrider)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -496,6 +501,37 @@ func (in *TargetNodeGroup) DeepCopy() *TargetNodeGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesOverrider) DeepCopyInto(out *ValuesOverrider) {
+	*out = *in
+	if in.ValueFiles != nil {
+		in, out := &in.ValueFiles, &out.ValueFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StringValues != nil {
+		in, out := &in.StringValues, &out.StringValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesOverrider.
+func (in *ValuesOverrider) DeepCopy() *ValuesOverrider {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesOverrider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadScope) DeepCopyInto(out *WorkloadScope) {
 	*out = *in
@@ -40,6 +40,14 @@ func NewDefaultCloudCoreConfig() *CloudCoreConfig {
 				BindAddress:     "127.0.0.1:9091",
 				EnableProfiling: false,
 			},
+			LeaderElection: &LeaderElection{
+				Enable:            false,
+				LeaseDuration:     constants.DefaultLeaderElectionLeaseDuration,
+				RenewDeadline:     constants.DefaultLeaderElectionRenewDeadline,
+				RetryPeriod:       constants.DefaultLeaderElectionRetryPeriod,
+				ResourceName:      constants.DefaultLeaderElectionResourceName,
+				ResourceNamespace: constants.SystemNamespace,
+			},
 		},
 		KubeAPIConfig: &KubeAPIConfig{
 			ContentType: constants.DefaultKubeContentType,
@@ -80,6 +88,26 @@ func NewDefaultCloudCoreConfig() *CloudCoreConfig {
 					Port:    10002,
 					Address: "0.0.0.0",
 				},
+				GRPC: &CloudHubGRPC{
+					Enable:           false,
+					Port:             10005,
+					Address:          "0.0.0.0",
+					KeepaliveTime:    30,
+					KeepaliveTimeout: 10,
+				},
+				MessageStore: &CloudHubMessageStore{
+					Enable:             false,
+					Path:               constants.DefaultCloudHubMessageStorePath,
+					Retention:          168,
+					MaxMessagesPerNode: 10000,
+				},
+				TrafficShaping: &CloudHubTrafficShaping{
+					Enable:     false,
+					NodeQPS:    100,
+					NodeBurst:  200,
+					QueueSize:  1000,
+					DropPolicy: DropPolicyLowestPriority,
+				},
 			},
 			EdgeController: &EdgeController{
 				Enable:              true,
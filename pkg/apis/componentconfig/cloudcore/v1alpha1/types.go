@@ -45,6 +45,35 @@ type CommonConfig struct {
 
 	// MonitorServer holds config that exposes prometheus metrics and pprof
 	MonitorServer MonitorServer `json:"monitorServer,omitempty"`
+
+	// LeaderElection indicates the leader election config of the cloudcore replicas
+	LeaderElection *LeaderElection `json:"leaderElection,omitempty"`
+}
+
+// LeaderElection indicates the leader election config. When it is enabled, only the leader
+// of the cloudcore replicas starts the modules and serves the edge nodes, the others stand by
+// and report not ready until they take over the leadership.
+type LeaderElection struct {
+	// Enable indicates whether the leader election is enabled
+	// default false
+	Enable bool `json:"enable"`
+	// LeaseDuration indicates the duration (second) the standby replicas wait after the last renewal
+	// of the leader before taking over the leadership
+	// default 15
+	LeaseDuration int32 `json:"leaseDuration,omitempty"`
+	// RenewDeadline indicates the duration (second) the leader retries to renew the leadership
+	// before giving it up, it must be less than LeaseDuration
+	// default 10
+	RenewDeadline int32 `json:"renewDeadline,omitempty"`
+	// RetryPeriod indicates the duration (second) between the attempts to acquire and renew the leadership
+	// default 2
+	RetryPeriod int32 `json:"retryPeriod,omitempty"`
+	// ResourceName indicates the name of the lease the leadership is recorded in
+	// default "cloudcore"
+	ResourceName string `json:"resourceName,omitempty"`
+	// ResourceNamespace indicates the namespace of the lease
+	// default "kubeedge"
+	ResourceNamespace string `json:"resourceNamespace,omitempty"`
 }
 
 // MonitorServer indicates MonitorServer config
@@ -122,6 +151,11 @@ type CloudHub struct {
 	// TLSCAKeyFile indicates caKey file path
 	// default "/etc/kubeedge/ca/rootCA.key"
 	TLSCAKeyFile string `json:"tlsCAKeyFile,omitempty"`
+	// TLSCABundleFile indicates the file path of the CA certificates trusted besides the ca,
+	// it contains the new or the previous ca when the ca is rotated, so that the edge nodes
+	// keep connecting without re-joining while the ca is switched
+	// default ""
+	TLSCABundleFile string `json:"tlsCABundleFile,omitempty"`
 	// TLSPrivateKeyFile indicates key file path
 	// default "/etc/kubeedge/certs/server.crt"
 	TLSCertFile string `json:"tlsCertFile,omitempty"`
@@ -141,6 +175,12 @@ type CloudHub struct {
 	// HTTPS indicates https server info
 	// +Required
 	HTTPS *CloudHubHTTPS `json:"https,omitempty"`
+	// GRPC indicates grpc server info
+	GRPC *CloudHubGRPC `json:"grpc,omitempty"`
+	// MessageStore indicates the persistent store of the messages sent to the edge nodes
+	MessageStore *CloudHubMessageStore `json:"messageStore,omitempty"`
+	// TrafficShaping indicates the rate limits and the prioritization of the upstream messages
+	TrafficShaping *CloudHubTrafficShaping `json:"trafficShaping,omitempty"`
 	// AdvertiseAddress sets the IP address for the cloudcore to advertise.
 	AdvertiseAddress []string `json:"advertiseAddress,omitempty"`
 	// DNSNames sets the DNSNames for CloudCore.
@@ -205,6 +245,123 @@ type CloudHubHTTPS struct {
 	Port uint32 `json:"port,omitempty"`
 }
 
+// CloudHubGRPC indicates the grpc config of CloudHub, edge nodes connect over
+// a bidirectional grpc stream which only needs HTTP/2 over TCP
+type CloudHubGRPC struct {
+	// Enable indicates whether enable grpc protocol
+	// default false
+	Enable bool `json:"enable"`
+	// Address indicates server ip address
+	// default 0.0.0.0
+	Address string `json:"address,omitempty"`
+	// Port indicates the open port for grpc server
+	// default 10005
+	Port uint32 `json:"port,omitempty"`
+	// KeepaliveTime indicates the interval of the keepalive pings sent to an idle edge node (second)
+	// default 30
+	KeepaliveTime int32 `json:"keepaliveTime,omitempty"`
+	// KeepaliveTimeout indicates how long to wait for a ping ack before closing the connection (second)
+	// default 10
+	KeepaliveTimeout int32 `json:"keepaliveTimeout,omitempty"`
+}
+
+// CloudHubMessageStore indicates the config of the persistent store of the downstream messages,
+// which keeps the messages of the offline edge nodes across the disconnections and the restarts
+// of CloudCore, and replays them when the nodes connect again
+type CloudHubMessageStore struct {
+	// Enable indicates whether persist the downstream messages
+	// default false
+	Enable bool `json:"enable"`
+	// Path indicates the database file of the store, it should be on a persistent volume
+	// default "/var/lib/kubeedge/cloudhub/messages.db"
+	Path string `json:"path,omitempty"`
+	// Retention indicates how long a message is kept in the store before it is dropped (hour)
+	// default 168
+	Retention int32 `json:"retention,omitempty"`
+	// MaxMessagesPerNode indicates the maximum number of the messages stored for an edge node,
+	// the messages beyond it are kept in memory only
+	// default 10000
+	MaxMessagesPerNode int32 `json:"maxMessagesPerNode,omitempty"`
+}
+
+// MessageQoSClass is the priority class of the upstream messages, the messages
+// of a higher class are dispatched before the ones of a lower class
+type MessageQoSClass string
+
+const (
+	// QoSClassHigh is the class of the messages dispatched first, such as the device twin updates
+	QoSClassHigh MessageQoSClass = "High"
+	// QoSClassNormal is the class of the messages that no rule matches, such as the pod status
+	QoSClassNormal MessageQoSClass = "Normal"
+	// QoSClassLow is the class of the messages dispatched last and dropped first, such as the events
+	QoSClassLow MessageQoSClass = "Low"
+)
+
+// DropPolicy indicates how to handle an upstream message when the queue of its edge node is full
+type DropPolicy string
+
+const (
+	// DropPolicyLowestPriority drops the oldest message of the lowest class lower than the
+	// class of the arriving message, or the arriving message if there is none
+	DropPolicyLowestPriority DropPolicy = "DropLowestPriority"
+	// DropPolicyNewest drops the arriving message
+	DropPolicyNewest DropPolicy = "DropNewest"
+	// DropPolicyBlock stops reading the messages from the edge node until the queue has room,
+	// keepalives of the node are not read either, so the queue should be drained in a keepalive interval
+	DropPolicyBlock DropPolicy = "Block"
+)
+
+// CloudHubTrafficShaping indicates the config of the traffic shaping of the upstream messages.
+// The messages of each edge node are queued by their QoS class and dispatched at the rate limits
+// of the node, so that a single chatty node can not starve the message dispatch of the others
+type CloudHubTrafficShaping struct {
+	// Enable indicates whether shape the upstream messages
+	// default false
+	Enable bool `json:"enable"`
+	// NodeQPS indicates the maximum rate of the upstream messages dispatched for an edge node
+	// default 100
+	NodeQPS int32 `json:"nodeQPS,omitempty"`
+	// NodeBurst indicates the burst of the upstream messages dispatched for an edge node
+	// default 200
+	NodeBurst int32 `json:"nodeBurst,omitempty"`
+	// QueueSize indicates the maximum number of the upstream messages queued for an edge node
+	// default 1000
+	QueueSize int32 `json:"queueSize,omitempty"`
+	// DropPolicy indicates how to handle a message when the queue of the edge node is full,
+	// one of DropLowestPriority, DropNewest and Block
+	// default DropLowestPriority
+	DropPolicy DropPolicy `json:"dropPolicy,omitempty"`
+	// GroupLimits indicates the rate limits of the message groups, each of them applies to every edge node
+	GroupLimits []MessageGroupLimit `json:"groupLimits,omitempty"`
+	// QoSRules indicates the QoS classes of the messages, the first matched rule wins. They are checked
+	// before the built-in rules, which put the device twin messages in the High class and the events
+	// in the Low class, the messages no rule matches are in the Normal class
+	QoSRules []MessageQoSRule `json:"qosRules,omitempty"`
+}
+
+// MessageGroupLimit indicates the rate limit of the upstream messages of a message group
+type MessageGroupLimit struct {
+	// Group indicates the message group, such as resource, twin and user
+	Group string `json:"group"`
+	// QPS indicates the maximum rate of the messages of the group dispatched for an edge node
+	QPS int32 `json:"qps"`
+	// Burst indicates the burst of the messages of the group dispatched for an edge node
+	Burst int32 `json:"burst"`
+}
+
+// MessageQoSRule indicates the QoS class of the upstream messages it matches, a message
+// matches the rule if it matches all the fields set in the rule
+type MessageQoSRule struct {
+	// Source indicates the source module of the message, such as twin and edged
+	Source string `json:"source,omitempty"`
+	// Group indicates the message group, such as resource, twin and user
+	Group string `json:"group,omitempty"`
+	// ResourceType indicates a segment of the message resource, such as podstatus and event
+	ResourceType string `json:"resourceType,omitempty"`
+	// Class indicates the QoS class of the matched messages, one of High, Normal and Low
+	Class MessageQoSClass `json:"class"`
+}
+
 // EdgeController indicates the config of EdgeController module
 type EdgeController struct {
 	// Enable indicates whether EdgeController is enabled,
@@ -458,6 +615,28 @@ type DynamicController struct {
 	// if set to false (for debugging etc.), skip checking other dynamicController configs.
 	// default true
 	Enable bool `json:"enable"`
+	// ResourcePolicies restrict the resources edge nodes can get, list and watch through the
+	// dynamicController, a node only receives the objects allowed by the policy of their resource.
+	// If any policy is set, reading the resources without a policy is forbidden.
+	// default empty, which allows all resources
+	ResourcePolicies []DynamicResourcePolicy `json:"resourcePolicies,omitempty"`
+}
+
+// DynamicResourcePolicy restricts reading a resource through the dynamicController, the
+// selectors are added to those requested by edge nodes and pushed down to the apiserver
+type DynamicResourcePolicy struct {
+	// Group of the resource, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Version of the resource, all versions are allowed if empty
+	Version string `json:"version,omitempty"`
+	// Resource is the plural name of the resource, such as configmaps
+	Resource string `json:"resource"`
+	// Namespaces the resource can be read from, all namespaces are allowed if empty
+	Namespaces []string `json:"namespaces,omitempty"`
+	// LabelSelector the objects need to match, such as "kubeedge.io/edge-visible=true"
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// FieldSelector the objects need to match, such as "type!=kubernetes.io/service-account-token"
+	FieldSelector string `json:"fieldSelector,omitempty"`
 }
 
 // CloudStream indicates the stream controller
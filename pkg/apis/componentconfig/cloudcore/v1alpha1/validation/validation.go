@@ -25,6 +25,8 @@ import (
 	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	netutils "k8s.io/utils/net"
@@ -48,7 +50,37 @@ func ValidateCloudCoreConfiguration(c *v1alpha1.CloudCoreConfig) field.ErrorList
 }
 
 func ValidateCommonConfig(c v1alpha1.CommonConfig) field.ErrorList {
-	return validateHostPort(c.MonitorServer.BindAddress, field.NewPath("monitorServer.bindAddress"))
+	allErrs := validateHostPort(c.MonitorServer.BindAddress, field.NewPath("monitorServer.bindAddress"))
+	if c.LeaderElection != nil {
+		allErrs = append(allErrs, validateLeaderElection(*c.LeaderElection, field.NewPath("leaderElection"))...)
+	}
+	return allErrs
+}
+
+func validateLeaderElection(l v1alpha1.LeaderElection, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !l.Enable {
+		return allErrs
+	}
+	if l.LeaseDuration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaseDuration"), l.LeaseDuration, "must be greater than zero"))
+	}
+	if l.RenewDeadline <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewDeadline"), l.RenewDeadline, "must be greater than zero"))
+	}
+	if l.RetryPeriod <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryPeriod"), l.RetryPeriod, "must be greater than zero"))
+	}
+	if l.LeaseDuration <= l.RenewDeadline {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaseDuration"), l.LeaseDuration, "must be greater than renewDeadline"))
+	}
+	if l.ResourceName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceName"), ""))
+	}
+	if l.ResourceNamespace == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceNamespace"), ""))
+	}
+	return allErrs
 }
 
 func validateHostPort(input string, fldPath *field.Path) field.ErrorList {
@@ -111,6 +143,35 @@ func ValidateModuleCloudHub(c v1alpha1.CloudHub) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("Address"), c.Quic.Address, m))
 		}
 	}
+	if c.GRPC != nil && c.GRPC.Enable {
+		for _, m := range utilvalidation.IsValidPortNum(int(c.GRPC.Port)) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("grpc", "port"), c.GRPC.Port, m))
+		}
+		for _, m := range utilvalidation.IsValidIP(c.GRPC.Address) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("grpc", "address"), c.GRPC.Address, m))
+		}
+		if c.GRPC.KeepaliveTime <= 0 || c.GRPC.KeepaliveTimeout <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("grpc", "keepaliveTime"), c.GRPC.KeepaliveTime,
+				"keepaliveTime and keepaliveTimeout must be positive"))
+		}
+	}
+	if c.MessageStore != nil && c.MessageStore.Enable {
+		if !filepath.IsAbs(c.MessageStore.Path) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("messageStore", "path"), c.MessageStore.Path,
+				"path must be an absolute path"))
+		}
+		if c.MessageStore.Retention <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("messageStore", "retention"), c.MessageStore.Retention,
+				"retention must be positive"))
+		}
+		if c.MessageStore.MaxMessagesPerNode <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("messageStore", "maxMessagesPerNode"), c.MessageStore.MaxMessagesPerNode,
+				"maxMessagesPerNode must be positive"))
+		}
+	}
+	if c.TrafficShaping != nil && c.TrafficShaping.Enable {
+		allErrs = append(allErrs, validateTrafficShaping(*c.TrafficShaping, field.NewPath("trafficShaping"))...)
+	}
 	if !strings.HasPrefix(strings.ToLower(c.UnixSocket.Address), "unix://") {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("address"),
 			c.UnixSocket.Address, "unixSocketAddress must has prefix unix://"))
@@ -131,6 +192,51 @@ func ValidateModuleCloudHub(c v1alpha1.CloudHub) field.ErrorList {
 }
 
 // ValidateModuleEdgeController validates `e` and returns an errorList if it is invalid
+func validateTrafficShaping(t v1alpha1.CloudHubTrafficShaping, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if t.NodeQPS <= 0 || t.NodeBurst <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeQPS"), t.NodeQPS,
+			"nodeQPS and nodeBurst must be positive"))
+	}
+	if t.QueueSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("queueSize"), t.QueueSize,
+			"queueSize must be positive"))
+	}
+	switch t.DropPolicy {
+	case v1alpha1.DropPolicyLowestPriority, v1alpha1.DropPolicyNewest, v1alpha1.DropPolicyBlock:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("dropPolicy"), t.DropPolicy,
+			[]string{string(v1alpha1.DropPolicyLowestPriority), string(v1alpha1.DropPolicyNewest), string(v1alpha1.DropPolicyBlock)}))
+	}
+	groups := make(map[string]bool)
+	for i, limit := range t.GroupLimits {
+		limitPath := fldPath.Child("groupLimits").Index(i)
+		switch {
+		case limit.Group == "":
+			allErrs = append(allErrs, field.Required(limitPath.Child("group"), "group must not be empty"))
+		case groups[limit.Group]:
+			allErrs = append(allErrs, field.Duplicate(limitPath.Child("group"), limit.Group))
+		}
+		groups[limit.Group] = true
+		if limit.QPS <= 0 || limit.Burst <= 0 {
+			allErrs = append(allErrs, field.Invalid(limitPath.Child("qps"), limit.QPS, "qps and burst must be positive"))
+		}
+	}
+	for i, rule := range t.QoSRules {
+		rulePath := fldPath.Child("qosRules").Index(i)
+		if rule.Source == "" && rule.Group == "" && rule.ResourceType == "" {
+			allErrs = append(allErrs, field.Required(rulePath, "one of source, group and resourceType must be set"))
+		}
+		switch rule.Class {
+		case v1alpha1.QoSClassHigh, v1alpha1.QoSClassNormal, v1alpha1.QoSClassLow:
+		default:
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("class"), rule.Class,
+				[]string{string(v1alpha1.QoSClassHigh), string(v1alpha1.QoSClassNormal), string(v1alpha1.QoSClassLow)}))
+		}
+	}
+	return allErrs
+}
+
 func ValidateModuleEdgeController(e v1alpha1.EdgeController) field.ErrorList {
 	if !e.Enable {
 		return field.ErrorList{}
@@ -169,6 +275,24 @@ func ValidateModuleDynamicController(d v1alpha1.DynamicController) field.ErrorLi
 	}
 
 	allErrs := field.ErrorList{}
+	resources := make(map[string]bool, len(d.ResourcePolicies))
+	for i, policy := range d.ResourcePolicies {
+		fldPath := field.NewPath("ResourcePolicies").Index(i)
+		if policy.Resource == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("Resource"), "Resource must not be empty"))
+		}
+		key := strings.Join([]string{policy.Group, policy.Version, policy.Resource}, "/")
+		if resources[key] {
+			allErrs = append(allErrs, field.Duplicate(fldPath, key))
+		}
+		resources[key] = true
+		if _, err := labels.Parse(policy.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("LabelSelector"), policy.LabelSelector, err.Error()))
+		}
+		if _, err := fields.ParseSelector(policy.FieldSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("FieldSelector"), policy.FieldSelector, err.Error()))
+		}
+	}
 	return allErrs
 }
 
@@ -225,6 +225,80 @@ func TestValidateModuleCloudHub(t *testing.T) {
 			expected: field.ErrorList{field.Invalid(field.NewPath("TokenRefreshDuration"),
 				time.Duration(0), "TokenRefreshDuration must be positive")},
 		},
+		{
+			name: "case9 invalid messageStore",
+			input: v1alpha1.CloudHub{
+				Enable: true,
+				HTTPS: &v1alpha1.CloudHubHTTPS{
+					Port: 10000,
+				},
+				WebSocket: &v1alpha1.CloudHubWebSocket{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				Quic: &v1alpha1.CloudHubQUIC{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				UnixSocket: &v1alpha1.CloudHubUnixSocket{
+					Address: unixAddr,
+				},
+				MessageStore: &v1alpha1.CloudHubMessageStore{
+					Enable:             true,
+					Path:               "messages.db",
+					Retention:          168,
+					MaxMessagesPerNode: 0,
+				},
+				TokenRefreshDuration: 1,
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("messageStore", "path"), "messages.db", "path must be an absolute path"),
+				field.Invalid(field.NewPath("messageStore", "maxMessagesPerNode"), int32(0), "maxMessagesPerNode must be positive"),
+			},
+		},
+		{
+			name: "case10 invalid trafficShaping",
+			input: v1alpha1.CloudHub{
+				Enable: true,
+				HTTPS: &v1alpha1.CloudHubHTTPS{
+					Port: 10000,
+				},
+				WebSocket: &v1alpha1.CloudHubWebSocket{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				Quic: &v1alpha1.CloudHubQUIC{
+					Port:    10002,
+					Address: "127.0.0.1",
+				},
+				UnixSocket: &v1alpha1.CloudHubUnixSocket{
+					Address: unixAddr,
+				},
+				TrafficShaping: &v1alpha1.CloudHubTrafficShaping{
+					Enable:     true,
+					NodeQPS:    100,
+					NodeBurst:  200,
+					QueueSize:  1000,
+					DropPolicy: "DropOldest",
+					GroupLimits: []v1alpha1.MessageGroupLimit{
+						{Group: "twin", QPS: 50, Burst: 100},
+						{Group: "twin", QPS: 10, Burst: 10},
+					},
+					QoSRules: []v1alpha1.MessageQoSRule{
+						{ResourceType: "podstatus", Class: v1alpha1.QoSClassHigh},
+						{Class: "Critical", Group: "user"},
+					},
+				},
+				TokenRefreshDuration: 1,
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("trafficShaping", "dropPolicy"), v1alpha1.DropPolicy("DropOldest"),
+					[]string{"DropLowestPriority", "DropNewest", "Block"}),
+				field.Duplicate(field.NewPath("trafficShaping", "groupLimits").Index(1).Child("group"), "twin"),
+				field.NotSupported(field.NewPath("trafficShaping", "qosRules").Index(1).Child("class"), v1alpha1.MessageQoSClass("Critical"),
+					[]string{"High", "Normal", "Low"}),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -352,6 +426,36 @@ func TestValidateModuleDynamicController(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 resource policies ok",
+			input: v1alpha1.DynamicController{
+				Enable: true,
+				ResourcePolicies: []v1alpha1.DynamicResourcePolicy{
+					{Resource: "configmaps", Namespaces: []string{"edge"}, LabelSelector: "app in (demo)"},
+					{Group: "apps", Version: "v1", Resource: "deployments", FieldSelector: "metadata.name!=demo"},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case4 invalid resource policies",
+			input: v1alpha1.DynamicController{
+				Enable: true,
+				ResourcePolicies: []v1alpha1.DynamicResourcePolicy{
+					{Resource: "configmaps"},
+					{Resource: "configmaps", LabelSelector: "app in demo"},
+					{FieldSelector: "metadata.name"},
+				},
+			},
+			expected: field.ErrorList{
+				field.Duplicate(field.NewPath("ResourcePolicies").Index(1), "//configmaps"),
+				field.Invalid(field.NewPath("ResourcePolicies").Index(1).Child("LabelSelector"), "app in demo",
+					"unable to parse requirement: found 'demo' expected: '('"),
+				field.Required(field.NewPath("ResourcePolicies").Index(2).Child("Resource"), "Resource must not be empty"),
+				field.Invalid(field.NewPath("ResourcePolicies").Index(2).Child("FieldSelector"), "metadata.name",
+					"invalid selector: 'metadata.name'; can't understand 'metadata.name'"),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -519,6 +623,40 @@ func TestValidateCommonConfig(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "valid leader election config",
+			commonConfig: v1alpha1.CommonConfig{
+				MonitorServer: v1alpha1.MonitorServer{
+					BindAddress: "127.0.0.1:9091",
+				},
+				LeaderElection: &v1alpha1.LeaderElection{
+					Enable:            true,
+					LeaseDuration:     15,
+					RenewDeadline:     10,
+					RetryPeriod:       2,
+					ResourceName:      "cloudcore",
+					ResourceNamespace: "kubeedge",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "invalid leader election renew deadline",
+			commonConfig: v1alpha1.CommonConfig{
+				MonitorServer: v1alpha1.MonitorServer{
+					BindAddress: "127.0.0.1:9091",
+				},
+				LeaderElection: &v1alpha1.LeaderElection{
+					Enable:            true,
+					LeaseDuration:     10,
+					RenewDeadline:     15,
+					RetryPeriod:       2,
+					ResourceName:      "cloudcore",
+					ResourceNamespace: "kubeedge",
+				},
+			},
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -47,6 +47,11 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 			AliasName:  DataBaseAliasName,
 			DataSource: DataBaseDataSource,
 		},
+		Supervisor: &Supervisor{
+			Enable:             true,
+			HealthzBindAddress: constants.DefaultEdgeCoreHealthzAddr,
+			StuckTimeout:       constants.DefaultModuleStuckTimeout,
+		},
 		Modules: &Modules{
 			Edged: &Edged{
 				Enable:                true,
@@ -95,6 +100,20 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 					Server:           net.JoinHostPort(localIP, "10000"),
 					WriteDeadline:    15,
 				},
+				GRPC: &EdgeHubGRPC{
+					Enable:           false,
+					HandshakeTimeout: 30,
+					Server:           net.JoinHostPort(localIP, "10005"),
+					WriteDeadline:    15,
+					KeepaliveTime:    30,
+					KeepaliveTimeout: 10,
+				},
+				Endpoints: &EdgeHubEndpoints{
+					Strategy:       EndpointStrategyFailover,
+					ProbeTimeout:   3,
+					InitialBackoff: 5,
+					MaxBackoff:     300,
+				},
 				HTTPServer: (&url.URL{
 					Scheme: "https",
 					Host:   net.JoinHostPort(localIP, "10002"),
@@ -114,6 +133,13 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 				MqttUsername:         "",
 				MqttPassword:         "",
 				MqttMode:             MqttModeExternal,
+				MqttProtocolVersion:  MqttProtocolVersion311,
+				MqttExternalBroker: &EventBusExternalBroker{
+					KeepAlive:      30,
+					ConnectTimeout: 30,
+					InitialBackoff: 5,
+					MaxBackoff:     60,
+				},
 				TLS: &EventBusTLS{
 					Enable:                false,
 					TLSMqttCAFile:         constants.DefaultMqttCAFile,
@@ -134,6 +160,19 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 					TLSPrivateKeyFile:     constants.DefaultKeyFile,
 					ServiceAccountIssuers: []string{constants.DefaultServiceAccountIssuer},
 					DummyServer:           constants.DefaultDummyServerAddr,
+					LocalWrite: &MetaServerLocalWrite{
+						Enable:            false,
+						ConflictPolicy:    ConflictPolicyCloudWins,
+						ReconcileInterval: 10,
+					},
+				},
+				GC: &MetaManagerGC{
+					Enable:           false,
+					Interval:         600,
+					EventTTL:         3600,
+					TerminatedPodTTL: 86400,
+					MaxDBSize:        0,
+					VacuumInterval:   86400,
 				},
 			},
 			ServiceBus: &ServiceBus{
@@ -158,6 +197,14 @@ func NewDefaultEdgeCoreConfig() (config *EdgeCoreConfig) {
 				ReadDeadline:            15,
 				TunnelServer:            net.JoinHostPort("127.0.0.1", strconv.Itoa(constants.DefaultTunnelPort)),
 				WriteDeadline:           15,
+				Metrics: &EdgeStreamMetrics{
+					Enable:             false,
+					Paths:              []string{"/metrics/resource"},
+					ScrapeInterval:     15,
+					BatchSize:          20,
+					BufferPath:         constants.DefaultEdgeStreamMetricsBufferPath,
+					MaxBufferedSamples: 5760,
+				},
 			},
 		},
 	}
@@ -32,6 +32,13 @@ const (
 	MqttModeExternal MqttMode = 2
 )
 
+const (
+	// MqttProtocolVersion311 is MQTT 3.1.1
+	MqttProtocolVersion311 MqttProtocolVersion = 4
+	// MqttProtocolVersion5 is MQTT 5
+	MqttProtocolVersion5 MqttProtocolVersion = 5
+)
+
 const (
 	// DataBaseDriverName is sqlite3
 	DataBaseDriverName = "sqlite3"
@@ -39,8 +46,17 @@ const (
 	DataBaseAliasName = "default"
 )
 
+const (
+	// EndpointStrategyFailover connects to the first healthy endpoint in the configured order
+	EndpointStrategyFailover EndpointStrategy = "Failover"
+	// EndpointStrategyRandom connects to a random healthy endpoint, spreading the edge nodes across them
+	EndpointStrategyRandom EndpointStrategy = "Random"
+)
+
 type ProtocolName string
 type MqttMode int
+type MqttProtocolVersion uint8
+type EndpointStrategy string
 
 // EdgeCoreConfig indicates the EdgeCore config which read from EdgeCore config file
 type EdgeCoreConfig struct {
@@ -53,11 +69,33 @@ type EdgeCoreConfig struct {
 	Modules *Modules `json:"modules,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental features.
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// Supervisor indicates the config of supervising the health of EdgeCore modules
+	Supervisor *Supervisor `json:"supervisor,omitempty"`
+}
+
+// Supervisor indicates the config of supervising the health of EdgeCore modules. The health
+// of the modules is served by a local healthz endpoint and reported as node conditions.
+// The exited and stuck modules are restarted with backoff only if the moduleRestart
+// feature gate is enabled, a stuck module which can not be stopped, e.g. edged, makes
+// EdgeCore exit to be restarted as a whole.
+type Supervisor struct {
+	// Enable indicates whether the health of the modules is served and reported
+	// default true
+	Enable bool `json:"enable"`
+	// HealthzBindAddress indicates the address the healthz endpoint listens on
+	// default "127.0.0.1:10360"
+	HealthzBindAddress string `json:"healthzBindAddress,omitempty"`
+	// StuckTimeout indicates how long a module may leave its messages pending before it is
+	// considered stuck (second), the stuck detection is disabled if it is 0
+	// default 120
+	StuckTimeout int32 `json:"stuckTimeout,omitempty"`
 }
 
 // DataBase indicates the database info
 type DataBase struct {
-	// DriverName indicates database driver name
+	// DriverName indicates database driver name, "sqlite3", "bbolt" which stores the
+	// database in a bbolt file at DataSource without depending on CGO, or "memory"
+	// which keeps the database in memory and loses it when edgecore exits
 	// default "sqlite3"
 	DriverName string `json:"driverName,omitempty"`
 	// AliasName indicates alias name
@@ -829,7 +867,7 @@ type ContainerRuntimeOptions struct {
 	RuntimeCgroups string `json:"runtimeCgroups,omitempty"`
 	// PodSandboxImage is the image whose network/ipc namespaces
 	// containers in each pod will use.
-	// default kubeedge/pause:3.6
+	// default kubeedge/pause:3.6, mcr.microsoft.com/oss/kubernetes/pause:3.6 on windows
 	PodSandboxImage string `json:"podSandboxImage,omitempty"`
 }
 
@@ -866,6 +904,12 @@ type EdgeHub struct {
 	// WebSocket indicates websocket config for EdgeHub module
 	// Optional if quic is configured
 	WebSocket *EdgeHubWebSocket `json:"websocket,omitempty"`
+	// GRPC indicates grpc config for EdgeHub module
+	// Optional if websocket or quic is configured
+	GRPC *EdgeHubGRPC `json:"grpc,omitempty"`
+	// Endpoints indicates additional cloudcore endpoints to fail over to
+	// Optional, edgehub only connects to the server of the enabled protocol if not set
+	Endpoints *EdgeHubEndpoints `json:"endpoints,omitempty"`
 	// Token indicates the priority of joining the cluster for the edge
 	// Deprecated: will be removed in future release, will not be saved in configuration file
 	Token string `json:"token"`
@@ -895,6 +939,51 @@ type EdgeHubQUIC struct {
 	WriteDeadline int32 `json:"writeDeadline,omitempty"`
 }
 
+// EdgeHubGRPC indicates the grpc client config
+type EdgeHubGRPC struct {
+	// Enable indicates whether enable this protocol
+	// default false
+	Enable bool `json:"enable"`
+	// HandshakeTimeout indicates handshake timeout (second)
+	// default 30
+	HandshakeTimeout int32 `json:"handshakeTimeout,omitempty"`
+	// Server indicates grpc server address (ip:port)
+	// +Required
+	Server string `json:"server,omitempty"`
+	// WriteDeadline indicates write deadline (second)
+	// default 15
+	WriteDeadline int32 `json:"writeDeadline,omitempty"`
+	// KeepaliveTime indicates the interval of the keepalive pings sent to cloudhub (second)
+	// default 30
+	KeepaliveTime int32 `json:"keepaliveTime,omitempty"`
+	// KeepaliveTimeout indicates how long to wait for a ping ack before reconnecting (second)
+	// default 10
+	KeepaliveTimeout int32 `json:"keepaliveTimeout,omitempty"`
+}
+
+// EdgeHubEndpoints indicates the cloudcore endpoints edgehub may connect to,
+// in addition to the server of the enabled protocol
+type EdgeHubEndpoints struct {
+	// Servers indicates the addresses (ip:port) of other cloudcores, serving the enabled protocol
+	Servers []string `json:"servers,omitempty"`
+	// SRVRecord indicates a DNS SRV record listing the cloudcore endpoints, such as
+	// _cloudhub._tcp.kubeedge.example.com, it is looked up whenever all endpoints were tried
+	SRVRecord string `json:"srvRecord,omitempty"`
+	// Strategy indicates how to pick the endpoint to connect to, Failover or Random
+	// default Failover
+	Strategy EndpointStrategy `json:"strategy,omitempty"`
+	// ProbeTimeout indicates the timeout of the TCP health probe of an endpoint before connecting to it (second)
+	// default 3
+	ProbeTimeout int32 `json:"probeTimeout,omitempty"`
+	// InitialBackoff indicates the wait time after all endpoints failed (second),
+	// doubled every time they fail again until MaxBackoff
+	// default 5
+	InitialBackoff int32 `json:"initialBackoff,omitempty"`
+	// MaxBackoff indicates the max wait time after all endpoints failed (second)
+	// default 300
+	MaxBackoff int32 `json:"maxBackoff,omitempty"`
+}
+
 // EdgeHubWebSocket indicates the websocket client config
 type EdgeHubWebSocket struct {
 	// Enable indicates whether enable this protocol
@@ -951,6 +1040,20 @@ type EventBus struct {
 	// MqttPassword indicates mqtt password
 	// default ""
 	MqttPassword string `json:"mqttPassword"`
+	// MqttUsernameFile indicates the file containing the mqtt username, such as a mounted secret,
+	// it is read on every connection and takes precedence over mqttUsername
+	// default ""
+	MqttUsernameFile string `json:"mqttUsernameFile,omitempty"`
+	// MqttPasswordFile indicates the file containing the mqtt password, such as a mounted secret,
+	// it is read on every connection and takes precedence over mqttPassword
+	// default ""
+	MqttPasswordFile string `json:"mqttPasswordFile,omitempty"`
+	// MqttProtocolVersion indicates the mqtt protocol version used with the external mqtt broker
+	// 4: MQTT 3.1.1, 5: MQTT 5
+	// default 4
+	MqttProtocolVersion MqttProtocolVersion `json:"mqttProtocolVersion,omitempty"`
+	// MqttExternalBroker indicates the connection config of the external mqtt broker
+	MqttExternalBroker *EventBusExternalBroker `json:"mqttExternalBroker,omitempty"`
 	// MqttMode indicates which broker type will be chosen
 	// 0: internal mqtt broker enable only.
 	// 1: internal and external mqtt broker enable.
@@ -976,6 +1079,38 @@ type EventBusTLS struct {
 	// TLSMqttPrivateKeyFile indicates the file containing x509 private key matching tlsMqttCertFile
 	// default "/etc/kubeedge/certs/server.key"
 	TLSMqttPrivateKeyFile string `json:"tlsMqttPrivateKeyFile,omitempty"`
+	// TLSMqttServerName indicates the server name to verify the certificate of the mqtt broker with,
+	// the host of the broker url is used if empty
+	// default ""
+	TLSMqttServerName string `json:"tlsMqttServerName,omitempty"`
+}
+
+// EventBusExternalBroker indicates the connection config of the external mqtt broker
+type EventBusExternalBroker struct {
+	// Servers indicates the urls of other brokers, tried in order after mqttServerExternal
+	// when connecting or reconnecting, such as "ssl://192.168.1.2:8883"
+	Servers []string `json:"servers,omitempty"`
+	// KeepAlive indicates the keep alive interval of the connection (second)
+	// default 30
+	KeepAlive int32 `json:"keepAlive,omitempty"`
+	// ConnectTimeout indicates the timeout of connecting to a broker (second)
+	// default 30
+	ConnectTimeout int32 `json:"connectTimeout,omitempty"`
+	// InitialBackoff indicates the wait time after all brokers failed (second),
+	// doubled every time they fail again until MaxBackoff
+	// default 5
+	InitialBackoff int32 `json:"initialBackoff,omitempty"`
+	// MaxBackoff indicates the max wait time after all brokers failed (second)
+	// default 60
+	MaxBackoff int32 `json:"maxBackoff,omitempty"`
+	// SessionExpiryInterval indicates how long the broker keeps the session after the
+	// connection is lost (second), only supported by MQTT 5. 0 starts a clean session on every connection
+	// default 0
+	SessionExpiryInterval uint32 `json:"sessionExpiryInterval,omitempty"`
+	// TopicAliasMaximum indicates how many topic aliases are used in each direction,
+	// only supported by MQTT 5. 0 disables topic aliases
+	// default 0
+	TopicAliasMaximum uint16 `json:"topicAliasMaximum,omitempty"`
 }
 
 // MetaManager indicates the MetaManager module config
@@ -993,6 +1128,35 @@ type MetaManager struct {
 	RemoteQueryTimeout int32 `json:"remoteQueryTimeout,omitempty"`
 	// The config of MetaServer
 	MetaServer *MetaServer `json:"metaServer,omitempty"`
+	// GC indicates the garbage collection of the metadata stored in the edge database
+	GC *MetaManagerGC `json:"gc,omitempty"`
+}
+
+// MetaManagerGC indicates the config of the garbage collection of the edge database,
+// which keeps the database of long-offline nodes from growing unbounded
+type MetaManagerGC struct {
+	// Enable indicates whether the garbage collection is enabled
+	// default false
+	Enable bool `json:"enable"`
+	// Interval indicates the interval of the garbage collection runs (second)
+	// default 600
+	Interval int32 `json:"interval,omitempty"`
+	// EventTTL indicates how long events are kept after they last occurred (second)
+	// default 3600
+	EventTTL int32 `json:"eventTTL,omitempty"`
+	// TerminatedPodTTL indicates how long pods which succeeded or failed are kept after
+	// their containers terminated (second)
+	// default 86400
+	TerminatedPodTTL int32 `json:"terminatedPodTTL,omitempty"`
+	// MaxDBSize indicates the max size of the database (MiB). Above it, all events and
+	// terminated pods are purged regardless of their TTL and the database is vacuumed.
+	// 0 means no limit
+	// default 0
+	MaxDBSize int32 `json:"maxDBSize,omitempty"`
+	// VacuumInterval indicates the interval of the VACUUM of the database which returns
+	// the space freed by the purged objects to the file system (second), 0 disables it
+	// default 86400
+	VacuumInterval int32 `json:"vacuumInterval,omitempty"`
 }
 
 type MetaServer struct {
@@ -1007,6 +1171,50 @@ type MetaServer struct {
 	// DummyServer defines the IP address of dummy interface and port
 	// that MetaServer listen on for edge pods to connect, format: ip:port
 	DummyServer string `json:"dummyServer"`
+	// LocalWrite allows edge pods to create and update resources through the MetaServer
+	// while the edge node is offline, which are reconciled with the cloud on reconnect
+	LocalWrite *MetaServerLocalWrite `json:"localWrite,omitempty"`
+}
+
+// ConflictPolicy indicates how a resource written locally while offline is reconciled,
+// if it was also changed in the cloud
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyCloudWins discards the local changes
+	ConflictPolicyCloudWins ConflictPolicy = "CloudWins"
+	// ConflictPolicyEdgeWins overwrites the changes made in the cloud
+	ConflictPolicyEdgeWins ConflictPolicy = "EdgeWins"
+	// ConflictPolicyMerge applies the local changes on top of the object in the cloud,
+	// the local value is kept if a field was changed on both sides
+	ConflictPolicyMerge ConflictPolicy = "Merge"
+)
+
+// MetaServerLocalWrite indicates the config of writing resources locally while offline
+type MetaServerLocalWrite struct {
+	// Enable indicates whether resources can be written locally while offline
+	// default false
+	Enable bool `json:"enable"`
+	// Resources are the resources which can be created and updated locally, such as
+	// configmaps, leases in coordination.k8s.io or custom resources
+	Resources []LocalWriteResource `json:"resources,omitempty"`
+	// ConflictPolicy indicates the conflict policy of the resources not setting one,
+	// one of CloudWins, EdgeWins and Merge
+	// default CloudWins
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+	// ReconcileInterval indicates the interval of reconciling the local writes with the cloud (second)
+	// default 10
+	ReconcileInterval int32 `json:"reconcileInterval,omitempty"`
+}
+
+// LocalWriteResource indicates a resource which can be written locally while offline
+type LocalWriteResource struct {
+	// Group of the resource, empty for the core group
+	Group string `json:"group,omitempty"`
+	// Resource is the plural name of the resource, such as configmaps
+	Resource string `json:"resource"`
+	// ConflictPolicy overrides the conflict policy of MetaServerLocalWrite for the resource
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // ServiceBus indicates the ServiceBus module config
@@ -1071,4 +1279,31 @@ type EdgeStream struct {
 	// WriteDeadline indicates write deadline (second)
 	// default 15
 	WriteDeadline int32 `json:"writeDeadline,omitempty"`
+	// Metrics indicates the config of forwarding the node metrics to cloudcore
+	Metrics *EdgeStreamMetrics `json:"metrics,omitempty"`
+}
+
+// EdgeStreamMetrics indicates the config of scraping the node metrics locally and
+// forwarding them to cloudcore through the tunnel, the metrics are buffered on disk
+// while the tunnel is disconnected
+type EdgeStreamMetrics struct {
+	// Enable indicates whether the node metrics are forwarded
+	// default false
+	Enable bool `json:"enable"`
+	// Paths indicates the metrics endpoints of edged to scrape
+	// default ["/metrics/resource"]
+	Paths []string `json:"paths,omitempty"`
+	// ScrapeInterval indicates the interval of scraping the metrics (second)
+	// default 15
+	ScrapeInterval int32 `json:"scrapeInterval,omitempty"`
+	// BatchSize indicates the max number of samples forwarded in one compressed batch
+	// default 20
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// BufferPath indicates the file the samples are buffered in until cloudcore acknowledges them
+	// default /var/lib/kubeedge/edgestream/metrics.db
+	BufferPath string `json:"bufferPath,omitempty"`
+	// MaxBufferedSamples indicates the max number of buffered samples, the oldest samples
+	// are dropped when it is reached
+	// default 5760
+	MaxBufferedSamples int32 `json:"maxBufferedSamples,omitempty"`
 }
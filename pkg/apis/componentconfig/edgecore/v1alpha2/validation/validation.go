@@ -18,6 +18,9 @@ package validation
 
 import (
 	"fmt"
+	"math"
+	"net"
+	"net/url"
 	"os"
 	"path"
 
@@ -41,6 +44,9 @@ func ValidateEdgeCoreConfiguration(c *v1alpha2.EdgeCoreConfig) field.ErrorList {
 	allErrs = append(allErrs, ValidateModuleDeviceTwin(*c.Modules.DeviceTwin)...)
 	allErrs = append(allErrs, ValidateModuleDBTest(*c.Modules.DBTest)...)
 	allErrs = append(allErrs, ValidateModuleEdgeStream(*c.Modules.EdgeStream)...)
+	if c.Supervisor != nil {
+		allErrs = append(allErrs, ValidateSupervisor(*c.Supervisor)...)
+	}
 	return allErrs
 }
 
@@ -83,9 +89,23 @@ func ValidateModuleEdgeHub(h v1alpha2.EdgeHub) field.ErrorList {
 	}
 	allErrs := field.ErrorList{}
 
-	if h.WebSocket.Enable == h.Quic.Enable {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("enable"),
-			h.Quic.Enable, "websocket.enable and quic.enable cannot be true and false at the same time"))
+	if h.GRPC == nil || !h.GRPC.Enable {
+		if h.WebSocket.Enable == h.Quic.Enable {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("enable"),
+				h.Quic.Enable, "websocket.enable and quic.enable cannot be true and false at the same time"))
+		}
+	} else {
+		if h.WebSocket.Enable || h.Quic.Enable {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("grpc", "enable"),
+				h.GRPC.Enable, "grpc.enable cannot be true together with websocket.enable or quic.enable"))
+		}
+		if h.GRPC.Server == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("grpc", "server"), "the grpc server address is required"))
+		}
+	}
+
+	if h.Endpoints != nil {
+		allErrs = append(allErrs, ValidateEdgeHubEndpoints(*h.Endpoints, field.NewPath("endpoints"))...)
 	}
 
 	if h.MessageQPS < 0 {
@@ -101,6 +121,36 @@ func ValidateModuleEdgeHub(h v1alpha2.EdgeHub) field.ErrorList {
 	return allErrs
 }
 
+// ValidateEdgeHubEndpoints validates `e` and returns an errorList if it is invalid
+func ValidateEdgeHubEndpoints(e v1alpha2.EdgeHubEndpoints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, server := range e.Servers {
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("servers").Index(i), server,
+				fmt.Sprintf("server must be an ip:port address, %v", err)))
+		}
+	}
+	switch e.Strategy {
+	case "", v1alpha2.EndpointStrategyFailover, v1alpha2.EndpointStrategyRandom:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategy"), e.Strategy,
+			[]string{string(v1alpha2.EndpointStrategyFailover), string(v1alpha2.EndpointStrategyRandom)}))
+	}
+	if e.ProbeTimeout < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("probeTimeout"), e.ProbeTimeout,
+			"probeTimeout must not be a negative number"))
+	}
+	if e.InitialBackoff < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), e.InitialBackoff,
+			"initialBackoff must not be a negative number"))
+	}
+	if e.MaxBackoff < e.InitialBackoff {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackoff"), e.MaxBackoff,
+			"maxBackoff must not be less than initialBackoff"))
+	}
+	return allErrs
+}
+
 // ValidateModuleEventBus validates `m` and returns an errorList if it is invalid
 func ValidateModuleEventBus(m v1alpha2.EventBus) field.ErrorList {
 	if !m.Enable {
@@ -112,15 +162,142 @@ func ValidateModuleEventBus(m v1alpha2.EventBus) field.ErrorList {
 			fmt.Sprintf("Mode need in [%v,%v] range", v1alpha2.MqttModeInternal,
 				v1alpha2.MqttModeExternal)))
 	}
+	switch m.MqttProtocolVersion {
+	case 0, v1alpha2.MqttProtocolVersion311, v1alpha2.MqttProtocolVersion5:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("mqttProtocolVersion"), m.MqttProtocolVersion,
+			[]string{fmt.Sprint(v1alpha2.MqttProtocolVersion311), fmt.Sprint(v1alpha2.MqttProtocolVersion5)}))
+	}
+	if m.MqttProtocolVersion == v1alpha2.MqttProtocolVersion5 && m.MqttMode >= v1alpha2.MqttModeBoth {
+		allErrs = append(allErrs, ValidateMqttBrokerURL(m.MqttServerExternal, m.MqttProtocolVersion, field.NewPath("mqttServerExternal"))...)
+	}
+	if m.MqttExternalBroker != nil {
+		allErrs = append(allErrs, ValidateEventBusExternalBroker(*m.MqttExternalBroker, m.MqttProtocolVersion, field.NewPath("mqttExternalBroker"))...)
+	}
 	return allErrs
 }
 
+// ValidateEventBusExternalBroker validates `b` and returns an errorList if it is invalid
+func ValidateEventBusExternalBroker(b v1alpha2.EventBusExternalBroker, version v1alpha2.MqttProtocolVersion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, server := range b.Servers {
+		allErrs = append(allErrs, ValidateMqttBrokerURL(server, version, fldPath.Child("servers").Index(i))...)
+	}
+	if b.KeepAlive < 0 || b.KeepAlive > math.MaxUint16 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("keepAlive"), b.KeepAlive,
+			fmt.Sprintf("keepAlive must be in [0,%d] range", math.MaxUint16)))
+	}
+	if b.ConnectTimeout < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("connectTimeout"), b.ConnectTimeout,
+			"connectTimeout must not be a negative number"))
+	}
+	if b.InitialBackoff < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), b.InitialBackoff,
+			"initialBackoff must not be a negative number"))
+	}
+	if b.MaxBackoff < b.InitialBackoff {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackoff"), b.MaxBackoff,
+			"maxBackoff must not be less than initialBackoff"))
+	}
+	if version != v1alpha2.MqttProtocolVersion5 {
+		if b.SessionExpiryInterval != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sessionExpiryInterval"), b.SessionExpiryInterval,
+				"sessionExpiryInterval is only supported by MQTT 5"))
+		}
+		if b.TopicAliasMaximum != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("topicAliasMaximum"), b.TopicAliasMaximum,
+				"topicAliasMaximum is only supported by MQTT 5"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateMqttBrokerURL validates the url of an external mqtt broker and returns an errorList if it is invalid
+func ValidateMqttBrokerURL(server string, version v1alpha2.MqttProtocolVersion, fldPath *field.Path) field.ErrorList {
+	schemes := []string{"tcp", "ssl", "tls", "tcps", "ws", "wss"}
+	if version == v1alpha2.MqttProtocolVersion5 {
+		// websocket is not supported by the MQTT 5 client
+		schemes = schemes[:4]
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, server, fmt.Sprintf("invalid broker url, %v", err))}
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return field.ErrorList{}
+		}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, u.Scheme, schemes)}
+}
+
 // ValidateModuleMetaManager validates `m` and returns an errorList if it is invalid
 func ValidateModuleMetaManager(m v1alpha2.MetaManager) field.ErrorList {
 	if !m.Enable {
 		return field.ErrorList{}
 	}
 	allErrs := field.ErrorList{}
+	if m.GC != nil && m.GC.Enable {
+		allErrs = append(allErrs, ValidateMetaManagerGC(*m.GC, field.NewPath("gc"))...)
+	}
+	if m.MetaServer != nil && m.MetaServer.LocalWrite != nil && m.MetaServer.LocalWrite.Enable {
+		allErrs = append(allErrs, ValidateMetaServerLocalWrite(*m.MetaServer.LocalWrite,
+			field.NewPath("metaServer", "localWrite"))...)
+	}
+	return allErrs
+}
+
+// ValidateMetaServerLocalWrite validates `lw` and returns an errorList if it is invalid
+func ValidateMetaServerLocalWrite(lw v1alpha2.MetaServerLocalWrite, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if lw.ReconcileInterval <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("reconcileInterval"), lw.ReconcileInterval,
+			"reconcileInterval must be a positive number"))
+	}
+	allErrs = append(allErrs, validateConflictPolicy(lw.ConflictPolicy, fldPath.Child("conflictPolicy"))...)
+	for i, res := range lw.Resources {
+		if res.Resource == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resources").Index(i).Child("resource"),
+				"resource must not be empty"))
+		}
+		allErrs = append(allErrs, validateConflictPolicy(res.ConflictPolicy,
+			fldPath.Child("resources").Index(i).Child("conflictPolicy"))...)
+	}
+	return allErrs
+}
+
+func validateConflictPolicy(policy v1alpha2.ConflictPolicy, fldPath *field.Path) field.ErrorList {
+	switch policy {
+	case "", v1alpha2.ConflictPolicyCloudWins, v1alpha2.ConflictPolicyEdgeWins, v1alpha2.ConflictPolicyMerge:
+		return field.ErrorList{}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, policy, []string{string(v1alpha2.ConflictPolicyCloudWins),
+		string(v1alpha2.ConflictPolicyEdgeWins), string(v1alpha2.ConflictPolicyMerge)})}
+}
+
+// ValidateMetaManagerGC validates `gc` and returns an errorList if it is invalid
+func ValidateMetaManagerGC(gc v1alpha2.MetaManagerGC, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if gc.Interval <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), gc.Interval,
+			"interval must be a positive number"))
+	}
+	if gc.EventTTL <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("eventTTL"), gc.EventTTL,
+			"eventTTL must be a positive number"))
+	}
+	if gc.TerminatedPodTTL <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("terminatedPodTTL"), gc.TerminatedPodTTL,
+			"terminatedPodTTL must be a positive number"))
+	}
+	if gc.MaxDBSize < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDBSize"), gc.MaxDBSize,
+			"maxDBSize must not be a negative number"))
+	}
+	if gc.VacuumInterval < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vacuumInterval"), gc.VacuumInterval,
+			"vacuumInterval must not be a negative number"))
+	}
 	return allErrs
 }
 
@@ -157,5 +334,46 @@ func ValidateModuleEdgeStream(m v1alpha2.EdgeStream) field.ErrorList {
 	if !m.Enable {
 		return allErrs
 	}
+	if m.Metrics != nil && m.Metrics.Enable {
+		for i, p := range m.Metrics.Paths {
+			if !path.IsAbs(p) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "paths").Index(i), p,
+					"metrics path must start with /"))
+			}
+		}
+		if m.Metrics.ScrapeInterval <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "scrapeInterval"), m.Metrics.ScrapeInterval,
+				"scrapeInterval must be positive"))
+		}
+		if m.Metrics.BatchSize <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "batchSize"), m.Metrics.BatchSize,
+				"batchSize must be positive"))
+		}
+		if !path.IsAbs(m.Metrics.BufferPath) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "bufferPath"), m.Metrics.BufferPath,
+				"bufferPath must be an absolute path"))
+		}
+		if m.Metrics.MaxBufferedSamples <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("metrics", "maxBufferedSamples"), m.Metrics.MaxBufferedSamples,
+				"maxBufferedSamples must be positive"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateSupervisor validates `s` and returns an errorList if it is invalid
+func ValidateSupervisor(s v1alpha2.Supervisor) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !s.Enable {
+		return allErrs
+	}
+	if _, _, err := net.SplitHostPort(s.HealthzBindAddress); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("healthzBindAddress"), s.HealthzBindAddress,
+			fmt.Sprintf("healthzBindAddress must be in the format of host:port, err: %v", err)))
+	}
+	if s.StuckTimeout < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("stuckTimeout"), s.StuckTimeout,
+			"stuckTimeout must not be negative"))
+	}
 	return allErrs
 }
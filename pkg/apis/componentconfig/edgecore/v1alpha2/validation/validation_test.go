@@ -212,6 +212,67 @@ func TestValidateModuleEdgeHub(t *testing.T) {
 	}
 }
 
+func TestValidateEdgeHubEndpoints(t *testing.T) {
+	cases := []struct {
+		name   string
+		input  v1alpha2.EdgeHubEndpoints
+		fields []string
+	}{
+		{
+			name: "case1 all ok",
+			input: v1alpha2.EdgeHubEndpoints{
+				Servers:        []string{"10.0.0.1:10000", "cloudcore.example.com:10000"},
+				SRVRecord:      "_cloudhub._tcp.kubeedge.example.com",
+				Strategy:       v1alpha2.EndpointStrategyRandom,
+				ProbeTimeout:   3,
+				InitialBackoff: 5,
+				MaxBackoff:     300,
+			},
+		},
+		{
+			name: "case2 server without port",
+			input: v1alpha2.EdgeHubEndpoints{
+				Servers: []string{"10.0.0.1:10000", "10.0.0.2"},
+			},
+			fields: []string{"endpoints.servers[1]"},
+		},
+		{
+			name: "case3 unsupported strategy",
+			input: v1alpha2.EdgeHubEndpoints{
+				Strategy: "RoundRobin",
+			},
+			fields: []string{"endpoints.strategy"},
+		},
+		{
+			name: "case4 negative timeouts",
+			input: v1alpha2.EdgeHubEndpoints{
+				ProbeTimeout:   -1,
+				InitialBackoff: -1,
+				MaxBackoff:     -1,
+			},
+			fields: []string{"endpoints.probeTimeout", "endpoints.initialBackoff"},
+		},
+		{
+			name: "case5 max backoff less than initial backoff",
+			input: v1alpha2.EdgeHubEndpoints{
+				InitialBackoff: 10,
+				MaxBackoff:     5,
+			},
+			fields: []string{"endpoints.maxBackoff"},
+		},
+	}
+
+	for _, c := range cases {
+		var fields []string
+		for _, err := range ValidateEdgeHubEndpoints(c.input, field.NewPath("endpoints")) {
+			fields = append(fields, err.Field)
+		}
+		if !reflect.DeepEqual(fields, c.fields) {
+			t.Errorf("%v: expected errors of %v, but got %v", c.name, c.fields, fields)
+		}
+	}
+}
+
 func TestValidateModuleEventBus(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -243,6 +304,66 @@ func TestValidateModuleEventBus(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 mqtt 5 external broker ok",
+			input: v1alpha2.EventBus{
+				Enable:              true,
+				MqttMode:            v1alpha2.MqttModeExternal,
+				MqttServerExternal:  "ssl://127.0.0.1:8883",
+				MqttProtocolVersion: v1alpha2.MqttProtocolVersion5,
+				MqttExternalBroker: &v1alpha2.EventBusExternalBroker{
+					Servers:               []string{"ssl://127.0.0.2:8883"},
+					InitialBackoff:        5,
+					MaxBackoff:            60,
+					SessionExpiryInterval: 3600,
+					TopicAliasMaximum:     10,
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case4 protocol version not supported",
+			input: v1alpha2.EventBus{
+				Enable:              true,
+				MqttMode:            v1alpha2.MqttModeExternal,
+				MqttProtocolVersion: v1alpha2.MqttProtocolVersion(3),
+			},
+			expected: field.ErrorList{field.NotSupported(field.NewPath("mqttProtocolVersion"), v1alpha2.MqttProtocolVersion(3),
+				[]string{"4", "5"})},
+		},
+		{
+			name: "case5 websocket broker with mqtt 5",
+			input: v1alpha2.EventBus{
+				Enable:              true,
+				MqttMode:            v1alpha2.MqttModeExternal,
+				MqttServerExternal:  "ws://127.0.0.1:8083",
+				MqttProtocolVersion: v1alpha2.MqttProtocolVersion5,
+			},
+			expected: field.ErrorList{field.NotSupported(field.NewPath("mqttServerExternal"), "ws",
+				[]string{"tcp", "ssl", "tls", "tcps"})},
+		},
+		{
+			name: "case6 mqtt 5 features with mqtt 3.1.1",
+			input: v1alpha2.EventBus{
+				Enable:              true,
+				MqttMode:            v1alpha2.MqttModeExternal,
+				MqttProtocolVersion: v1alpha2.MqttProtocolVersion311,
+				MqttExternalBroker: &v1alpha2.EventBusExternalBroker{
+					Servers:               []string{"http://127.0.0.2:1883"},
+					InitialBackoff:        10,
+					MaxBackoff:            5,
+					SessionExpiryInterval: 3600,
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("mqttExternalBroker", "servers").Index(0), "http",
+					[]string{"tcp", "ssl", "tls", "tcps", "ws", "wss"}),
+				field.Invalid(field.NewPath("mqttExternalBroker", "maxBackoff"), int32(5),
+					"maxBackoff must not be less than initialBackoff"),
+				field.Invalid(field.NewPath("mqttExternalBroker", "sessionExpiryInterval"), uint32(3600),
+					"sessionExpiryInterval is only supported by MQTT 5"),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -272,6 +393,83 @@ func TestValidateModuleMetaManager(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 gc enabled",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				GC: &v1alpha2.MetaManagerGC{
+					Enable:           true,
+					Interval:         600,
+					EventTTL:         3600,
+					TerminatedPodTTL: 86400,
+					VacuumInterval:   86400,
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case4 gc interval not positive",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				GC: &v1alpha2.MetaManagerGC{
+					Enable:           true,
+					EventTTL:         3600,
+					TerminatedPodTTL: 86400,
+					MaxDBSize:        -1,
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("gc", "interval"), int32(0), "interval must be a positive number"),
+				field.Invalid(field.NewPath("gc", "maxDBSize"), int32(-1), "maxDBSize must not be a negative number"),
+			},
+		},
+		{
+			name: "case5 gc disabled",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				GC: &v1alpha2.MetaManagerGC{
+					Enable: false,
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case6 local write enabled",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				MetaServer: &v1alpha2.MetaServer{
+					LocalWrite: &v1alpha2.MetaServerLocalWrite{
+						Enable:            true,
+						ConflictPolicy:    v1alpha2.ConflictPolicyCloudWins,
+						ReconcileInterval: 10,
+						Resources: []v1alpha2.LocalWriteResource{
+							{Resource: "configmaps"},
+							{Group: "coordination.k8s.io", Resource: "leases", ConflictPolicy: v1alpha2.ConflictPolicyEdgeWins},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case7 local write invalid",
+			input: v1alpha2.MetaManager{
+				Enable: true,
+				MetaServer: &v1alpha2.MetaServer{
+					LocalWrite: &v1alpha2.MetaServerLocalWrite{
+						Enable:            true,
+						ReconcileInterval: 10,
+						Resources:         []v1alpha2.LocalWriteResource{{ConflictPolicy: "LocalWins"}},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("metaServer", "localWrite", "resources").Index(0).Child("resource"),
+					"resource must not be empty"),
+				field.NotSupported(field.NewPath("metaServer", "localWrite", "resources").Index(0).Child("conflictPolicy"),
+					v1alpha2.ConflictPolicy("LocalWins"), []string{"CloudWins", "EdgeWins", "Merge"}),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -388,6 +586,39 @@ func TestValidateModuleEdgeStream(t *testing.T) {
 			},
 			expected: field.ErrorList{},
 		},
+		{
+			name: "case3 metrics enabled",
+			input: v1alpha2.EdgeStream{
+				Enable: true,
+				Metrics: &v1alpha2.EdgeStreamMetrics{
+					Enable:             true,
+					Paths:              []string{"/metrics/resource"},
+					ScrapeInterval:     15,
+					BatchSize:          20,
+					BufferPath:         "/var/lib/kubeedge/edgestream/metrics.db",
+					MaxBufferedSamples: 5760,
+				},
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case4 invalid metrics",
+			input: v1alpha2.EdgeStream{
+				Enable: true,
+				Metrics: &v1alpha2.EdgeStreamMetrics{
+					Enable:     true,
+					Paths:      []string{"metrics/resource"},
+					BatchSize:  20,
+					BufferPath: "metrics.db",
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("metrics", "paths").Index(0), "metrics/resource", "metrics path must start with /"),
+				field.Invalid(field.NewPath("metrics", "scrapeInterval"), int32(0), "scrapeInterval must be positive"),
+				field.Invalid(field.NewPath("metrics", "bufferPath"), "metrics.db", "bufferPath must be an absolute path"),
+				field.Invalid(field.NewPath("metrics", "maxBufferedSamples"), int32(0), "maxBufferedSamples must be positive"),
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -396,3 +627,48 @@ func TestValidateModuleEdgeStream(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSupervisor(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    v1alpha2.Supervisor
+		expected field.ErrorList
+	}{
+		{
+			name: "case1 not enabled",
+			input: v1alpha2.Supervisor{
+				Enable:       false,
+				StuckTimeout: -1,
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case2 enabled",
+			input: v1alpha2.Supervisor{
+				Enable:             true,
+				HealthzBindAddress: "127.0.0.1:10360",
+				StuckTimeout:       120,
+			},
+			expected: field.ErrorList{},
+		},
+		{
+			name: "case3 invalid",
+			input: v1alpha2.Supervisor{
+				Enable:             true,
+				HealthzBindAddress: "127.0.0.1",
+				StuckTimeout:       -1,
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("healthzBindAddress"), "127.0.0.1",
+					"healthzBindAddress must be in the format of host:port, err: address 127.0.0.1: missing port in address"),
+				field.Invalid(field.NewPath("stuckTimeout"), int32(-1), "stuckTimeout must not be negative"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if result := ValidateSupervisor(c.input); !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("%v: expected %v, but got %v", c.name, c.expected, result)
+		}
+	}
+}
@@ -39,6 +39,15 @@ type DeviceSpec struct {
 	Properties []DeviceProperty `json:"properties,omitempty"`
 	// Required: The protocol configuration used to connect to the device.
 	Protocol ProtocolConfig `json:"protocol,omitempty"`
+	// DataPlane streams the telemetry of the device from the mapper directly to
+	// sinks reachable from the edge node, without going through edgecore and the
+	// cloud. Twin properties are still synced as usual.
+	// +optional
+	DataPlane *DeviceDataPlane `json:"dataPlane,omitempty"`
+	// Firmware is the desired firmware of the device. The mapper upgrades the device
+	// whenever the desired version differs from the version reported by the device.
+	// +optional
+	Firmware *DeviceFirmware `json:"firmware,omitempty"`
 }
 
 // DeviceStatus reports the device state and the desired/reported values of twin attributes.
@@ -47,6 +56,55 @@ type DeviceStatus struct {
 	// Optional: A passive device won't have twin properties and this list could be empty.
 	// +optional
 	Twins []Twin `json:"twins,omitempty"`
+	// Firmware reports the firmware version running on the device and the state of
+	// the latest firmware upgrade.
+	// +optional
+	Firmware *DeviceFirmwareStatus `json:"firmware,omitempty"`
+}
+
+// DeviceFirmware describes a firmware image of the device.
+type DeviceFirmware struct {
+	// Required: The version of the firmware.
+	Version string `json:"version,omitempty"`
+	// Required: The http or https URL the mapper downloads the firmware image from.
+	URL string `json:"url,omitempty"`
+	// Required: The checksum of the firmware image in the form <algorithm>:<hex digest>,
+	// like sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.
+	// Only sha256 is supported.
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// FirmwareState is the state of the firmware upgrade of a device.
+type FirmwareState string
+
+// The firmware upgrade of a device goes from Idle through Downloading, Verifying and
+// Applying to Succeeded, or to Failed from any of the intermediate states.
+const (
+	FirmwareStateIdle        FirmwareState = "Idle"
+	FirmwareStateDownloading FirmwareState = "Downloading"
+	FirmwareStateVerifying   FirmwareState = "Verifying"
+	FirmwareStateApplying    FirmwareState = "Applying"
+	FirmwareStateSucceeded   FirmwareState = "Succeeded"
+	FirmwareStateFailed      FirmwareState = "Failed"
+)
+
+// DeviceFirmwareStatus is the firmware state reported by the mapper.
+type DeviceFirmwareStatus struct {
+	// The firmware version running on the device.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// The desired firmware version the state refers to.
+	// +optional
+	DesiredVersion string `json:"desiredVersion,omitempty"`
+	// The state of the upgrade to the desired firmware version.
+	// +optional
+	State FirmwareState `json:"state,omitempty"`
+	// A human readable message indicating why the upgrade failed.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// The time of the latest state transition.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // Twin provides a logical representation of control properties (writable properties in the
@@ -87,6 +145,213 @@ type ProtocolConfig struct {
 	// +optional
 	// +kubebuilder:validation:XPreserveUnknownFields
 	ConfigData *CustomizedValue `json:"configData,omitempty"`
+	// Protocol configuration for the built-in OPC UA protocol, used when ProtocolName is "opcua"
+	// +optional
+	OpcUA *ProtocolConfigOpcUA `json:"opcua,omitempty"`
+}
+
+// ProtocolConfigOpcUA describes how the mapper connects to an OPC UA server.
+// Certificates, keys and passwords are files on the edge node of the device,
+// so that no secret is stored in the device.
+type ProtocolConfigOpcUA struct {
+	// Required: The URL for opc server endpoint, like opc.tcp://127.0.0.1:4840
+	URL string `json:"url,omitempty"`
+	// The security policy of the secure channel.
+	// Defaults to "None".
+	// +optional
+	SecurityPolicy OpcUASecurityPolicy `json:"securityPolicy,omitempty"`
+	// The security mode of the secure channel, it must be "None" if and only if the
+	// security policy is "None".
+	// Defaults to "None".
+	// +optional
+	SecurityMode OpcUASecurityMode `json:"securityMode,omitempty"`
+	// Path of the PEM encoded client certificate, used for the secure channel and
+	// for certificate authentication.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
+	// Path of the PEM encoded private key of the client certificate.
+	// +optional
+	PrivateKey string `json:"privateKey,omitempty"`
+	// Path of the PEM encoded certificate the opc server must present, the server
+	// certificate is not pinned if it is empty.
+	// +optional
+	ServerCertificate string `json:"serverCertificate,omitempty"`
+	// The user identity of the session.
+	// Defaults to anonymous.
+	// +optional
+	Authentication *OpcUAAuthentication `json:"authentication,omitempty"`
+	// Timeout in milliseconds of the requests to the opc server.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// OpcUAAuthentication describes the user identity of an OPC UA session.
+type OpcUAAuthentication struct {
+	// Required: The type of the user identity token.
+	Type OpcUAAuthenticationType `json:"type,omitempty"`
+	// Username for access opc server, required by the "UserName" type.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+	// Path of the file holding the password of UserName.
+	// +optional
+	PasswordFile string `json:"passwordFile,omitempty"`
+}
+
+// The OPC UA security policy of a secure channel.
+// +kubebuilder:validation:Enum=None;Basic128Rsa15;Basic256;Basic256Sha256;Aes128_Sha256_RsaOaep;Aes256_Sha256_RsaPss
+type OpcUASecurityPolicy string
+
+// OPC UA security policy constants.
+const (
+	OpcUASecurityPolicyNone                OpcUASecurityPolicy = "None"
+	OpcUASecurityPolicyBasic128Rsa15       OpcUASecurityPolicy = "Basic128Rsa15"
+	OpcUASecurityPolicyBasic256            OpcUASecurityPolicy = "Basic256"
+	OpcUASecurityPolicyBasic256Sha256      OpcUASecurityPolicy = "Basic256Sha256"
+	OpcUASecurityPolicyAes128Sha256RsaOaep OpcUASecurityPolicy = "Aes128_Sha256_RsaOaep"
+	OpcUASecurityPolicyAes256Sha256RsaPss  OpcUASecurityPolicy = "Aes256_Sha256_RsaPss"
+)
+
+// The OPC UA security mode of a secure channel.
+// +kubebuilder:validation:Enum=None;Sign;SignAndEncrypt
+type OpcUASecurityMode string
+
+// OPC UA security mode constants.
+const (
+	OpcUASecurityModeNone           OpcUASecurityMode = "None"
+	OpcUASecurityModeSign           OpcUASecurityMode = "Sign"
+	OpcUASecurityModeSignAndEncrypt OpcUASecurityMode = "SignAndEncrypt"
+)
+
+// The type of the user identity token of an OPC UA session. "Certificate" uses
+// the client certificate of the protocol configuration.
+// +kubebuilder:validation:Enum=Anonymous;UserName;Certificate
+type OpcUAAuthenticationType string
+
+// OPC UA user identity token type constants.
+const (
+	OpcUAAuthenticationAnonymous   OpcUAAuthenticationType = "Anonymous"
+	OpcUAAuthenticationUserName    OpcUAAuthenticationType = "UserName"
+	OpcUAAuthenticationCertificate OpcUAAuthenticationType = "Certificate"
+)
+
+// ProtocolNameOpcUA is the protocol name of devices using the built-in OPC UA configuration.
+const ProtocolNameOpcUA = "opcua"
+
+// DeviceDataPlane describes the telemetry the mapper streams to edge-local sinks.
+type DeviceDataPlane struct {
+	// Names of the properties to stream, all properties of the device if empty.
+	// +optional
+	Properties []string `json:"properties,omitempty"`
+	// The number of samples per second taken of each property, up to 100000.
+	// Defaults to the collectCycle of the property.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	SampleRate int32 `json:"sampleRate,omitempty"`
+	// The maximum number of samples written to a sink at once.
+	// Defaults to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BatchSize int32 `json:"batchSize,omitempty"`
+	// The interval in milliseconds after which the buffered samples are written
+	// even if the batch is not full. Defaults to 1000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	FlushInterval int64 `json:"flushInterval,omitempty"`
+	// The number of samples buffered for each sink, the oldest samples are dropped
+	// when a sink cannot keep up. Defaults to 10000.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BufferSize int32 `json:"bufferSize,omitempty"`
+	// Required: The sinks the samples are written to.
+	Sinks []DataPlaneSink `json:"sinks,omitempty"`
+}
+
+// DataPlaneSink is a destination of the telemetry of a device, exactly one of
+// the sink configurations must be set.
+type DataPlaneSink struct {
+	// Required: The name of the sink, unique within the device.
+	Name string `json:"name,omitempty"`
+	// Write the samples to an InfluxDB v2 bucket.
+	// +optional
+	InfluxDB *DataPlaneSinkInfluxDB `json:"influxdb,omitempty"`
+	// Write the samples to a Prometheus remote-write endpoint.
+	// +optional
+	PrometheusRemoteWrite *DataPlaneSinkPrometheusRemoteWrite `json:"prometheusRemoteWrite,omitempty"`
+	// Publish the samples to a topic of a local MQTT broker.
+	// +optional
+	MQTT *DataPlaneSinkMQTT `json:"mqtt,omitempty"`
+	// Append the samples to CSV files on the edge node.
+	// +optional
+	CSV *DataPlaneSinkCSV `json:"csv,omitempty"`
+}
+
+type DataPlaneSinkInfluxDB struct {
+	// Required: The URL of the InfluxDB server, like http://127.0.0.1:8086
+	URL string `json:"url,omitempty"`
+	// Required: The organization of the bucket.
+	Org string `json:"org,omitempty"`
+	// Required: The bucket the samples are written to.
+	Bucket string `json:"bucket,omitempty"`
+	// The measurement of the samples, the property name is the field key.
+	// Defaults to the device name.
+	// +optional
+	Measurement string `json:"measurement,omitempty"`
+	// Additional tags of the samples.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// Path of the file holding the API token on the edge node.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
+}
+
+type DataPlaneSinkPrometheusRemoteWrite struct {
+	// Required: The URL of the remote-write endpoint, like http://127.0.0.1:9090/api/v1/write
+	URL string `json:"url,omitempty"`
+	// The name of the metric, the samples are labeled with the device, namespace and property.
+	// Defaults to "kubeedge_device_property".
+	// +optional
+	MetricName string `json:"metricName,omitempty"`
+	// Additional labels of the samples.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Path of the file holding the bearer token on the edge node.
+	// +optional
+	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+	// Timeout in milliseconds of a write request.
+	// +optional
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+type DataPlaneSinkMQTT struct {
+	// Required: The broker address, like tcp://127.0.0.1:1883
+	Broker string `json:"broker,omitempty"`
+	// The topic the batches are published to.
+	// Defaults to "$ke/dataplane/<namespace>/<device name>".
+	// +optional
+	Topic string `json:"topic,omitempty"`
+	// The qos of the published messages.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	QoS int32 `json:"qos,omitempty"`
+	// The client ID of the connection, defaults to a generated one.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+}
+
+type DataPlaneSinkCSV struct {
+	// Required: The directory on the edge node the CSV files are written to,
+	// the samples of a device are appended to <namespace>_<device name>.csv.
+	Path string `json:"path,omitempty"`
+	// The size in bytes after which the file is rotated. Defaults to 104857600.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFileSize int64 `json:"maxFileSize,omitempty"`
+	// The number of rotated files kept. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFiles int32 `json:"maxFiles,omitempty"`
 }
 
 // DeviceProperty describes the specifics all the properties of the device.
@@ -252,6 +517,29 @@ type VisitorConfig struct {
 	// Required: The configData of customized protocol
 	// +kubebuilder:validation:XPreserveUnknownFields
 	ConfigData *CustomizedValue `json:"configData,omitempty"`
+	// Visitor configuration for the built-in OPC UA protocol, replacing configData
+	// +optional
+	OpcUA *VisitorConfigOPCUA `json:"opcua,omitempty"`
+}
+
+// VisitorConfigOPCUA describes how the mapper accesses a device property on an OPC UA server.
+type VisitorConfigOPCUA struct {
+	// Required: The ID of opc-ua node, e.g. "ns=2;s=Temperature" or "ns=1;i=1005"
+	NodeID string `json:"nodeID,omitempty"`
+	// The name of opc-ua node
+	// +optional
+	BrowseName string `json:"browseName,omitempty"`
+	// The publishing interval in milliseconds of a subscription to the data changes
+	// of the node. If it is set, the mapper reports the property when the node
+	// changes instead of reading the node every collectCycle.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SubscriptionInterval int64 `json:"subscriptionInterval,omitempty"`
+	// The interval in milliseconds the opc server samples the node for the subscription.
+	// Defaults to the subscription interval.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	SamplingInterval int64 `json:"samplingInterval,omitempty"`
 }
 
 // +genclient
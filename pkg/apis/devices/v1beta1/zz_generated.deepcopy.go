@@ -151,6 +151,120 @@ func (in *DBMethodTDEngine) DeepCopy() *DBMethodTDEngine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSink) DeepCopyInto(out *DataPlaneSink) {
+	*out = *in
+	if in.InfluxDB != nil {
+		in, out := &in.InfluxDB, &out.InfluxDB
+		*out = new(DataPlaneSinkInfluxDB)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusRemoteWrite != nil {
+		in, out := &in.PrometheusRemoteWrite, &out.PrometheusRemoteWrite
+		*out = new(DataPlaneSinkPrometheusRemoteWrite)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MQTT != nil {
+		in, out := &in.MQTT, &out.MQTT
+		*out = new(DataPlaneSinkMQTT)
+		**out = **in
+	}
+	if in.CSV != nil {
+		in, out := &in.CSV, &out.CSV
+		*out = new(DataPlaneSinkCSV)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSink.
+func (in *DataPlaneSink) DeepCopy() *DataPlaneSink {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSinkCSV) DeepCopyInto(out *DataPlaneSinkCSV) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSinkCSV.
+func (in *DataPlaneSinkCSV) DeepCopy() *DataPlaneSinkCSV {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSinkCSV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSinkInfluxDB) DeepCopyInto(out *DataPlaneSinkInfluxDB) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSinkInfluxDB.
+func (in *DataPlaneSinkInfluxDB) DeepCopy() *DataPlaneSinkInfluxDB {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSinkInfluxDB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSinkMQTT) DeepCopyInto(out *DataPlaneSinkMQTT) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSinkMQTT.
+func (in *DataPlaneSinkMQTT) DeepCopy() *DataPlaneSinkMQTT {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSinkMQTT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataPlaneSinkPrometheusRemoteWrite) DeepCopyInto(out *DataPlaneSinkPrometheusRemoteWrite) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataPlaneSinkPrometheusRemoteWrite.
+func (in *DataPlaneSinkPrometheusRemoteWrite) DeepCopy() *DataPlaneSinkPrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(DataPlaneSinkPrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Device) DeepCopyInto(out *Device) {
 	*out = *in
@@ -179,6 +293,67 @@ func (in *Device) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceDataPlane) DeepCopyInto(out *DeviceDataPlane) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]DataPlaneSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceDataPlane.
+func (in *DeviceDataPlane) DeepCopy() *DeviceDataPlane {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceDataPlane)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceFirmware) DeepCopyInto(out *DeviceFirmware) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceFirmware.
+func (in *DeviceFirmware) DeepCopy() *DeviceFirmware {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceFirmware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceFirmwareStatus) DeepCopyInto(out *DeviceFirmwareStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceFirmwareStatus.
+func (in *DeviceFirmwareStatus) DeepCopy() *DeviceFirmwareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceFirmwareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceList) DeepCopyInto(out *DeviceList) {
 	*out = *in
@@ -332,6 +507,16 @@ func (in *DeviceSpec) DeepCopyInto(out *DeviceSpec) {
 		}
 	}
 	in.Protocol.DeepCopyInto(&out.Protocol)
+	if in.DataPlane != nil {
+		in, out := &in.DataPlane, &out.DataPlane
+		*out = new(DeviceDataPlane)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(DeviceFirmware)
+		**out = **in
+	}
 	return
 }
 
@@ -355,6 +540,11 @@ func (in *DeviceStatus) DeepCopyInto(out *DeviceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Firmware != nil {
+		in, out := &in.Firmware, &out.Firmware
+		*out = new(DeviceFirmwareStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -439,6 +629,22 @@ func (in *MySQLClientConfig) DeepCopy() *MySQLClientConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpcUAAuthentication) DeepCopyInto(out *OpcUAAuthentication) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpcUAAuthentication.
+func (in *OpcUAAuthentication) DeepCopy() *OpcUAAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(OpcUAAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProtocolConfig) DeepCopyInto(out *ProtocolConfig) {
 	*out = *in
@@ -446,6 +652,11 @@ func (in *ProtocolConfig) DeepCopyInto(out *ProtocolConfig) {
 		in, out := &in.ConfigData, &out.ConfigData
 		*out = (*in).DeepCopy()
 	}
+	if in.OpcUA != nil {
+		in, out := &in.OpcUA, &out.OpcUA
+		*out = new(ProtocolConfigOpcUA)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -459,6 +670,27 @@ func (in *ProtocolConfig) DeepCopy() *ProtocolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolConfigOpcUA) DeepCopyInto(out *ProtocolConfigOpcUA) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(OpcUAAuthentication)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolConfigOpcUA.
+func (in *ProtocolConfigOpcUA) DeepCopy() *ProtocolConfigOpcUA {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolConfigOpcUA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PushMethod) DeepCopyInto(out *PushMethod) {
 	*out = *in
@@ -602,6 +834,11 @@ func (in *VisitorConfig) DeepCopyInto(out *VisitorConfig) {
 		in, out := &in.ConfigData, &out.ConfigData
 		*out = (*in).DeepCopy()
 	}
+	if in.OpcUA != nil {
+		in, out := &in.OpcUA, &out.OpcUA
+		*out = new(VisitorConfigOPCUA)
+		**out = **in
+	}
 	return
 }
 
@@ -614,3 +851,19 @@ func (in *VisitorConfig) DeepCopy() *VisitorConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VisitorConfigOPCUA) DeepCopyInto(out *VisitorConfigOPCUA) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VisitorConfigOPCUA.
+func (in *VisitorConfigOPCUA) DeepCopy() *VisitorConfigOPCUA {
+	if in == nil {
+		return nil
+	}
+	out := new(VisitorConfigOPCUA)
+	in.DeepCopyInto(out)
+	return out
+}
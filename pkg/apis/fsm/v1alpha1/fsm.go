@@ -42,4 +42,7 @@ const (
 
 const (
 	EventTimeOut = "TimeOut"
+	// EventProgress is reported by the nodes during the long running stages,
+	// it keeps the state and resets the timeout of the node task
+	EventProgress = "Progress"
 )
@@ -30,9 +30,10 @@ var PrePullRule = map[string]State{
 	"Checking/Check/Failure":   TaskFailed,
 	"Checking/TimeOut/Failure": TaskFailed,
 
-	"Pulling/Pull/Success":    TaskSuccessful,
-	"Pulling/Pull/Failure":    TaskFailed,
-	"Pulling/TimeOut/Failure": TaskFailed,
+	"Pulling/Pull/Success":     TaskSuccessful,
+	"Pulling/Pull/Failure":     TaskFailed,
+	"Pulling/TimeOut/Failure":  TaskFailed,
+	"Pulling/Progress/Success": PullingState,
 }
 
 var PrePullStageSequence = map[State]State{
@@ -47,6 +47,12 @@ var UpgradeRule = map[string]State{
 	"Upgrading/Rollback/Failure": TaskFailed,
 	"Upgrading/Rollback/Success": TaskFailed,
 
+	// the canary nodes are rolled back or the task is paused if they fail the health gate
+	"Upgrading/HealthCheck/Failure": TaskPause,
+	"Successful/Rollback/Failure":   TaskFailed,
+	"Successful/Rollback/Success":   TaskFailed,
+	"Successful/TimeOut/Failure":    TaskFailed,
+
 	//TODO delete in version 1.18
 	"Init/Rollback/Failure": TaskFailed,
 	"Init/Rollback/Success": TaskFailed,
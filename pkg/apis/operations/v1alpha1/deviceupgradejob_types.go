@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	devicesv1beta1 "github.com/kubeedge/kubeedge/pkg/apis/devices/v1beta1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceUpgradeJob is used to upgrade the firmware of devices from cloud side.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+type DeviceUpgradeJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec represents the specification of the desired behavior of DeviceUpgradeJob.
+	// +required
+	Spec DeviceUpgradeJobSpec `json:"spec"`
+
+	// Status represents the status of DeviceUpgradeJob.
+	// +optional
+	Status DeviceUpgradeJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeviceUpgradeJobList is a list of DeviceUpgradeJob.
+type DeviceUpgradeJobList struct {
+	// Standard type metadata.
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of DeviceUpgradeJob.
+	Items []DeviceUpgradeJob `json:"items"`
+}
+
+// DeviceUpgradeJobSpec represents the specification of the desired behavior of DeviceUpgradeJob.
+type DeviceUpgradeJobSpec struct {
+	// Firmware is the firmware the devices are upgraded to.
+	// +required
+	Firmware devicesv1beta1.DeviceFirmware `json:"firmware"`
+
+	// DeviceNames is a request to select some specific devices in the namespace of the job.
+	// Please note that sets of DeviceNames and LabelSelector are ORed.
+	// Users must set one and can only set one.
+	// +optional
+	DeviceNames []string `json:"deviceNames,omitempty"`
+
+	// LabelSelector is a filter to select devices in the namespace of the job by labels.
+	// Please note that sets of DeviceNames and LabelSelector are ORed.
+	// Users must set one and can only set one.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// BatchSize specifies the number of devices upgraded in a batch, the next batch is
+	// started only after all the devices of the current batch finish the upgrade.
+	// The default BatchSize value is 1.
+	// +optional
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// FailureTolerate specifies the task tolerance failure ratio, no more batches are
+	// started once the ratio of the failed devices exceeds it.
+	// The default FailureTolerate value is 0, the job stops at the first failed device.
+	// +optional
+	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// TimeoutSeconds limits the duration of the firmware upgrade of each device.
+	// Default to 600.
+	// If set to 0, we'll use the default value 600.
+	// +optional
+	TimeoutSeconds *uint32 `json:"timeoutSeconds,omitempty"`
+}
+
+// DeviceUpgradeJobState is the state of DeviceUpgradeJob.
+type DeviceUpgradeJobState string
+
+const (
+	// DeviceUpgradeJobUpgrading means the batches of the devices are being upgraded.
+	DeviceUpgradeJobUpgrading DeviceUpgradeJobState = "Upgrading"
+	// DeviceUpgradeJobCompleted means all the devices finished the upgrade, the ratio of
+	// the failed devices is within the failure tolerance.
+	DeviceUpgradeJobCompleted DeviceUpgradeJobState = "Completed"
+	// DeviceUpgradeJobFailed means the ratio of the failed devices exceeds the failure tolerance.
+	DeviceUpgradeJobFailed DeviceUpgradeJobState = "Failed"
+)
+
+// DeviceUpgradeState is the upgrade state of a device of DeviceUpgradeJob.
+type DeviceUpgradeState string
+
+const (
+	// DeviceUpgradePending means the device is waiting for its batch.
+	DeviceUpgradePending DeviceUpgradeState = "Pending"
+	// DeviceUpgradeUpgrading means the desired firmware of the device is set and the
+	// mapper is upgrading the device.
+	DeviceUpgradeUpgrading DeviceUpgradeState = "Upgrading"
+	// DeviceUpgradeSucceeded means the device reports the desired firmware version.
+	DeviceUpgradeSucceeded DeviceUpgradeState = "Succeeded"
+	// DeviceUpgradeFailed means the mapper failed to upgrade the device, or the upgrade
+	// timed out, or the device is not found.
+	DeviceUpgradeFailed DeviceUpgradeState = "Failed"
+)
+
+// DeviceUpgradeJobStatus stores the status of DeviceUpgradeJob.
+// +kubebuilder:validation:Type=object
+type DeviceUpgradeJobStatus struct {
+	// State represents for the state phase of the DeviceUpgradeJob.
+	// There are several possible state values: "", Upgrading, Completed and Failed.
+	State DeviceUpgradeJobState `json:"state,omitempty"`
+	// CurrentBatch is the index of the latest batch started, starting from 1.
+	CurrentBatch int32 `json:"currentBatch,omitempty"`
+	// Succeeded is the number of devices which are upgraded successfully.
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of devices which failed to upgrade.
+	Failed int32 `json:"failed,omitempty"`
+	// Reason represents for the reason of the DeviceUpgradeJob failure.
+	Reason string `json:"reason,omitempty"`
+	// Devices contains the upgrade status for each device.
+	Devices []DeviceUpgradeStatus `json:"deviceStatus,omitempty"`
+}
+
+// DeviceUpgradeStatus stores the upgrade status of a device.
+type DeviceUpgradeStatus struct {
+	// DeviceName is the name of the device.
+	DeviceName string `json:"deviceName,omitempty"`
+	// Batch is the index of the batch the device is upgraded in, starting from 1.
+	// +optional
+	Batch int32 `json:"batch,omitempty"`
+	// State represents for the upgrade state of the device.
+	// There are several possible state values: Pending, Upgrading, Succeeded and Failed.
+	State DeviceUpgradeState `json:"state,omitempty"`
+	// FirmwareState is the latest firmware state reported by the mapper during the upgrade.
+	// +optional
+	FirmwareState devicesv1beta1.FirmwareState `json:"firmwareState,omitempty"`
+	// Reason represents for the reason of the device upgrade failure.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// StartTime is the time the upgrade of the device is started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the upgrade of the device finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/kubeedge/kubeedge/pkg/apis/fsm/v1alpha1"
@@ -97,6 +98,8 @@ type ImagePrePullTemplate struct {
 	Concurrency int32 `json:"concurrency,omitempty"`
 
 	// TimeoutSeconds limits the duration of the node prepull job on each edgenode.
+	// The edge nodes report the progress while pulling the images or waiting for
+	// the pull windows, which resets the timeout.
 	// Default to 300.
 	// If set to 0, we'll use the default value 300.
 	// +optional
@@ -107,12 +110,56 @@ type ImagePrePullTemplate struct {
 	// +optional
 	ImageSecret string `json:"imageSecrets,omitempty"`
 
+	// ImagePullSecrets specifies the secrets for image pull of the nodes in node groups.
+	// The secrets of all the entries matching a node are used together with ImageSecret,
+	// each image is pulled with the credentials of the secrets matching its registry.
+	// +optional
+	ImagePullSecrets []NodeGroupImagePullSecret `json:"imagePullSecrets,omitempty"`
+
+	// BandwidthLimit specifies the maximum download rate of the images on each edge node,
+	// in bytes per second, e.g. 512Ki. The download rate is not limited if it is not set.
+	// It is only supported by the edge nodes using containerd.
+	// +optional
+	BandwidthLimit *resource.Quantity `json:"bandwidthLimit,omitempty"`
+
+	// PullWindows specifies the daily time windows in the local time of the edge nodes
+	// in which the images are pulled. A pull in progress when the window closes is paused
+	// and resumed in the next window. The images are pulled at any time if it is empty.
+	// +optional
+	PullWindows []PullWindow `json:"pullWindows,omitempty"`
+
 	// RetryTimes specifies the retry times if image pull failed on each edgenode.
 	// Default to 0
 	// +optional
 	RetryTimes int32 `json:"retryTimes,omitempty"`
 }
 
+// NodeGroupImagePullSecret specifies the image pull secrets of the nodes in a node group.
+type NodeGroupImagePullSecret struct {
+	// NodeGroup is the name of the NodeGroup whose nodes use the secrets.
+	// The secrets are used by all the nodes if it is empty.
+	// +optional
+	NodeGroup string `json:"nodeGroup,omitempty"`
+
+	// Secrets are the image pull secrets in {namespace}/{secretName} format.
+	// +required
+	Secrets []string `json:"secrets"`
+}
+
+// PullWindow is a daily time window in the local time of the edge nodes.
+type PullWindow struct {
+	// Start is the start time of the window in HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	Start string `json:"start"`
+
+	// End is the end time of the window in HH:MM format,
+	// the window spans midnight if End is not later than Start.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +required
+	End string `json:"end"`
+}
+
 // ImagePrePullJobStatus stores the status of ImagePrePullJob.
 // contains images prepull status on multiple edge nodes.
 // +kubebuilder:validation:Type=object
@@ -155,10 +202,28 @@ type ImageStatus struct {
 	Image string `json:"image,omitempty"`
 
 	// State represents for the state phase of this image pull on the edge node
-	// There are two possible state values: successful, failed.
+	// There are three possible state values: pulling, successful, failed.
 	State api.State `json:"state,omitempty"`
 
 	// Reason represents the fail reason if image pull failed
 	// +optional
 	Reason string `json:"reason,omitempty"`
+
+	// Layers represents the download progress of the layers of the image,
+	// it is only reported by the edge nodes using containerd.
+	// +optional
+	Layers []LayerStatus `json:"layers,omitempty"`
+}
+
+// LayerStatus stores the download progress of an image layer.
+// +kubebuilder:validation:Type=object
+type LayerStatus struct {
+	// Digest is the digest of the layer
+	Digest string `json:"digest"`
+
+	// Size is the size of the layer in bytes
+	Size int64 `json:"size"`
+
+	// Downloaded is the downloaded size of the layer in bytes
+	Downloaded int64 `json:"downloaded"`
 }
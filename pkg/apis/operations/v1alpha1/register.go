@@ -67,6 +67,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeUpgradeJobList{},
 		&ImagePrePullJob{},
 		&ImagePrePullJobList{},
+		&DeviceUpgradeJob{},
+		&DeviceUpgradeJobList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -99,6 +99,51 @@ type NodeUpgradeJobSpec struct {
 	// The default FailureTolerate value is 0.1.
 	// +optional
 	FailureTolerate string `json:"failureTolerate,omitempty"`
+
+	// Canary specifies the canary nodes which are upgraded before the other nodes.
+	// If it is set, the other nodes are upgraded only after the canary nodes pass the health gate.
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+// CanaryFailurePolicy specifies what to do when the canary nodes fail the health gate.
+type CanaryFailurePolicy string
+
+const (
+	// CanaryFailurePause stops the NodeUpgradeJob and keeps the canary nodes on the new version,
+	// the other nodes are not upgraded.
+	CanaryFailurePause CanaryFailurePolicy = "Pause"
+	// CanaryFailureRollback rolls back the canary nodes to the backup of the origin version
+	// and fails the NodeUpgradeJob, the other nodes are not upgraded.
+	CanaryFailureRollback CanaryFailurePolicy = "Rollback"
+)
+
+// CanaryStrategy specifies the canary nodes of NodeUpgradeJob and the health gate they must pass.
+type CanaryStrategy struct {
+	// Percentage is the percentage of the selected nodes which are upgraded as canary nodes,
+	// at least one node is selected.
+	// Users must set one of Percentage and LabelSelector and can only set one.
+	// +optional
+	Percentage int32 `json:"percentage,omitempty"`
+	// LabelSelector selects the canary nodes from the selected nodes by labels.
+	// Users must set one of Percentage and LabelSelector and can only set one.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// HealthySeconds is how long the canary nodes must stay Ready with all their pods Ready
+	// after they are upgraded.
+	// Default to 300.
+	// +optional
+	HealthySeconds int32 `json:"healthySeconds,omitempty"`
+	// GateTimeoutSeconds limits the duration of the health gate, the gate fails if the canary nodes
+	// are not healthy for HealthySeconds in GateTimeoutSeconds.
+	// Default to 900.
+	// +optional
+	GateTimeoutSeconds int32 `json:"gateTimeoutSeconds,omitempty"`
+	// FailurePolicy specifies what to do when the canary nodes fail the health gate.
+	// There are two possible values: Pause and Rollback.
+	// Default to Pause.
+	// +optional
+	FailurePolicy CanaryFailurePolicy `json:"failurePolicy,omitempty"`
 }
 
 // NodeUpgradeJobStatus stores the status of NodeUpgradeJob.
@@ -125,6 +170,38 @@ type NodeUpgradeJobStatus struct {
 	Time string `json:"time,omitempty"`
 	// Status contains upgrade Status for each edge node.
 	Status []TaskStatus `json:"nodeStatus,omitempty"`
+	// Canary contains the status of the canary nodes.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+}
+
+// CanaryPhase is the phase of the canary nodes of NodeUpgradeJob.
+type CanaryPhase string
+
+const (
+	// CanaryUpgrading means the canary nodes are being upgraded.
+	CanaryUpgrading CanaryPhase = "Upgrading"
+	// CanaryVerifying means the canary nodes are upgraded and checked by the health gate.
+	CanaryVerifying CanaryPhase = "Verifying"
+	// CanaryPassed means the canary nodes passed the health gate, the other nodes are upgraded.
+	CanaryPassed CanaryPhase = "Passed"
+	// CanaryRollingBack means the canary nodes failed the health gate and are being rolled back.
+	CanaryRollingBack CanaryPhase = "RollingBack"
+	// CanaryFailed means the canary nodes failed the health gate.
+	CanaryFailed CanaryPhase = "Failed"
+)
+
+// CanaryStatus stores the status of the canary nodes of NodeUpgradeJob.
+type CanaryStatus struct {
+	// Nodes are the names of the canary nodes.
+	Nodes []string `json:"nodes,omitempty"`
+	// Phase represents for the phase of the canary nodes.
+	// There are several possible phase values: Upgrading, Verifying, Passed, RollingBack and Failed.
+	Phase CanaryPhase `json:"phase,omitempty"`
+	// Reason represents for the reason of the health gate failure.
+	Reason string `json:"reason,omitempty"`
+	// Time represents for the time of the latest phase transition.
+	Time string `json:"time,omitempty"`
 }
 
 // TaskStatus stores the status of Upgrade for each edge node.
@@ -65,6 +65,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RuleList{},
 		&RuleEndpoint{},
 		&RuleEndpointList{},
+		&RuleDeadLetter{},
+		&RuleDeadLetterList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
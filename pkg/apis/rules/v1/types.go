@@ -19,6 +19,10 @@ type RuleSpec struct {
 	// targetResource is a map representing the resource info of target. For api
 	// ruleendpoint type its value is {"resource":"http://a.com"}. For eventbus ruleendpoint
 	// type its value is {"topic":"/xxxx"}. For servicebus ruleendpoint type its value is {"path":"/request_path"}.
+	// For kubernetesevent ruleendpoint type its value is {"reason":"TemperatureAlert","eventType":"Warning"}.
+	// For cloudevents ruleendpoint type its value is {"resource":"http://broker.example.com","type":"com.example.alert"},
+	// the resource is the kafka topic if the ruleendpoint protocol is kafka. The "extensions" of both types
+	// maps the message headers to the event, e.g. "edgenode=nodeName,edgetopic=topic".
 	TargetResource map[string]string `json:"targetResource"`
 }
 
@@ -54,11 +58,15 @@ type RuleList struct {
 
 // RuleEndpointSpec defines endpoint of rule.
 type RuleEndpointSpec struct {
-	// RuleEndpointType defines type: servicebus, rest
+	// RuleEndpointType defines type: servicebus, rest, webhook, kubernetesevent, cloudevents
 	RuleEndpointType RuleEndpointTypeDef `json:"ruleEndpointType"`
 	// Properties: properties of endpoint. for example:
 	// servicebus:
 	// {"service_port":"8080"}
+	// webhook, the secret holds the HMAC key of the requests in its "hmacKey":
+	// {"secret":"my-webhook-secret"}
+	// cloudevents, the events are sent over http by default, or to the kafka brokers:
+	// {"protocol":"kafka","brokers":"kafka-0:9092,kafka-1:9092"}
 	Properties map[string]string `json:"properties,omitempty"`
 }
 
@@ -67,9 +75,12 @@ type RuleEndpointTypeDef string
 
 // RuleEndpoint's types.
 const (
-	RuleEndpointTypeRest       RuleEndpointTypeDef = "rest"
-	RuleEndpointTypeEventBus   RuleEndpointTypeDef = "eventbus"
-	RuleEndpointTypeServiceBus RuleEndpointTypeDef = "servicebus"
+	RuleEndpointTypeRest            RuleEndpointTypeDef = "rest"
+	RuleEndpointTypeEventBus        RuleEndpointTypeDef = "eventbus"
+	RuleEndpointTypeServiceBus      RuleEndpointTypeDef = "servicebus"
+	RuleEndpointTypeWebhook         RuleEndpointTypeDef = "webhook"
+	RuleEndpointTypeKubernetesEvent RuleEndpointTypeDef = "kubernetesevent"
+	RuleEndpointTypeCloudEvents     RuleEndpointTypeDef = "cloudevents"
 )
 
 // +genclient
@@ -91,3 +102,51 @@ type RuleEndpointList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []RuleEndpoint `json:"items"`
 }
+
+// RuleDeadLetterSpec defines a message which could not be delivered to the target of a rule.
+type RuleDeadLetterSpec struct {
+	// Target represents the ruleendpoint name the message was delivered to.
+	Target string `json:"target"`
+	// TargetResource is a map representing the resource info of target the message was delivered with.
+	TargetResource map[string]string `json:"targetResource"`
+	// NodeName represents the edge node the message came from.
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// Data is the content of the message.
+	Data []byte `json:"data"`
+	// Replay asks the router to deliver the message to the target again,
+	// the dead letter is deleted once the message is delivered.
+	// +optional
+	Replay bool `json:"replay,omitempty"`
+}
+
+// RuleDeadLetterStatus defines the delivery attempts of a dead letter.
+type RuleDeadLetterStatus struct {
+	// Attempts represents how many times the message was delivered.
+	Attempts int32 `json:"attempts"`
+	// LastError represents the failed reason of the last delivery.
+	LastError string `json:"lastError,omitempty"`
+	// LastAttemptTime represents the time of the last delivery.
+	LastAttemptTime metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// RuleDeadLetter is the Schema for the ruledeadletters API
+// +k8s:openapi-gen=true
+type RuleDeadLetter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuleDeadLetterSpec   `json:"spec"`
+	Status RuleDeadLetterStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// RuleDeadLetterList contains a list of RuleDeadLetter
+type RuleDeadLetterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuleDeadLetter `json:"items"`
+}
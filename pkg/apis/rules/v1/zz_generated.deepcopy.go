@@ -53,6 +53,112 @@ func (in *Rule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleDeadLetter) DeepCopyInto(out *RuleDeadLetter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleDeadLetter.
+func (in *RuleDeadLetter) DeepCopy() *RuleDeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleDeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleDeadLetter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleDeadLetterList) DeepCopyInto(out *RuleDeadLetterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RuleDeadLetter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleDeadLetterList.
+func (in *RuleDeadLetterList) DeepCopy() *RuleDeadLetterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleDeadLetterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleDeadLetterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleDeadLetterSpec) DeepCopyInto(out *RuleDeadLetterSpec) {
+	*out = *in
+	if in.TargetResource != nil {
+		in, out := &in.TargetResource, &out.TargetResource
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleDeadLetterSpec.
+func (in *RuleDeadLetterSpec) DeepCopy() *RuleDeadLetterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleDeadLetterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleDeadLetterStatus) DeepCopyInto(out *RuleDeadLetterStatus) {
+	*out = *in
+	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleDeadLetterStatus.
+func (in *RuleDeadLetterStatus) DeepCopy() *RuleDeadLetterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleDeadLetterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleEndpoint) DeepCopyInto(out *RuleEndpoint) {
 	*out = *in
@@ -28,6 +28,10 @@ type FakeOperationsV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeOperationsV1alpha1) DeviceUpgradeJobs(namespace string) v1alpha1.DeviceUpgradeJobInterface {
+	return &FakeDeviceUpgradeJobs{c, namespace}
+}
+
 func (c *FakeOperationsV1alpha1) ImagePrePullJobs() v1alpha1.ImagePrePullJobInterface {
 	return &FakeImagePrePullJobs{c}
 }
@@ -28,6 +28,7 @@ import (
 
 type OperationsV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	DeviceUpgradeJobsGetter
 	ImagePrePullJobsGetter
 	NodeUpgradeJobsGetter
 }
@@ -37,6 +38,10 @@ type OperationsV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *OperationsV1alpha1Client) DeviceUpgradeJobs(namespace string) DeviceUpgradeJobInterface {
+	return newDeviceUpgradeJobs(c, namespace)
+}
+
 func (c *OperationsV1alpha1Client) ImagePrePullJobs() ImagePrePullJobInterface {
 	return newImagePrePullJobs(c)
 }
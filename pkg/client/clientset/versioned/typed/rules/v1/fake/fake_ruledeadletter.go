@@ -0,0 +1,129 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRuleDeadLetters implements RuleDeadLetterInterface
+type FakeRuleDeadLetters struct {
+	Fake *FakeRulesV1
+	ns   string
+}
+
+var ruledeadlettersResource = v1.SchemeGroupVersion.WithResource("ruledeadletters")
+
+var ruledeadlettersKind = v1.SchemeGroupVersion.WithKind("RuleDeadLetter")
+
+// Get takes name of the ruleDeadLetter, and returns the corresponding ruleDeadLetter object, and an error if there is any.
+func (c *FakeRuleDeadLetters) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RuleDeadLetter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(ruledeadlettersResource, c.ns, name), &v1.RuleDeadLetter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RuleDeadLetter), err
+}
+
+// List takes label and field selectors, and returns the list of RuleDeadLetters that match those selectors.
+func (c *FakeRuleDeadLetters) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RuleDeadLetterList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(ruledeadlettersResource, ruledeadlettersKind, c.ns, opts), &v1.RuleDeadLetterList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1.RuleDeadLetterList{ListMeta: obj.(*v1.RuleDeadLetterList).ListMeta}
+	for _, item := range obj.(*v1.RuleDeadLetterList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested ruleDeadLetters.
+func (c *FakeRuleDeadLetters) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(ruledeadlettersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a ruleDeadLetter and creates it.  Returns the server's representation of the ruleDeadLetter, and an error, if there is any.
+func (c *FakeRuleDeadLetters) Create(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.CreateOptions) (result *v1.RuleDeadLetter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(ruledeadlettersResource, c.ns, ruleDeadLetter), &v1.RuleDeadLetter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RuleDeadLetter), err
+}
+
+// Update takes the representation of a ruleDeadLetter and updates it. Returns the server's representation of the ruleDeadLetter, and an error, if there is any.
+func (c *FakeRuleDeadLetters) Update(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.UpdateOptions) (result *v1.RuleDeadLetter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(ruledeadlettersResource, c.ns, ruleDeadLetter), &v1.RuleDeadLetter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RuleDeadLetter), err
+}
+
+// Delete takes name of the ruleDeadLetter and deletes it. Returns an error if one occurs.
+func (c *FakeRuleDeadLetters) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(ruledeadlettersResource, c.ns, name, opts), &v1.RuleDeadLetter{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRuleDeadLetters) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(ruledeadlettersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1.RuleDeadLetterList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched ruleDeadLetter.
+func (c *FakeRuleDeadLetters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RuleDeadLetter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(ruledeadlettersResource, c.ns, name, pt, data, subresources...), &v1.RuleDeadLetter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.RuleDeadLetter), err
+}
@@ -32,6 +32,10 @@ func (c *FakeRulesV1) Rules(namespace string) v1.RuleInterface {
 	return &FakeRules{c, namespace}
 }
 
+func (c *FakeRulesV1) RuleDeadLetters(namespace string) v1.RuleDeadLetterInterface {
+	return &FakeRuleDeadLetters{c, namespace}
+}
+
 func (c *FakeRulesV1) RuleEndpoints(namespace string) v1.RuleEndpointInterface {
 	return &FakeRuleEndpoints{c, namespace}
 }
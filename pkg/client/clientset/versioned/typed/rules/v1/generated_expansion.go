@@ -20,4 +20,6 @@ package v1
 
 type RuleExpansion interface{}
 
+type RuleDeadLetterExpansion interface{}
+
 type RuleEndpointExpansion interface{}
@@ -0,0 +1,178 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	scheme "github.com/kubeedge/kubeedge/pkg/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RuleDeadLettersGetter has a method to return a RuleDeadLetterInterface.
+// A group's client should implement this interface.
+type RuleDeadLettersGetter interface {
+	RuleDeadLetters(namespace string) RuleDeadLetterInterface
+}
+
+// RuleDeadLetterInterface has methods to work with RuleDeadLetter resources.
+type RuleDeadLetterInterface interface {
+	Create(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.CreateOptions) (*v1.RuleDeadLetter, error)
+	Update(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.UpdateOptions) (*v1.RuleDeadLetter, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.RuleDeadLetter, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.RuleDeadLetterList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RuleDeadLetter, err error)
+	RuleDeadLetterExpansion
+}
+
+// ruleDeadLetters implements RuleDeadLetterInterface
+type ruleDeadLetters struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRuleDeadLetters returns a RuleDeadLetters
+func newRuleDeadLetters(c *RulesV1Client, namespace string) *ruleDeadLetters {
+	return &ruleDeadLetters{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the ruleDeadLetter, and returns the corresponding ruleDeadLetter object, and an error if there is any.
+func (c *ruleDeadLetters) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RuleDeadLetter, err error) {
+	result = &v1.RuleDeadLetter{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RuleDeadLetters that match those selectors.
+func (c *ruleDeadLetters) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RuleDeadLetterList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.RuleDeadLetterList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested ruleDeadLetters.
+func (c *ruleDeadLetters) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a ruleDeadLetter and creates it.  Returns the server's representation of the ruleDeadLetter, and an error, if there is any.
+func (c *ruleDeadLetters) Create(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.CreateOptions) (result *v1.RuleDeadLetter, err error) {
+	result = &v1.RuleDeadLetter{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(ruleDeadLetter).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a ruleDeadLetter and updates it. Returns the server's representation of the ruleDeadLetter, and an error, if there is any.
+func (c *ruleDeadLetters) Update(ctx context.Context, ruleDeadLetter *v1.RuleDeadLetter, opts metav1.UpdateOptions) (result *v1.RuleDeadLetter, err error) {
+	result = &v1.RuleDeadLetter{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		Name(ruleDeadLetter.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(ruleDeadLetter).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the ruleDeadLetter and deletes it. Returns an error if one occurs.
+func (c *ruleDeadLetters) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *ruleDeadLetters) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched ruleDeadLetter.
+func (c *ruleDeadLetters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RuleDeadLetter, err error) {
+	result = &v1.RuleDeadLetter{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("ruledeadletters").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -29,6 +29,7 @@ import (
 type RulesV1Interface interface {
 	RESTClient() rest.Interface
 	RulesGetter
+	RuleDeadLettersGetter
 	RuleEndpointsGetter
 }
 
@@ -41,6 +42,10 @@ func (c *RulesV1Client) Rules(namespace string) RuleInterface {
 	return newRules(c, namespace)
 }
 
+func (c *RulesV1Client) RuleDeadLetters(namespace string) RuleDeadLetterInterface {
+	return newRuleDeadLetters(c, namespace)
+}
+
 func (c *RulesV1Client) RuleEndpoints(namespace string) RuleEndpointInterface {
 	return newRuleEndpoints(c, namespace)
 }
@@ -70,6 +70,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Devices().V1beta1().DeviceModels().Informer()}, nil
 
 		// Group=operations, Version=v1alpha1
+	case operationsv1alpha1.SchemeGroupVersion.WithResource("deviceupgradejobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().DeviceUpgradeJobs().Informer()}, nil
 	case operationsv1alpha1.SchemeGroupVersion.WithResource("imageprepulljobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Operations().V1alpha1().ImagePrePullJobs().Informer()}, nil
 	case operationsv1alpha1.SchemeGroupVersion.WithResource("nodeupgradejobs"):
@@ -88,6 +90,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=rules.kubeedge.io, Version=v1
 	case v1.SchemeGroupVersion.WithResource("rules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rules().V1().Rules().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("ruledeadletters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rules().V1().RuleDeadLetters().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("ruleendpoints"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rules().V1().RuleEndpoints().Informer()}, nil
 
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// DeviceUpgradeJobs returns a DeviceUpgradeJobInformer.
+	DeviceUpgradeJobs() DeviceUpgradeJobInformer
 	// ImagePrePullJobs returns a ImagePrePullJobInformer.
 	ImagePrePullJobs() ImagePrePullJobInformer
 	// NodeUpgradeJobs returns a NodeUpgradeJobInformer.
@@ -41,6 +43,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// DeviceUpgradeJobs returns a DeviceUpgradeJobInformer.
+func (v *version) DeviceUpgradeJobs() DeviceUpgradeJobInformer {
+	return &deviceUpgradeJobInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ImagePrePullJobs returns a ImagePrePullJobInformer.
 func (v *version) ImagePrePullJobs() ImagePrePullJobInformer {
 	return &imagePrePullJobInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// Rules returns a RuleInformer.
 	Rules() RuleInformer
+	// RuleDeadLetters returns a RuleDeadLetterInformer.
+	RuleDeadLetters() RuleDeadLetterInformer
 	// RuleEndpoints returns a RuleEndpointInformer.
 	RuleEndpoints() RuleEndpointInformer
 }
@@ -46,6 +48,11 @@ func (v *version) Rules() RuleInformer {
 	return &ruleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RuleDeadLetters returns a RuleDeadLetterInformer.
+func (v *version) RuleDeadLetters() RuleDeadLetterInformer {
+	return &ruleDeadLetterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // RuleEndpoints returns a RuleEndpointInformer.
 func (v *version) RuleEndpoints() RuleEndpointInformer {
 	return &ruleEndpointInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// DeviceUpgradeJobListerExpansion allows custom methods to be added to
+// DeviceUpgradeJobLister.
+type DeviceUpgradeJobListerExpansion interface{}
+
+// DeviceUpgradeJobNamespaceListerExpansion allows custom methods to be added to
+// DeviceUpgradeJobNamespaceLister.
+type DeviceUpgradeJobNamespaceListerExpansion interface{}
+
 // ImagePrePullJobListerExpansion allows custom methods to be added to
 // ImagePrePullJobLister.
 type ImagePrePullJobListerExpansion interface{}
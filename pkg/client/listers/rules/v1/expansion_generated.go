@@ -26,6 +26,14 @@ type RuleListerExpansion interface{}
 // RuleNamespaceLister.
 type RuleNamespaceListerExpansion interface{}
 
+// RuleDeadLetterListerExpansion allows custom methods to be added to
+// RuleDeadLetterLister.
+type RuleDeadLetterListerExpansion interface{}
+
+// RuleDeadLetterNamespaceListerExpansion allows custom methods to be added to
+// RuleDeadLetterNamespaceLister.
+type RuleDeadLetterNamespaceListerExpansion interface{}
+
 // RuleEndpointListerExpansion allows custom methods to be added to
 // RuleEndpointLister.
 type RuleEndpointListerExpansion interface{}
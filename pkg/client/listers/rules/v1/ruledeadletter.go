@@ -0,0 +1,99 @@
+/*
+Copyright The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/kubeedge/kubeedge/pkg/apis/rules/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RuleDeadLetterLister helps list RuleDeadLetters.
+// All objects returned here must be treated as read-only.
+type RuleDeadLetterLister interface {
+	// List lists all RuleDeadLetters in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RuleDeadLetter, err error)
+	// RuleDeadLetters returns an object that can list and get RuleDeadLetters.
+	RuleDeadLetters(namespace string) RuleDeadLetterNamespaceLister
+	RuleDeadLetterListerExpansion
+}
+
+// ruleDeadLetterLister implements the RuleDeadLetterLister interface.
+type ruleDeadLetterLister struct {
+	indexer cache.Indexer
+}
+
+// NewRuleDeadLetterLister returns a new RuleDeadLetterLister.
+func NewRuleDeadLetterLister(indexer cache.Indexer) RuleDeadLetterLister {
+	return &ruleDeadLetterLister{indexer: indexer}
+}
+
+// List lists all RuleDeadLetters in the indexer.
+func (s *ruleDeadLetterLister) List(selector labels.Selector) (ret []*v1.RuleDeadLetter, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RuleDeadLetter))
+	})
+	return ret, err
+}
+
+// RuleDeadLetters returns an object that can list and get RuleDeadLetters.
+func (s *ruleDeadLetterLister) RuleDeadLetters(namespace string) RuleDeadLetterNamespaceLister {
+	return ruleDeadLetterNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RuleDeadLetterNamespaceLister helps list and get RuleDeadLetters.
+// All objects returned here must be treated as read-only.
+type RuleDeadLetterNamespaceLister interface {
+	// List lists all RuleDeadLetters in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RuleDeadLetter, err error)
+	// Get retrieves the RuleDeadLetter from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.RuleDeadLetter, error)
+	RuleDeadLetterNamespaceListerExpansion
+}
+
+// ruleDeadLetterNamespaceLister implements the RuleDeadLetterNamespaceLister
+// interface.
+type ruleDeadLetterNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RuleDeadLetters in the indexer for a given namespace.
+func (s ruleDeadLetterNamespaceLister) List(selector labels.Selector) (ret []*v1.RuleDeadLetter, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RuleDeadLetter))
+	})
+	return ret, err
+}
+
+// Get retrieves the RuleDeadLetter from the indexer for a given namespace and name.
+func (s ruleDeadLetterNamespaceLister) Get(name string) (*v1.RuleDeadLetter, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("ruledeadletter"), name)
+	}
+	return obj.(*v1.RuleDeadLetter), nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpchub holds what cloudhub and edgehub share to exchange messages over
+// a bidirectional gRPC stream, one stream per edge node.
+package grpchub
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	"github.com/kubeedge/viaduct/pkg/translator"
+)
+
+const (
+	// ProtocolTypeGRPC is the hub protocol name of the grpc transport
+	ProtocolTypeGRPC = "grpc"
+
+	// ServiceName is the name of the grpc service cloudhub serves
+	ServiceName = "kubeedge.cloudhub.v1.Hub"
+	// ConnectMethod is the full name of the bidirectional stream carrying the messages
+	ConnectMethod = "/" + ServiceName + "/Connect"
+
+	// MetadataNodeID and MetadataProjectID are the stream metadata keys identifying
+	// the edge node, the same as the headers websocket and quic connections send
+	MetadataNodeID    = "node_id"
+	MetadataProjectID = "project_id"
+
+	// MaxMessageSize is the max size of a message frame, both sent and received
+	MaxMessageSize = 32 * 1024 * 1024
+)
+
+// ErrWriteTimeout is returned when a frame could not be sent before the write deadline,
+// after which the stream is unusable.
+var ErrWriteTimeout = errors.New("write frame timeout")
+
+// Frame is a single message on the stream, the model.Message encoded the same way
+// the websocket and quic transports encode it.
+type Frame struct {
+	Data []byte
+}
+
+// EncodeMessage encodes msg into a frame.
+func EncodeMessage(msg *model.Message) (*Frame, error) {
+	data, err := translator.NewTran().Encode(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &Frame{Data: data}, nil
+}
+
+// DecodeMessage decodes the frame into msg.
+func DecodeMessage(frame *Frame, msg *model.Message) error {
+	return translator.NewTran().Decode(frame.Data, msg)
+}
+
+// Codec is the grpc codec of the frames, which are sent as they are without
+// another layer of protobuf encoding.
+type Codec struct{}
+
+// Marshal returns the data of the frame.
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	frame, ok := v.(*Frame)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message type %T", v)
+	}
+	return frame.Data, nil
+}
+
+// Unmarshal copies data into the frame, as grpc may reuse the buffer.
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	frame, ok := v.(*Frame)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", v)
+	}
+	frame.Data = append([]byte(nil), data...)
+	return nil
+}
+
+// Name returns the name of the codec.
+func (Codec) Name() string {
+	return "kubeedge-frame"
+}
+
+// HubServer is the server of the grpc service.
+type HubServer interface {
+	// Connect serves the stream of an edge node until the node or the server closes it
+	Connect(stream grpc.ServerStream) error
+}
+
+// ServiceDesc describes the grpc service, it is written by hand as the service only
+// has a single stream of frames.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*HubServer)(nil),
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       connectHandler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+}
+
+func connectHandler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HubServer).Connect(stream)
+}
+
+// FrameSender is implemented by both grpc.ClientStream and grpc.ServerStream.
+type FrameSender interface {
+	SendMsg(m interface{}) error
+}
+
+// FrameWriter serializes the writes to a stream. SendMsg blocks while the flow
+// control window of the stream is exhausted, so a peer that does not keep up
+// pushes back on the writer until the write deadline is exceeded.
+type FrameWriter struct {
+	lock   sync.Mutex
+	stream FrameSender
+	err    error
+	// onTimeout is called when a write times out, it should close the stream
+	onTimeout func()
+}
+
+// NewFrameWriter returns a writer of stream, onTimeout is called once a write
+// exceeded its deadline.
+func NewFrameWriter(stream FrameSender, onTimeout func()) *FrameWriter {
+	return &FrameWriter{stream: stream, onTimeout: onTimeout}
+}
+
+// Write sends the frame, waiting until deadline if it is not zero. Once a write
+// timed out the writer fails all following writes with ErrWriteTimeout.
+func (w *FrameWriter) Write(frame *Frame, deadline time.Time) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	if deadline.IsZero() {
+		return w.stream.SendMsg(frame)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		result <- w.stream.SendMsg(frame)
+	}()
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		// The pending SendMsg only returns once the stream is closed, and
+		// SendMsg must not be called concurrently, so the writer is done.
+		w.err = ErrWriteTimeout
+		if w.onTimeout != nil {
+			w.onTimeout()
+		}
+		return w.err
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpchub
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+)
+
+func TestEncodeDecodeMessage(t *testing.T) {
+	msg := model.NewMessage("parent").
+		BuildRouter("edgehub", "resource", "node/test/pod/nginx", model.UpdateOperation).
+		FillBody([]byte(`{"name":"nginx"}`))
+
+	frame, err := EncodeMessage(msg)
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	data, err := Codec{}.Marshal(frame)
+	if err != nil {
+		t.Fatalf("failed to marshal frame: %v", err)
+	}
+	received := &Frame{}
+	if err := (Codec{}).Unmarshal(data, received); err != nil {
+		t.Fatalf("failed to unmarshal frame: %v", err)
+	}
+	// the codec must not keep the buffer of grpc
+	data[0]++
+
+	decoded := &model.Message{}
+	if err := DecodeMessage(received, decoded); err != nil {
+		t.Fatalf("failed to decode message: %v", err)
+	}
+	if decoded.GetID() != msg.GetID() || decoded.GetParentID() != "parent" ||
+		decoded.GetResource() != msg.GetResource() || decoded.GetOperation() != msg.GetOperation() {
+		t.Errorf("decoded message %+v does not match %+v", decoded, msg)
+	}
+	if content, _ := decoded.GetContentData(); string(content) != `{"name":"nginx"}` {
+		t.Errorf("unexpected content %s", content)
+	}
+}
+
+func TestCodecRejectsOtherTypes(t *testing.T) {
+	if _, err := (Codec{}).Marshal("frame"); err == nil {
+		t.Error("expected an error marshaling a string")
+	}
+	var s string
+	if err := (Codec{}).Unmarshal([]byte("frame"), &s); err == nil {
+		t.Error("expected an error unmarshaling into a string")
+	}
+}
+
+type blockingSender struct {
+	release chan struct{}
+	sent    int
+}
+
+func (s *blockingSender) SendMsg(m interface{}) error {
+	<-s.release
+	s.sent++
+	return nil
+}
+
+func TestFrameWriter(t *testing.T) {
+	sender := &blockingSender{release: make(chan struct{})}
+	close(sender.release)
+	w := NewFrameWriter(sender, func() { t.Error("unexpected timeout") })
+	if err := w.Write(&Frame{}, time.Time{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := w.Write(&Frame{}, time.Now().Add(time.Second)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sender.sent != 2 {
+		t.Errorf("expected 2 frames sent, got %d", sender.sent)
+	}
+}
+
+func TestFrameWriterTimeout(t *testing.T) {
+	sender := &blockingSender{release: make(chan struct{})}
+	defer close(sender.release)
+	timedOut := 0
+	w := NewFrameWriter(sender, func() { timedOut++ })
+
+	if err := w.Write(&Frame{}, time.Now().Add(10*time.Millisecond)); !errors.Is(err, ErrWriteTimeout) {
+		t.Fatalf("expected ErrWriteTimeout, got %v", err)
+	}
+	// the stream is unusable once a write timed out
+	if err := w.Write(&Frame{}, time.Time{}); !errors.Is(err, ErrWriteTimeout) {
+		t.Fatalf("expected ErrWriteTimeout, got %v", err)
+	}
+	if timedOut != 1 {
+		t.Errorf("expected onTimeout to be called once, got %d", timedOut)
+	}
+}
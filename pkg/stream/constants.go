@@ -13,4 +13,11 @@ const (
 	MessageTypeRemoveConnect
 	MessageTypeCloseConnect
 	MessageTypeAttachConnect
+	MessageTypePortForwardConnect
+	MessageTypeMetricsBatch
+	MessageTypeMetricsAck
 )
+
+// StreamBufferSize is the size of the buffer used to relay the data of
+// exec, attach and port-forward connections through the tunnel
+const StreamBufferSize = 32 * 1024
@@ -94,7 +94,7 @@ func (ah *EdgedAttachConnection) write2CloudStream(tunnel SafeWriteTunneler, con
 		stop <- struct{}{}
 	}()
 
-	var data [256]byte
+	var data [StreamBufferSize]byte
 	for {
 		n, err := con.Read(data[:])
 		if err != nil {
@@ -113,6 +113,8 @@ func (ah *EdgedAttachConnection) write2CloudStream(tunnel SafeWriteTunneler, con
 }
 
 func (ah *EdgedAttachConnection) Serve(tunnel SafeWriteTunneler) error {
+	defer sendRemoveConnect(tunnel, ah)
+
 	tripper := spdy.NewRoundTripper(nil)
 	req, err := http.NewRequest(ah.Method, ah.URL.String(), nil)
 	if err != nil {
@@ -127,18 +129,6 @@ func (ah *EdgedAttachConnection) Serve(tunnel SafeWriteTunneler) error {
 	defer con.Close()
 
 	go ah.receiveFromCloudStream(con, ah.Stop)
-
-	defer func() {
-		for retry := 0; retry < 3; retry++ {
-			msg := NewMessage(ah.MessID, MessageTypeRemoveConnect, nil)
-			if err := tunnel.WriteMessage(msg); err != nil {
-				klog.Errorf("%v send %s message error %v", ah, msg.MessageType, err)
-			} else {
-				break
-			}
-		}
-	}()
-
 	go ah.write2CloudStream(tunnel, con, ah.Stop)
 
 	<-ah.Stop
@@ -1,6 +1,10 @@
 package stream
 
-import "fmt"
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+)
 
 // EdgedConnection indicate the connection request to the edged
 type EdgedConnection interface {
@@ -12,3 +16,16 @@ type EdgedConnection interface {
 	CleanChannel()
 	fmt.Stringer
 }
+
+// sendRemoveConnect tells the cloud side that the connection is gone, otherwise
+// kube-apiserver waits for an upgrade response that never comes
+func sendRemoveConnect(tunnel SafeWriteTunneler, c EdgedConnection) {
+	msg := NewMessage(c.GetMessageID(), MessageTypeRemoveConnect, nil)
+	for retry := 0; retry < 3; retry++ {
+		if err := tunnel.WriteMessage(msg); err != nil {
+			klog.Errorf("%v send %s message error %v", c, msg.MessageType, err)
+		} else {
+			return
+		}
+	}
+}
@@ -85,7 +85,7 @@ func (e *EdgedExecConnection) write2CloudStream(tunnel SafeWriteTunneler, con ne
 		stop <- struct{}{}
 	}()
 
-	var data [256]byte
+	var data [StreamBufferSize]byte
 	for {
 		n, err := con.Read(data[:])
 		if err != nil {
@@ -104,6 +104,8 @@ func (e *EdgedExecConnection) write2CloudStream(tunnel SafeWriteTunneler, con ne
 }
 
 func (e *EdgedExecConnection) Serve(tunnel SafeWriteTunneler) error {
+	defer sendRemoveConnect(tunnel, e)
+
 	tripper := spdy.NewRoundTripper(nil)
 	req, err := http.NewRequest(e.Method, e.URL.String(), nil)
 	if err != nil {
@@ -118,18 +120,6 @@ func (e *EdgedExecConnection) Serve(tunnel SafeWriteTunneler) error {
 	defer con.Close()
 
 	go e.receiveFromCloudStream(con, e.Stop)
-
-	defer func() {
-		for retry := 0; retry < 3; retry++ {
-			msg := NewMessage(e.MessID, MessageTypeRemoveConnect, nil)
-			if err := tunnel.WriteMessage(msg); err != nil {
-				klog.Errorf("%v send %s message error %v", e, msg.MessageType, err)
-			} else {
-				break
-			}
-		}
-	}()
-
 	go e.write2CloudStream(tunnel, con, e.Stop)
 
 	<-e.Stop
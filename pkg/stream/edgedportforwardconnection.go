@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/klog/v2"
+)
+
+// EdgedPortForwardConnection relays a port-forward request to edged. The upgrade
+// request is replayed as is, so both SPDY and WebSocket port-forward streams are
+// passed through the tunnel unchanged.
+type EdgedPortForwardConnection struct {
+	ReadChan chan *Message `json:"-"`
+	Stop     chan struct{} `json:"-"`
+	MessID   uint64
+	URL      url.URL     `json:"url"`
+	Header   http.Header `json:"header"`
+	Method   string      `json:"method"`
+}
+
+func (pf *EdgedPortForwardConnection) CreateConnectMessage() (*Message, error) {
+	data, err := json.Marshal(pf)
+	if err != nil {
+		return nil, err
+	}
+	return NewMessage(pf.MessID, MessageTypePortForwardConnect, data), nil
+}
+
+func (pf *EdgedPortForwardConnection) GetMessageID() uint64 {
+	return pf.MessID
+}
+
+func (pf *EdgedPortForwardConnection) String() string {
+	return fmt.Sprintf("EDGE_PORTFORWARD_CONNECTOR Message MessageID %v", pf.MessID)
+}
+
+func (pf *EdgedPortForwardConnection) CacheTunnelMessage(msg *Message) {
+	pf.ReadChan <- msg
+}
+
+func (pf *EdgedPortForwardConnection) CloseReadChannel() {
+	close(pf.ReadChan)
+}
+
+func (pf *EdgedPortForwardConnection) CleanChannel() {
+	for {
+		select {
+		case <-pf.Stop:
+		default:
+			return
+		}
+	}
+}
+
+func (pf *EdgedPortForwardConnection) receiveFromCloudStream(con net.Conn, stop chan struct{}) {
+	for message := range pf.ReadChan {
+		switch message.MessageType {
+		case MessageTypeRemoveConnect:
+			klog.V(6).Infof("%s receive remove client id %v", pf.String(), message.ConnectID)
+			stop <- struct{}{}
+		case MessageTypeData:
+			_, err := con.Write(message.Data)
+			klog.V(6).Infof("%s receive port-forward %v bytes", pf.String(), len(message.Data))
+			if err != nil {
+				klog.Errorf("failed to write, err: %v", err)
+			}
+		}
+	}
+	klog.V(6).Infof("%s read channel closed", pf.String())
+}
+
+func (pf *EdgedPortForwardConnection) write2CloudStream(tunnel SafeWriteTunneler, con net.Conn, stop chan struct{}) {
+	defer func() {
+		stop <- struct{}{}
+	}()
+
+	var data [StreamBufferSize]byte
+	for {
+		n, err := con.Read(data[:])
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				klog.Errorf("%v failed to read port-forward data, err:%v", pf.String(), err)
+			}
+			return
+		}
+		msg := NewMessage(pf.MessID, MessageTypeData, data[:n])
+		if err := tunnel.WriteMessage(msg); err != nil {
+			klog.Errorf("%v failed to write to tunnel, msg: %+v, err: %v", pf.String(), msg, err)
+			return
+		}
+		klog.V(6).Infof("%v write port-forward %v bytes", pf.String(), n)
+	}
+}
+
+func (pf *EdgedPortForwardConnection) Serve(tunnel SafeWriteTunneler) error {
+	defer sendRemoveConnect(tunnel, pf)
+
+	tripper := spdy.NewRoundTripper(nil)
+	req, err := http.NewRequest(pf.Method, pf.URL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create port-forward request, err: %v", err)
+	}
+	req.Header = pf.Header
+	con, err := tripper.Dial(req)
+	if err != nil {
+		klog.Errorf("failed to dial, err: %v", err)
+		return err
+	}
+	defer con.Close()
+
+	go pf.receiveFromCloudStream(con, pf.Stop)
+	go pf.write2CloudStream(tunnel, con, pf.Stop)
+
+	<-pf.Stop
+	klog.V(6).Infof("receive stop signal, so stop port-forward scan ...")
+	return nil
+}
+
+var _ EdgedConnection = &EdgedPortForwardConnection{}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTunnel records the messages written to the tunnel, the first failures writes fail
+type fakeTunnel struct {
+	lock     sync.Mutex
+	failures int
+	attempts int
+	messages []*Message
+}
+
+func (t *fakeTunnel) WriteMessage(m *Message) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.attempts++
+	if t.attempts <= t.failures {
+		return errors.New("tunnel is broken")
+	}
+	// the data buffer is reused by the sender once the message is written
+	t.messages = append(t.messages, NewMessage(m.ConnectID, m.MessageType, append([]byte(nil), m.Data...)))
+	return nil
+}
+
+func (t *fakeTunnel) WriteControl(int, []byte, time.Time) error {
+	return nil
+}
+
+func (t *fakeTunnel) NextReader() (int, io.Reader, error) {
+	return 0, nil, io.EOF
+}
+
+func (t *fakeTunnel) Close() error {
+	return nil
+}
+
+func (t *fakeTunnel) written() []*Message {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return append([]*Message(nil), t.messages...)
+}
+
+func TestPortForwardRelay(t *testing.T) {
+	pf := &EdgedPortForwardConnection{
+		ReadChan: make(chan *Message, 128),
+		Stop:     make(chan struct{}, 2),
+		MessID:   3,
+	}
+	edged, peer := net.Pipe()
+	defer edged.Close()
+	tunnel := &fakeTunnel{}
+	go pf.receiveFromCloudStream(edged, pf.Stop)
+	go pf.write2CloudStream(tunnel, edged, pf.Stop)
+
+	// the data from the cloud is written to edged as it is
+	pf.CacheTunnelMessage(NewMessage(3, MessageTypeData, []byte("ping")))
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(peer, buf); err != nil || string(buf) != "ping" {
+		t.Fatalf("expected edged to receive ping, got %q, err: %v", buf, err)
+	}
+
+	// the response of edged is relayed to the cloud, in chunks of the buffer size
+	response := bytes.Repeat([]byte("x"), 2*StreamBufferSize+1)
+	if _, err := peer.Write(response); err != nil {
+		t.Fatalf("failed to write response: %v", err)
+	}
+	var relayed []byte
+	for deadline := time.Now().Add(5 * time.Second); len(relayed) < len(response); {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %d bytes to be relayed, but got %d", len(response), len(relayed))
+		}
+		relayed = relayed[:0]
+		for _, m := range tunnel.written() {
+			if m.ConnectID != 3 || m.MessageType != MessageTypeData || len(m.Data) > StreamBufferSize {
+				t.Fatalf("unexpected message %s with %d bytes", m.String(), len(m.Data))
+			}
+			relayed = append(relayed, m.Data...)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !bytes.Equal(relayed, response) {
+		t.Fatalf("expected the response to be relayed unchanged")
+	}
+
+	// the cloud closes its side
+	pf.CacheTunnelMessage(NewMessage(3, MessageTypeRemoveConnect, nil))
+	select {
+	case <-pf.Stop:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the connection to stop once the cloud removes it")
+	}
+	pf.CloseReadChannel()
+
+	// edged closes its side
+	peer.Close()
+	select {
+	case <-pf.Stop:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the connection to stop once edged closes it")
+	}
+}
+
+func TestSendRemoveConnect(t *testing.T) {
+	pf := &EdgedPortForwardConnection{MessID: 5}
+
+	tunnel := &fakeTunnel{failures: 2}
+	sendRemoveConnect(tunnel, pf)
+	if messages := tunnel.written(); tunnel.attempts != 3 || len(messages) != 1 ||
+		messages[0].MessageType != MessageTypeRemoveConnect || messages[0].ConnectID != 5 {
+		t.Errorf("expected the remove message to be sent on the third attempt, got %d attempts, messages: %v", tunnel.attempts, messages)
+	}
+
+	tunnel = &fakeTunnel{failures: 5}
+	sendRemoveConnect(tunnel, pf)
+	if tunnel.attempts != 3 {
+		t.Errorf("expected the remove message to be given up after 3 attempts, got %d", tunnel.attempts)
+	}
+}
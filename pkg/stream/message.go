@@ -37,6 +37,12 @@ func (m MessageType) String() string {
 		return "EXEC_CONNECT"
 	case MessageTypeAttachConnect:
 		return "ATTACH_CONNECT"
+	case MessageTypePortForwardConnect:
+		return "PORTFORWARD_CONNECT"
+	case MessageTypeMetricsBatch:
+		return "METRICS_BATCH"
+	case MessageTypeMetricsAck:
+		return "METRICS_ACK"
 	case MessageTypeMetricConnect:
 		return "METRIC_CONNECT"
 	case MessageTypeData:
@@ -47,6 +53,16 @@ func (m MessageType) String() string {
 	return "UNKNOWN"
 }
 
+// IsConnect reports whether m asks the edge to open a new connection to edged
+func (m MessageType) IsConnect() bool {
+	switch m {
+	case MessageTypeLogsConnect, MessageTypeExecConnect, MessageTypeMetricConnect,
+		MessageTypeAttachConnect, MessageTypePortForwardConnect:
+		return true
+	}
+	return false
+}
+
 type Message struct {
 	// ConnectID indicate the apiserver connection id
 	ConnectID   uint64
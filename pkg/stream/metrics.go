@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// maxMetricsBatchLength is the max length of a decompressed metrics batch
+const maxMetricsBatchLength = 64 << 20
+
+// MetricsSample is one scrape of a metrics endpoint of edged
+type MetricsSample struct {
+	// Path is the path of the metrics endpoint, e.g. /metrics/resource
+	Path string `json:"path"`
+	// Timestamp is the time the endpoint was scraped
+	Timestamp time.Time `json:"timestamp"`
+	// Data is the body returned by the endpoint, e.g. metrics in the Prometheus text format
+	Data string `json:"data"`
+}
+
+// EncodeMetricsBatch compresses samples into the data of a MessageTypeMetricsBatch message
+func EncodeMetricsBatch(samples []MetricsSample) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(samples); err != nil {
+		return nil, fmt.Errorf("failed to encode metrics batch, err: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress metrics batch, err: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeMetricsBatch decodes the samples of a MessageTypeMetricsBatch message
+func DecodeMetricsBatch(data []byte) ([]MetricsSample, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress metrics batch, err: %v", err)
+	}
+	defer zr.Close()
+
+	var samples []MetricsSample
+	if err := json.NewDecoder(io.LimitReader(zr, maxMetricsBatchLength)).Decode(&samples); err != nil {
+		return nil, fmt.Errorf("failed to decode metrics batch, err: %v", err)
+	}
+	return samples, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+// Deregister cordons the edge node and adds the NoExecute deregistered taint to it,
+// so no more pods are scheduled to the node and the pods on it are evicted. If the node
+// is not cordoned yet, it is annotated so that Reregister only undoes the cordon set here
+func Deregister(ctx context.Context, client kubernetes.Interface, name string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		n, err := client.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if n.Spec.Unschedulable && IsDeregistered(n) {
+			return nil
+		}
+		if !n.Spec.Unschedulable {
+			if n.Annotations == nil {
+				n.Annotations = make(map[string]string)
+			}
+			n.Annotations[constants.NodeDeregisterCordonedAnnotation] = "true"
+			n.Spec.Unschedulable = true
+		}
+		if !IsDeregistered(n) {
+			now := metav1.Now()
+			n.Spec.Taints = append(n.Spec.Taints, v1.Taint{
+				Key:       constants.NodeDeregisteredTaintKey,
+				Effect:    v1.TaintEffectNoExecute,
+				TimeAdded: &now,
+			})
+		}
+		_, err = client.CoreV1().Nodes().Update(ctx, n, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// Reregister removes the deregistered taint from the edge node and uncordons it if it was
+// cordoned by Deregister, the cordon of the admin is kept. It returns false if the node
+// was not deregistered
+func Reregister(ctx context.Context, client kubernetes.Interface, name string) (bool, error) {
+	reregistered := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		n, err := client.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !IsDeregistered(n) {
+			return nil
+		}
+		taints := make([]v1.Taint, 0, len(n.Spec.Taints))
+		for _, taint := range n.Spec.Taints {
+			if taint.Key != constants.NodeDeregisteredTaintKey {
+				taints = append(taints, taint)
+			}
+		}
+		n.Spec.Taints = taints
+		if _, exist := n.Annotations[constants.NodeDeregisterCordonedAnnotation]; exist {
+			delete(n.Annotations, constants.NodeDeregisterCordonedAnnotation)
+			n.Spec.Unschedulable = false
+		}
+		if _, err = client.CoreV1().Nodes().Update(ctx, n, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		reregistered = true
+		return nil
+	})
+	return reregistered, err
+}
+
+// IsDeregistered returns whether the node has the deregistered taint
+func IsDeregistered(n *v1.Node) bool {
+	for _, taint := range n.Spec.Taints {
+		if taint.Key == constants.NodeDeregisteredTaintKey {
+			return true
+		}
+	}
+	return false
+}
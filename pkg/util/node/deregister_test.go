@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeedge/kubeedge/common/constants"
+)
+
+func TestDeregisterAndReregister(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge-node"},
+		Spec:       v1.NodeSpec{Taints: []v1.Taint{{Key: "dedicated", Value: "edge", Effect: v1.TaintEffectNoSchedule}}},
+	})
+
+	// deregistering twice adds the taint only once
+	for i := 0; i < 2; i++ {
+		if err := Deregister(ctx, client, "edge-node"); err != nil {
+			t.Fatalf("failed to deregister node: %v", err)
+		}
+	}
+	n, _ := client.CoreV1().Nodes().Get(ctx, "edge-node", metav1.GetOptions{})
+	if !n.Spec.Unschedulable || !IsDeregistered(n) || len(n.Spec.Taints) != 2 {
+		t.Fatalf("expected the node to be cordoned and tainted, but got %+v", n.Spec)
+	}
+
+	reregistered, err := Reregister(ctx, client, "edge-node")
+	if err != nil || !reregistered {
+		t.Fatalf("expected the node to be reregistered, got %v, err: %v", reregistered, err)
+	}
+	n, _ = client.CoreV1().Nodes().Get(ctx, "edge-node", metav1.GetOptions{})
+	if n.Spec.Unschedulable || IsDeregistered(n) || len(n.Spec.Taints) != 1 {
+		t.Fatalf("expected the node to be uncordoned and untainted, but got %+v", n.Spec)
+	}
+	if _, exist := n.Annotations[constants.NodeDeregisterCordonedAnnotation]; exist {
+		t.Errorf("expected the cordoned annotation to be removed")
+	}
+
+	if reregistered, err = Reregister(ctx, client, "edge-node"); err != nil || reregistered {
+		t.Errorf("expected the node not to be reregistered again, got %v, err: %v", reregistered, err)
+	}
+	if err := Deregister(ctx, client, "missing"); err == nil {
+		t.Errorf("expected deregistering a missing node to fail")
+	}
+}
+
+func TestReregisterKeepsCordon(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(&v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge-node"},
+		Spec:       v1.NodeSpec{Unschedulable: true},
+	})
+
+	if err := Deregister(ctx, client, "edge-node"); err != nil {
+		t.Fatalf("failed to deregister node: %v", err)
+	}
+	n, _ := client.CoreV1().Nodes().Get(ctx, "edge-node", metav1.GetOptions{})
+	if _, exist := n.Annotations[constants.NodeDeregisterCordonedAnnotation]; exist {
+		t.Errorf("expected the node cordoned by the admin not to be annotated")
+	}
+	if reregistered, err := Reregister(ctx, client, "edge-node"); err != nil || !reregistered {
+		t.Fatalf("expected the node to be reregistered, got %v, err: %v", reregistered, err)
+	}
+	n, _ = client.CoreV1().Nodes().Get(ctx, "edge-node", metav1.GetOptions{})
+	if !n.Spec.Unschedulable || IsDeregistered(n) {
+		t.Errorf("expected the cordon of the admin to be kept, but got %+v", n.Spec)
+	}
+}
@@ -30,6 +30,8 @@ type Context struct {
 	typeChsLock  sync.RWMutex
 	anonChannels map[string]chan model.Message
 	anonChsLock  sync.RWMutex
+	// lastReceived records the unix nano time each module received its last message
+	lastReceived sync.Map
 }
 
 var channelContext *Context
@@ -81,6 +83,9 @@ func (ctx *Context) Send(module string, message model.Message) {
 func (ctx *Context) Receive(module string) (model.Message, error) {
 	if channel := ctx.getChannel(module); channel != nil {
 		content := <-channel
+		if last, ok := ctx.lastReceived.Load(module); ok {
+			last.(*atomic.Int64).Store(time.Now().UnixNano())
+		}
 		return content, nil
 	}
 
@@ -373,6 +378,23 @@ func (ctx *Context) addTypeChannel(module, group string, moduleCh chan model.Mes
 func (ctx *Context) AddModule(info *common.ModuleInfo) {
 	channel := ctx.newChannel()
 	ctx.addChannel(info.ModuleName, channel)
+
+	last := &atomic.Int64{}
+	last.Store(time.Now().UnixNano())
+	ctx.lastReceived.Store(info.ModuleName, last)
+}
+
+// QueueStatus returns the number of messages pending in the channel of the module,
+// and the time the module received its last message
+func (ctx *Context) QueueStatus(module string) (int, time.Time, error) {
+	ctx.chsLock.RLock()
+	channel, exist := ctx.channels[module]
+	ctx.chsLock.RUnlock()
+	last, ok := ctx.lastReceived.Load(module)
+	if !exist || !ok {
+		return 0, time.Time{}, fmt.Errorf("failed to get channel for module(%s)", module)
+	}
+	return len(channel), time.Unix(0, last.(*atomic.Int64).Load()), nil
 }
 
 // AddModuleGroup adds modules into module context group
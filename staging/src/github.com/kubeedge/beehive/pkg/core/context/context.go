@@ -26,3 +26,11 @@ type MessageContext interface {
 	SendToGroup(group string, message model.Message)
 	SendToGroupSync(group string, message model.Message, timeout time.Duration) error
 }
+
+// QueueStatusGetter is implemented by the message contexts which can tell whether
+// the modules keep receiving their messages
+type QueueStatusGetter interface {
+	// QueueStatus returns the number of messages pending for the module, and the time
+	// the module received its last message
+	QueueStatus(module string) (int, time.Time, error)
+}
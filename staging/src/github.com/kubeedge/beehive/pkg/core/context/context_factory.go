@@ -139,6 +139,20 @@ func Receive(module string) (model.Message, error) {
 	return messageContext.Receive(module)
 }
 
+// QueueStatus returns the number of messages pending for the module and the time
+// the module received its last message
+func QueueStatus(module string) (int, time.Time, error) {
+	messageContext, err := getMessageContext(module)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	getter, ok := messageContext.(QueueStatusGetter)
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("queue status is not supported by the context of module %s", module)
+	}
+	return getter.QueueStatus(module)
+}
+
 // SendSync sends message in sync mode
 // module: the destination of the message
 // timeout: if <= 0 using default value(30s)
@@ -3,8 +3,8 @@ package core
 import (
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
-	"time"
 
 	"k8s.io/klog/v2"
 
@@ -46,20 +46,37 @@ func StartModules() {
 		if module.remote {
 			go moduleKeeper(name, module, m)
 		} else {
-			go localModuleKeeper(module)
+			go supervise(beehiveContext.GetContext(), module.module)
 		}
 
 		klog.Infof("starting module %s", name)
 	}
 }
 
+var (
+	shutdownRequested = make(chan struct{})
+	shutdownOnce      sync.Once
+)
+
+// RequestShutdown shuts down the modules as if a signal is received, it is used where the
+// process does not get the signals, e.g. when it runs as a Windows service
+func RequestShutdown() {
+	shutdownOnce.Do(func() {
+		close(shutdownRequested)
+	})
+}
+
 // GracefulShutdown is if it gets the special signals it does modules cleanup
 func GracefulShutdown() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGHUP, syscall.SIGTERM,
 		syscall.SIGQUIT, syscall.SIGILL, syscall.SIGTRAP, syscall.SIGABRT)
-	s := <-c
-	klog.Infof("Get os signal %v", s.String())
+	select {
+	case s := <-c:
+		klog.Infof("Get os signal %v", s.String())
+	case <-shutdownRequested:
+		klog.Info("Shutdown is requested")
+	}
 
 	// Cleanup each modules
 	beehiveContext.Cancel()
@@ -90,44 +107,3 @@ func moduleKeeper(name string, moduleInfo *ModuleInfo, m common.ModuleInfo) {
 		beehiveContext.AddModuleGroup(name, moduleInfo.module.Group())
 	}
 }
-
-// localModuleKeeper starts and tries to keep module running when module exited.
-// Call EnableModuleRestart() to enable auto-restarting feature in alpha version.
-func localModuleKeeper(m *ModuleInfo) {
-	if !moduleRestartEnabled {
-		m.module.Start()
-		return
-	}
-
-	ctx := beehiveContext.GetContext()
-	backoffDuration := time.Second
-
-	// do if module exits
-	afterFunc := func() {
-		if r := recover(); r != nil {
-			klog.Errorf("module %s panicking: %v", m.module.Name(), r)
-		}
-		klog.Errorf("module %s exited, will restart in %ds", m.module.Name(), int(backoffDuration.Seconds()))
-	}
-
-	for {
-		func() {
-			defer afterFunc()
-			m.module.Start()
-		}()
-
-		select {
-		case <-ctx.Done():
-			klog.Infof("module %s shutdown", m.module.Name())
-			return
-		case <-time.After(backoffDuration):
-		}
-
-		if backoffDuration < 30*time.Second {
-			backoffDuration *= 2
-			if backoffDuration > 30*time.Second {
-				backoffDuration = 30 * time.Second
-			}
-		}
-	}
-}
@@ -0,0 +1,375 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
+)
+
+// ModuleState is the state of a local module supervised by the core
+type ModuleState string
+
+const (
+	// ModuleRunning means the module is running
+	ModuleRunning ModuleState = "Running"
+	// ModuleStuck means the module has left its messages pending for longer than the stuck timeout
+	ModuleStuck ModuleState = "Stuck"
+	// ModuleRestarting means the module exited or is stopped and waits for the backoff to be restarted
+	ModuleRestarting ModuleState = "Restarting"
+	// ModuleExited means the module exited and is not restarted
+	ModuleExited ModuleState = "Exited"
+)
+
+const (
+	restartBackoffInitial = time.Second
+	restartBackoffMax     = 30 * time.Second
+	// restartBackoffReset is how long a module keeps running before its backoff is reset
+	restartBackoffReset = 5 * time.Minute
+)
+
+// ModuleStatus is the status of a local module
+type ModuleStatus struct {
+	Name  string      `json:"name"`
+	State ModuleState `json:"state"`
+	// Restarts is the number of times the module has been restarted
+	Restarts int32 `json:"restarts"`
+	// Reason is the reason of the last exit or stuck of the module
+	Reason string `json:"reason,omitempty"`
+	// PendingMessages is the number of messages waiting to be received by the module
+	PendingMessages    int       `json:"pendingMessages"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// Healthy returns whether the module is running and not stuck
+func (s ModuleStatus) Healthy() bool {
+	return s.State == ModuleRunning
+}
+
+// QueuePauser is implemented by the modules which stop receiving their messages on purpose,
+// e.g. edgehub while it is disconnected from the cloud. The stuck detection skips these
+// modules while their queues are paused.
+type QueuePauser interface {
+	QueuePaused() bool
+}
+
+// Stopper is implemented by the modules which can be restarted when they are stuck. Stop
+// releases the resources the module holds, e.g. its listeners, and makes its Start return.
+// A stuck module is only started again once its Start returned, a stuck module which does not
+// implement Stopper, or whose Start does not return in time, can not be restarted in place and
+// the process exits to be restarted by its service manager.
+type Stopper interface {
+	Stop()
+}
+
+// restartProcess exits the process when a stuck module can not be restarted in place
+var restartProcess = func(module, reason string) {
+	klog.Exitf("module %s is %s and can not be restarted in place, exit to restart the process", module, reason)
+}
+
+var (
+	supervisorsLock sync.RWMutex
+	supervisors     = make(map[string]*moduleSupervisor)
+	// moduleStuckTimeout is 0 if the stuck detection is disabled
+	moduleStuckTimeout time.Duration
+)
+
+// SetModuleStuckTimeout sets how long a module may leave its messages pending before it is
+// considered stuck, the stuck detection is disabled if timeout is 0. Stuck modules are
+// restarted if the module restart is enabled. It must be called before the modules start.
+func SetModuleStuckTimeout(timeout time.Duration) {
+	moduleStuckTimeout = timeout
+}
+
+// GetModuleStatuses returns the statuses of the local modules sorted by name
+func GetModuleStatuses() []ModuleStatus {
+	supervisorsLock.RLock()
+	defer supervisorsLock.RUnlock()
+
+	statuses := make([]ModuleStatus, 0, len(supervisors))
+	for _, s := range supervisors {
+		statuses = append(statuses, s.getStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// moduleSupervisor starts a local module, restarts it with backoff when it exits or is stuck
+// if the module restart is enabled, and records the status of it.
+//
+// The goroutine of a stuck module is abandoned once the module is stopped, the exit of an
+// abandoned goroutine is ignored.
+type moduleSupervisor struct {
+	module       Module
+	restart      bool
+	stuckTimeout time.Duration
+	// stopTimeout is how long the Start of a stopped module may take to return
+	stopTimeout time.Duration
+	queueStatus func(module string) (int, time.Time, error)
+
+	lock   sync.Mutex
+	status ModuleStatus
+	// generation is increased each time the module is started
+	generation int
+	// active is the time since which the module is expected to receive its messages,
+	// the module is considered stuck if it receives none within the stuck timeout since then
+	active time.Time
+	// done is closed once the Start of the running generation returns
+	done   chan struct{}
+	events chan moduleEvent
+}
+
+type moduleEvent struct {
+	reason string
+	// stuck is true if the module is stuck, its Start has not returned
+	stuck bool
+	done  <-chan struct{}
+}
+
+func newModuleSupervisor(m Module) *moduleSupervisor {
+	return &moduleSupervisor{
+		module:       m,
+		restart:      moduleRestartEnabled,
+		stuckTimeout: moduleStuckTimeout,
+		stopTimeout:  moduleStuckTimeout,
+		queueStatus:  beehiveContext.QueueStatus,
+		status: ModuleStatus{
+			Name:               m.Name(),
+			State:              ModuleRunning,
+			LastTransitionTime: time.Now(),
+		},
+		events: make(chan moduleEvent, 1),
+	}
+}
+
+// supervise starts the module and keeps it running until ctx is done
+func supervise(ctx context.Context, m Module) {
+	s := newModuleSupervisor(m)
+	supervisorsLock.Lock()
+	supervisors[m.Name()] = s
+	supervisorsLock.Unlock()
+
+	if s.stuckTimeout > 0 {
+		go s.watch(ctx)
+	}
+	s.run(ctx)
+}
+
+func (s *moduleSupervisor) run(ctx context.Context) {
+	backoff := restartBackoffInitial
+	for {
+		started := time.Now()
+		s.start()
+
+		var event moduleEvent
+		select {
+		case <-ctx.Done():
+			klog.Infof("module %s shutdown", s.module.Name())
+			return
+		case event = <-s.events:
+		}
+		// the modules exit when edgecore or cloudcore shuts down
+		if ctx.Err() != nil {
+			klog.Infof("module %s shutdown", s.module.Name())
+			return
+		}
+		// some modules return once they started their goroutines
+		if !s.restart {
+			klog.Infof("module %s %s", s.module.Name(), event.reason)
+			s.setState(ModuleExited, event.reason)
+			return
+		}
+
+		if event.stuck && !s.stop(ctx, event) {
+			if ctx.Err() != nil {
+				klog.Infof("module %s shutdown", s.module.Name())
+				return
+			}
+			s.setState(ModuleStuck, event.reason)
+			restartProcess(s.module.Name(), event.reason)
+			return
+		}
+
+		if time.Since(started) > restartBackoffReset {
+			backoff = restartBackoffInitial
+		}
+		klog.Errorf("module %s %s, will restart in %ds", s.module.Name(), event.reason, int(backoff.Seconds()))
+		select {
+		case <-ctx.Done():
+			klog.Infof("module %s shutdown", s.module.Name())
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > restartBackoffMax {
+			backoff = restartBackoffMax
+		}
+
+		s.lock.Lock()
+		s.status.Restarts++
+		s.lock.Unlock()
+	}
+}
+
+// stop stops the stuck module and waits for its Start to return, it returns false if the
+// module can not be stopped
+func (s *moduleSupervisor) stop(ctx context.Context, event moduleEvent) bool {
+	stopper, ok := s.module.(Stopper)
+	if !ok {
+		return false
+	}
+	klog.Infof("stopping stuck module %s", s.module.Name())
+	stopper.Stop()
+	timer := time.NewTimer(s.stopTimeout)
+	defer timer.Stop()
+	select {
+	case <-event.done:
+		return true
+	case <-ctx.Done():
+	case <-timer.C:
+		klog.Errorf("module %s does not return within %s after it is stopped", s.module.Name(), s.stopTimeout)
+	}
+	return false
+}
+
+// start starts a new goroutine of the module
+func (s *moduleSupervisor) start() {
+	done := make(chan struct{})
+	s.lock.Lock()
+	s.generation++
+	generation := s.generation
+	s.active = time.Now()
+	s.done = done
+	s.setStateLocked(ModuleRunning, "")
+	s.lock.Unlock()
+
+	go func() {
+		reason := "exited"
+		defer func() {
+			// panics are only recovered if the module can be restarted, keep the old behavior otherwise
+			if s.restart {
+				if r := recover(); r != nil {
+					klog.Errorf("module %s panicking: %v", s.module.Name(), r)
+					reason = fmt.Sprintf("panicked: %v", r)
+				}
+			}
+			close(done)
+			s.notify(generation, reason)
+		}()
+		s.module.Start()
+	}()
+}
+
+// notify sends the exit event of the generation if it is the running one
+func (s *moduleSupervisor) notify(generation int, reason string) {
+	s.lock.Lock()
+	abandoned := s.abandonLocked(generation, reason)
+	s.lock.Unlock()
+	if abandoned {
+		s.events <- moduleEvent{reason: reason}
+	}
+}
+
+// abandonLocked abandons the generation if it is the running one, so that at most one event is
+// sent for a generation and the events never block since run receives each of them before it
+// starts the next generation. s.lock must be held.
+func (s *moduleSupervisor) abandonLocked(generation int, reason string) bool {
+	if generation != s.generation {
+		return false
+	}
+	s.generation++
+	if s.restart {
+		s.setStateLocked(ModuleRestarting, reason)
+	}
+	return true
+}
+
+// watch checks whether the module is stuck periodically
+func (s *moduleSupervisor) watch(ctx context.Context) {
+	period := s.stuckTimeout / 4
+	if period < time.Second {
+		period = time.Second
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(time.Now())
+		}
+	}
+}
+
+func (s *moduleSupervisor) check(now time.Time) {
+	pending, lastReceived, err := s.queueStatus(s.module.Name())
+	if err != nil {
+		klog.V(4).Infof("failed to get queue status of module %s, err: %v", s.module.Name(), err)
+		return
+	}
+	paused := false
+	if pauser, ok := s.module.(QueuePauser); ok {
+		paused = pauser.QueuePaused()
+	}
+
+	s.lock.Lock()
+	switch {
+	case paused:
+		s.active = now
+	case lastReceived.After(s.active):
+		s.active = lastReceived
+	}
+	stuck := pending > 0 && now.Sub(s.active) > s.stuckTimeout
+	s.status.PendingMessages = pending
+	state, done := s.status.State, s.done
+	var reason string
+	if stuck {
+		reason = fmt.Sprintf("stuck, %d messages pending and none received since %s", pending, s.active.Format(time.RFC3339))
+	}
+	// the module is only restarted if it is running, not once it is already restarting
+	restart := stuck && state == ModuleRunning && s.restart && s.abandonLocked(s.generation, reason)
+	s.lock.Unlock()
+
+	switch {
+	case restart:
+		klog.Errorf("module %s is %s", s.module.Name(), reason)
+		s.events <- moduleEvent{reason: reason, stuck: true, done: done}
+	case stuck && state == ModuleRunning && !s.restart:
+		klog.Errorf("module %s is %s", s.module.Name(), reason)
+		s.setState(ModuleStuck, reason)
+	case !stuck && state == ModuleStuck:
+		klog.Infof("module %s recovered from stuck", s.module.Name())
+		s.setState(ModuleRunning, "")
+	}
+}
+
+func (s *moduleSupervisor) setState(state ModuleState, reason string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.setStateLocked(state, reason)
+}
+
+// setStateLocked sets the state of the module, s.lock must be held
+func (s *moduleSupervisor) setStateLocked(state ModuleState, reason string) {
+	if s.status.State != state {
+		s.status.LastTransitionTime = time.Now()
+	}
+	s.status.State = state
+	// the reason of the last failure is kept after the module recovers
+	if reason != "" {
+		s.status.Reason = reason
+	}
+}
+
+func (s *moduleSupervisor) getStatus() ModuleStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.status
+}
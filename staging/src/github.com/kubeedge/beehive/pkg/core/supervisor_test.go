@@ -0,0 +1,299 @@
+package core
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeModule struct {
+	starts atomic.Int32
+	// start is called with the number of times the module is started
+	start  func(n int32)
+	paused bool
+}
+
+func (m *fakeModule) Name() string {
+	return "fake"
+}
+
+func (m *fakeModule) Group() string {
+	return "fake"
+}
+
+func (m *fakeModule) Enable() bool {
+	return true
+}
+
+func (m *fakeModule) Start() {
+	m.start(m.starts.Add(1))
+}
+
+func (m *fakeModule) QueuePaused() bool {
+	return m.paused
+}
+
+func waitForStatus(t *testing.T, s *moduleSupervisor, cond func(ModuleStatus) bool) ModuleStatus {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status := s.getStatus()
+		if cond(status) {
+			return status
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the module status, the last status is %+v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSupervisorRestartPanickedModule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := &fakeModule{start: func(n int32) {
+		if n == 1 {
+			panic("boom")
+		}
+		<-ctx.Done()
+	}}
+	s := newModuleSupervisor(m)
+	s.restart = true
+	go s.run(ctx)
+
+	status := waitForStatus(t, s, func(status ModuleStatus) bool {
+		return status.Restarts == 1 && status.Healthy()
+	})
+	if status.Reason != "panicked: boom" {
+		t.Errorf("expected the reason of the last failure to be kept, but got %q", status.Reason)
+	}
+}
+
+func TestSupervisorExitedModule(t *testing.T) {
+	s := newModuleSupervisor(&fakeModule{start: func(int32) {}})
+	s.restart = false
+	s.run(context.Background())
+
+	if status := s.getStatus(); status.State != ModuleExited || status.Healthy() {
+		t.Errorf("expected the module not to be restarted, but got %+v", status)
+	}
+}
+
+func TestSupervisorStuckModule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := &fakeModule{start: func(int32) {
+		<-ctx.Done()
+	}}
+	s := newModuleSupervisor(m)
+	s.stuckTimeout = time.Minute
+	pending, lastReceived := 0, time.Now()
+	s.queueStatus = func(string) (int, time.Time, error) {
+		return pending, lastReceived, nil
+	}
+	s.start()
+	now := time.Now()
+
+	// an idle module is not stuck
+	s.check(now.Add(2 * time.Minute))
+	if status := s.getStatus(); !status.Healthy() {
+		t.Fatalf("expected the idle module to be healthy, but got %+v", status)
+	}
+
+	pending = 10
+	s.check(now.Add(30 * time.Second))
+	if status := s.getStatus(); !status.Healthy() || status.PendingMessages != 10 {
+		t.Fatalf("expected the module to be healthy within the stuck timeout, but got %+v", status)
+	}
+
+	m.paused = true
+	s.check(now.Add(3 * time.Minute))
+	m.paused = false
+	s.check(now.Add(3*time.Minute + 30*time.Second))
+	if status := s.getStatus(); !status.Healthy() {
+		t.Fatalf("expected the paused module to be healthy, but got %+v", status)
+	}
+
+	s.check(now.Add(5 * time.Minute))
+	status := s.getStatus()
+	if status.State != ModuleStuck || !strings.HasPrefix(status.Reason, "stuck, 10 messages pending") {
+		t.Fatalf("expected the module to be stuck, but got %+v", status)
+	}
+
+	pending, lastReceived = 0, now.Add(5*time.Minute)
+	s.check(now.Add(5*time.Minute + time.Second))
+	if status := s.getStatus(); !status.Healthy() {
+		t.Errorf("expected the module to recover, but got %+v", status)
+	}
+}
+
+// listenerModule holds a listener while it runs, it can only be started again once the
+// listener of the last start is released
+type listenerModule struct {
+	fakeModule
+	addr string
+	// hang is closed to return from Start if it does not return once the module is stopped
+	hang chan struct{}
+
+	lock     sync.Mutex
+	listener net.Listener
+	stop     chan struct{}
+	// failures is the number of starts which failed to listen
+	failures atomic.Int32
+}
+
+func (m *listenerModule) Start() {
+	n := m.starts.Add(1)
+	ln, err := net.Listen("tcp", m.addr)
+	if err != nil {
+		m.failures.Add(1)
+		return
+	}
+	stop := make(chan struct{})
+	m.lock.Lock()
+	m.listener, m.stop = ln, stop
+	m.lock.Unlock()
+	m.start(n)
+	if m.hang != nil {
+		<-m.hang
+		return
+	}
+	<-stop
+}
+
+func (m *listenerModule) Stop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.listener.Close()
+	close(m.stop)
+}
+
+func newListenerModule(t *testing.T, start func(n int32)) *listenerModule {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+	return &listenerModule{fakeModule: fakeModule{start: start}, addr: addr}
+}
+
+func newStuckSupervisor(m Module) *moduleSupervisor {
+	s := newModuleSupervisor(m)
+	s.restart = true
+	s.stuckTimeout = time.Minute
+	s.stopTimeout = 100 * time.Millisecond
+	lastReceived := time.Now()
+	s.queueStatus = func(string) (int, time.Time, error) {
+		return 1, lastReceived, nil
+	}
+	return s
+}
+
+// replaceRestartProcess records the process restarts instead of exiting
+func replaceRestartProcess(t *testing.T) *atomic.Int32 {
+	var restarts atomic.Int32
+	original := restartProcess
+	restartProcess = func(string, string) {
+		restarts.Add(1)
+	}
+	t.Cleanup(func() {
+		restartProcess = original
+	})
+	return &restarts
+}
+
+func TestSupervisorRestartStuckModule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	processRestarts := replaceRestartProcess(t)
+	m := newListenerModule(t, func(int32) {})
+	s := newStuckSupervisor(m)
+	go s.run(ctx)
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return m.starts.Load() == 1
+	})
+
+	s.check(time.Now().Add(2 * time.Minute))
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return status.Restarts == 1 && status.Healthy()
+	})
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return m.starts.Load() == 2
+	})
+	// the module is started again only after the listener is released
+	if m.failures.Load() != 0 || processRestarts.Load() != 0 {
+		t.Errorf("expected the stuck module to be restarted in place, got %d failed starts and %d process restarts",
+			m.failures.Load(), processRestarts.Load())
+	}
+}
+
+func TestSupervisorStuckModuleNotStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	processRestarts := replaceRestartProcess(t)
+	m := newListenerModule(t, func(int32) {})
+	m.hang = make(chan struct{})
+	defer close(m.hang)
+	s := newStuckSupervisor(m)
+	go s.run(ctx)
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return m.starts.Load() == 1
+	})
+
+	s.check(time.Now().Add(2 * time.Minute))
+	status := waitForStatus(t, s, func(status ModuleStatus) bool {
+		return status.State == ModuleStuck
+	})
+	if processRestarts.Load() != 1 || m.starts.Load() != 1 || status.Restarts != 0 {
+		t.Errorf("expected the process to be restarted instead of the module, got %d process restarts, %d starts and %+v",
+			processRestarts.Load(), m.starts.Load(), status)
+	}
+}
+
+func TestSupervisorStuckModuleWithoutStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	processRestarts := replaceRestartProcess(t)
+	m := &fakeModule{start: func(int32) {
+		<-ctx.Done()
+	}}
+	s := newStuckSupervisor(m)
+	go s.run(ctx)
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return m.starts.Load() == 1
+	})
+
+	s.check(time.Now().Add(2 * time.Minute))
+	waitForStatus(t, s, func(status ModuleStatus) bool {
+		return status.State == ModuleStuck
+	})
+	if processRestarts.Load() != 1 || m.starts.Load() != 1 {
+		t.Errorf("expected the process to be restarted instead of the module, got %d process restarts and %d starts",
+			processRestarts.Load(), m.starts.Load())
+	}
+}
+
+func TestSupervisorStuckModuleNotifiedOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := &fakeModule{start: func(int32) {
+		<-ctx.Done()
+	}}
+	s := newStuckSupervisor(m)
+	s.start()
+
+	// the module is restarting once it is stuck, the later checks do not notify it again
+	s.check(time.Now().Add(2 * time.Minute))
+	s.check(time.Now().Add(3 * time.Minute))
+	if status := s.getStatus(); status.State != ModuleRestarting || len(s.events) != 1 {
+		t.Fatalf("expected one event of the restarting module, got %d events and %+v", len(s.events), status)
+	}
+	if event := <-s.events; !event.stuck {
+		t.Errorf("expected the event of the stuck module, got %+v", event)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csv
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+const (
+	defaultMaxFileSize = 100 * 1024 * 1024
+	defaultMaxFiles    = 5
+)
+
+var header = []string{"timestamp", "namespace", "device", "property", "type", "value"}
+
+// SinkConfig is the config of a data plane sink appending to CSV files on the edge node.
+type SinkConfig struct {
+	Path        string `json:"path,omitempty"`
+	MaxFileSize int64  `json:"maxFileSize,omitempty"`
+	MaxFiles    int32  `json:"maxFiles,omitempty"`
+}
+
+// Sink appends the samples of every device to <namespace>_<device name>.csv in the
+// configured directory, and rotates the file to .1, .2, ... when it grows too large.
+type Sink struct {
+	config *SinkConfig
+	files  map[string]*csvFile
+}
+
+type csvFile struct {
+	path   string
+	file   *os.File
+	writer *csv.Writer
+	// size includes the buffered records
+	size int64
+}
+
+func NewDataPlaneSink(config json.RawMessage) (global.DataPlaneSink, error) {
+	sinkConfig := new(SinkConfig)
+	if err := json.Unmarshal(config, sinkConfig); err != nil {
+		return nil, err
+	}
+	if sinkConfig.MaxFileSize <= 0 {
+		sinkConfig.MaxFileSize = defaultMaxFileSize
+	}
+	if sinkConfig.MaxFiles <= 0 {
+		sinkConfig.MaxFiles = defaultMaxFiles
+	}
+	return &Sink{config: sinkConfig, files: map[string]*csvFile{}}, nil
+}
+
+func (s *Sink) InitSink() error {
+	return os.MkdirAll(s.config.Path, 0750)
+}
+
+func (s *Sink) Write(data []*common.DataModel) error {
+	for _, d := range data {
+		f, err := s.file(d)
+		if err != nil {
+			return err
+		}
+		if err := f.write([]string{strconv.FormatInt(d.TimeStamp, 10), d.Namespace, d.DeviceName, d.PropertyName, d.Type, d.Value}); err != nil {
+			return err
+		}
+		if f.size >= s.config.MaxFileSize {
+			if err := s.rotate(f); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range s.files {
+		if f.file == nil {
+			continue
+		}
+		if err := f.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	var lastErr error
+	for key, f := range s.files {
+		if err := f.close(); err != nil {
+			lastErr = err
+		}
+		delete(s.files, key)
+	}
+	return lastErr
+}
+
+// file returns the open file of the device of the sample, creating it with a header if needed.
+func (s *Sink) file(d *common.DataModel) (*csvFile, error) {
+	key := d.Namespace + "_" + d.DeviceName
+	if f, ok := s.files[key]; ok && f.file != nil {
+		return f, nil
+	}
+	f := &csvFile{path: filepath.Join(s.config.Path, key+".csv")}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	s.files[key] = f
+	return f, nil
+}
+
+// rotate shifts the rotated files of f by one, dropping the oldest, and starts a new file.
+func (s *Sink) rotate(f *csvFile) error {
+	if err := f.close(); err != nil {
+		return err
+	}
+	for i := s.config.MaxFiles; i > 1; i-- {
+		older := fmt.Sprintf("%s.%d", f.path, i-1)
+		if _, err := os.Stat(older); err == nil {
+			if err := os.Rename(older, fmt.Sprintf("%s.%d", f.path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Rename(f.path, f.path+".1"); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *csvFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size = file, info.Size()
+	f.writer = csv.NewWriter(file)
+	if f.size == 0 {
+		return f.write(header)
+	}
+	return nil
+}
+
+func (f *csvFile) write(record []string) error {
+	if err := f.writer.Write(record); err != nil {
+		return err
+	}
+	// The written size is estimated as the csv writer buffers the records and
+	// quotes only some fields.
+	for _, field := range record {
+		f.size += int64(len(field)) + 1
+	}
+	return nil
+}
+
+func (f *csvFile) flush() error {
+	f.writer.Flush()
+	return f.writer.Error()
+}
+
+func (f *csvFile) close() error {
+	err := f.flush()
+	if closeErr := f.file.Close(); err == nil {
+		err = closeErr
+	}
+	f.file = nil
+	return err
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package influxdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+// SinkConfig is the config of a data plane sink writing to an InfluxDB v2 bucket.
+type SinkConfig struct {
+	URL         string            `json:"url,omitempty"`
+	Org         string            `json:"org,omitempty"`
+	Bucket      string            `json:"bucket,omitempty"`
+	Measurement string            `json:"measurement,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	TokenFile   string            `json:"tokenFile,omitempty"`
+}
+
+type Sink struct {
+	config   *SinkConfig
+	client   influxdb2.Client
+	writeAPI api.WriteAPIBlocking
+}
+
+func NewDataPlaneSink(config json.RawMessage) (global.DataPlaneSink, error) {
+	sinkConfig := new(SinkConfig)
+	if err := json.Unmarshal(config, sinkConfig); err != nil {
+		return nil, err
+	}
+	return &Sink{config: sinkConfig}, nil
+}
+
+func (s *Sink) InitSink() error {
+	var token string
+	if s.config.TokenFile != "" {
+		data, err := os.ReadFile(s.config.TokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read influxdb token file: %v", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+	// The samples are batched by the data plane pipeline already
+	s.client = influxdb2.NewClientWithOptions(s.config.URL, token, influxdb2.DefaultOptions().SetPrecision(time.Millisecond))
+	s.writeAPI = s.client.WriteAPIBlocking(s.config.Org, s.config.Bucket)
+	return nil
+}
+
+// Write writes every sample as a point with the property name as field key,
+// numeric and boolean values are written as such and all others as strings.
+func (s *Sink) Write(data []*common.DataModel) error {
+	points := make([]*write.Point, 0, len(data))
+	for _, d := range data {
+		measurement := s.config.Measurement
+		if measurement == "" {
+			measurement = d.DeviceName
+		}
+		tags := map[string]string{"device": d.DeviceName, "namespace": d.Namespace}
+		for k, v := range s.config.Tags {
+			tags[k] = v
+		}
+		points = append(points, influxdb2.NewPoint(measurement, tags,
+			map[string]interface{}{d.PropertyName: fieldValue(d.Value)}, time.UnixMilli(d.TimeStamp)))
+	}
+	return s.writeAPI.WritePoint(context.Background(), points...)
+}
+
+func (s *Sink) Close() error {
+	if s.client != nil {
+		s.client.Close()
+	}
+	return nil
+}
+
+func fieldValue(value string) interface{} {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b
+	}
+	return value
+}
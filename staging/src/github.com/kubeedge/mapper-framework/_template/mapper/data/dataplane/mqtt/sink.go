@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mqtt
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+// SinkConfig is the config of a data plane sink publishing to a local MQTT broker.
+type SinkConfig struct {
+	Broker   string `json:"broker,omitempty"`
+	Topic    string `json:"topic,omitempty"`
+	QoS      int32  `json:"qos,omitempty"`
+	ClientID string `json:"clientID,omitempty"`
+}
+
+type Sink struct {
+	config *SinkConfig
+	client mqtt.Client
+}
+
+// Sample is a sample in the published batches.
+type Sample struct {
+	Property  string `json:"property"`
+	Value     string `json:"value"`
+	Type      string `json:"type,omitempty"`
+	TimeStamp int64  `json:"timestamp"`
+}
+
+func NewDataPlaneSink(config json.RawMessage) (global.DataPlaneSink, error) {
+	sinkConfig := new(SinkConfig)
+	if err := json.Unmarshal(config, sinkConfig); err != nil {
+		return nil, err
+	}
+	return &Sink{config: sinkConfig}, nil
+}
+
+func (s *Sink) InitSink() error {
+	opts := mqtt.NewClientOptions().AddBroker(s.config.Broker).SetAutoReconnect(true)
+	if s.config.ClientID != "" {
+		opts.SetClientID(s.config.ClientID)
+	}
+	s.client = mqtt.NewClient(opts)
+	if token := s.client.Connect(); token.Wait() && token.Error() != nil {
+		return fmt.Errorf("failed to connect to broker %s: %v", s.config.Broker, token.Error())
+	}
+	return nil
+}
+
+// Write publishes the samples of each device as a JSON array, by default to
+// $ke/dataplane/<namespace>/<device name>.
+func (s *Sink) Write(data []*common.DataModel) error {
+	batches := map[string][]Sample{}
+	var topics []string
+	for _, d := range data {
+		topic := s.config.Topic
+		if topic == "" {
+			topic = fmt.Sprintf("$ke/dataplane/%s/%s", d.Namespace, d.DeviceName)
+		}
+		if _, ok := batches[topic]; !ok {
+			topics = append(topics, topic)
+		}
+		batches[topic] = append(batches[topic], Sample{
+			Property:  d.PropertyName,
+			Value:     d.Value,
+			Type:      d.Type,
+			TimeStamp: d.TimeStamp,
+		})
+	}
+	for _, topic := range topics {
+		payload, err := json.Marshal(batches[topic])
+		if err != nil {
+			return err
+		}
+		token := s.client.Publish(topic, byte(s.config.QoS), false, payload)
+		if !token.WaitTimeout(10*time.Second) || token.Error() != nil {
+			return fmt.Errorf("failed to publish to topic %s: %v", topic, token.Error())
+		}
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	if s.client != nil {
+		s.client.Disconnect(250)
+	}
+	return nil
+}
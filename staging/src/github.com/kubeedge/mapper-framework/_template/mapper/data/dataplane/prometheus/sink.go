@@ -0,0 +1,203 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+const defaultMetricName = "kubeedge_device_property"
+
+// SinkConfig is the config of a data plane sink writing to a Prometheus remote-write endpoint.
+type SinkConfig struct {
+	URL             string            `json:"url,omitempty"`
+	MetricName      string            `json:"metricName,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	BearerTokenFile string            `json:"bearerTokenFile,omitempty"`
+	// Timeout in milliseconds
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+type Sink struct {
+	config *SinkConfig
+	token  string
+	client *http.Client
+}
+
+func NewDataPlaneSink(config json.RawMessage) (global.DataPlaneSink, error) {
+	sinkConfig := new(SinkConfig)
+	if err := json.Unmarshal(config, sinkConfig); err != nil {
+		return nil, err
+	}
+	if sinkConfig.MetricName == "" {
+		sinkConfig.MetricName = defaultMetricName
+	}
+	return &Sink{config: sinkConfig}, nil
+}
+
+func (s *Sink) InitSink() error {
+	if s.config.BearerTokenFile != "" {
+		data, err := os.ReadFile(s.config.BearerTokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read bearer token file: %v", err)
+		}
+		s.token = strings.TrimSpace(string(data))
+	}
+	timeout := time.Duration(s.config.Timeout) * time.Millisecond
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	s.client = &http.Client{Timeout: timeout}
+	return nil
+}
+
+// Write sends the samples with a numeric or boolean value as one remote-write request,
+// with a time series per property.
+func (s *Sink) Write(data []*common.DataModel) error {
+	body := snappy.Encode(nil, s.encodeWriteRequest(data))
+	req, err := http.NewRequest(http.MethodPost, s.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("remote write returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (s *Sink) Close() error {
+	return nil
+}
+
+type label struct {
+	name, value string
+}
+
+type series struct {
+	labels  []label
+	samples []byte
+}
+
+// encodeWriteRequest encodes the samples as a prometheus.WriteRequest protobuf message.
+func (s *Sink) encodeWriteRequest(data []*common.DataModel) []byte {
+	var order []string
+	seriesByKey := map[string]*series{}
+	for _, d := range data {
+		value, ok := sampleValue(d.Value)
+		if !ok {
+			continue
+		}
+		key := d.Namespace + "/" + d.DeviceName + "/" + d.PropertyName
+		cur, ok := seriesByKey[key]
+		if !ok {
+			cur = &series{labels: s.seriesLabels(d)}
+			seriesByKey[key] = cur
+			order = append(order, key)
+		}
+		// Sample: double value = 1; int64 timestamp = 2;
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, math.Float64bits(value))
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(d.TimeStamp))
+		cur.samples = protowire.AppendTag(cur.samples, 2, protowire.BytesType)
+		cur.samples = protowire.AppendBytes(cur.samples, sample)
+	}
+
+	// WriteRequest: repeated TimeSeries timeseries = 1;
+	// TimeSeries: repeated Label labels = 1; repeated Sample samples = 2;
+	var req []byte
+	for _, key := range order {
+		cur := seriesByKey[key]
+		var ts []byte
+		for _, l := range cur.labels {
+			var lb []byte
+			lb = protowire.AppendTag(lb, 1, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.name)
+			lb = protowire.AppendTag(lb, 2, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.value)
+			ts = protowire.AppendTag(ts, 1, protowire.BytesType)
+			ts = protowire.AppendBytes(ts, lb)
+		}
+		ts = append(ts, cur.samples...)
+		req = protowire.AppendTag(req, 1, protowire.BytesType)
+		req = protowire.AppendBytes(req, ts)
+	}
+	return req
+}
+
+// seriesLabels returns the labels of the series of the sample, sorted by name as
+// remote-write requires.
+func (s *Sink) seriesLabels(d *common.DataModel) []label {
+	labels := map[string]string{}
+	for k, v := range s.config.Labels {
+		labels[k] = v
+	}
+	labels["__name__"] = s.config.MetricName
+	labels["device"] = d.DeviceName
+	labels["namespace"] = d.Namespace
+	labels["property"] = d.PropertyName
+	res := make([]label, 0, len(labels))
+	for k, v := range labels {
+		res = append(res, label{name: k, value: v})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].name < res[j].name
+	})
+	return res
+}
+
+func sampleValue(value string) (float64, bool) {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f, true
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		if b {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
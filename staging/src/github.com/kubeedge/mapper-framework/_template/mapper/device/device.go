@@ -13,6 +13,10 @@ import (
 
 	"k8s.io/klog/v2"
 
+	csvSink "github.com/kubeedge/Template/data/dataplane/csv"
+	influxSink "github.com/kubeedge/Template/data/dataplane/influxdb"
+	mqttSink "github.com/kubeedge/Template/data/dataplane/mqtt"
+	prometheusSink "github.com/kubeedge/Template/data/dataplane/prometheus"
 	dbInflux "github.com/kubeedge/Template/data/dbmethod/influxdb2"
 	dbMysql "github.com/kubeedge/Template/data/dbmethod/mysql"
 	dbRedis "github.com/kubeedge/Template/data/dbmethod/redis"
@@ -23,6 +27,8 @@ import (
 	"github.com/kubeedge/Template/driver"
 	dmiapi "github.com/kubeedge/kubeedge/pkg/apis/dmi/v1beta1"
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/dataplane"
+	"github.com/kubeedge/mapper-framework/pkg/firmware"
 	"github.com/kubeedge/mapper-framework/pkg/global"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
 )
@@ -34,6 +40,9 @@ type DevPanel struct {
 	wg           sync.WaitGroup
 	serviceMutex sync.Mutex
 	quitChan     chan os.Signal
+	// firmware keeps the firmware upgraders of the devices across the restarts of the devices
+	firmware      map[string]*firmware.Upgrader
+	firmwareMutex sync.Mutex
 }
 
 var (
@@ -53,6 +62,7 @@ func NewDevPanel() *DevPanel {
 			wg:           sync.WaitGroup{},
 			serviceMutex: sync.Mutex{},
 			quitChan:     make(chan os.Signal),
+			firmware:     make(map[string]*firmware.Upgrader),
 		}
 	})
 	return devPanel
@@ -102,12 +112,37 @@ func (d *DevPanel) start(ctx context.Context, dev *driver.CustomizedDev) {
 		klog.Errorf("Init device %s error: %v", dev.Instance.ID, err)
 		return
 	}
-	go dataHandler(ctx, dev)
+	upgrader := d.getFirmwareUpgrader(dev)
+	go dataHandler(ctx, dev, upgrader)
+	if dev.Instance.DataPlane != nil {
+		go dataPlaneHandler(ctx, dev)
+	}
+	if dev.Instance.Firmware != nil {
+		upgrader.Sync(ctx, dev.Instance.Firmware, dev.CustomizedClient.UpgradeFirmware)
+	}
 	<-ctx.Done()
 }
 
+// getFirmwareUpgrader returns the firmware upgrader of the device, the upgrader is created
+// with the firmware version read from the device when the device is started the first time
+func (d *DevPanel) getFirmwareUpgrader(dev *driver.CustomizedDev) *firmware.Upgrader {
+	d.firmwareMutex.Lock()
+	defer d.firmwareMutex.Unlock()
+
+	if upgrader, ok := d.firmware[dev.Instance.ID]; ok {
+		return upgrader
+	}
+	version, err := dev.CustomizedClient.GetFirmwareVersion()
+	if err != nil {
+		klog.Errorf("Get firmware version of device %s error: %v", dev.Instance.ID, err)
+	}
+	upgrader := firmware.NewUpgrader(dev.Instance.Name, dev.Instance.Namespace, version)
+	d.firmware[dev.Instance.ID] = upgrader
+	return upgrader
+}
+
 // dataHandler initialize the timer to handle data plane and devicetwin.
-func dataHandler(ctx context.Context, dev *driver.CustomizedDev) {
+func dataHandler(ctx context.Context, dev *driver.CustomizedDev, upgrader *firmware.Upgrader) {
 	for _, twin := range dev.Instance.Twins {
 		twin.Property.PProperty.DataType = strings.ToLower(twin.Property.PProperty.DataType)
 		var visitorConfig driver.VisitorConfig
@@ -147,6 +182,7 @@ func dataHandler(ctx context.Context, dev *driver.CustomizedDev) {
 			Topic:           fmt.Sprintf(common.TopicTwinUpdate, dev.Instance.ID),
 			CollectCycle:    time.Duration(twin.Property.CollectCycle),
 			ReportToCloud:   twin.Property.ReportToCloud,
+			Firmware:        upgrader,
 		}
 		go twinData.Run(ctx)
 		// handle push method
@@ -225,6 +261,81 @@ func pushHandler(ctx context.Context, twin *common.Twin, client *driver.Customiz
 	}()
 }
 
+// dataPlaneHandler samples the properties of the device at the data plane rate and
+// streams the samples to the edge-local sinks of the device until ctx is done.
+func dataPlaneHandler(ctx context.Context, dev *driver.CustomizedDev) {
+	config := dev.Instance.DataPlane
+	sinks := make(map[string]global.DataPlaneSink, len(config.Sinks))
+	for _, sinkConfig := range config.Sinks {
+		var sink global.DataPlaneSink
+		var err error
+		switch sinkConfig.SinkType {
+		case common.DataPlaneSinkInfluxDB:
+			sink, err = influxSink.NewDataPlaneSink(sinkConfig.SinkConfig)
+		case common.DataPlaneSinkPrometheusRemoteWrite:
+			sink, err = prometheusSink.NewDataPlaneSink(sinkConfig.SinkConfig)
+		case common.DataPlaneSinkMQTT:
+			sink, err = mqttSink.NewDataPlaneSink(sinkConfig.SinkConfig)
+		case common.DataPlaneSinkCSV:
+			sink, err = csvSink.NewDataPlaneSink(sinkConfig.SinkConfig)
+		default:
+			err = fmt.Errorf("unsupported sink type %s", sinkConfig.SinkType)
+		}
+		if err == nil {
+			err = sink.InitSink()
+		}
+		if err != nil {
+			klog.Errorf("init data plane sink %s of device %s error: %v", sinkConfig.Name, dev.Instance.Name, err)
+			continue
+		}
+		sinks[sinkConfig.Name] = sink
+	}
+	if len(sinks) == 0 {
+		return
+	}
+	pipeline := dataplane.NewPipeline(dev.Instance.Name, config, sinks)
+
+	for i := range dev.Instance.Properties {
+		property := dev.Instance.Properties[i]
+		if !pipeline.Streams(property.PropertyName) || strings.ToLower(property.PProperty.DataType) == "stream" {
+			continue
+		}
+		var visitorConfig driver.VisitorConfig
+		if err := json.Unmarshal(property.Visitors, &visitorConfig); err != nil {
+			klog.Errorf("Unmarshal VisitorConfig of property %s error: %v", property.PropertyName, err)
+			continue
+		}
+		go sampleProperty(ctx, dev, &property, &visitorConfig, pipeline)
+	}
+	pipeline.Run(ctx)
+}
+
+// sampleProperty pushes the value of the property to the pipeline every sample interval.
+func sampleProperty(ctx context.Context, dev *driver.CustomizedDev, property *common.DeviceProperty, visitorConfig *driver.VisitorConfig, pipeline *dataplane.Pipeline) {
+	dataType := strings.ToLower(property.PProperty.DataType)
+	ticker := time.NewTicker(pipeline.SampleInterval(time.Duration(property.CollectCycle)))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			deviceData, err := dev.CustomizedClient.GetDeviceData(visitorConfig)
+			if err != nil {
+				klog.V(4).Infof("sample property %s of device %s error: %v", property.PropertyName, dev.Instance.Name, err)
+				continue
+			}
+			sData, err := common.ConvertToString(deviceData)
+			if err != nil {
+				klog.V(4).Infof("Failed to convert data plane sample: %v", err)
+				continue
+			}
+			pipeline.Push(common.NewDataModel(dev.Instance.Name, property.PropertyName, dev.Instance.Namespace,
+				common.WithType(dataType), common.WithValue(sData)))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // dbHandler start db client to save data
 func dbHandler(ctx context.Context, twin *common.Twin, client *driver.CustomizedClient, visitorConfig *driver.VisitorConfig, dataModel *common.DataModel) {
 	switch twin.Property.PushMethod.DBMethod.DBMethodName {
@@ -406,6 +517,9 @@ func (d *DevPanel) RemoveDevice(deviceID string) error {
 	defer d.serviceMutex.Unlock()
 	dev := d.devices[deviceID]
 	delete(d.devices, deviceID)
+	d.firmwareMutex.Lock()
+	delete(d.firmware, deviceID)
+	d.firmwareMutex.Unlock()
 	err := d.stopDev(dev, deviceID)
 	if err != nil {
 		return err
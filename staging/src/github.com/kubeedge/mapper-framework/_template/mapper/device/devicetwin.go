@@ -12,6 +12,7 @@ import (
 	"github.com/kubeedge/Template/driver"
 	dmiapi "github.com/kubeedge/kubeedge/pkg/apis/dmi/v1beta1"
 	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/firmware"
 	"github.com/kubeedge/mapper-framework/pkg/grpcclient"
 	"github.com/kubeedge/mapper-framework/pkg/util/parse"
 )
@@ -28,6 +29,8 @@ type TwinData struct {
 	Results         interface{}
 	CollectCycle    time.Duration
 	ReportToCloud   bool
+	// Firmware reports the firmware state of the device with the twins
+	Firmware *firmware.Upgrader
 }
 
 func (td *TwinData) GetPayLoad() ([]byte, error) {
@@ -83,6 +86,9 @@ func (td *TwinData) PushToEdgeCore() {
 			//State: "OK",
 		},
 	}
+	if td.Firmware != nil {
+		rdsr.ReportedDevice.Firmware = td.Firmware.Status()
+	}
 
 	if err := grpcclient.ReportDeviceStatus(rdsr); err != nil {
 		klog.Errorf("fail to report device status of %s with err: %+v", rdsr.DeviceName, err)
@@ -1,6 +1,7 @@
 package driver
 
 import (
+	"context"
 	"sync"
 )
 
@@ -31,6 +32,19 @@ func (c *CustomizedClient) SetDeviceData(data interface{}, visitor *VisitorConfi
 	return nil
 }
 
+func (c *CustomizedClient) GetFirmwareVersion() (string, error) {
+	// TODO: get the firmware version running on the device
+	// you can use c.ProtocolConfig
+	return "", nil
+}
+
+func (c *CustomizedClient) UpgradeFirmware(ctx context.Context, imagePath string) (string, error) {
+	// TODO: write the verified firmware image at imagePath to the device,
+	// return the firmware version running on the device after the upgrade
+	// you can use c.ProtocolConfig
+	return "", nil
+}
+
 func (c *CustomizedClient) StopDevice() error {
 	// TODO: stop device
 	// you can use c.ProtocolConfig
@@ -28,6 +28,16 @@ type DeviceInstance struct {
 	Model        string           `json:"model,omitempty"`
 	Twins        []Twin           `json:"twins,omitempty"`
 	Properties   []DeviceProperty `json:"properties,omitempty"`
+	DataPlane    *DataPlaneConfig `json:"dataPlane,omitempty"`
+	Firmware     *FirmwareConfig  `json:"firmware,omitempty"`
+}
+
+// FirmwareConfig is structure to store the desired firmware of the device
+type FirmwareConfig struct {
+	Version string `json:"version,omitempty"`
+	URL     string `json:"url,omitempty"`
+	// Checksum is in the form <algorithm>:<hex digest>, only sha256 is supported
+	Checksum string `json:"checksum,omitempty"`
 }
 
 // DeviceModel is structure to store detailed information about the devicemodel in the mapper.
@@ -61,6 +71,37 @@ type ProtocolConfig struct {
 	ConfigData json.RawMessage `json:"configData,omitempty"`
 }
 
+// OpcUAProtocolConfig is the configData of a device using the built-in OPC UA protocol config.
+// Certificate, PrivateKey, ServerCertificate and PasswordFile are file paths on the edge node.
+type OpcUAProtocolConfig struct {
+	URL               string               `json:"url"`
+	SecurityPolicy    string               `json:"securityPolicy,omitempty"`
+	SecurityMode      string               `json:"securityMode,omitempty"`
+	Certificate       string               `json:"certificate,omitempty"`
+	PrivateKey        string               `json:"privateKey,omitempty"`
+	ServerCertificate string               `json:"serverCertificate,omitempty"`
+	Authentication    *OpcUAAuthentication `json:"authentication,omitempty"`
+	// Timeout of the requests to the opc server in milliseconds
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// OpcUAAuthentication is the user identity of an OPC UA session.
+type OpcUAAuthentication struct {
+	Type         string `json:"type"`
+	UserName     string `json:"userName,omitempty"`
+	PasswordFile string `json:"passwordFile,omitempty"`
+}
+
+// OpcUAVisitorConfig is the configData of the visitor of a property read from an OPC UA node.
+type OpcUAVisitorConfig struct {
+	NodeID     string `json:"nodeID"`
+	BrowseName string `json:"browseName,omitempty"`
+	// Publishing and sampling intervals of the subscription to the node in milliseconds,
+	// the node is read every collectCycle if SubscriptionInterval is 0
+	SubscriptionInterval int64 `json:"subscriptionInterval,omitempty"`
+	SamplingInterval     int64 `json:"samplingInterval,omitempty"`
+}
+
 // DeviceProperty is structure to store propertyVisitor in device.
 type DeviceProperty struct {
 	Name         string          `json:"name,omitempty"`
@@ -76,6 +117,25 @@ type DeviceProperty struct {
 	PProperty     ModelProperty
 }
 
+// DataPlaneConfig is structure to store the telemetry streamed to edge-local sinks
+type DataPlaneConfig struct {
+	Properties []string `json:"properties,omitempty"`
+	// SampleRate is the number of samples per second of each property
+	SampleRate int32 `json:"sampleRate,omitempty"`
+	BatchSize  int32 `json:"batchSize,omitempty"`
+	// FlushInterval in milliseconds
+	FlushInterval int64                 `json:"flushInterval,omitempty"`
+	BufferSize    int32                 `json:"bufferSize,omitempty"`
+	Sinks         []DataPlaneSinkConfig `json:"sinks,omitempty"`
+}
+
+// DataPlaneSinkConfig is structure to store a sink of the data plane
+type DataPlaneSinkConfig struct {
+	Name       string          `json:"name"`
+	SinkType   string          `json:"sinkType"`
+	SinkConfig json.RawMessage `json:"sinkConfig"`
+}
+
 // PushMethodConfig is structure to store push config
 type PushMethodConfig struct {
 	MethodName   string          `json:"MethodName"`
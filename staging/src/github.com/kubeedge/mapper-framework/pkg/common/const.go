@@ -28,6 +28,8 @@ const (
 )
 const (
 	ProtocolCustomized = "customized-protocol"
+	// ProtocolOpcUA is the protocol name of devices using the built-in OPC UA config
+	ProtocolOpcUA = "opcua"
 )
 
 const (
@@ -35,6 +37,29 @@ const (
 	PushMethodMQTT = "mqtt"
 )
 
+const (
+	DataPlaneSinkInfluxDB              = "influxdb"
+	DataPlaneSinkPrometheusRemoteWrite = "prometheusRemoteWrite"
+	DataPlaneSinkMQTT                  = "mqtt"
+	DataPlaneSinkCSV                   = "csv"
+)
+
+const (
+	DefaultDataPlaneBatchSize     = 1000
+	DefaultDataPlaneFlushInterval = time.Second
+	DefaultDataPlaneBufferSize    = 10000
+)
+
+// Firmware upgrade state definition.
+const (
+	FirmwareStateIdle        = "Idle"
+	FirmwareStateDownloading = "Downloading"
+	FirmwareStateVerifying   = "Verifying"
+	FirmwareStateApplying    = "Applying"
+	FirmwareStateSucceeded   = "Succeeded"
+	FirmwareStateFailed      = "Failed"
+)
+
 const DefaultCollectCycle = time.Second
 const DefaultReportCycle = time.Second
 
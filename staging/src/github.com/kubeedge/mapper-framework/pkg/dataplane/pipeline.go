@@ -0,0 +1,229 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataplane
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/global"
+)
+
+// Pipeline streams the samples of a device to its edge-local sinks. Every sink has
+// its own bounded buffer and writer, so that a slow sink neither blocks the sampling
+// nor the other sinks. When a buffer is full the oldest samples are dropped.
+type Pipeline struct {
+	deviceName    string
+	properties    map[string]bool
+	sampleRate    int32
+	batchSize     int
+	flushInterval time.Duration
+	writers       []*sinkWriter
+}
+
+// NewPipeline creates the pipeline of the device for the given sinks, keyed by sink name.
+func NewPipeline(deviceName string, config *common.DataPlaneConfig, sinks map[string]global.DataPlaneSink) *Pipeline {
+	p := &Pipeline{
+		deviceName:    deviceName,
+		sampleRate:    config.SampleRate,
+		batchSize:     int(config.BatchSize),
+		flushInterval: time.Duration(config.FlushInterval) * time.Millisecond,
+	}
+	if p.batchSize <= 0 {
+		p.batchSize = common.DefaultDataPlaneBatchSize
+	}
+	if p.flushInterval <= 0 {
+		p.flushInterval = common.DefaultDataPlaneFlushInterval
+	}
+	bufferSize := int(config.BufferSize)
+	if bufferSize <= 0 {
+		bufferSize = common.DefaultDataPlaneBufferSize
+	}
+	if bufferSize < p.batchSize {
+		bufferSize = p.batchSize
+	}
+	if len(config.Properties) > 0 {
+		p.properties = make(map[string]bool, len(config.Properties))
+		for _, name := range config.Properties {
+			p.properties[name] = true
+		}
+	}
+	for _, sinkConfig := range config.Sinks {
+		sink, ok := sinks[sinkConfig.Name]
+		if !ok {
+			continue
+		}
+		p.writers = append(p.writers, &sinkWriter{
+			name:   sinkConfig.Name,
+			sink:   sink,
+			buffer: newRing(bufferSize),
+			notify: make(chan struct{}, 1),
+		})
+	}
+	return p
+}
+
+// Streams reports whether the property is streamed by the pipeline.
+func (p *Pipeline) Streams(propertyName string) bool {
+	return p.properties == nil || p.properties[propertyName]
+}
+
+// SampleInterval returns the interval between two samples of a property, given by the
+// sample rate of the pipeline or else by the collect cycle of the property.
+func (p *Pipeline) SampleInterval(collectCycle time.Duration) time.Duration {
+	if p.sampleRate > 0 {
+		return time.Second / time.Duration(p.sampleRate)
+	}
+	if collectCycle > 0 {
+		return collectCycle
+	}
+	return common.DefaultCollectCycle
+}
+
+// Push queues a sample for all sinks, it never blocks.
+func (p *Pipeline) Push(data *common.DataModel) {
+	for _, w := range p.writers {
+		w.push(data, p.batchSize)
+	}
+}
+
+// Run writes the queued samples to the sinks until ctx is done, then writes the
+// remaining samples and closes the sinks.
+func (p *Pipeline) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, w := range p.writers {
+		wg.Add(1)
+		go func(w *sinkWriter) {
+			defer wg.Done()
+			w.run(ctx, p.deviceName, p.batchSize, p.flushInterval)
+		}(w)
+	}
+	wg.Wait()
+}
+
+// sinkWriter buffers the samples of a sink and writes them in batches.
+type sinkWriter struct {
+	name    string
+	sink    global.DataPlaneSink
+	mu      sync.Mutex
+	buffer  *ring
+	dropped uint64
+	notify  chan struct{}
+}
+
+func (w *sinkWriter) push(data *common.DataModel, batchSize int) {
+	w.mu.Lock()
+	if w.buffer.push(data) {
+		w.dropped++
+		if w.dropped == 1 || w.dropped%uint64(w.buffer.capacity()) == 0 {
+			klog.Warningf("data plane sink %s cannot keep up, dropped %d samples in total", w.name, w.dropped)
+		}
+	}
+	full := w.buffer.len() >= batchSize
+	w.mu.Unlock()
+	if full {
+		select {
+		case w.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (w *sinkWriter) run(ctx context.Context, deviceName string, batchSize int, flushInterval time.Duration) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.flush(deviceName, batchSize, true)
+		case <-w.notify:
+			w.flush(deviceName, batchSize, false)
+		case <-ctx.Done():
+			w.flush(deviceName, batchSize, true)
+			if err := w.sink.Close(); err != nil {
+				klog.Errorf("failed to close data plane sink %s of device %s: %v", w.name, deviceName, err)
+			}
+			return
+		}
+	}
+}
+
+// flush writes the full batches, and the last partial batch too if all is set.
+func (w *sinkWriter) flush(deviceName string, batchSize int, all bool) {
+	for {
+		w.mu.Lock()
+		if w.buffer.len() == 0 || (!all && w.buffer.len() < batchSize) {
+			w.mu.Unlock()
+			return
+		}
+		batch := w.buffer.pop(batchSize)
+		w.mu.Unlock()
+		if err := w.sink.Write(batch); err != nil {
+			klog.Errorf("failed to write %d samples of device %s to data plane sink %s: %v", len(batch), deviceName, w.name, err)
+		}
+	}
+}
+
+// ring is a fixed size FIFO queue which overwrites its oldest item when full.
+type ring struct {
+	items []*common.DataModel
+	head  int
+	size  int
+}
+
+func newRing(capacity int) *ring {
+	return &ring{items: make([]*common.DataModel, capacity)}
+}
+
+func (r *ring) len() int {
+	return r.size
+}
+
+func (r *ring) capacity() int {
+	return len(r.items)
+}
+
+// push appends item and reports whether the oldest item was dropped to make room for it.
+func (r *ring) push(item *common.DataModel) bool {
+	if r.size == len(r.items) {
+		r.items[r.head] = item
+		r.head = (r.head + 1) % len(r.items)
+		return true
+	}
+	r.items[(r.head+r.size)%len(r.items)] = item
+	r.size++
+	return false
+}
+
+// pop removes and returns up to n of the oldest items.
+func (r *ring) pop(n int) []*common.DataModel {
+	if n > r.size {
+		n = r.size
+	}
+	res := make([]*common.DataModel, n)
+	for i := range res {
+		res[i] = r.items[(r.head+i)%len(r.items)]
+		r.items[(r.head+i)%len(r.items)] = nil
+	}
+	r.head = (r.head + n) % len(r.items)
+	r.size -= n
+	return res
+}
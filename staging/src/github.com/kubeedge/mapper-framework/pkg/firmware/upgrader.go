@@ -0,0 +1,237 @@
+/*
+Copyright 2024 The KubeEdge Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firmware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	dmiapi "github.com/kubeedge/kubeedge/pkg/apis/dmi/v1beta1"
+	"github.com/kubeedge/mapper-framework/pkg/common"
+	"github.com/kubeedge/mapper-framework/pkg/grpcclient"
+)
+
+const checksumPrefixSHA256 = "sha256:"
+
+// ApplyFunc writes the verified firmware image at imagePath to the device, and returns
+// the firmware version running on the device after the upgrade
+type ApplyFunc func(ctx context.Context, imagePath string) (string, error)
+
+// Upgrader runs the firmware upgrades of a device. An upgrade goes from Idle through Downloading,
+// Verifying and Applying to Succeeded or Failed, and every state transition is reported to edgecore.
+type Upgrader struct {
+	deviceName      string
+	deviceNamespace string
+	client          *http.Client
+
+	mu     sync.Mutex
+	status dmiapi.DeviceFirmwareStatus
+	// running is the upgrade in progress
+	running *upgradeRun
+	// failed is the desired firmware of the latest failed upgrade
+	failed *common.FirmwareConfig
+}
+
+type upgradeRun struct {
+	ctx  context.Context
+	done chan struct{}
+}
+
+// NewUpgrader returns the Upgrader of the device running the firmware version
+func NewUpgrader(deviceName, deviceNamespace, version string) *Upgrader {
+	return &Upgrader{
+		deviceName:      deviceName,
+		deviceNamespace: deviceNamespace,
+		client:          &http.Client{},
+		status: dmiapi.DeviceFirmwareStatus{
+			Version: version,
+			State:   common.FirmwareStateIdle,
+		},
+	}
+}
+
+// Status returns the latest firmware state of the device. The mapper reports it with the twins,
+// so a state transition lost while edgecore is disconnected from the cloud is synced later.
+func (u *Upgrader) Status() *dmiapi.DeviceFirmwareStatus {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return &dmiapi.DeviceFirmwareStatus{
+		Version:            u.status.Version,
+		DesiredVersion:     u.status.DesiredVersion,
+		State:              u.status.State,
+		Reason:             u.status.Reason,
+		LastTransitionTime: u.status.LastTransitionTime,
+	}
+}
+
+// Sync starts the upgrade to the desired firmware in the background, unless the device already
+// runs the desired version or an upgrade is in progress. A failed upgrade is not retried until
+// the desired firmware changes. The upgrade is interrupted when ctx is done, which happens when
+// the device is restarted, and the next Sync waits for the interrupted upgrade and starts it again.
+func (u *Upgrader) Sync(ctx context.Context, desired *common.FirmwareConfig, apply ApplyFunc) {
+	if desired == nil || desired.Version == "" {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if run := u.running; run != nil {
+		if run.ctx.Err() == nil {
+			return
+		}
+		u.mu.Unlock()
+		<-run.done
+		u.mu.Lock()
+	}
+	if u.running != nil || u.status.Version == desired.Version {
+		return
+	}
+	if u.failed != nil && *u.failed == *desired {
+		return
+	}
+	run := &upgradeRun{ctx: ctx, done: make(chan struct{})}
+	u.running = run
+	u.failed = nil
+	u.status.DesiredVersion = desired.Version
+	go u.upgrade(run, *desired, apply)
+}
+
+func (u *Upgrader) upgrade(run *upgradeRun, desired common.FirmwareConfig, apply ApplyFunc) {
+	ctx := run.ctx
+	defer func() {
+		u.mu.Lock()
+		u.running = nil
+		u.mu.Unlock()
+		close(run.done)
+	}()
+
+	klog.Infof("start to upgrade firmware of device %s to version %s", u.deviceName, desired.Version)
+	u.transition(common.FirmwareStateDownloading, "")
+	path, digest, err := u.download(ctx, desired.URL)
+	if path != "" {
+		defer os.Remove(path)
+	}
+	if err != nil {
+		u.fail(ctx, &desired, fmt.Sprintf("failed to download firmware: %v", err))
+		return
+	}
+
+	u.transition(common.FirmwareStateVerifying, "")
+	if err := verifyChecksum(desired.Checksum, digest); err != nil {
+		u.fail(ctx, &desired, err.Error())
+		return
+	}
+
+	u.transition(common.FirmwareStateApplying, "")
+	version, err := apply(ctx, path)
+	if err != nil {
+		u.fail(ctx, &desired, fmt.Sprintf("failed to apply firmware: %v", err))
+		return
+	}
+	if version == "" {
+		version = desired.Version
+	}
+
+	u.mu.Lock()
+	u.status.Version = version
+	u.mu.Unlock()
+	u.transition(common.FirmwareStateSucceeded, "")
+	klog.Infof("upgrade firmware of device %s to version %s successfully", u.deviceName, version)
+}
+
+// download saves the firmware image to a temporary file, it returns the path of the file
+// and the sha256 digest of the image
+func (u *Upgrader) download(ctx context.Context, url string) (string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", "", err
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	file, err := os.CreateTemp("", "firmware-*.bin")
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(file, hash), resp.Body); err != nil {
+		return file.Name(), "", err
+	}
+	return file.Name(), hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func verifyChecksum(checksum, digest string) error {
+	if !strings.HasPrefix(checksum, checksumPrefixSHA256) {
+		return fmt.Errorf("unsupported checksum %q, only sha256 is supported", checksum)
+	}
+	if expected := strings.TrimPrefix(checksum, checksumPrefixSHA256); !strings.EqualFold(expected, digest) {
+		return fmt.Errorf("checksum mismatch, expected %s but got %s", expected, digest)
+	}
+	return nil
+}
+
+// fail reports the failed upgrade, the upgrade interrupted by ctx goes back to Idle to be started again
+func (u *Upgrader) fail(ctx context.Context, desired *common.FirmwareConfig, reason string) {
+	if ctx.Err() != nil {
+		klog.Warningf("upgrade firmware of device %s is interrupted: %s", u.deviceName, reason)
+		u.transition(common.FirmwareStateIdle, "upgrade is interrupted")
+		return
+	}
+	klog.Errorf("failed to upgrade firmware of device %s to version %s: %s", u.deviceName, desired.Version, reason)
+	u.mu.Lock()
+	u.failed = desired
+	u.mu.Unlock()
+	u.transition(common.FirmwareStateFailed, reason)
+}
+
+func (u *Upgrader) transition(state, reason string) {
+	u.mu.Lock()
+	u.status.State = state
+	u.status.Reason = reason
+	u.status.LastTransitionTime = time.Now().UTC().Format(time.RFC3339)
+	u.mu.Unlock()
+
+	request := &dmiapi.ReportDeviceStatusRequest{
+		DeviceName:      u.deviceName,
+		DeviceNamespace: u.deviceNamespace,
+		ReportedDevice: &dmiapi.DeviceStatus{
+			Firmware: u.Status(),
+		},
+	}
+	if err := grpcclient.ReportDeviceStatus(request); err != nil {
+		klog.Errorf("fail to report firmware state %s of device %s with err: %v", state, u.deviceName, err)
+	}
+}
@@ -41,6 +41,16 @@ type DataPanel interface {
 	Push(data *common.DataModel)
 }
 
+// DataPlaneSink defined an edge-local sink the data plane streams device telemetry to
+type DataPlaneSink interface {
+	// InitSink initialization operation before write
+	InitSink() error
+	// Write writes a batch of samples, which may belong to different properties
+	Write(data []*common.DataModel) error
+	// Close flushes and releases the sink
+	Close() error
+}
+
 // DataBaseClient defined database interface, save data and provide data to REST API
 type DataBaseClient interface {
 	// TODO add more interface
@@ -2,6 +2,7 @@ package parse
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"k8s.io/klog/v2"
 
@@ -28,7 +29,9 @@ func BuildProtocolFromGrpc(device *dmiapi.Device) (common.ProtocolConfig, error)
 
 	customizedProtocol := make(map[string]interface{})
 	customizedProtocol["protocolName"] = protocolName
-	if device.Spec.Protocol.ConfigData != nil {
+	if opcua := device.Spec.Protocol.Opcua; opcua != nil {
+		customizedProtocol["configData"] = buildOpcUAProtocolConfig(opcua)
+	} else if device.Spec.Protocol.ConfigData != nil {
 		recvAdapter := make(map[string]interface{})
 		for k, v := range device.Spec.Protocol.ConfigData.Data {
 			value, err := common.DecodeAnyValue(v)
@@ -50,6 +53,71 @@ func BuildProtocolFromGrpc(device *dmiapi.Device) (common.ProtocolConfig, error)
 	}, nil
 }
 
+// buildOpcUAProtocolConfig converts the OPC UA protocol config of the device manager
+// into the configData of the protocol.
+func buildOpcUAProtocolConfig(opcua *dmiapi.ProtocolConfigOpcUA) common.OpcUAProtocolConfig {
+	config := common.OpcUAProtocolConfig{
+		URL:               opcua.Url,
+		SecurityPolicy:    opcua.SecurityPolicy,
+		SecurityMode:      opcua.SecurityMode,
+		Certificate:       opcua.Certificate,
+		PrivateKey:        opcua.PrivateKey,
+		ServerCertificate: opcua.ServerCertificate,
+		Timeout:           opcua.Timeout,
+	}
+	if auth := opcua.Authentication; auth != nil {
+		config.Authentication = &common.OpcUAAuthentication{
+			Type:         auth.Type,
+			UserName:     auth.UserName,
+			PasswordFile: auth.PasswordFile,
+		}
+	}
+	return config
+}
+
+// buildDataPlaneFromGrpc converts the data plane of the device, the config of every
+// sink is kept as the JSON of its sink type.
+func buildDataPlaneFromGrpc(device *dmiapi.Device) (*common.DataPlaneConfig, error) {
+	dataPlane := device.GetSpec().GetDataPlane()
+	if dataPlane == nil {
+		return nil, nil
+	}
+	res := &common.DataPlaneConfig{
+		Properties:    dataPlane.GetProperties(),
+		SampleRate:    dataPlane.GetSampleRate(),
+		BatchSize:     dataPlane.GetBatchSize(),
+		FlushInterval: dataPlane.GetFlushInterval(),
+		BufferSize:    dataPlane.GetBufferSize(),
+	}
+	for _, sink := range dataPlane.GetSinks() {
+		var sinkType string
+		var sinkConfig interface{}
+		switch {
+		case sink.Influxdb != nil:
+			sinkType, sinkConfig = common.DataPlaneSinkInfluxDB, sink.Influxdb
+		case sink.PrometheusRemoteWrite != nil:
+			sinkType, sinkConfig = common.DataPlaneSinkPrometheusRemoteWrite, sink.PrometheusRemoteWrite
+		case sink.Mqtt != nil:
+			sinkType, sinkConfig = common.DataPlaneSinkMQTT, sink.Mqtt
+		case sink.Csv != nil:
+			sinkType, sinkConfig = common.DataPlaneSinkCSV, sink.Csv
+		default:
+			klog.Errorf("data plane sink %s of device %s has no config", sink.GetName(), device.GetName())
+			continue
+		}
+		config, err := json.Marshal(sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal data plane sink %s, err: %v", sink.GetName(), err)
+		}
+		res.Sinks = append(res.Sinks, common.DataPlaneSinkConfig{
+			Name:       sink.GetName(),
+			SinkType:   sinkType,
+			SinkConfig: config,
+		})
+	}
+	return res, nil
+}
+
 func buildTwinsFromGrpc(device *dmiapi.Device) []common.Twin {
 	if len(device.Spec.Properties) == 0 {
 		return nil
@@ -84,17 +152,26 @@ func buildPropertiesFromGrpc(device *dmiapi.Device) []common.DeviceProperty {
 	for _, pptv := range device.Spec.Properties {
 		// get visitorConfig filed by grpc device instance
 		var visitorConfig []byte
-		recvAdapter := make(map[string]interface{})
-		for k, v := range pptv.Visitors.ConfigData.Data {
-			value, err := common.DecodeAnyValue(v)
-			if err != nil {
-				continue
-			}
-			recvAdapter[k] = value
-		}
 		customizedProtocol := make(map[string]interface{})
 		customizedProtocol["protocolName"] = pptv.Visitors.ProtocolName
-		customizedProtocol["configData"] = recvAdapter
+		if opcua := pptv.Visitors.Opcua; opcua != nil {
+			customizedProtocol["configData"] = common.OpcUAVisitorConfig{
+				NodeID:               opcua.NodeID,
+				BrowseName:           opcua.BrowseName,
+				SubscriptionInterval: opcua.SubscriptionInterval,
+				SamplingInterval:     opcua.SamplingInterval,
+			}
+		} else {
+			recvAdapter := make(map[string]interface{})
+			for k, v := range pptv.Visitors.GetConfigData().GetData() {
+				value, err := common.DecodeAnyValue(v)
+				if err != nil {
+					continue
+				}
+				recvAdapter[k] = value
+			}
+			customizedProtocol["configData"] = recvAdapter
+		}
 		visitorConfig, err = json.Marshal(customizedProtocol)
 		if err != nil {
 			klog.Errorf("err: %+v", err)
@@ -246,6 +323,18 @@ func GetDeviceFromGrpc(device *dmiapi.Device, commonModel *common.DeviceModel) (
 		Twins:        buildTwinsFromGrpc(device),
 		Properties:   buildPropertiesFromGrpc(device),
 	}
+	dataPlane, err := buildDataPlaneFromGrpc(device)
+	if err != nil {
+		return nil, err
+	}
+	instance.DataPlane = dataPlane
+	if firmware := device.GetSpec().GetFirmware(); firmware != nil {
+		instance.Firmware = &common.FirmwareConfig{
+			Version:  firmware.GetVersion(),
+			URL:      firmware.GetUrl(),
+			Checksum: firmware.GetChecksum(),
+		}
+	}
 	// copy Properties to twin
 	propertiesMap := make(map[string]common.DeviceProperty)
 	for i := range instance.Properties {
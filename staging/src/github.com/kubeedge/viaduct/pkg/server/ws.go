@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/x509"
 	glog "log"
 	"net/http"
 	"os"
@@ -87,8 +88,9 @@ func (srv *WSServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		Handler:  srv.options.Handler,
 		CtrlLane: lane.NewLane(api.ProtocolTypeWS, wsConn),
 		State: &conn.ConnectionState{
-			State:   api.StatConnected,
-			Headers: req.Header.Clone(),
+			State:            api.StatConnected,
+			Headers:          req.Header.Clone(),
+			PeerCertificates: peerCertificates(req),
 		},
 		AutoRoute:          srv.options.AutoRoute,
 		OnReadTransportErr: srv.options.OnReadTransportErr,
@@ -118,3 +120,11 @@ func (srv *WSServer) Close() error {
 	}
 	return nil
 }
+
+// peerCertificates returns the certificates the client presents in the TLS handshake
+func peerCertificates(req *http.Request) []*x509.Certificate {
+	if req.TLS == nil {
+		return nil
+	}
+	return req.TLS.PeerCertificates
+}